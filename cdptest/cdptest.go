@@ -0,0 +1,216 @@
+// Package cdptest provides a scriptable in-process server that speaks the DevTools protocol, for testing code that uses cdp.Dial.
+package cdptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/neelance/cdp-go/devtools"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// HandlerFunc answers a command. If it returns an *rpc.ProtocolError, its code, message and data are sent to the client.
+// Any other error is sent as a server error with the error's text as message.
+type HandlerFunc func(params json.RawMessage) (result interface{}, err error)
+
+// Call is a command received by the server.
+type Call struct {
+	Method string
+	Params json.RawMessage
+}
+
+// Server is a fake browser. Commands without a registered handler are answered with a "method not found" error.
+type Server struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	handlers map[string]HandlerFunc
+	calls    []Call
+	conns    map[*conn]struct{}
+}
+
+type conn struct {
+	ws      *websocket.Conn
+	sending sync.Mutex
+}
+
+// NewServer starts a server. It serves WebSocket connections on every path below /devtools/ and the HTTP endpoints /json/version and /json/list.
+func NewServer() *Server {
+	s := &Server{
+		handlers: make(map[string]HandlerFunc),
+		conns:    make(map[*conn]struct{}),
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/devtools/", websocket.Handler(s.serveConn))
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, &devtools.Version{
+			Browser:              "cdptest/1.0",
+			ProtocolVersion:      "1.2",
+			WebSocketDebuggerURL: s.WebSocketURL(),
+		})
+	})
+	mux.HandleFunc("/json/list", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, []*devtools.Target{{
+			ID:                   "cdptest",
+			Type:                 "page",
+			Title:                "cdptest",
+			URL:                  "about:blank",
+			WebSocketDebuggerURL: s.wsURL("/devtools/page/cdptest"),
+		}})
+	})
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// WebSocketURL returns the WebSocket debugger URL of the browser target, to be passed to cdp.Dial.
+// The embedded Server's URL is the HTTP endpoint, to be passed to devtools.New.
+func (s *Server) WebSocketURL() string {
+	return s.wsURL("/devtools/browser/cdptest")
+}
+
+func (s *Server) wsURL(path string) string {
+	return "ws" + strings.TrimPrefix(s.Server.URL, "http") + path
+}
+
+// Handle registers the handler for the given method, e.g. "Page.navigate".
+func (s *Server) Handle(method string, h HandlerFunc) {
+	s.mutex.Lock()
+	s.handlers[method] = h
+	s.mutex.Unlock()
+}
+
+// HandleResult registers a handler that always answers the given method with result.
+func (s *Server) HandleResult(method string, result interface{}) {
+	s.Handle(method, func(json.RawMessage) (interface{}, error) {
+		return result, nil
+	})
+}
+
+// Emit sends an event to all connected clients.
+func (s *Server) Emit(method string, params interface{}) error {
+	for _, c := range s.connections() {
+		if err := c.send(map[string]interface{}{"method": method, "params": params}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Calls returns all commands received so far, in order.
+func (s *Server) Calls() []Call {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Call(nil), s.calls...)
+}
+
+// Methods returns the methods of all commands received so far, in order.
+func (s *Server) Methods() []string {
+	var methods []string
+	for _, c := range s.Calls() {
+		methods = append(methods, c.Method)
+	}
+	return methods
+}
+
+// ExpectMethods returns an error if the methods received so far differ from the given sequence.
+func (s *Server) ExpectMethods(methods ...string) error {
+	got := s.Methods()
+	if strings.Join(got, ",") != strings.Join(methods, ",") {
+		return fmt.Errorf("cdptest: got calls %v, expected %v", got, methods)
+	}
+	return nil
+}
+
+// Disconnect closes all client connections, as if the browser went away.
+func (s *Server) Disconnect() {
+	for _, c := range s.connections() {
+		c.ws.Close()
+	}
+}
+
+// Close disconnects all clients and shuts the server down.
+func (s *Server) Close() {
+	s.Disconnect()
+	s.Server.Close()
+}
+
+func (s *Server) connections() []*conn {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var conns []*conn
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	return conns
+}
+
+func (s *Server) serveConn(ws *websocket.Conn) {
+	c := &conn{ws: ws}
+	s.mutex.Lock()
+	s.conns[c] = struct{}{}
+	s.mutex.Unlock()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.conns, c)
+		s.mutex.Unlock()
+		ws.Close()
+	}()
+
+	for {
+		var req struct {
+			ID     uint64          `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := websocket.JSON.Receive(ws, &req); err != nil {
+			return
+		}
+
+		s.mutex.Lock()
+		s.calls = append(s.calls, Call{Method: req.Method, Params: req.Params})
+		h := s.handlers[req.Method]
+		s.mutex.Unlock()
+
+		var result interface{}
+		var err error
+		if h != nil {
+			result, err = h(req.Params)
+		} else {
+			err = &rpc.ProtocolError{Code: rpc.CodeMethodNotFound, Message: fmt.Sprintf("'%s' wasn't found", req.Method)}
+		}
+
+		resp := map[string]interface{}{"id": req.ID}
+		if err != nil {
+			perr, ok := err.(*rpc.ProtocolError)
+			if !ok {
+				perr = &rpc.ProtocolError{Code: rpc.CodeServerError, Message: err.Error()}
+			}
+			resp["error"] = perr
+		} else {
+			if result == nil {
+				result = struct{}{}
+			}
+			resp["result"] = result
+		}
+		if err := c.send(resp); err != nil {
+			return
+		}
+	}
+}
+
+func (c *conn) send(v interface{}) error {
+	c.sending.Lock()
+	defer c.sending.Unlock()
+	return websocket.JSON.Send(c.ws, v)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -1,8 +1,6 @@
 package cdp
 
 import (
-	"golang.org/x/net/websocket"
-
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/accessibility"
@@ -84,14 +82,9 @@ type Client struct {
 	Tracing           tracing.Client
 }
 
-func Dial(url string) *Client {
-	conn, err := websocket.Dial(url, "", url)
-	if err != nil {
-		panic(err)
-	}
-
-	cl := rpc.NewClient(conn)
-	return &Client{
+// NewClient returns a Client that sends all commands of all domains over cl.
+func NewClient(cl *rpc.Client) *Client {
+	c := &Client{
 		Client: cl,
 
 		Accessibility:     accessibility.Client{Client: cl},
@@ -131,4 +124,6 @@ func Dial(url string) *Client {
 		Tethering:         tethering.Client{Client: cl},
 		Tracing:           tracing.Client{Client: cl},
 	}
+	c.watchInspector()
+	return c
 }
@@ -0,0 +1,32 @@
+package cdp
+
+import (
+	"errors"
+
+	"github.com/neelance/cdp-go/protocol/inspector"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// DetachedError is returned by calls on a client whose target detached the debugging connection,
+// e.g. because the target was closed or replaced by another debugging client.
+type DetachedError struct {
+	Reason string
+}
+
+func (e *DetachedError) Error() string {
+	return "cdp: detached from target: " + e.Reason
+}
+
+// ErrTargetCrashed is returned by calls on a client whose target has crashed.
+var ErrTargetCrashed = errors.New("cdp: target crashed")
+
+// watchInspector closes the connection when the target reports that it is going away, so pending calls fail
+// with the reason instead of waiting for the socket to close. See Err for the reason after Done is closed.
+func (c *Client) watchInspector() {
+	c.Inspector.OnDetached(func(e *inspector.DetachedEvent) {
+		c.CloseWithError(&DetachedError{Reason: e.Reason})
+	}, rpc.Ordered())
+	c.Inspector.OnTargetCrashed(func(e *inspector.TargetCrashedEvent) {
+		c.CloseWithError(ErrTargetCrashed)
+	}, rpc.Ordered())
+}
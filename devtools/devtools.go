@@ -0,0 +1,117 @@
+// Package devtools implements a client for the HTTP endpoints of the DevTools server
+// (/json/version, /json/list, /json/new, /json/activate and /json/close).
+package devtools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/neelance/cdp-go/protocol/target"
+)
+
+// DevTools is the HTTP endpoint of a browser's remote debugging server, e.g. "http://localhost:9222".
+type DevTools struct {
+	URL    string
+	Client *http.Client
+}
+
+// Version is the browser version information returned by /json/version.
+type Version struct {
+	Browser              string `json:"Browser"`
+	ProtocolVersion      string `json:"Protocol-Version"`
+	UserAgent            string `json:"User-Agent"`
+	V8Version            string `json:"V8-Version"`
+	WebKitVersion        string `json:"WebKit-Version"`
+	WebSocketDebuggerURL string `json:"webSocketDebuggerUrl"`
+}
+
+// Target describes a debuggable target. Its WebSocketDebuggerURL can be passed to cdp.DialContext.
+type Target struct {
+	ID                   target.TargetID `json:"id"`
+	Type                 string          `json:"type"`
+	Title                string          `json:"title"`
+	Description          string          `json:"description"`
+	URL                  string          `json:"url"`
+	FaviconURL           string          `json:"faviconUrl"`
+	DevtoolsFrontendURL  string          `json:"devtoolsFrontendUrl"`
+	WebSocketDebuggerURL string          `json:"webSocketDebuggerUrl"`
+}
+
+// New returns a DevTools client for the given HTTP endpoint.
+func New(url string) *DevTools {
+	return &DevTools{URL: strings.TrimSuffix(url, "/"), Client: http.DefaultClient}
+}
+
+// Version returns the browser version information.
+func (d *DevTools) Version(ctx context.Context) (*Version, error) {
+	var v Version
+	if err := d.do(ctx, "GET", "/json/version", &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// List returns all targets, including pages, workers and service workers.
+func (d *DevTools) List(ctx context.Context) ([]*Target, error) {
+	var targets []*Target
+	if err := d.do(ctx, "GET", "/json/list", &targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+// NewTarget opens a new tab. An empty url opens about:blank.
+func (d *DevTools) NewTarget(ctx context.Context, u string) (*Target, error) {
+	path := "/json/new"
+	if u != "" {
+		path += "?" + url.QueryEscape(u)
+	}
+	var t Target
+	if err := d.do(ctx, "PUT", path, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Activate brings the target's tab to the foreground.
+func (d *DevTools) Activate(ctx context.Context, id target.TargetID) error {
+	return d.do(ctx, "GET", "/json/activate/"+string(id), nil)
+}
+
+// Close closes the target.
+func (d *DevTools) Close(ctx context.Context, id target.TargetID) error {
+	return d.do(ctx, "GET", "/json/close/"+string(id), nil)
+}
+
+func (d *DevTools) do(ctx context.Context, method, path string, v interface{}) error {
+	req, err := http.NewRequest(method, d.URL+path, nil)
+	if err != nil {
+		return err
+	}
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("devtools: %s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	if v == nil {
+		return nil
+	}
+	return json.Unmarshal(body, v)
+}
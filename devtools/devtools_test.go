@@ -0,0 +1,107 @@
+package devtools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type request struct {
+	method string
+	path   string
+	query  string
+}
+
+func newServer(t *testing.T) (*DevTools, *[]request) {
+	var requests []request
+	mux := http.NewServeMux()
+	mux.HandleFunc("/json/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Browser": "Chrome/60.0", "Protocol-Version": "1.2", "webSocketDebuggerUrl": "ws://host/devtools/browser/b"}`))
+	})
+	mux.HandleFunc("/json/list", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"id": "p1", "type": "page", "url": "about:blank", "webSocketDebuggerUrl": "ws://host/devtools/page/p1"}]`))
+	})
+	mux.HandleFunc("/json/new", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, request{r.Method, r.URL.Path, r.URL.RawQuery})
+		w.Write([]byte(`{"id": "p2", "type": "page", "webSocketDebuggerUrl": "ws://host/devtools/page/p2"}`))
+	})
+	mux.HandleFunc("/json/activate/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, request{r.Method, r.URL.Path, r.URL.RawQuery})
+		w.Write([]byte("Target activated"))
+	})
+	mux.HandleFunc("/json/close/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, request{r.Method, r.URL.Path, r.URL.RawQuery})
+		if strings.HasSuffix(r.URL.Path, "/unknown") {
+			http.Error(w, "No such target id: unknown", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("Target is closing"))
+	})
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return New(s.URL + "/"), &requests
+}
+
+func TestVersion(t *testing.T) {
+	d, _ := newServer(t)
+	v, err := d.Version(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Browser != "Chrome/60.0" || v.ProtocolVersion != "1.2" || v.WebSocketDebuggerURL != "ws://host/devtools/browser/b" {
+		t.Errorf("unexpected version: %+v", v)
+	}
+}
+
+func TestList(t *testing.T) {
+	d, _ := newServer(t)
+	targets, err := d.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 1 || targets[0].ID != "p1" || targets[0].WebSocketDebuggerURL != "ws://host/devtools/page/p1" {
+		t.Errorf("unexpected targets: %+v", targets)
+	}
+}
+
+func TestNewTarget(t *testing.T) {
+	d, requests := newServer(t)
+	target, err := d.NewTarget(context.Background(), "http://example.com/?a=1&b=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.ID != "p2" {
+		t.Errorf("unexpected target: %+v", target)
+	}
+	want := request{"PUT", "/json/new", "http%3A%2F%2Fexample.com%2F%3Fa%3D1%26b%3D2"}
+	if len(*requests) != 1 || (*requests)[0] != want {
+		t.Errorf("got requests %v, want %v", *requests, want)
+	}
+}
+
+func TestActivateAndClose(t *testing.T) {
+	d, requests := newServer(t)
+	if err := d.Activate(context.Background(), "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Close(context.Background(), "p1"); err != nil {
+		t.Fatal(err)
+	}
+	want := []request{{"GET", "/json/activate/p1", ""}, {"GET", "/json/close/p1", ""}}
+	if len(*requests) != 2 || (*requests)[0] != want[0] || (*requests)[1] != want[1] {
+		t.Errorf("got requests %v, want %v", *requests, want)
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	d, _ := newServer(t)
+	err := d.Close(context.Background(), "unknown")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "404") || !strings.Contains(err.Error(), "No such target id: unknown") {
+		t.Errorf("error does not contain status and body: %v", err)
+	}
+}
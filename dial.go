@@ -0,0 +1,125 @@
+package cdp
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/neelance/cdp-go/rpc"
+)
+
+type dialOptions struct {
+	handshakeTimeout time.Duration
+	header           http.Header
+	tlsConfig        *tls.Config
+	origin           string
+	clientOptions    []rpc.Option
+	reconnect        bool
+}
+
+// Option configures DialContext.
+type Option func(*dialOptions)
+
+// WithHandshakeTimeout limits the time spent on establishing the WebSocket connection.
+func WithHandshakeTimeout(d time.Duration) Option {
+	return func(o *dialOptions) {
+		o.handshakeTimeout = d
+	}
+}
+
+// WithHeader adds HTTP headers to the WebSocket handshake request, e.g. for authentication.
+func WithHeader(header http.Header) Option {
+	return func(o *dialOptions) {
+		for k, v := range header {
+			o.header[k] = append(o.header[k], v...)
+		}
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used for wss:// URLs.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(o *dialOptions) {
+		o.tlsConfig = config
+	}
+}
+
+// WithOrigin sets the Origin of the WebSocket handshake. It defaults to the URL being dialed.
+func WithOrigin(origin string) Option {
+	return func(o *dialOptions) {
+		o.origin = origin
+	}
+}
+
+// WithClientOptions passes options to rpc.NewClient, e.g. interceptors.
+func WithClientOptions(opts ...rpc.Option) Option {
+	return func(o *dialOptions) {
+		o.clientOptions = append(o.clientOptions, opts...)
+	}
+}
+
+// WithReconnect makes the Client reconnect if the WebSocket connection fails, instead of terminating.
+// After reconnecting, the domains that were enabled are enabled again and persistent configuration like
+// Network.setExtraHTTPHeaders, Network.setBlockedURLs, Emulation.setDeviceMetricsOverride and
+// Page.addScriptToEvaluateOnLoad is replayed. Use rpc.OnGap to be notified of events that may have been lost.
+// See rpc.WithReconnect for the handling of calls.
+func WithReconnect() Option {
+	return func(o *dialOptions) {
+		o.reconnect = true
+	}
+}
+
+// DialContext connects to the WebSocket debugger URL of a browser or target.
+// The context only limits connection establishment; it does not affect the returned Client.
+func DialContext(ctx context.Context, url string, opts ...Option) (*Client, error) {
+	o := &dialOptions{
+		header: make(http.Header),
+		origin: url,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	config, err := websocket.NewConfig(url, o.origin)
+	if err != nil {
+		return nil, err
+	}
+	config.Header = o.header
+	config.TlsConfig = o.tlsConfig
+
+	dial := func(ctx context.Context) (io.ReadWriteCloser, error) {
+		if o.handshakeTimeout != 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, o.handshakeTimeout)
+			defer cancel()
+		}
+		return config.DialContext(ctx)
+	}
+	conn, err := dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientOptions := o.clientOptions
+	if o.reconnect {
+		state := &connState{}
+		// the state interceptor is innermost, so it only records calls that actually reached the browser
+		clientOptions = append(clientOptions[:len(clientOptions):len(clientOptions)],
+			rpc.WithCallInterceptor(state.intercept),
+			rpc.WithReconnect(dial, state.restore),
+		)
+	}
+	return NewClient(rpc.NewClient(conn, clientOptions...)), nil
+}
+
+// Dial is like DialContext, but panics if the connection can not be established.
+func Dial(url string) *Client {
+	cl, err := DialContext(context.Background(), url)
+	if err != nil {
+		panic(err)
+	}
+	return cl
+}
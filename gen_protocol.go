@@ -5,11 +5,13 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"flag"
 	"io/ioutil"
 	"os"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
 )
 
 type Protocol struct {
@@ -25,7 +27,8 @@ type Domain struct {
 	Description  string
 	Experimental bool
 
-	Imports []string `json:"-"`
+	StdImports []string `json:"-"`
+	Imports    []string `json:"-"`
 }
 
 func (d *Domain) GoPackage() string {
@@ -50,12 +53,16 @@ func (d *Domain) lookupType(id string) *Type {
 }
 
 func (d *Domain) addImport(name string) {
-	for _, imp := range d.Imports {
+	imports := &d.Imports
+	if !strings.Contains(name, ".") {
+		imports = &d.StdImports
+	}
+	for _, imp := range *imports {
 		if imp == name {
 			return
 		}
 	}
-	d.Imports = append(d.Imports, name)
+	*imports = append(*imports, name)
 }
 
 type Type struct {
@@ -64,6 +71,25 @@ type Type struct {
 	Experimental bool
 	Properties   []*Property
 	TypeRef
+
+	GoMap string `json:"-"` // Go type of an object type without properties
+}
+
+// mapTypes are the Go types of object types without properties whose values are known to be strings.
+// Other object types without properties are generated as map[string]interface{}.
+var mapTypes = map[string]string{
+	"Network.Headers": "map[string]string",
+}
+
+func (d *Domain) mapObjects() {
+	for _, t := range d.Types {
+		if t.Type == "object" && len(t.Properties) == 0 {
+			t.GoMap = "map[string]interface{}"
+			if m, ok := mapTypes[d.Domain+"."+t.ID]; ok {
+				t.GoMap = m
+			}
+		}
+	}
 }
 
 func (t *Type) Doc() string {
@@ -94,6 +120,18 @@ func (c *Command) GoResultType() string {
 	return c.GoName() + "Result"
 }
 
+func (c *Command) GoParamsType() string {
+	return c.GoName() + "Params"
+}
+
+func (c *Command) GoFutureType() string {
+	return c.GoName() + "Future"
+}
+
+func (c *Command) IsDomainToggle() bool {
+	return c.Name == "enable" || c.Name == "disable"
+}
+
 func (c *Command) Doc() string {
 	doc := c.Description
 	if c.Experimental {
@@ -117,6 +155,10 @@ func (e *Event) GoType() string {
 	return e.GoName() + "Event"
 }
 
+func (e *Event) GoWaiterType() string {
+	return e.GoName() + "Waiter"
+}
+
 func (e *Event) Doc() string {
 	doc := e.Description
 	if e.Experimental {
@@ -158,6 +200,64 @@ type TypeRef struct {
 	Type  string
 	Ref   string `json:"$ref"`
 	Items *TypeRef
+	Enum  []string
+}
+
+type EnumValue struct {
+	GoName string
+	Value  string
+}
+
+func (t *Type) EnumValues() []*EnumValue {
+	var values []*EnumValue
+	for _, v := range t.Enum {
+		name := t.ID
+		for _, part := range strings.FieldsFunc(v, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			name += strings.ToUpper(part[:1]) + part[1:]
+		}
+		values = append(values, &EnumValue{GoName: name, Value: v})
+	}
+	return values
+}
+
+// inlineEnums turns the enums of properties, parameters and return values into types of the domain,
+// named after their parent and the property, e.g. RemoteObjectType for RemoteObject.type.
+func (d *Domain) inlineEnums() {
+	define := func(parent, kind, owner string, props []*Property) {
+		for _, p := range props {
+			ref := &p.TypeRef
+			if ref.Type == "array" && ref.Items != nil {
+				ref = ref.Items
+			}
+			if len(ref.Enum) == 0 || ref.Ref != "" {
+				continue
+			}
+			id := parent + p.GoName()
+			for _, t := range d.Types {
+				if t.ID == id {
+					panic("enum type name already in use: " + id)
+				}
+			}
+			d.Types = append(d.Types, &Type{
+				ID:          id,
+				Description: "Values of the " + p.Name + " " + kind + " of " + owner + ".",
+				TypeRef:     TypeRef{Type: ref.Type, Enum: ref.Enum},
+			})
+			*ref = TypeRef{Ref: id}
+		}
+	}
+	for _, t := range d.Types {
+		define(t.ID, "property", t.ID, t.Properties)
+	}
+	for _, c := range d.Commands {
+		define(c.GoName(), "parameter", d.Domain+"."+c.Name, c.Parameters)
+		define(c.GoName(), "return value", d.Domain+"."+c.Name, c.Returns)
+	}
+	for _, e := range d.Events {
+		define(e.GoName(), "parameter", d.Domain+"."+e.Name, e.Parameters)
+	}
 }
 
 func goType(domains []*Domain, d *Domain, t *TypeRef) string {
@@ -175,7 +275,7 @@ func goType(domains []*Domain, d *Domain, t *TypeRef) string {
 			ref = ref[i+1:]
 		}
 
-		if d.lookupType(ref).Type == "object" {
+		if t := d.lookupType(ref); t.Type == "object" && t.GoMap == "" {
 			return "*" + pkg + ref
 		}
 		return pkg + ref
@@ -199,6 +299,71 @@ func goType(domains []*Domain, d *Domain, t *TypeRef) string {
 	}
 }
 
+// isNillable reports whether the Go type of t can be nil, so an absent optional value needs no pointer.
+func isNillable(domains []*Domain, d *Domain, t *TypeRef) bool {
+	if ref := t.Ref; ref != "" {
+		if ref == "Page.FrameId" || ref == "Page.ResourceType" {
+			return false
+		}
+		if i := strings.Index(ref, "."); i != -1 {
+			d = findDomain(domains, ref[:i])
+			ref = ref[i+1:]
+		}
+		t := d.lookupType(ref)
+		if t.Type == "object" || t.Type == "array" || t.Type == "any" {
+			return true
+		}
+		return false
+	}
+	switch t.Type {
+	case "array", "object", "any":
+		return true
+	}
+	return false
+}
+
+// isString reports whether the Go type of t is a string type, including enums.
+func isString(domains []*Domain, d *Domain, t *TypeRef) bool {
+	if ref := t.Ref; ref != "" {
+		if ref == "Page.FrameId" || ref == "Page.ResourceType" {
+			return true
+		}
+		if i := strings.Index(ref, "."); i != -1 {
+			d = findDomain(domains, ref[:i])
+			ref = ref[i+1:]
+		}
+		return d.lookupType(ref).Type == "string"
+	}
+	return t.Type == "string"
+}
+
+// isRawAny reports whether p is an optional value of any type. It is a json.RawMessage, since unlike
+// interface{} it tells an absent value from null.
+func isRawAny(p *Property) bool {
+	return p.Optional && p.Type == "any"
+}
+
+// enumKind returns how a value of t holds an enum type: "value", "slice" or "" if it does not.
+func enumKind(domains []*Domain, d *Domain, t *TypeRef) string {
+	kind := "value"
+	if t.Type == "array" {
+		kind = "slice"
+		t = t.Items
+	}
+	ref := t.Ref
+	if ref == "" || ref == "Page.FrameId" || ref == "Page.ResourceType" {
+		return ""
+	}
+	if i := strings.Index(ref, "."); i != -1 {
+		d = findDomain(domains, ref[:i])
+		ref = ref[i+1:]
+	}
+	if len(d.lookupType(ref).Enum) == 0 {
+		return ""
+	}
+	return kind
+}
+
 func findDomain(domains []*Domain, name string) *Domain {
 	for _, d := range domains {
 		if d.Domain == name {
@@ -208,7 +373,14 @@ func findDomain(domains []*Domain, name string) *Domain {
 	panic("domain not found")
 }
 
+var optional = flag.String("optional", "pointer", `how to generate optional fields of primitive types: "pointer" or "generic" for rpc.Optional`)
+
 func main() {
+	flag.Parse()
+	if *optional != "pointer" && *optional != "generic" {
+		panic("invalid value for -optional: " + *optional)
+	}
+
 	var domains []*Domain
 	readProtocol := func(filename string) {
 		in, err := os.Open(filename)
@@ -228,30 +400,25 @@ func main() {
 	sort.Slice(domains, func(i, j int) bool {
 		return domains[i].Domain < domains[j].Domain
 	})
+	for _, d := range domains {
+		d.inlineEnums()
+		d.mapObjects()
+	}
 
 	os.RemoveAll("protocol")
 	os.Mkdir("protocol", 0777)
 
 	for _, d := range domains {
-		t := template.Must(template.New("").Funcs(template.FuncMap{
-			"goType": func(t *TypeRef) string {
-				return goType(domains, d, t)
-			},
-		}).Parse(domainTmpl))
-
-		// collect imports
-		if err := t.Execute(ioutil.Discard, d); err != nil {
-			panic(err)
-		}
-
-		var buf bytes.Buffer
-		if err := t.Execute(&buf, d); err != nil {
-			panic(err)
+		for _, c := range d.Commands {
+			// the set field of a params struct has 64 bits and bit 0 is taken, see "bit"
+			if len(c.Parameters) > 63 {
+				panic("too many parameters for the set bits of the params struct: " + d.Domain + "." + c.Name)
+			}
 		}
-
+		out := generateDomain(domains, d)
 		dir := "protocol/" + d.GoPackage()
 		os.Mkdir(dir, 0777)
-		if err := ioutil.WriteFile(dir+"/"+d.GoPackage()+".go", buf.Bytes(), 0666); err != nil {
+		if err := ioutil.WriteFile(dir+"/"+d.GoPackage()+".go", out, 0666); err != nil {
 			panic(err)
 		}
 	}
@@ -266,11 +433,85 @@ func main() {
 	}
 }
 
+// generateDomain returns the source of the package of d.
+func generateDomain(domains []*Domain, d *Domain) []byte {
+	t := template.Must(template.New("").Funcs(template.FuncMap{
+		"goType": func(t *TypeRef) string {
+			return goType(domains, d, t)
+		},
+		// optional values of non-nillable types are pointers or rpc.Optional, so absent and zero values differ
+		"isOptional": func(p *Property) bool {
+			return p.Optional && !isNillable(domains, d, &p.TypeRef)
+		},
+		"fieldType": func(p *Property) string {
+			t := goType(domains, d, &p.TypeRef)
+			switch {
+			case isRawAny(p):
+				d.addImport("encoding/json")
+				return "json.RawMessage"
+			case !p.Optional || isNillable(domains, d, &p.TypeRef):
+				return t
+			case *optional == "generic":
+				return "rpc.Optional[" + t + "]"
+			default:
+				return "*" + t
+			}
+		},
+		"setterType": func(p *Property) string {
+			if isRawAny(p) {
+				return "json.RawMessage"
+			}
+			return goType(domains, d, &p.TypeRef)
+		},
+		"jsonTag": func(p *Property) string {
+			switch {
+			case !p.Optional:
+				return p.Name
+			case *optional == "generic" && !isNillable(domains, d, &p.TypeRef):
+				return p.Name + ",omitzero"
+			default:
+				return p.Name + ",omitempty"
+			}
+		},
+		"genericOptional": func() bool {
+			return *optional == "generic"
+		},
+		"isNillable": func(p *Property) bool {
+			return isNillable(domains, d, &p.TypeRef)
+		},
+		"isString": func(p *Property) bool {
+			return isString(domains, d, &p.TypeRef)
+		},
+		"enumKind": func(p *Property) string {
+			return enumKind(domains, d, &p.TypeRef)
+		},
+		"bit": func(i int) int {
+			return i + 1
+		},
+	}).Parse(domainTmpl))
+
+	// collect imports
+	if err := t.Execute(ioutil.Discard, d); err != nil {
+		panic(err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, d); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
 const domainTmpl = `
 {{if .Doc}}// {{.Doc}}{{end}}
 package {{.GoPackage}}
 {{$domain := .Domain}}
 import (
+	"context"
+	{{- range .StdImports}}
+		"{{.}}"
+	{{- end}}
+
 	"github.com/neelance/cdp-go/rpc"
 
 	{{range .Imports}}
@@ -284,35 +525,117 @@ type Client struct {
 }
 
 {{range .Types}}
+	{{$type := .}}
 	{{if .Doc}}// {{.Doc}}{{end}}
-	{{if eq .Type "object"}}
+	{{if .GoMap}}
+		type {{.ID}} {{.GoMap}}
+	{{else if eq .Type "object"}}
 		type {{.ID}} struct {
 			{{- range .Properties}}
 				{{if .Doc}}// {{.Doc}}{{end}}
-				{{.GoName}} {{goType .TypeRef}} ` + "`" + `json:"{{.Name}}{{if .Optional}},omitempty{{end}}"` + "`" + `
+				{{.GoName}} {{fieldType .}} ` + "`" + `json:"{{jsonTag .}}"` + "`" + `
 			{{end}}
 		}
 	{{else}}
 		type {{.ID}} {{goType .TypeRef}}
+
+		{{if .Enum}}
+			const (
+				{{- range .EnumValues}}
+					{{.GoName}} {{$type.ID}} = "{{.Value}}"
+				{{- end}}
+			)
+
+			// IsValid reports whether v is one of the values defined by the protocol.
+			func (v {{.ID}}) IsValid() bool {
+				switch v {
+				case {{range $i, $v := .EnumValues}}{{if $i}}, {{end}}{{$v.GoName}}{{end}}:
+					return true
+				}
+				return false
+			}
+		{{end}}
 	{{end}}
 {{end}}
 
 {{range .Commands}}
 	{{$reqType := .GoRequestType}}
+	{{$paramsType := .GoParamsType}}
+	// {{$paramsType}} are the parameters of {{$domain}}.{{.Name}}.
+	type {{$paramsType}} struct {
+		{{- range .Parameters}}
+			{{if .Doc}}// {{.Doc}}{{end}}
+			{{.GoName}} {{fieldType .}} ` + "`" + `json:"{{jsonTag .}}"` + "`" + `
+		{{end}}
+
+		{{- if .Parameters}}
+			set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+		{{- end}}
+	}
+
+	{{if .Parameters}}
+		// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+		// is not defined by the protocol. It is called by the client before sending. If the params were not created
+		// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+		// Other required values like numbers can only be reported as missing for params created by a builder.
+		func (p *{{$paramsType}}) Validate() error {
+			var missing, invalid []string
+			{{- range $i, $p := .Parameters}}
+				{{- $kind := enumKind .}}
+				{{- if and (not .Optional) (isNillable .)}}
+					if p.{{.GoName}} == nil {
+						missing = append(missing, "{{.Name}}")
+					}
+				{{- else if not .Optional}}
+					if p.set&1 != 0 && p.set&(1<<{{bit $i}}) == 0{{if isString .}} || p.set&1 == 0 && p.{{.GoName}} == ""{{end}} {
+						missing = append(missing, "{{.Name}}")
+					}{{if eq $kind "value"}} else if !p.{{.GoName}}.IsValid() {
+						invalid = append(invalid, "{{.Name}}")
+					}{{end}}
+				{{- else if eq $kind "value"}}
+					if {{if genericOptional}}p.{{.GoName}}.Valid && !p.{{.GoName}}.Value.IsValid(){{else}}p.{{.GoName}} != nil && !p.{{.GoName}}.IsValid(){{end}} {
+						invalid = append(invalid, "{{.Name}}")
+					}
+				{{- end}}
+				{{- if eq $kind "slice"}}
+					for _, v := range p.{{.GoName}} {
+						if !v.IsValid() {
+							invalid = append(invalid, "{{.Name}}")
+							break
+						}
+					}
+				{{- end}}
+			{{- end}}
+			if missing == nil && invalid == nil {
+				return nil
+			}
+			return &rpc.ParamsError{Method: "{{$domain}}.{{.Name}}", Missing: missing, Invalid: invalid}
+		}
+	{{end}}
+
 	type {{$reqType}} struct {
 		client *rpc.Client
-		opts map[string]interface{}
+		params *{{$paramsType}}
 	}
 
 	{{if .Doc}}// {{.Doc}}{{end}}
 	func (d *Client) {{.GoName}}() *{{$reqType}} {
-		return &{{$reqType}}{opts: make(map[string]interface{}), client: d.Client}
+		return &{{$reqType}}{client: d.Client, params: &{{$paramsType}}{{"{"}}{{if .Parameters}}set: 1{{end}}}}
+	}
+
+	// Do{{.GoName}} sends {{$domain}}.{{.Name}} with the given parameters and waits for it to complete. Nil params are empty.
+	func (d *Client) Do{{.GoName}}(ctx context.Context, params *{{$paramsType}}) {{if .Returns}}(*{{.GoResultType}}, error){{else}}error{{end}} {
+		if params == nil {
+			params = new({{$paramsType}})
+		}
+		return (&{{$reqType}}{client: d.Client, params: params}).DoContext(ctx)
 	}
 
-	{{- range .Parameters}}
+	{{- range $i, $p := .Parameters}}
 		{{if .Doc}}// {{.Doc}}{{end}}
-		func (r *{{$reqType}}) {{.GoName}}(v {{goType .TypeRef}}) *{{$reqType}} {
-			r.opts["{{.Name}}"] = v
+		func (r *{{$reqType}}) {{.GoName}}(v {{setterType .}}) *{{$reqType}} {
+			r.params.{{.GoName}} = {{if not (isOptional .)}}v{{else if genericOptional}}rpc.Some(v){{else}}&v{{end}}
+			r.params.set |= 1 << {{bit $i}}
 			return r
 		}
 	{{end}}
@@ -321,18 +644,63 @@ type Client struct {
 		type {{.GoResultType}} struct {
 			{{- range .Returns}}
 				{{if .Doc}}// {{.Doc}}{{end}}
-				{{.GoName}} {{goType .TypeRef}} ` + "`" + `json:"{{.Name}}"` + "`" + `
+				{{.GoName}} {{fieldType .}} ` + "`" + `json:"{{jsonTag .}}"` + "`" + `
 			{{end}}
 		}
 
 		func (r *{{.GoRequestType}}) Do() (*{{.GoResultType}}, error) {
+			return r.DoContext(context.Background())
+		}
+
+		func (r *{{.GoRequestType}}) DoContext(ctx context.Context) (*{{.GoResultType}}, error) {
 			var result {{.GoResultType}}
-			err := r.client.Call("{{$domain}}.{{.Name}}", r.opts, &result)
+			err := r.client.CallContext(ctx, "{{$domain}}.{{.Name}}", r.params, &result)
 			return &result, err
 		}
+
+		type {{.GoFutureType}} struct {
+			*rpc.Call
+			result {{.GoResultType}}
+		}
+
+		// Get waits for the command to complete and returns its result.
+		func (f *{{.GoFutureType}}) Get() (*{{.GoResultType}}, error) {
+			err := f.Wait()
+			return &f.result, err
+		}
+
+		// DoAsync sends the command without waiting for its result.
+		func (r *{{.GoRequestType}}) DoAsync(ctx context.Context) *{{.GoFutureType}} {
+			f := new({{.GoFutureType}})
+			f.Call = r.client.Go(ctx, "{{$domain}}.{{.Name}}", r.params, &f.result)
+			return f
+		}
+	{{else if .IsDomainToggle}}
+		func (r *{{.GoRequestType}}) Do() error {
+			return r.DoContext(context.Background())
+		}
+
+		// DoContext {{.Name}}s the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+		func (r *{{.GoRequestType}}) DoContext(ctx context.Context) error {
+			return r.DoAsync(ctx).Wait()
+		}
+
+		// DoAsync {{.Name}}s the domain without waiting for it to complete.
+		func (r *{{.GoRequestType}}) DoAsync(ctx context.Context) *rpc.Call {
+			return r.client.{{if eq .Name "enable"}}Enable{{else}}Disable{{end}}Domain(ctx, "{{$domain}}", r.params)
+		}
 	{{else}}
 		func (r *{{.GoRequestType}}) Do() error {
-			return r.client.Call("{{$domain}}.{{.Name}}", r.opts, nil)
+			return r.DoContext(context.Background())
+		}
+
+		func (r *{{.GoRequestType}}) DoContext(ctx context.Context) error {
+			return r.client.CallContext(ctx, "{{$domain}}.{{.Name}}", r.params, nil)
+		}
+
+		// DoAsync sends the command without waiting for it to complete.
+		func (r *{{.GoRequestType}}) DoAsync(ctx context.Context) *rpc.Call {
+			return r.client.Go(ctx, "{{$domain}}.{{.Name}}", r.params, nil)
 		}
 	{{end}}
 {{end}}
@@ -348,9 +716,48 @@ func init() {
 	type {{.GoType}} struct {
 		{{- range .Parameters}}
 			{{if .Doc}}// {{.Doc}}{{end}}
-			{{.GoName}} {{goType .TypeRef}} ` + "`" + `json:"{{.Name}}"` + "`" + `
+			{{.GoName}} {{fieldType .}} ` + "`" + `json:"{{jsonTag .}}"` + "`" + `
 		{{end}}
 	}
+
+	{{if .Doc}}// {{.Doc}}{{end}}
+	func (d *Client) On{{.GoName}}(fn func(*{{.GoType}}), opts ...rpc.SubscribeOption) *rpc.Subscription {
+		return d.Client.Subscribe("{{$domain}}.{{.Name}}", func(e interface{}) {
+			fn(e.(*{{.GoType}}))
+		}, opts...)
+	}
+
+	// Filter{{.GoName}} is rpc.WithFilter for On{{.GoName}}.
+	func Filter{{.GoName}}(fn func(*{{.GoType}}) bool) rpc.SubscribeOption {
+		return rpc.WithFilter(func(e interface{}) bool {
+			return fn(e.(*{{.GoType}}))
+		})
+	}
+
+	// {{.GoWaiterType}} waits for a {{$domain}}.{{.Name}} event. It is created by WaitFor{{.GoName}}.
+	type {{.GoWaiterType}} struct {
+		*rpc.Waiter
+	}
+
+	// Wait waits for the matching event.
+	func (w *{{.GoWaiterType}}) Wait() (*{{.GoType}}, error) {
+		e, err := w.Waiter.Wait()
+		if err != nil {
+			return nil, err
+		}
+		return e.(*{{.GoType}}), nil
+	}
+
+	// WaitFor{{.GoName}} arms a waiter for the first {{$domain}}.{{.Name}} event for which predicate returns true. A nil predicate matches any event.
+	func (d *Client) WaitFor{{.GoName}}(ctx context.Context, predicate func(*{{.GoType}}) bool) *{{.GoWaiterType}} {
+		var p func(interface{}) bool
+		if predicate != nil {
+			p = func(e interface{}) bool {
+				return predicate(e.(*{{.GoType}}))
+			}
+		}
+		return &{{.GoWaiterType}}{d.Client.WaitFor(ctx, "{{$domain}}.{{.Name}}", p)}
+	}
 {{end}}
 `
 
@@ -358,8 +765,6 @@ const clientTmpl = `
 package cdp
 
 import (
-	"golang.org/x/net/websocket"
-
 	"github.com/neelance/cdp-go/rpc"
 
 	{{range .}}
@@ -375,19 +780,16 @@ type Client struct {
 	{{- end}}
 }
 
-func Dial(url string) *Client {
-	conn, err := websocket.Dial(url, "", url)
-	if err != nil {
-		panic(err)
-	}
-
-	cl := rpc.NewClient(conn)
-	return &Client{
+// NewClient returns a Client that sends all commands of all domains over cl.
+func NewClient(cl *rpc.Client) *Client {
+	c := &Client{
 		Client: cl,
 
 		{{range .}}
 			{{.Domain}}: {{.GoPackage}}.Client{Client: cl},
 		{{- end}}
 	}
+	c.watchInspector()
+	return c
 }
 `
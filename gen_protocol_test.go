@@ -0,0 +1,109 @@
+// +build generate
+
+package main
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// fakeProtocol has the kinds of properties whose generated types depend on the -optional flag.
+const fakeProtocol = `{"domains": [{
+	"domain": "Fake",
+	"types": [
+		{"id": "Mode", "type": "string", "enum": ["fast", "slow"]}
+	],
+	"commands": [{
+		"name": "act",
+		"parameters": [
+			{"name": "name", "type": "string"},
+			{"name": "count", "type": "integer", "optional": true},
+			{"name": "mode", "$ref": "Mode", "optional": true},
+			{"name": "value", "type": "any", "optional": true}
+		],
+		"returns": [
+			{"name": "result", "type": "any", "optional": true},
+			{"name": "done", "type": "boolean", "optional": true}
+		]
+	}],
+	"events": [{
+		"name": "happened",
+		"parameters": [
+			{"name": "data", "type": "any", "optional": true}
+		]
+	}]
+}]}`
+
+func generateFake(t *testing.T, mode string) string {
+	old := *optional
+	*optional = mode
+	defer func() { *optional = old }()
+
+	var protocol Protocol
+	if err := json.Unmarshal([]byte(fakeProtocol), &protocol); err != nil {
+		t.Fatal(err)
+	}
+	d := protocol.Domains[0]
+	d.inlineEnums()
+	d.mapObjects()
+	src, err := format.Source(generateDomain(protocol.Domains, d))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "fake.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("fake", fset, []*ast.File{f}, nil); err != nil {
+		t.Fatalf("generated code does not compile: %v\n%s", err, src)
+	}
+	return string(src)
+}
+
+func checkContains(t *testing.T, src string, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		if !strings.Contains(src, w) {
+			t.Errorf("generated code does not contain %q", w)
+		}
+	}
+}
+
+func TestGeneratePointer(t *testing.T) {
+	src := generateFake(t, "pointer")
+	checkContains(t, src,
+		"Name string `json:\"name\"`",
+		"Count *int `json:\"count,omitempty\"`",
+		"Mode *Mode `json:\"mode,omitempty\"`",
+		"Value json.RawMessage `json:\"value,omitempty\"`",
+		"Result json.RawMessage `json:\"result,omitempty\"`",
+		"Done *bool `json:\"done,omitempty\"`",
+		"Data json.RawMessage `json:\"data,omitempty\"`",
+		"func (r *ActRequest) Count(v int) *ActRequest {\n\tr.params.Count = &v",
+		"func (r *ActRequest) Value(v json.RawMessage) *ActRequest {\n\tr.params.Value = v",
+	)
+}
+
+func TestGenerateGeneric(t *testing.T) {
+	src := generateFake(t, "generic")
+	checkContains(t, src,
+		"Name string `json:\"name\"`",
+		"Count rpc.Optional[int] `json:\"count,omitzero\"`",
+		"Mode rpc.Optional[Mode] `json:\"mode,omitzero\"`",
+		"Value json.RawMessage `json:\"value,omitempty\"`",
+		"Done rpc.Optional[bool] `json:\"done,omitzero\"`",
+		"func (r *ActRequest) Count(v int) *ActRequest {\n\tr.params.Count = rpc.Some(v)",
+		"func (r *ActRequest) Value(v json.RawMessage) *ActRequest {\n\tr.params.Value = v",
+		"p.Mode.Valid && !p.Mode.Value.IsValid()",
+	)
+}
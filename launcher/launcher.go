@@ -0,0 +1,328 @@
+// Package launcher starts a Chromium based browser and connects to it.
+package launcher
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	cdp "github.com/neelance/cdp-go"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// DefaultExecPaths are the executables that Launch looks for if no path is given.
+var DefaultExecPaths = []string{"chromium", "chromium-browser", "google-chrome", "google-chrome-stable", "chrome"}
+
+type options struct {
+	execPath string
+	args     []string
+	headless bool
+	timeout  time.Duration
+	stderr   io.Writer
+	env      []string
+	pipe     bool
+	client   []rpc.Option
+}
+
+// Option configures Launch.
+type Option func(*options)
+
+// WithExecPath sets the browser executable. By default DefaultExecPaths are searched in $PATH.
+func WithExecPath(path string) Option {
+	return func(o *options) {
+		o.execPath = path
+	}
+}
+
+// WithArgs adds command line arguments for the browser.
+func WithArgs(args ...string) Option {
+	return func(o *options) {
+		o.args = append(o.args, args...)
+	}
+}
+
+// WithHeadless starts the browser without a window.
+func WithHeadless() Option {
+	return func(o *options) {
+		o.headless = true
+	}
+}
+
+// WithTimeout limits how long Launch waits for the browser to become ready. The default is 30 seconds.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithStderr forwards the browser's standard error output to w.
+func WithStderr(w io.Writer) Option {
+	return func(o *options) {
+		o.stderr = w
+	}
+}
+
+// WithEnv sets the environment of the browser process, in the form of os.Environ.
+func WithEnv(env []string) Option {
+	return func(o *options) {
+		o.env = env
+	}
+}
+
+// WithPipe connects to the browser with --remote-debugging-pipe instead of a TCP port.
+func WithPipe() Option {
+	return func(o *options) {
+		o.pipe = true
+	}
+}
+
+// WithClientOptions passes options to rpc.NewClient, e.g. interceptors.
+func WithClientOptions(opts ...rpc.Option) Option {
+	return func(o *options) {
+		o.client = append(o.client, opts...)
+	}
+}
+
+// Browser is a running browser process with a connected client.
+type Browser struct {
+	*cdp.Client
+
+	// WebSocketURL is the debugger URL of the browser target. It is empty if WithPipe was used.
+	WebSocketURL string
+
+	cmd         *exec.Cmd
+	userDataDir string
+	exited      chan struct{}
+	exitErr     error
+}
+
+var listeningRe = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
+
+// Launch starts a browser with a temporary user data directory, waits for it to be ready and connects to it.
+func Launch(ctx context.Context, opts ...Option) (*Browser, error) {
+	o := &options{
+		timeout: 30 * time.Second,
+		stderr:  ioutil.Discard,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.execPath == "" {
+		for _, name := range DefaultExecPaths {
+			if path, err := exec.LookPath(name); err == nil {
+				o.execPath = path
+				break
+			}
+		}
+		if o.execPath == "" {
+			return nil, errors.New("launcher: no browser executable found")
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "cdp-go-")
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{
+		"--user-data-dir=" + dir,
+		"--no-first-run",
+		"--no-default-browser-check",
+	}
+	if o.pipe {
+		args = append(args, "--remote-debugging-pipe")
+	} else {
+		args = append(args, "--remote-debugging-port=0")
+	}
+	if o.headless {
+		args = append(args, "--headless")
+	}
+	args = append(args, o.args...)
+	args = append(args, "about:blank")
+
+	cmd := exec.Command(o.execPath, args...)
+	cmd.Env = o.env
+	setProcessGroup(cmd)
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	var pipeConn io.ReadWriteCloser
+	var childFiles []*os.File // closed after starting, so the pipes see EOF when the browser exits
+	if o.pipe {
+		// the browser reads commands from fd 3 and writes responses and events to fd 4
+		cmdRead, cmdWrite, err := os.Pipe()
+		if err != nil {
+			os.RemoveAll(dir)
+			return nil, err
+		}
+		respRead, respWrite, err := os.Pipe()
+		if err != nil {
+			cmdRead.Close()
+			cmdWrite.Close()
+			os.RemoveAll(dir)
+			return nil, err
+		}
+		cmd.ExtraFiles = []*os.File{cmdRead, respWrite}
+		childFiles = cmd.ExtraFiles
+		pipeConn = rpc.NewPipeConn(respRead, cmdWrite)
+	}
+
+	err = cmd.Start()
+	for _, f := range childFiles {
+		f.Close()
+	}
+	if err != nil {
+		if pipeConn != nil {
+			pipeConn.Close()
+		}
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	b := &Browser{
+		cmd:         cmd,
+		userDataDir: dir,
+		exited:      make(chan struct{}),
+	}
+
+	urlFromStderr := make(chan string, 1)
+	go func() {
+		s := bufio.NewScanner(stderr)
+		for s.Scan() {
+			fmt.Fprintln(o.stderr, s.Text())
+			if m := listeningRe.FindStringSubmatch(s.Text()); m != nil {
+				select {
+				case urlFromStderr <- m[1]:
+				default:
+				}
+			}
+		}
+		io.Copy(o.stderr, stderr)
+		b.exitErr = cmd.Wait()
+		close(b.exited)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	if pipeConn != nil {
+		b.Client = cdp.NewClient(rpc.NewClient(pipeConn, o.client...))
+		if err := b.waitForPipe(ctx); err != nil {
+			b.Client.Close()
+			b.kill()
+			return nil, err
+		}
+		return b, nil
+	}
+
+	b.WebSocketURL, err = b.waitForURL(ctx, urlFromStderr)
+	if err != nil {
+		b.kill()
+		return nil, err
+	}
+
+	b.Client, err = cdp.DialContext(ctx, b.WebSocketURL, cdp.WithClientOptions(o.client...))
+	if err != nil {
+		b.kill()
+		return nil, err
+	}
+	return b, nil
+}
+
+// waitForURL waits for the debugger URL to be printed on stderr or written to the DevToolsActivePort file.
+func (b *Browser) waitForURL(ctx context.Context, urlFromStderr <-chan string) (string, error) {
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case url := <-urlFromStderr:
+			return url, nil
+		case <-ticker.C:
+			if url, ok := readActivePort(b.userDataDir); ok {
+				return url, nil
+			}
+		case <-b.exited:
+			return "", fmt.Errorf("launcher: browser exited before it was ready: %v", b.exitErr)
+		case <-ctx.Done():
+			return "", fmt.Errorf("launcher: waiting for browser: %v", ctx.Err())
+		}
+	}
+}
+
+// waitForPipe waits for the browser to answer a command on the pipe. Any answer, including an error
+// for a command that the browser does not know, means that it is ready.
+func (b *Browser) waitForPipe(ctx context.Context) error {
+	probe := b.Client.Go(ctx, "Browser.getVersion", nil, nil)
+	select {
+	case <-probe.Done():
+		var perr *rpc.ProtocolError
+		if err := probe.Wait(); err != nil && !errors.As(err, &perr) {
+			// the pipe usually breaks shortly before the exit status is known, which is the better error
+			select {
+			case <-b.exited:
+				return fmt.Errorf("launcher: browser exited before it was ready: %v", b.exitErr)
+			case <-time.After(100 * time.Millisecond):
+			}
+			return fmt.Errorf("launcher: waiting for browser: %v", err)
+		}
+		return nil
+	case <-b.exited:
+		return fmt.Errorf("launcher: browser exited before it was ready: %v", b.exitErr)
+	}
+}
+
+// readActivePort reads the port and browser target path that the browser writes to <user-data-dir>/DevToolsActivePort.
+func readActivePort(dir string) (string, bool) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "DevToolsActivePort"))
+	if err != nil {
+		return "", false
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) < 2 {
+		return "", false // not completely written yet
+	}
+	return "ws://127.0.0.1:" + strings.TrimSpace(lines[0]) + strings.TrimSpace(lines[1]), true
+}
+
+// Done returns a channel that is closed when the browser process has exited.
+func (b *Browser) Done() <-chan struct{} {
+	return b.exited
+}
+
+// Close closes the connection, kills the browser and all of its child processes and removes the user data directory.
+func (b *Browser) Close() error {
+	if b.Client != nil {
+		b.Client.Close()
+	}
+	return b.kill()
+}
+
+// kill kills the browser and removes the user data directory, even if killing failed.
+func (b *Browser) kill() error {
+	var err error
+	select {
+	case <-b.exited:
+	default:
+		err = killProcessGroup(b.cmd)
+		if err == nil {
+			<-b.exited
+		}
+	}
+	if rmErr := os.RemoveAll(b.userDataDir); err == nil {
+		err = rmErr
+	}
+	return err
+}
@@ -0,0 +1,154 @@
+//go:build !windows
+// +build !windows
+
+package launcher
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neelance/cdp-go/cdptest"
+)
+
+// fakeBrowser writes a shell script that acts as the browser executable.
+func fakeBrowser(t *testing.T, script string) string {
+	path := filepath.Join(t.TempDir(), "fake-browser")
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// userDataDir is a shell snippet that extracts the --user-data-dir argument.
+const userDataDir = `for arg in "$@"; do case "$arg" in --user-data-dir=*) dir="${arg#--user-data-dir=}";; esac; done
+`
+
+func launch(t *testing.T, script string, opts ...Option) (*Browser, *cdptest.Server, error) {
+	s := cdptest.NewServer()
+	t.Cleanup(s.Close)
+	opts = append([]Option{
+		WithExecPath(fakeBrowser(t, script)),
+		WithEnv(append(os.Environ(), "FAKE_URL="+s.WebSocketURL())),
+		WithTimeout(10 * time.Second),
+	}, opts...)
+	b, err := Launch(context.Background(), opts...)
+	return b, s, err
+}
+
+func checkClose(t *testing.T, b *Browser) {
+	dir := b.userDataDir
+	if err := b.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("user data directory %s was not removed", dir)
+	}
+	select {
+	case <-b.Done():
+	default:
+		t.Error("process has not exited")
+	}
+}
+
+func TestLaunchBanner(t *testing.T) {
+	b, s, err := launch(t, `echo "DevTools listening on $FAKE_URL" >&2
+exec sleep 60
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.WebSocketURL != s.WebSocketURL() {
+		t.Errorf("got URL %q, want %q", b.WebSocketURL, s.WebSocketURL())
+	}
+	s.HandleResult("Fake.ping", nil)
+	if err := b.Call("Fake.ping", nil, nil); err != nil {
+		t.Errorf("client is not connected: %v", err)
+	}
+	checkClose(t, b)
+}
+
+func TestLaunchActivePort(t *testing.T) {
+	b, s, err := launch(t, userDataDir+`url="${FAKE_URL#ws://127.0.0.1:}"
+printf '%s\n%s\n' "${url%%/*}" "/${url#*/}" > "$dir/DevToolsActivePort"
+exec sleep 60
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.WebSocketURL != s.WebSocketURL() {
+		t.Errorf("got URL %q, want %q", b.WebSocketURL, s.WebSocketURL())
+	}
+	checkClose(t, b)
+}
+
+func TestLaunchEarlyExit(t *testing.T) {
+	script := userDataDir + `echo "$dir" > "$FAKE_DIR_FILE"
+echo "no display" >&2
+exit 3
+`
+	dirFile := filepath.Join(t.TempDir(), "dir")
+	_, _, err := launch(t, script, WithEnv(append(os.Environ(), "FAKE_DIR_FILE="+dirFile)))
+	if err == nil || !strings.Contains(err.Error(), "exited before it was ready") || !strings.Contains(err.Error(), "exit status 3") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, _ := os.ReadFile(dirFile)
+	dir := strings.TrimSpace(string(data))
+	if dir == "" {
+		t.Fatal("fake browser did not record its user data directory")
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("user data directory %s was not removed", dir)
+	}
+}
+
+func TestLaunchTimeout(t *testing.T) {
+	_, _, err := launch(t, "exec sleep 60\n", WithTimeout(200*time.Millisecond))
+	if err == nil || !strings.Contains(err.Error(), "waiting for browser") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestKillExitedProcess(t *testing.T) {
+	b, _, err := launch(t, `echo "DevTools listening on $FAKE_URL" >&2
+exec sleep 60
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.cmd.Process.Kill()
+	<-b.Done()
+	if err := killProcessGroup(b.cmd); err != nil {
+		t.Errorf("killing a process group that is gone: %v", err)
+	}
+	checkClose(t, b)
+}
+
+func TestLaunchPipe(t *testing.T) {
+	// answers the first command, which is the readiness probe
+	b, _, err := launch(t, `head -c 1 <&3 >/dev/null
+printf '{"id":1,"result":{}}\0' >&4
+exec sleep 60
+`, WithPipe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkClose(t, b)
+}
+
+func TestLaunchPipeEarlyExit(t *testing.T) {
+	_, _, err := launch(t, "exit 3\n", WithPipe())
+	if err == nil || !strings.Contains(err.Error(), "exited before it was ready") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLaunchPipeTimeout(t *testing.T) {
+	_, _, err := launch(t, "exec sleep 60\n", WithPipe(), WithTimeout(200*time.Millisecond))
+	if err == nil || !strings.Contains(err.Error(), "waiting for browser") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
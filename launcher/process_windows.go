@@ -0,0 +1,22 @@
+package launcher
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"strconv"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {
+}
+
+// killProcessGroup kills the process and its children. A process that is already gone is no error.
+func killProcessGroup(cmd *exec.Cmd) error {
+	if err := exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run(); err != nil {
+		// taskkill also fails if the process has exited, fall back to killing the process itself
+		if err := cmd.Process.Kill(); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			return err
+		}
+	}
+	return nil
+}
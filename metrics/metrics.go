@@ -0,0 +1,153 @@
+// Package metrics collects per-method statistics of commands and events.
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// Recorder receives measurements. Implementations must be safe for concurrent use.
+type Recorder interface {
+	// CommandStarted is called when a command is sent.
+	CommandStarted(method string)
+	// CommandFinished is called when a command has completed, failed or was abandoned.
+	CommandFinished(method string, d time.Duration, err error)
+	// EventReceived is called for each event.
+	EventReceived(method string)
+}
+
+// Options returns the rpc options that report all commands and events of a client to r.
+func Options(r Recorder) []rpc.Option {
+	return []rpc.Option{
+		rpc.WithCallInterceptor(func(ctx context.Context, method string, params interface{}, invoke rpc.Invoker) (json.RawMessage, error) {
+			r.CommandStarted(method)
+			start := time.Now()
+			result, err := invoke(ctx, method, params)
+			r.CommandFinished(method, time.Since(start), err)
+			return result, err
+		}),
+		rpc.WithEventInterceptor(func(method string, params json.RawMessage, deliver func(json.RawMessage)) {
+			r.EventReceived(method)
+			deliver(params)
+		}),
+	}
+}
+
+// DefaultBuckets are the upper bounds of the latency histograms, in milliseconds.
+var DefaultBuckets = []float64{1, 2, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// Expvar is a Recorder that publishes its counters with the expvar package.
+type Expvar struct {
+	Commands *expvar.Map // number of commands per method
+	Errors   *expvar.Map // number of failed commands per method
+	Events   *expvar.Map // number of events per method
+	InFlight *expvar.Int // number of commands waiting for a response
+	Latency  *expvar.Map // *Histogram of command latencies per method
+
+	buckets []float64
+	mutex   sync.Mutex
+}
+
+// publishMutex serializes NewExpvar, so concurrent calls with the same name do not both try to publish a map.
+var publishMutex sync.Mutex
+
+// NewExpvar creates a Recorder and publishes it as an expvar map with the given name. If a map with that
+// name is already published, e.g. by an earlier call with the same name, the new Recorder replaces its
+// entries. Like expvar.Publish, NewExpvar panics if the name is taken by a var that is not an *expvar.Map.
+func NewExpvar(name string) *Expvar {
+	e := &Expvar{
+		Commands: new(expvar.Map).Init(),
+		Errors:   new(expvar.Map).Init(),
+		Events:   new(expvar.Map).Init(),
+		InFlight: new(expvar.Int),
+		Latency:  new(expvar.Map).Init(),
+		buckets:  DefaultBuckets,
+	}
+	publishMutex.Lock()
+	defer publishMutex.Unlock()
+	m, ok := expvar.Get(name).(*expvar.Map)
+	if !ok {
+		m = expvar.NewMap(name)
+	}
+	m.Set("commands", e.Commands)
+	m.Set("errors", e.Errors)
+	m.Set("events", e.Events)
+	m.Set("in_flight", e.InFlight)
+	m.Set("latency_ms", e.Latency)
+	return e
+}
+
+func (e *Expvar) CommandStarted(method string) {
+	e.Commands.Add(method, 1)
+	e.InFlight.Add(1)
+}
+
+func (e *Expvar) CommandFinished(method string, d time.Duration, err error) {
+	e.InFlight.Add(-1)
+	if err != nil {
+		e.Errors.Add(method, 1)
+	}
+
+	e.mutex.Lock()
+	h, ok := e.Latency.Get(method).(*Histogram)
+	if !ok {
+		h = NewHistogram(e.buckets)
+		e.Latency.Set(method, h)
+	}
+	e.mutex.Unlock()
+	h.Observe(float64(d) / float64(time.Millisecond))
+}
+
+func (e *Expvar) EventReceived(method string) {
+	e.Events.Add(method, 1)
+}
+
+// Histogram counts observations in buckets. It implements expvar.Var.
+type Histogram struct {
+	mutex   sync.Mutex
+	buckets []float64
+	counts  []uint64 // one more than buckets, for values above the last bound
+	count   uint64
+	sum     float64
+}
+
+// NewHistogram creates a histogram with the given ascending upper bounds.
+func NewHistogram(buckets []float64) *Histogram {
+	return &Histogram{
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)+1),
+	}
+}
+
+// Observe adds a value to the histogram.
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	h.mutex.Lock()
+	h.counts[i]++
+	h.count++
+	h.sum += v
+	h.mutex.Unlock()
+}
+
+// String returns the histogram as JSON, with cumulative counts per upper bound.
+func (h *Histogram) String() string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	var b strings.Builder
+	fmt.Fprintf(&b, `{"count": %d, "sum": %g, "buckets": {`, h.count, h.sum)
+	var cumulative uint64
+	for i, bound := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(&b, `"%g": %d, `, bound, cumulative)
+	}
+	fmt.Fprintf(&b, `"+Inf": %d}}`, h.count)
+	return b.String()
+}
@@ -0,0 +1,113 @@
+package metrics_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/metrics"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func TestHistogramBuckets(t *testing.T) {
+	h := metrics.NewHistogram([]float64{1, 10, 100})
+	for _, v := range []float64{0.5, 1, 2, 10, 50, 1000} {
+		h.Observe(v)
+	}
+	var got struct {
+		Count   uint64
+		Sum     float64
+		Buckets map[string]uint64
+	}
+	if err := json.Unmarshal([]byte(h.String()), &got); err != nil {
+		t.Fatalf("histogram is not valid JSON: %v\n%s", err, h.String())
+	}
+	if got.Count != 6 || got.Sum != 1063.5 {
+		t.Errorf("got count %d and sum %g, want 6 and 1063.5", got.Count, got.Sum)
+	}
+	want := map[string]uint64{"1": 2, "10": 4, "100": 5, "+Inf": 6}
+	for bound, n := range want {
+		if got.Buckets[bound] != n {
+			t.Errorf("got %d observations up to %s, want %d", got.Buckets[bound], bound, n)
+		}
+	}
+	if len(got.Buckets) != len(want) {
+		t.Errorf("got buckets %v, want %v", got.Buckets, want)
+	}
+}
+
+func TestExpvar(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	release := make(chan struct{})
+	s.Handle("Fake.slow", func(json.RawMessage) (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+	s.Handle("Fake.fail", func(json.RawMessage) (interface{}, error) {
+		return nil, errors.New("failed")
+	})
+
+	e := metrics.NewExpvar("cdp_test_expvar")
+	ws, err := websocket.Dial(s.WebSocketURL(), "", s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(ws, metrics.Options(e)...)
+	defer c.Close()
+
+	call := c.Go(context.Background(), "Fake.slow", nil, nil)
+	for e.InFlight.Value() != 1 {
+		select {
+		case <-call.Done():
+			t.Fatalf("call finished before it was released: %v", call.Wait())
+		case <-time.After(time.Millisecond):
+		}
+	}
+	close(release)
+	if err := call.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Call("Fake.fail", nil, nil); err == nil {
+		t.Fatal("call did not fail")
+	}
+
+	if n := e.InFlight.Value(); n != 0 {
+		t.Errorf("got %d commands in flight after all finished", n)
+	}
+	for _, v := range []struct {
+		m      *expvar.Map
+		method string
+		want   string
+	}{
+		{e.Commands, "Fake.slow", "1"},
+		{e.Commands, "Fake.fail", "1"},
+		{e.Errors, "Fake.fail", "1"},
+	} {
+		if got := v.m.Get(v.method); got == nil || got.String() != v.want {
+			t.Errorf("got %v for %s, want %s", got, v.method, v.want)
+		}
+	}
+	if e.Errors.Get("Fake.slow") != nil {
+		t.Error("successful command counted as error")
+	}
+	if _, ok := e.Latency.Get("Fake.slow").(*metrics.Histogram); !ok {
+		t.Error("no latency histogram for the command")
+	}
+}
+
+func TestNewExpvarSameName(t *testing.T) {
+	metrics.NewExpvar("cdp_test_same_name")
+	e := metrics.NewExpvar("cdp_test_same_name")
+	e.CommandStarted("Fake.method")
+	m := expvar.Get("cdp_test_same_name").(*expvar.Map)
+	if m.Get("commands") != e.Commands {
+		t.Error("published map does not belong to the latest recorder")
+	}
+}
@@ -0,0 +1,50 @@
+// Package otelcdp creates OpenTelemetry spans for commands.
+package otelcdp
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/neelance/cdp-go/rpc"
+)
+
+const instrumentationName = "github.com/neelance/cdp-go/otelcdp"
+
+// Option returns an rpc option that creates a client span for each command, named by its method,
+// e.g. "DOM.querySelector". A nil provider uses the global TracerProvider.
+func Option(tp trace.TracerProvider) rpc.Option {
+	return rpc.WithCallInterceptor(CallInterceptor(tp))
+}
+
+// CallInterceptor creates a client span for each command. A nil provider uses the global TracerProvider.
+func CallInterceptor(tp trace.TracerProvider) rpc.CallInterceptor {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	tracer := tp.Tracer(instrumentationName)
+	return func(ctx context.Context, method string, params interface{}, invoke rpc.Invoker) (json.RawMessage, error) {
+		ctx, span := tracer.Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "cdp"),
+				attribute.String("rpc.method", method),
+			),
+		)
+		defer span.End()
+
+		result, err := invoke(ctx, method, params)
+		if err != nil {
+			if perr, ok := err.(*rpc.ProtocolError); ok {
+				span.SetAttributes(attribute.Int("cdp.error_code", perr.Code))
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return result, err
+	}
+}
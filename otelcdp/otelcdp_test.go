@@ -0,0 +1,119 @@
+package otelcdp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"golang.org/x/net/websocket"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/otelcdp"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// recorder is a TracerProvider that keeps the spans it creates.
+type recorder struct {
+	noop.TracerProvider
+	mutex sync.Mutex
+	spans []*span
+}
+
+func (r *recorder) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return tracer{r: r}
+}
+
+type tracer struct {
+	noop.Tracer
+	r *recorder
+}
+
+func (t tracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	config := trace.NewSpanStartConfig(opts...)
+	s := &span{name: name, attrs: config.Attributes()}
+	t.r.mutex.Lock()
+	t.r.spans = append(t.r.spans, s)
+	t.r.mutex.Unlock()
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+type span struct {
+	noop.Span
+	name   string
+	attrs  []attribute.KeyValue
+	status codes.Code
+	errs   []error
+	ended  bool
+}
+
+func (s *span) SetAttributes(kv ...attribute.KeyValue) { s.attrs = append(s.attrs, kv...) }
+func (s *span) SetStatus(code codes.Code, _ string)    { s.status = code }
+func (s *span) End(...trace.SpanEndOption)             { s.ended = true }
+
+func (s *span) RecordError(err error, _ ...trace.EventOption) {
+	s.errs = append(s.errs, err)
+}
+
+func (s *span) attr(key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range s.attrs {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestSpanStatus(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Fake.ok", nil)
+	s.Handle("Fake.fail", func(json.RawMessage) (interface{}, error) {
+		return nil, &rpc.ProtocolError{Code: -32000, Message: "failed"}
+	})
+
+	r := &recorder{}
+	ws, err := websocket.Dial(s.WebSocketURL(), "", s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(ws, otelcdp.Option(r))
+	defer c.Close()
+
+	if err := c.Call("Fake.ok", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Call("Fake.fail", nil, nil); err == nil {
+		t.Fatal("call did not fail")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(r.spans))
+	}
+	ok, fail := r.spans[0], r.spans[1]
+	for _, sp := range r.spans {
+		if !sp.ended {
+			t.Errorf("span %s was not ended", sp.name)
+		}
+		if v, _ := sp.attr("rpc.method"); v.AsString() != sp.name {
+			t.Errorf("got rpc.method %q on span %s", v.AsString(), sp.name)
+		}
+	}
+	if ok.name != "Fake.ok" || ok.status != codes.Unset || len(ok.errs) != 0 {
+		t.Errorf("got span %s with status %v and errors %v, want Fake.ok without error", ok.name, ok.status, ok.errs)
+	}
+	var perr *rpc.ProtocolError
+	if fail.name != "Fake.fail" || fail.status != codes.Error || len(fail.errs) != 1 || !errors.As(fail.errs[0], &perr) {
+		t.Errorf("got span %s with status %v and errors %v, want Fake.fail with the protocol error", fail.name, fail.status, fail.errs)
+	}
+	if v, ok := fail.attr("cdp.error_code"); !ok || v.AsInt64() != -32000 {
+		t.Errorf("got error code %v, want -32000", v.AsInt64())
+	}
+}
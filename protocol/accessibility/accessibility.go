@@ -2,6 +2,9 @@
 package accessibility
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/dom"
@@ -20,14 +23,83 @@ type AXNodeId string
 
 type AXValueType string
 
+const (
+	AXValueTypeBoolean            AXValueType = "boolean"
+	AXValueTypeTristate           AXValueType = "tristate"
+	AXValueTypeBooleanOrUndefined AXValueType = "booleanOrUndefined"
+	AXValueTypeIdref              AXValueType = "idref"
+	AXValueTypeIdrefList          AXValueType = "idrefList"
+	AXValueTypeInteger            AXValueType = "integer"
+	AXValueTypeNode               AXValueType = "node"
+	AXValueTypeNodeList           AXValueType = "nodeList"
+	AXValueTypeNumber             AXValueType = "number"
+	AXValueTypeString             AXValueType = "string"
+	AXValueTypeComputedString     AXValueType = "computedString"
+	AXValueTypeToken              AXValueType = "token"
+	AXValueTypeTokenList          AXValueType = "tokenList"
+	AXValueTypeDomRelation        AXValueType = "domRelation"
+	AXValueTypeRole               AXValueType = "role"
+	AXValueTypeInternalRole       AXValueType = "internalRole"
+	AXValueTypeValueUndefined     AXValueType = "valueUndefined"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v AXValueType) IsValid() bool {
+	switch v {
+	case AXValueTypeBoolean, AXValueTypeTristate, AXValueTypeBooleanOrUndefined, AXValueTypeIdref, AXValueTypeIdrefList, AXValueTypeInteger, AXValueTypeNode, AXValueTypeNodeList, AXValueTypeNumber, AXValueTypeString, AXValueTypeComputedString, AXValueTypeToken, AXValueTypeTokenList, AXValueTypeDomRelation, AXValueTypeRole, AXValueTypeInternalRole, AXValueTypeValueUndefined:
+		return true
+	}
+	return false
+}
+
 // Enum of possible property sources.
 
 type AXValueSourceType string
 
+const (
+	AXValueSourceTypeAttribute      AXValueSourceType = "attribute"
+	AXValueSourceTypeImplicit       AXValueSourceType = "implicit"
+	AXValueSourceTypeStyle          AXValueSourceType = "style"
+	AXValueSourceTypeContents       AXValueSourceType = "contents"
+	AXValueSourceTypePlaceholder    AXValueSourceType = "placeholder"
+	AXValueSourceTypeRelatedElement AXValueSourceType = "relatedElement"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v AXValueSourceType) IsValid() bool {
+	switch v {
+	case AXValueSourceTypeAttribute, AXValueSourceTypeImplicit, AXValueSourceTypeStyle, AXValueSourceTypeContents, AXValueSourceTypePlaceholder, AXValueSourceTypeRelatedElement:
+		return true
+	}
+	return false
+}
+
 // Enum of possible native property sources (as a subtype of a particular AXValueSourceType).
 
 type AXValueNativeSourceType string
 
+const (
+	AXValueNativeSourceTypeDescription    AXValueNativeSourceType = "description"
+	AXValueNativeSourceTypeFigcaption     AXValueNativeSourceType = "figcaption"
+	AXValueNativeSourceTypeLabel          AXValueNativeSourceType = "label"
+	AXValueNativeSourceTypeLabelfor       AXValueNativeSourceType = "labelfor"
+	AXValueNativeSourceTypeLabelwrapped   AXValueNativeSourceType = "labelwrapped"
+	AXValueNativeSourceTypeLegend         AXValueNativeSourceType = "legend"
+	AXValueNativeSourceTypeRubyannotation AXValueNativeSourceType = "rubyannotation"
+	AXValueNativeSourceTypeTablecaption   AXValueNativeSourceType = "tablecaption"
+	AXValueNativeSourceTypeTitle          AXValueNativeSourceType = "title"
+	AXValueNativeSourceTypeOther          AXValueNativeSourceType = "other"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v AXValueNativeSourceType) IsValid() bool {
+	switch v {
+	case AXValueNativeSourceTypeDescription, AXValueNativeSourceTypeFigcaption, AXValueNativeSourceTypeLabel, AXValueNativeSourceTypeLabelfor, AXValueNativeSourceTypeLabelwrapped, AXValueNativeSourceTypeLegend, AXValueNativeSourceTypeRubyannotation, AXValueNativeSourceTypeTablecaption, AXValueNativeSourceTypeTitle, AXValueNativeSourceTypeOther:
+		return true
+	}
+	return false
+}
+
 // A single source for a computed AX property.
 
 type AXValueSource struct {
@@ -38,25 +110,25 @@ type AXValueSource struct {
 	Value *AXValue `json:"value,omitempty"`
 
 	// The name of the relevant attribute, if any. (optional)
-	Attribute string `json:"attribute,omitempty"`
+	Attribute *string `json:"attribute,omitempty"`
 
 	// The value of the relevant attribute, if any. (optional)
 	AttributeValue *AXValue `json:"attributeValue,omitempty"`
 
 	// Whether this source is superseded by a higher priority source. (optional)
-	Superseded bool `json:"superseded,omitempty"`
+	Superseded *bool `json:"superseded,omitempty"`
 
 	// The native markup source for this value, e.g. a <label> element. (optional)
-	NativeSource AXValueNativeSourceType `json:"nativeSource,omitempty"`
+	NativeSource *AXValueNativeSourceType `json:"nativeSource,omitempty"`
 
 	// The value, such as a node or node list, of the native source. (optional)
 	NativeSourceValue *AXValue `json:"nativeSourceValue,omitempty"`
 
 	// Whether the value for this property is invalid. (optional)
-	Invalid bool `json:"invalid,omitempty"`
+	Invalid *bool `json:"invalid,omitempty"`
 
 	// Reason for the value being invalid, if it is. (optional)
-	InvalidReason string `json:"invalidReason,omitempty"`
+	InvalidReason *string `json:"invalidReason,omitempty"`
 }
 
 type AXRelatedNode struct {
@@ -64,10 +136,10 @@ type AXRelatedNode struct {
 	BackendDOMNodeId dom.BackendNodeId `json:"backendDOMNodeId"`
 
 	// The IDRef value provided, if any. (optional)
-	Idref string `json:"idref,omitempty"`
+	Idref *string `json:"idref,omitempty"`
 
 	// The text alternative of this node in the current context. (optional)
-	Text string `json:"text,omitempty"`
+	Text *string `json:"text,omitempty"`
 }
 
 type AXProperty struct {
@@ -85,7 +157,7 @@ type AXValue struct {
 	Type AXValueType `json:"type"`
 
 	// The computed value of this property. (optional)
-	Value interface{} `json:"value,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
 
 	// One or more related nodes, if applicable. (optional)
 	RelatedNodes []*AXRelatedNode `json:"relatedNodes,omitempty"`
@@ -145,28 +217,64 @@ type AXNode struct {
 	ChildIds []AXNodeId `json:"childIds,omitempty"`
 
 	// The backend ID for the associated DOM node, if any. (optional)
-	BackendDOMNodeId dom.BackendNodeId `json:"backendDOMNodeId,omitempty"`
+	BackendDOMNodeId *dom.BackendNodeId `json:"backendDOMNodeId,omitempty"`
+}
+
+// GetPartialAXTreeParams are the parameters of Accessibility.getPartialAXTree.
+type GetPartialAXTreeParams struct {
+	// ID of node to get the partial accessibility tree for.
+	NodeId dom.NodeId `json:"nodeId"`
+
+	// Whether to fetch this nodes ancestors, siblings and children. Defaults to true. (optional)
+	FetchRelatives *bool `json:"fetchRelatives,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetPartialAXTreeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Accessibility.getPartialAXTree", Missing: missing, Invalid: invalid}
 }
 
 type GetPartialAXTreeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetPartialAXTreeParams
 }
 
 // Fetches the accessibility node and partial accessibility tree for this DOM node, if it exists. (experimental)
 func (d *Client) GetPartialAXTree() *GetPartialAXTreeRequest {
-	return &GetPartialAXTreeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetPartialAXTreeRequest{client: d.Client, params: &GetPartialAXTreeParams{set: 1}}
+}
+
+// DoGetPartialAXTree sends Accessibility.getPartialAXTree with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetPartialAXTree(ctx context.Context, params *GetPartialAXTreeParams) (*GetPartialAXTreeResult, error) {
+	if params == nil {
+		params = new(GetPartialAXTreeParams)
+	}
+	return (&GetPartialAXTreeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // ID of node to get the partial accessibility tree for.
 func (r *GetPartialAXTreeRequest) NodeId(v dom.NodeId) *GetPartialAXTreeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Whether to fetch this nodes ancestors, siblings and children. Defaults to true. (optional)
 func (r *GetPartialAXTreeRequest) FetchRelatives(v bool) *GetPartialAXTreeRequest {
-	r.opts["fetchRelatives"] = v
+	r.params.FetchRelatives = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -176,10 +284,32 @@ type GetPartialAXTreeResult struct {
 }
 
 func (r *GetPartialAXTreeRequest) Do() (*GetPartialAXTreeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetPartialAXTreeRequest) DoContext(ctx context.Context) (*GetPartialAXTreeResult, error) {
 	var result GetPartialAXTreeResult
-	err := r.client.Call("Accessibility.getPartialAXTree", r.opts, &result)
+	err := r.client.CallContext(ctx, "Accessibility.getPartialAXTree", r.params, &result)
 	return &result, err
 }
 
+type GetPartialAXTreeFuture struct {
+	*rpc.Call
+	result GetPartialAXTreeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetPartialAXTreeFuture) Get() (*GetPartialAXTreeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetPartialAXTreeRequest) DoAsync(ctx context.Context) *GetPartialAXTreeFuture {
+	f := new(GetPartialAXTreeFuture)
+	f.Call = r.client.Go(ctx, "Accessibility.getPartialAXTree", r.params, &f.result)
+	return f
+}
+
 func init() {
 }
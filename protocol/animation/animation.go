@@ -2,6 +2,8 @@
 package animation
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/dom"
@@ -41,10 +43,10 @@ type Animation struct {
 	Source *AnimationEffect `json:"source"`
 
 	// Animation type of <code>Animation</code>.
-	Type string `json:"type"`
+	Type AnimationType `json:"type"`
 
 	// A unique ID for <code>Animation</code> representing the sources that triggered this CSS animation/transition. (optional)
-	CssId string `json:"cssId,omitempty"`
+	CssId *string `json:"cssId,omitempty"`
 }
 
 // AnimationEffect instance (experimental)
@@ -85,7 +87,7 @@ type AnimationEffect struct {
 
 type KeyframesRule struct {
 	// CSS keyframed animation's name. (optional)
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	// List of animation keyframes.
 	Keyframes []*KeyframeStyle `json:"keyframes"`
@@ -101,42 +103,117 @@ type KeyframeStyle struct {
 	Easing string `json:"easing"`
 }
 
+// Values of the type property of Animation.
+
+type AnimationType string
+
+const (
+	AnimationTypeCSSTransition AnimationType = "CSSTransition"
+	AnimationTypeCSSAnimation  AnimationType = "CSSAnimation"
+	AnimationTypeWebAnimation  AnimationType = "WebAnimation"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v AnimationType) IsValid() bool {
+	switch v {
+	case AnimationTypeCSSTransition, AnimationTypeCSSAnimation, AnimationTypeWebAnimation:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Animation.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables animation domain notifications.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Animation.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Animation.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Animation", r.params)
+}
+
+// DisableParams are the parameters of Animation.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables animation domain notifications.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Animation.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Animation.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Animation", r.params)
+}
+
+// GetPlaybackRateParams are the parameters of Animation.getPlaybackRate.
+type GetPlaybackRateParams struct {
 }
 
 type GetPlaybackRateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetPlaybackRateParams
 }
 
 // Gets the playback rate of the document timeline.
 func (d *Client) GetPlaybackRate() *GetPlaybackRateRequest {
-	return &GetPlaybackRateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetPlaybackRateRequest{client: d.Client, params: &GetPlaybackRateParams{}}
+}
+
+// DoGetPlaybackRate sends Animation.getPlaybackRate with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetPlaybackRate(ctx context.Context, params *GetPlaybackRateParams) (*GetPlaybackRateResult, error) {
+	if params == nil {
+		params = new(GetPlaybackRateParams)
+	}
+	return (&GetPlaybackRateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetPlaybackRateResult struct {
@@ -145,44 +222,139 @@ type GetPlaybackRateResult struct {
 }
 
 func (r *GetPlaybackRateRequest) Do() (*GetPlaybackRateResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetPlaybackRateRequest) DoContext(ctx context.Context) (*GetPlaybackRateResult, error) {
 	var result GetPlaybackRateResult
-	err := r.client.Call("Animation.getPlaybackRate", r.opts, &result)
+	err := r.client.CallContext(ctx, "Animation.getPlaybackRate", r.params, &result)
 	return &result, err
 }
 
+type GetPlaybackRateFuture struct {
+	*rpc.Call
+	result GetPlaybackRateResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetPlaybackRateFuture) Get() (*GetPlaybackRateResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetPlaybackRateRequest) DoAsync(ctx context.Context) *GetPlaybackRateFuture {
+	f := new(GetPlaybackRateFuture)
+	f.Call = r.client.Go(ctx, "Animation.getPlaybackRate", r.params, &f.result)
+	return f
+}
+
+// SetPlaybackRateParams are the parameters of Animation.setPlaybackRate.
+type SetPlaybackRateParams struct {
+	// Playback rate for animations on page
+	PlaybackRate float64 `json:"playbackRate"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetPlaybackRateParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "playbackRate")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Animation.setPlaybackRate", Missing: missing, Invalid: invalid}
+}
+
 type SetPlaybackRateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetPlaybackRateParams
 }
 
 // Sets the playback rate of the document timeline.
 func (d *Client) SetPlaybackRate() *SetPlaybackRateRequest {
-	return &SetPlaybackRateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetPlaybackRateRequest{client: d.Client, params: &SetPlaybackRateParams{set: 1}}
+}
+
+// DoSetPlaybackRate sends Animation.setPlaybackRate with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetPlaybackRate(ctx context.Context, params *SetPlaybackRateParams) error {
+	if params == nil {
+		params = new(SetPlaybackRateParams)
+	}
+	return (&SetPlaybackRateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Playback rate for animations on page
 func (r *SetPlaybackRateRequest) PlaybackRate(v float64) *SetPlaybackRateRequest {
-	r.opts["playbackRate"] = v
+	r.params.PlaybackRate = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetPlaybackRateRequest) Do() error {
-	return r.client.Call("Animation.setPlaybackRate", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetPlaybackRateRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Animation.setPlaybackRate", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetPlaybackRateRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Animation.setPlaybackRate", r.params, nil)
+}
+
+// GetCurrentTimeParams are the parameters of Animation.getCurrentTime.
+type GetCurrentTimeParams struct {
+	// Id of animation.
+	Id string `json:"id"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetCurrentTimeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Id == "" {
+		missing = append(missing, "id")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Animation.getCurrentTime", Missing: missing, Invalid: invalid}
 }
 
 type GetCurrentTimeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetCurrentTimeParams
 }
 
 // Returns the current time of the an animation.
 func (d *Client) GetCurrentTime() *GetCurrentTimeRequest {
-	return &GetCurrentTimeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetCurrentTimeRequest{client: d.Client, params: &GetCurrentTimeParams{set: 1}}
+}
+
+// DoGetCurrentTime sends Animation.getCurrentTime with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetCurrentTime(ctx context.Context, params *GetCurrentTimeParams) (*GetCurrentTimeResult, error) {
+	if params == nil {
+		params = new(GetCurrentTimeParams)
+	}
+	return (&GetCurrentTimeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of animation.
 func (r *GetCurrentTimeRequest) Id(v string) *GetCurrentTimeRequest {
-	r.opts["id"] = v
+	r.params.Id = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -192,128 +364,374 @@ type GetCurrentTimeResult struct {
 }
 
 func (r *GetCurrentTimeRequest) Do() (*GetCurrentTimeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetCurrentTimeRequest) DoContext(ctx context.Context) (*GetCurrentTimeResult, error) {
 	var result GetCurrentTimeResult
-	err := r.client.Call("Animation.getCurrentTime", r.opts, &result)
+	err := r.client.CallContext(ctx, "Animation.getCurrentTime", r.params, &result)
 	return &result, err
 }
 
+type GetCurrentTimeFuture struct {
+	*rpc.Call
+	result GetCurrentTimeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetCurrentTimeFuture) Get() (*GetCurrentTimeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetCurrentTimeRequest) DoAsync(ctx context.Context) *GetCurrentTimeFuture {
+	f := new(GetCurrentTimeFuture)
+	f.Call = r.client.Go(ctx, "Animation.getCurrentTime", r.params, &f.result)
+	return f
+}
+
+// SetPausedParams are the parameters of Animation.setPaused.
+type SetPausedParams struct {
+	// Animations to set the pause state of.
+	Animations []string `json:"animations"`
+
+	// Paused state to set to.
+	Paused bool `json:"paused"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetPausedParams) Validate() error {
+	var missing, invalid []string
+	if p.Animations == nil {
+		missing = append(missing, "animations")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "paused")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Animation.setPaused", Missing: missing, Invalid: invalid}
+}
+
 type SetPausedRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetPausedParams
 }
 
 // Sets the paused state of a set of animations.
 func (d *Client) SetPaused() *SetPausedRequest {
-	return &SetPausedRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetPausedRequest{client: d.Client, params: &SetPausedParams{set: 1}}
+}
+
+// DoSetPaused sends Animation.setPaused with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetPaused(ctx context.Context, params *SetPausedParams) error {
+	if params == nil {
+		params = new(SetPausedParams)
+	}
+	return (&SetPausedRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Animations to set the pause state of.
 func (r *SetPausedRequest) Animations(v []string) *SetPausedRequest {
-	r.opts["animations"] = v
+	r.params.Animations = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Paused state to set to.
 func (r *SetPausedRequest) Paused(v bool) *SetPausedRequest {
-	r.opts["paused"] = v
+	r.params.Paused = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetPausedRequest) Do() error {
-	return r.client.Call("Animation.setPaused", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetPausedRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Animation.setPaused", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetPausedRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Animation.setPaused", r.params, nil)
+}
+
+// SetTimingParams are the parameters of Animation.setTiming.
+type SetTimingParams struct {
+	// Animation id.
+	AnimationId string `json:"animationId"`
+
+	// Duration of the animation.
+	Duration float64 `json:"duration"`
+
+	// Delay of the animation.
+	Delay float64 `json:"delay"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetTimingParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.AnimationId == "" {
+		missing = append(missing, "animationId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "duration")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "delay")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Animation.setTiming", Missing: missing, Invalid: invalid}
 }
 
 type SetTimingRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetTimingParams
 }
 
 // Sets the timing of an animation node.
 func (d *Client) SetTiming() *SetTimingRequest {
-	return &SetTimingRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetTimingRequest{client: d.Client, params: &SetTimingParams{set: 1}}
+}
+
+// DoSetTiming sends Animation.setTiming with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetTiming(ctx context.Context, params *SetTimingParams) error {
+	if params == nil {
+		params = new(SetTimingParams)
+	}
+	return (&SetTimingRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Animation id.
 func (r *SetTimingRequest) AnimationId(v string) *SetTimingRequest {
-	r.opts["animationId"] = v
+	r.params.AnimationId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Duration of the animation.
 func (r *SetTimingRequest) Duration(v float64) *SetTimingRequest {
-	r.opts["duration"] = v
+	r.params.Duration = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Delay of the animation.
 func (r *SetTimingRequest) Delay(v float64) *SetTimingRequest {
-	r.opts["delay"] = v
+	r.params.Delay = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetTimingRequest) Do() error {
-	return r.client.Call("Animation.setTiming", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetTimingRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Animation.setTiming", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetTimingRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Animation.setTiming", r.params, nil)
+}
+
+// SeekAnimationsParams are the parameters of Animation.seekAnimations.
+type SeekAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+
+	// Set the current time of each animation.
+	CurrentTime float64 `json:"currentTime"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SeekAnimationsParams) Validate() error {
+	var missing, invalid []string
+	if p.Animations == nil {
+		missing = append(missing, "animations")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "currentTime")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Animation.seekAnimations", Missing: missing, Invalid: invalid}
 }
 
 type SeekAnimationsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SeekAnimationsParams
 }
 
 // Seek a set of animations to a particular time within each animation.
 func (d *Client) SeekAnimations() *SeekAnimationsRequest {
-	return &SeekAnimationsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SeekAnimationsRequest{client: d.Client, params: &SeekAnimationsParams{set: 1}}
+}
+
+// DoSeekAnimations sends Animation.seekAnimations with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSeekAnimations(ctx context.Context, params *SeekAnimationsParams) error {
+	if params == nil {
+		params = new(SeekAnimationsParams)
+	}
+	return (&SeekAnimationsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // List of animation ids to seek.
 func (r *SeekAnimationsRequest) Animations(v []string) *SeekAnimationsRequest {
-	r.opts["animations"] = v
+	r.params.Animations = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Set the current time of each animation.
 func (r *SeekAnimationsRequest) CurrentTime(v float64) *SeekAnimationsRequest {
-	r.opts["currentTime"] = v
+	r.params.CurrentTime = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SeekAnimationsRequest) Do() error {
-	return r.client.Call("Animation.seekAnimations", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SeekAnimationsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Animation.seekAnimations", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SeekAnimationsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Animation.seekAnimations", r.params, nil)
+}
+
+// ReleaseAnimationsParams are the parameters of Animation.releaseAnimations.
+type ReleaseAnimationsParams struct {
+	// List of animation ids to seek.
+	Animations []string `json:"animations"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReleaseAnimationsParams) Validate() error {
+	var missing, invalid []string
+	if p.Animations == nil {
+		missing = append(missing, "animations")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Animation.releaseAnimations", Missing: missing, Invalid: invalid}
 }
 
 type ReleaseAnimationsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReleaseAnimationsParams
 }
 
 // Releases a set of animations to no longer be manipulated.
 func (d *Client) ReleaseAnimations() *ReleaseAnimationsRequest {
-	return &ReleaseAnimationsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReleaseAnimationsRequest{client: d.Client, params: &ReleaseAnimationsParams{set: 1}}
+}
+
+// DoReleaseAnimations sends Animation.releaseAnimations with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoReleaseAnimations(ctx context.Context, params *ReleaseAnimationsParams) error {
+	if params == nil {
+		params = new(ReleaseAnimationsParams)
+	}
+	return (&ReleaseAnimationsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // List of animation ids to seek.
 func (r *ReleaseAnimationsRequest) Animations(v []string) *ReleaseAnimationsRequest {
-	r.opts["animations"] = v
+	r.params.Animations = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ReleaseAnimationsRequest) Do() error {
-	return r.client.Call("Animation.releaseAnimations", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ReleaseAnimationsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Animation.releaseAnimations", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ReleaseAnimationsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Animation.releaseAnimations", r.params, nil)
+}
+
+// ResolveAnimationParams are the parameters of Animation.resolveAnimation.
+type ResolveAnimationParams struct {
+	// Animation id.
+	AnimationId string `json:"animationId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ResolveAnimationParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.AnimationId == "" {
+		missing = append(missing, "animationId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Animation.resolveAnimation", Missing: missing, Invalid: invalid}
 }
 
 type ResolveAnimationRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ResolveAnimationParams
 }
 
 // Gets the remote object of the Animation.
 func (d *Client) ResolveAnimation() *ResolveAnimationRequest {
-	return &ResolveAnimationRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ResolveAnimationRequest{client: d.Client, params: &ResolveAnimationParams{set: 1}}
+}
+
+// DoResolveAnimation sends Animation.resolveAnimation with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoResolveAnimation(ctx context.Context, params *ResolveAnimationParams) (*ResolveAnimationResult, error) {
+	if params == nil {
+		params = new(ResolveAnimationParams)
+	}
+	return (&ResolveAnimationRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Animation id.
 func (r *ResolveAnimationRequest) AnimationId(v string) *ResolveAnimationRequest {
-	r.opts["animationId"] = v
+	r.params.AnimationId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -323,11 +741,33 @@ type ResolveAnimationResult struct {
 }
 
 func (r *ResolveAnimationRequest) Do() (*ResolveAnimationResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *ResolveAnimationRequest) DoContext(ctx context.Context) (*ResolveAnimationResult, error) {
 	var result ResolveAnimationResult
-	err := r.client.Call("Animation.resolveAnimation", r.opts, &result)
+	err := r.client.CallContext(ctx, "Animation.resolveAnimation", r.params, &result)
 	return &result, err
 }
 
+type ResolveAnimationFuture struct {
+	*rpc.Call
+	result ResolveAnimationResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *ResolveAnimationFuture) Get() (*ResolveAnimationResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *ResolveAnimationRequest) DoAsync(ctx context.Context) *ResolveAnimationFuture {
+	f := new(ResolveAnimationFuture)
+	f.Call = r.client.Go(ctx, "Animation.resolveAnimation", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["Animation.animationCreated"] = func() interface{} { return new(AnimationCreatedEvent) }
 	rpc.EventTypes["Animation.animationStarted"] = func() interface{} { return new(AnimationStartedEvent) }
@@ -340,14 +780,131 @@ type AnimationCreatedEvent struct {
 	Id string `json:"id"`
 }
 
+// Event for each animation that has been created.
+func (d *Client) OnAnimationCreated(fn func(*AnimationCreatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Animation.animationCreated", func(e interface{}) {
+		fn(e.(*AnimationCreatedEvent))
+	}, opts...)
+}
+
+// FilterAnimationCreated is rpc.WithFilter for OnAnimationCreated.
+func FilterAnimationCreated(fn func(*AnimationCreatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AnimationCreatedEvent))
+	})
+}
+
+// AnimationCreatedWaiter waits for a Animation.animationCreated event. It is created by WaitForAnimationCreated.
+type AnimationCreatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AnimationCreatedWaiter) Wait() (*AnimationCreatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AnimationCreatedEvent), nil
+}
+
+// WaitForAnimationCreated arms a waiter for the first Animation.animationCreated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAnimationCreated(ctx context.Context, predicate func(*AnimationCreatedEvent) bool) *AnimationCreatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AnimationCreatedEvent))
+		}
+	}
+	return &AnimationCreatedWaiter{d.Client.WaitFor(ctx, "Animation.animationCreated", p)}
+}
+
 // Event for animation that has been started.
 type AnimationStartedEvent struct {
 	// Animation that was started.
 	Animation *Animation `json:"animation"`
 }
 
+// Event for animation that has been started.
+func (d *Client) OnAnimationStarted(fn func(*AnimationStartedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Animation.animationStarted", func(e interface{}) {
+		fn(e.(*AnimationStartedEvent))
+	}, opts...)
+}
+
+// FilterAnimationStarted is rpc.WithFilter for OnAnimationStarted.
+func FilterAnimationStarted(fn func(*AnimationStartedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AnimationStartedEvent))
+	})
+}
+
+// AnimationStartedWaiter waits for a Animation.animationStarted event. It is created by WaitForAnimationStarted.
+type AnimationStartedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AnimationStartedWaiter) Wait() (*AnimationStartedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AnimationStartedEvent), nil
+}
+
+// WaitForAnimationStarted arms a waiter for the first Animation.animationStarted event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAnimationStarted(ctx context.Context, predicate func(*AnimationStartedEvent) bool) *AnimationStartedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AnimationStartedEvent))
+		}
+	}
+	return &AnimationStartedWaiter{d.Client.WaitFor(ctx, "Animation.animationStarted", p)}
+}
+
 // Event for when an animation has been cancelled.
 type AnimationCanceledEvent struct {
 	// Id of the animation that was cancelled.
 	Id string `json:"id"`
 }
+
+// Event for when an animation has been cancelled.
+func (d *Client) OnAnimationCanceled(fn func(*AnimationCanceledEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Animation.animationCanceled", func(e interface{}) {
+		fn(e.(*AnimationCanceledEvent))
+	}, opts...)
+}
+
+// FilterAnimationCanceled is rpc.WithFilter for OnAnimationCanceled.
+func FilterAnimationCanceled(fn func(*AnimationCanceledEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AnimationCanceledEvent))
+	})
+}
+
+// AnimationCanceledWaiter waits for a Animation.animationCanceled event. It is created by WaitForAnimationCanceled.
+type AnimationCanceledWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AnimationCanceledWaiter) Wait() (*AnimationCanceledEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AnimationCanceledEvent), nil
+}
+
+// WaitForAnimationCanceled arms a waiter for the first Animation.animationCanceled event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAnimationCanceled(ctx context.Context, predicate func(*AnimationCanceledEvent) bool) *AnimationCanceledWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AnimationCanceledEvent))
+		}
+	}
+	return &AnimationCanceledWaiter{d.Client.WaitFor(ctx, "Animation.animationCanceled", p)}
+}
@@ -2,6 +2,8 @@
 package applicationcache
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -55,14 +57,26 @@ type FrameWithManifest struct {
 	Status int `json:"status"`
 }
 
+// GetFramesWithManifestsParams are the parameters of ApplicationCache.getFramesWithManifests.
+type GetFramesWithManifestsParams struct {
+}
+
 type GetFramesWithManifestsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetFramesWithManifestsParams
 }
 
 // Returns array of frame identifiers with manifest urls for each frame containing a document associated with some application cache.
 func (d *Client) GetFramesWithManifests() *GetFramesWithManifestsRequest {
-	return &GetFramesWithManifestsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetFramesWithManifestsRequest{client: d.Client, params: &GetFramesWithManifestsParams{}}
+}
+
+// DoGetFramesWithManifests sends ApplicationCache.getFramesWithManifests with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetFramesWithManifests(ctx context.Context, params *GetFramesWithManifestsParams) (*GetFramesWithManifestsResult, error) {
+	if params == nil {
+		params = new(GetFramesWithManifestsParams)
+	}
+	return (&GetFramesWithManifestsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetFramesWithManifestsResult struct {
@@ -71,38 +85,114 @@ type GetFramesWithManifestsResult struct {
 }
 
 func (r *GetFramesWithManifestsRequest) Do() (*GetFramesWithManifestsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetFramesWithManifestsRequest) DoContext(ctx context.Context) (*GetFramesWithManifestsResult, error) {
 	var result GetFramesWithManifestsResult
-	err := r.client.Call("ApplicationCache.getFramesWithManifests", r.opts, &result)
+	err := r.client.CallContext(ctx, "ApplicationCache.getFramesWithManifests", r.params, &result)
 	return &result, err
 }
 
+type GetFramesWithManifestsFuture struct {
+	*rpc.Call
+	result GetFramesWithManifestsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetFramesWithManifestsFuture) Get() (*GetFramesWithManifestsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetFramesWithManifestsRequest) DoAsync(ctx context.Context) *GetFramesWithManifestsFuture {
+	f := new(GetFramesWithManifestsFuture)
+	f.Call = r.client.Go(ctx, "ApplicationCache.getFramesWithManifests", r.params, &f.result)
+	return f
+}
+
+// EnableParams are the parameters of ApplicationCache.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables application cache domain notifications.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends ApplicationCache.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("ApplicationCache.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "ApplicationCache", r.params)
+}
+
+// GetManifestForFrameParams are the parameters of ApplicationCache.getManifestForFrame.
+type GetManifestForFrameParams struct {
+	// Identifier of the frame containing document whose manifest is retrieved.
+	FrameId string `json:"frameId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetManifestForFrameParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ApplicationCache.getManifestForFrame", Missing: missing, Invalid: invalid}
 }
 
 type GetManifestForFrameRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetManifestForFrameParams
 }
 
 // Returns manifest URL for document in the given frame.
 func (d *Client) GetManifestForFrame() *GetManifestForFrameRequest {
-	return &GetManifestForFrameRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetManifestForFrameRequest{client: d.Client, params: &GetManifestForFrameParams{set: 1}}
+}
+
+// DoGetManifestForFrame sends ApplicationCache.getManifestForFrame with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetManifestForFrame(ctx context.Context, params *GetManifestForFrameParams) (*GetManifestForFrameResult, error) {
+	if params == nil {
+		params = new(GetManifestForFrameParams)
+	}
+	return (&GetManifestForFrameRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the frame containing document whose manifest is retrieved.
 func (r *GetManifestForFrameRequest) FrameId(v string) *GetManifestForFrameRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -112,24 +202,78 @@ type GetManifestForFrameResult struct {
 }
 
 func (r *GetManifestForFrameRequest) Do() (*GetManifestForFrameResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetManifestForFrameRequest) DoContext(ctx context.Context) (*GetManifestForFrameResult, error) {
 	var result GetManifestForFrameResult
-	err := r.client.Call("ApplicationCache.getManifestForFrame", r.opts, &result)
+	err := r.client.CallContext(ctx, "ApplicationCache.getManifestForFrame", r.params, &result)
 	return &result, err
 }
 
+type GetManifestForFrameFuture struct {
+	*rpc.Call
+	result GetManifestForFrameResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetManifestForFrameFuture) Get() (*GetManifestForFrameResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetManifestForFrameRequest) DoAsync(ctx context.Context) *GetManifestForFrameFuture {
+	f := new(GetManifestForFrameFuture)
+	f.Call = r.client.Go(ctx, "ApplicationCache.getManifestForFrame", r.params, &f.result)
+	return f
+}
+
+// GetApplicationCacheForFrameParams are the parameters of ApplicationCache.getApplicationCacheForFrame.
+type GetApplicationCacheForFrameParams struct {
+	// Identifier of the frame containing document whose application cache is retrieved.
+	FrameId string `json:"frameId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetApplicationCacheForFrameParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ApplicationCache.getApplicationCacheForFrame", Missing: missing, Invalid: invalid}
+}
+
 type GetApplicationCacheForFrameRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetApplicationCacheForFrameParams
 }
 
 // Returns relevant application cache data for the document in given frame.
 func (d *Client) GetApplicationCacheForFrame() *GetApplicationCacheForFrameRequest {
-	return &GetApplicationCacheForFrameRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetApplicationCacheForFrameRequest{client: d.Client, params: &GetApplicationCacheForFrameParams{set: 1}}
+}
+
+// DoGetApplicationCacheForFrame sends ApplicationCache.getApplicationCacheForFrame with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetApplicationCacheForFrame(ctx context.Context, params *GetApplicationCacheForFrameParams) (*GetApplicationCacheForFrameResult, error) {
+	if params == nil {
+		params = new(GetApplicationCacheForFrameParams)
+	}
+	return (&GetApplicationCacheForFrameRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the frame containing document whose application cache is retrieved.
 func (r *GetApplicationCacheForFrameRequest) FrameId(v string) *GetApplicationCacheForFrameRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -139,11 +283,33 @@ type GetApplicationCacheForFrameResult struct {
 }
 
 func (r *GetApplicationCacheForFrameRequest) Do() (*GetApplicationCacheForFrameResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetApplicationCacheForFrameRequest) DoContext(ctx context.Context) (*GetApplicationCacheForFrameResult, error) {
 	var result GetApplicationCacheForFrameResult
-	err := r.client.Call("ApplicationCache.getApplicationCacheForFrame", r.opts, &result)
+	err := r.client.CallContext(ctx, "ApplicationCache.getApplicationCacheForFrame", r.params, &result)
 	return &result, err
 }
 
+type GetApplicationCacheForFrameFuture struct {
+	*rpc.Call
+	result GetApplicationCacheForFrameResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetApplicationCacheForFrameFuture) Get() (*GetApplicationCacheForFrameResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetApplicationCacheForFrameRequest) DoAsync(ctx context.Context) *GetApplicationCacheForFrameFuture {
+	f := new(GetApplicationCacheForFrameFuture)
+	f.Call = r.client.Go(ctx, "ApplicationCache.getApplicationCacheForFrame", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["ApplicationCache.applicationCacheStatusUpdated"] = func() interface{} { return new(ApplicationCacheStatusUpdatedEvent) }
 	rpc.EventTypes["ApplicationCache.networkStateUpdated"] = func() interface{} { return new(NetworkStateUpdatedEvent) }
@@ -160,6 +326,82 @@ type ApplicationCacheStatusUpdatedEvent struct {
 	Status int `json:"status"`
 }
 
+func (d *Client) OnApplicationCacheStatusUpdated(fn func(*ApplicationCacheStatusUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("ApplicationCache.applicationCacheStatusUpdated", func(e interface{}) {
+		fn(e.(*ApplicationCacheStatusUpdatedEvent))
+	}, opts...)
+}
+
+// FilterApplicationCacheStatusUpdated is rpc.WithFilter for OnApplicationCacheStatusUpdated.
+func FilterApplicationCacheStatusUpdated(fn func(*ApplicationCacheStatusUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ApplicationCacheStatusUpdatedEvent))
+	})
+}
+
+// ApplicationCacheStatusUpdatedWaiter waits for a ApplicationCache.applicationCacheStatusUpdated event. It is created by WaitForApplicationCacheStatusUpdated.
+type ApplicationCacheStatusUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ApplicationCacheStatusUpdatedWaiter) Wait() (*ApplicationCacheStatusUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ApplicationCacheStatusUpdatedEvent), nil
+}
+
+// WaitForApplicationCacheStatusUpdated arms a waiter for the first ApplicationCache.applicationCacheStatusUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForApplicationCacheStatusUpdated(ctx context.Context, predicate func(*ApplicationCacheStatusUpdatedEvent) bool) *ApplicationCacheStatusUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ApplicationCacheStatusUpdatedEvent))
+		}
+	}
+	return &ApplicationCacheStatusUpdatedWaiter{d.Client.WaitFor(ctx, "ApplicationCache.applicationCacheStatusUpdated", p)}
+}
+
 type NetworkStateUpdatedEvent struct {
 	IsNowOnline bool `json:"isNowOnline"`
 }
+
+func (d *Client) OnNetworkStateUpdated(fn func(*NetworkStateUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("ApplicationCache.networkStateUpdated", func(e interface{}) {
+		fn(e.(*NetworkStateUpdatedEvent))
+	}, opts...)
+}
+
+// FilterNetworkStateUpdated is rpc.WithFilter for OnNetworkStateUpdated.
+func FilterNetworkStateUpdated(fn func(*NetworkStateUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*NetworkStateUpdatedEvent))
+	})
+}
+
+// NetworkStateUpdatedWaiter waits for a ApplicationCache.networkStateUpdated event. It is created by WaitForNetworkStateUpdated.
+type NetworkStateUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *NetworkStateUpdatedWaiter) Wait() (*NetworkStateUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*NetworkStateUpdatedEvent), nil
+}
+
+// WaitForNetworkStateUpdated arms a waiter for the first ApplicationCache.networkStateUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForNetworkStateUpdated(ctx context.Context, predicate func(*NetworkStateUpdatedEvent) bool) *NetworkStateUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*NetworkStateUpdatedEvent))
+		}
+	}
+	return &NetworkStateUpdatedWaiter{d.Client.WaitFor(ctx, "ApplicationCache.networkStateUpdated", p)}
+}
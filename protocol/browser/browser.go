@@ -2,6 +2,8 @@
 package browser
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/target"
@@ -18,38 +20,86 @@ type WindowID int
 
 type WindowState string
 
+const (
+	WindowStateNormal     WindowState = "normal"
+	WindowStateMinimized  WindowState = "minimized"
+	WindowStateMaximized  WindowState = "maximized"
+	WindowStateFullscreen WindowState = "fullscreen"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v WindowState) IsValid() bool {
+	switch v {
+	case WindowStateNormal, WindowStateMinimized, WindowStateMaximized, WindowStateFullscreen:
+		return true
+	}
+	return false
+}
+
 // Browser window bounds information
 
 type Bounds struct {
 	// The offset from the left edge of the screen to the window in pixels. (optional)
-	Left int `json:"left,omitempty"`
+	Left *int `json:"left,omitempty"`
 
 	// The offset from the top edge of the screen to the window in pixels. (optional)
-	Top int `json:"top,omitempty"`
+	Top *int `json:"top,omitempty"`
 
 	// The window width in pixels. (optional)
-	Width int `json:"width,omitempty"`
+	Width *int `json:"width,omitempty"`
 
 	// The window height in pixels. (optional)
-	Height int `json:"height,omitempty"`
+	Height *int `json:"height,omitempty"`
 
 	// The window state. Default to normal. (optional)
-	WindowState WindowState `json:"windowState,omitempty"`
+	WindowState *WindowState `json:"windowState,omitempty"`
+}
+
+// GetWindowForTargetParams are the parameters of Browser.getWindowForTarget.
+type GetWindowForTargetParams struct {
+	// Devtools agent host id.
+	TargetId target.TargetID `json:"targetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetWindowForTargetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.TargetId == "" {
+		missing = append(missing, "targetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Browser.getWindowForTarget", Missing: missing, Invalid: invalid}
 }
 
 type GetWindowForTargetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetWindowForTargetParams
 }
 
 // Get the browser window that contains the devtools target.
 func (d *Client) GetWindowForTarget() *GetWindowForTargetRequest {
-	return &GetWindowForTargetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetWindowForTargetRequest{client: d.Client, params: &GetWindowForTargetParams{set: 1}}
+}
+
+// DoGetWindowForTarget sends Browser.getWindowForTarget with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetWindowForTarget(ctx context.Context, params *GetWindowForTargetParams) (*GetWindowForTargetResult, error) {
+	if params == nil {
+		params = new(GetWindowForTargetParams)
+	}
+	return (&GetWindowForTargetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Devtools agent host id.
 func (r *GetWindowForTargetRequest) TargetId(v target.TargetID) *GetWindowForTargetRequest {
-	r.opts["targetId"] = v
+	r.params.TargetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -62,50 +112,152 @@ type GetWindowForTargetResult struct {
 }
 
 func (r *GetWindowForTargetRequest) Do() (*GetWindowForTargetResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetWindowForTargetRequest) DoContext(ctx context.Context) (*GetWindowForTargetResult, error) {
 	var result GetWindowForTargetResult
-	err := r.client.Call("Browser.getWindowForTarget", r.opts, &result)
+	err := r.client.CallContext(ctx, "Browser.getWindowForTarget", r.params, &result)
 	return &result, err
 }
 
+type GetWindowForTargetFuture struct {
+	*rpc.Call
+	result GetWindowForTargetResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetWindowForTargetFuture) Get() (*GetWindowForTargetResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetWindowForTargetRequest) DoAsync(ctx context.Context) *GetWindowForTargetFuture {
+	f := new(GetWindowForTargetFuture)
+	f.Call = r.client.Go(ctx, "Browser.getWindowForTarget", r.params, &f.result)
+	return f
+}
+
+// SetWindowBoundsParams are the parameters of Browser.setWindowBounds.
+type SetWindowBoundsParams struct {
+	// Browser window id.
+	WindowId WindowID `json:"windowId"`
+
+	// New window bounds. The 'minimized', 'maximized' and 'fullscreen' states cannot be combined with 'left', 'top', 'width' or 'height'. Leaves unspecified fields unchanged.
+	Bounds *Bounds `json:"bounds"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetWindowBoundsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "windowId")
+	}
+	if p.Bounds == nil {
+		missing = append(missing, "bounds")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Browser.setWindowBounds", Missing: missing, Invalid: invalid}
+}
+
 type SetWindowBoundsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetWindowBoundsParams
 }
 
 // Set position and/or size of the browser window.
 func (d *Client) SetWindowBounds() *SetWindowBoundsRequest {
-	return &SetWindowBoundsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetWindowBoundsRequest{client: d.Client, params: &SetWindowBoundsParams{set: 1}}
+}
+
+// DoSetWindowBounds sends Browser.setWindowBounds with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetWindowBounds(ctx context.Context, params *SetWindowBoundsParams) error {
+	if params == nil {
+		params = new(SetWindowBoundsParams)
+	}
+	return (&SetWindowBoundsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Browser window id.
 func (r *SetWindowBoundsRequest) WindowId(v WindowID) *SetWindowBoundsRequest {
-	r.opts["windowId"] = v
+	r.params.WindowId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // New window bounds. The 'minimized', 'maximized' and 'fullscreen' states cannot be combined with 'left', 'top', 'width' or 'height'. Leaves unspecified fields unchanged.
 func (r *SetWindowBoundsRequest) Bounds(v *Bounds) *SetWindowBoundsRequest {
-	r.opts["bounds"] = v
+	r.params.Bounds = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetWindowBoundsRequest) Do() error {
-	return r.client.Call("Browser.setWindowBounds", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetWindowBoundsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Browser.setWindowBounds", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetWindowBoundsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Browser.setWindowBounds", r.params, nil)
+}
+
+// GetWindowBoundsParams are the parameters of Browser.getWindowBounds.
+type GetWindowBoundsParams struct {
+	// Browser window id.
+	WindowId WindowID `json:"windowId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetWindowBoundsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "windowId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Browser.getWindowBounds", Missing: missing, Invalid: invalid}
 }
 
 type GetWindowBoundsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetWindowBoundsParams
 }
 
 // Get position and size of the browser window.
 func (d *Client) GetWindowBounds() *GetWindowBoundsRequest {
-	return &GetWindowBoundsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetWindowBoundsRequest{client: d.Client, params: &GetWindowBoundsParams{set: 1}}
+}
+
+// DoGetWindowBounds sends Browser.getWindowBounds with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetWindowBounds(ctx context.Context, params *GetWindowBoundsParams) (*GetWindowBoundsResult, error) {
+	if params == nil {
+		params = new(GetWindowBoundsParams)
+	}
+	return (&GetWindowBoundsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Browser window id.
 func (r *GetWindowBoundsRequest) WindowId(v WindowID) *GetWindowBoundsRequest {
-	r.opts["windowId"] = v
+	r.params.WindowId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -115,10 +267,32 @@ type GetWindowBoundsResult struct {
 }
 
 func (r *GetWindowBoundsRequest) Do() (*GetWindowBoundsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetWindowBoundsRequest) DoContext(ctx context.Context) (*GetWindowBoundsResult, error) {
 	var result GetWindowBoundsResult
-	err := r.client.Call("Browser.getWindowBounds", r.opts, &result)
+	err := r.client.CallContext(ctx, "Browser.getWindowBounds", r.params, &result)
 	return &result, err
 }
 
+type GetWindowBoundsFuture struct {
+	*rpc.Call
+	result GetWindowBoundsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetWindowBoundsFuture) Get() (*GetWindowBoundsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetWindowBoundsRequest) DoAsync(ctx context.Context) *GetWindowBoundsFuture {
+	f := new(GetWindowBoundsFuture)
+	f.Call = r.client.Go(ctx, "Browser.getWindowBounds", r.params, &f.result)
+	return f
+}
+
 func init() {
 }
@@ -2,6 +2,8 @@
 package cachestorage
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -40,19 +42,51 @@ type Cache struct {
 	CacheName string `json:"cacheName"`
 }
 
+// RequestCacheNamesParams are the parameters of CacheStorage.requestCacheNames.
+type RequestCacheNamesParams struct {
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RequestCacheNamesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SecurityOrigin == "" {
+		missing = append(missing, "securityOrigin")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CacheStorage.requestCacheNames", Missing: missing, Invalid: invalid}
+}
+
 type RequestCacheNamesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestCacheNamesParams
 }
 
 // Requests cache names.
 func (d *Client) RequestCacheNames() *RequestCacheNamesRequest {
-	return &RequestCacheNamesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestCacheNamesRequest{client: d.Client, params: &RequestCacheNamesParams{set: 1}}
+}
+
+// DoRequestCacheNames sends CacheStorage.requestCacheNames with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestCacheNames(ctx context.Context, params *RequestCacheNamesParams) (*RequestCacheNamesResult, error) {
+	if params == nil {
+		params = new(RequestCacheNamesParams)
+	}
+	return (&RequestCacheNamesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Security origin.
 func (r *RequestCacheNamesRequest) SecurityOrigin(v string) *RequestCacheNamesRequest {
-	r.opts["securityOrigin"] = v
+	r.params.SecurityOrigin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -62,36 +96,104 @@ type RequestCacheNamesResult struct {
 }
 
 func (r *RequestCacheNamesRequest) Do() (*RequestCacheNamesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestCacheNamesRequest) DoContext(ctx context.Context) (*RequestCacheNamesResult, error) {
 	var result RequestCacheNamesResult
-	err := r.client.Call("CacheStorage.requestCacheNames", r.opts, &result)
+	err := r.client.CallContext(ctx, "CacheStorage.requestCacheNames", r.params, &result)
 	return &result, err
 }
 
+type RequestCacheNamesFuture struct {
+	*rpc.Call
+	result RequestCacheNamesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RequestCacheNamesFuture) Get() (*RequestCacheNamesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RequestCacheNamesRequest) DoAsync(ctx context.Context) *RequestCacheNamesFuture {
+	f := new(RequestCacheNamesFuture)
+	f.Call = r.client.Go(ctx, "CacheStorage.requestCacheNames", r.params, &f.result)
+	return f
+}
+
+// RequestEntriesParams are the parameters of CacheStorage.requestEntries.
+type RequestEntriesParams struct {
+	// ID of cache to get entries from.
+	CacheId CacheId `json:"cacheId"`
+
+	// Number of records to skip.
+	SkipCount int `json:"skipCount"`
+
+	// Number of records to fetch.
+	PageSize int `json:"pageSize"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RequestEntriesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.CacheId == "" {
+		missing = append(missing, "cacheId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "skipCount")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "pageSize")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CacheStorage.requestEntries", Missing: missing, Invalid: invalid}
+}
+
 type RequestEntriesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestEntriesParams
 }
 
 // Requests data from cache.
 func (d *Client) RequestEntries() *RequestEntriesRequest {
-	return &RequestEntriesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestEntriesRequest{client: d.Client, params: &RequestEntriesParams{set: 1}}
+}
+
+// DoRequestEntries sends CacheStorage.requestEntries with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestEntries(ctx context.Context, params *RequestEntriesParams) (*RequestEntriesResult, error) {
+	if params == nil {
+		params = new(RequestEntriesParams)
+	}
+	return (&RequestEntriesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // ID of cache to get entries from.
 func (r *RequestEntriesRequest) CacheId(v CacheId) *RequestEntriesRequest {
-	r.opts["cacheId"] = v
+	r.params.CacheId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Number of records to skip.
 func (r *RequestEntriesRequest) SkipCount(v int) *RequestEntriesRequest {
-	r.opts["skipCount"] = v
+	r.params.SkipCount = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Number of records to fetch.
 func (r *RequestEntriesRequest) PageSize(v int) *RequestEntriesRequest {
-	r.opts["pageSize"] = v
+	r.params.PageSize = v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -104,55 +206,166 @@ type RequestEntriesResult struct {
 }
 
 func (r *RequestEntriesRequest) Do() (*RequestEntriesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestEntriesRequest) DoContext(ctx context.Context) (*RequestEntriesResult, error) {
 	var result RequestEntriesResult
-	err := r.client.Call("CacheStorage.requestEntries", r.opts, &result)
+	err := r.client.CallContext(ctx, "CacheStorage.requestEntries", r.params, &result)
 	return &result, err
 }
 
+type RequestEntriesFuture struct {
+	*rpc.Call
+	result RequestEntriesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RequestEntriesFuture) Get() (*RequestEntriesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RequestEntriesRequest) DoAsync(ctx context.Context) *RequestEntriesFuture {
+	f := new(RequestEntriesFuture)
+	f.Call = r.client.Go(ctx, "CacheStorage.requestEntries", r.params, &f.result)
+	return f
+}
+
+// DeleteCacheParams are the parameters of CacheStorage.deleteCache.
+type DeleteCacheParams struct {
+	// Id of cache for deletion.
+	CacheId CacheId `json:"cacheId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DeleteCacheParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.CacheId == "" {
+		missing = append(missing, "cacheId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CacheStorage.deleteCache", Missing: missing, Invalid: invalid}
+}
+
 type DeleteCacheRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DeleteCacheParams
 }
 
 // Deletes a cache.
 func (d *Client) DeleteCache() *DeleteCacheRequest {
-	return &DeleteCacheRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DeleteCacheRequest{client: d.Client, params: &DeleteCacheParams{set: 1}}
+}
+
+// DoDeleteCache sends CacheStorage.deleteCache with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDeleteCache(ctx context.Context, params *DeleteCacheParams) error {
+	if params == nil {
+		params = new(DeleteCacheParams)
+	}
+	return (&DeleteCacheRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of cache for deletion.
 func (r *DeleteCacheRequest) CacheId(v CacheId) *DeleteCacheRequest {
-	r.opts["cacheId"] = v
+	r.params.CacheId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *DeleteCacheRequest) Do() error {
-	return r.client.Call("CacheStorage.deleteCache", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DeleteCacheRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "CacheStorage.deleteCache", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DeleteCacheRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "CacheStorage.deleteCache", r.params, nil)
+}
+
+// DeleteEntryParams are the parameters of CacheStorage.deleteEntry.
+type DeleteEntryParams struct {
+	// Id of cache where the entry will be deleted.
+	CacheId CacheId `json:"cacheId"`
+
+	// URL spec of the request.
+	Request string `json:"request"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DeleteEntryParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.CacheId == "" {
+		missing = append(missing, "cacheId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Request == "" {
+		missing = append(missing, "request")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CacheStorage.deleteEntry", Missing: missing, Invalid: invalid}
 }
 
 type DeleteEntryRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DeleteEntryParams
 }
 
 // Deletes a cache entry.
 func (d *Client) DeleteEntry() *DeleteEntryRequest {
-	return &DeleteEntryRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DeleteEntryRequest{client: d.Client, params: &DeleteEntryParams{set: 1}}
+}
+
+// DoDeleteEntry sends CacheStorage.deleteEntry with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDeleteEntry(ctx context.Context, params *DeleteEntryParams) error {
+	if params == nil {
+		params = new(DeleteEntryParams)
+	}
+	return (&DeleteEntryRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of cache where the entry will be deleted.
 func (r *DeleteEntryRequest) CacheId(v CacheId) *DeleteEntryRequest {
-	r.opts["cacheId"] = v
+	r.params.CacheId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // URL spec of the request.
 func (r *DeleteEntryRequest) Request(v string) *DeleteEntryRequest {
-	r.opts["request"] = v
+	r.params.Request = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *DeleteEntryRequest) Do() error {
-	return r.client.Call("CacheStorage.deleteEntry", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DeleteEntryRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "CacheStorage.deleteEntry", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DeleteEntryRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "CacheStorage.deleteEntry", r.params, nil)
 }
 
 func init() {
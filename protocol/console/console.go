@@ -2,6 +2,8 @@
 package console
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -14,64 +16,177 @@ type Client struct {
 
 type ConsoleMessage struct {
 	// Message source.
-	Source string `json:"source"`
+	Source ConsoleMessageSource `json:"source"`
 
 	// Message severity.
-	Level string `json:"level"`
+	Level ConsoleMessageLevel `json:"level"`
 
 	// Message text.
 	Text string `json:"text"`
 
 	// URL of the message origin. (optional)
-	URL string `json:"url,omitempty"`
+	URL *string `json:"url,omitempty"`
 
 	// Line number in the resource that generated this message (1-based). (optional)
-	Line int `json:"line,omitempty"`
+	Line *int `json:"line,omitempty"`
 
 	// Column number in the resource that generated this message (1-based). (optional)
-	Column int `json:"column,omitempty"`
+	Column *int `json:"column,omitempty"`
+}
+
+// Values of the source property of ConsoleMessage.
+
+type ConsoleMessageSource string
+
+const (
+	ConsoleMessageSourceXml         ConsoleMessageSource = "xml"
+	ConsoleMessageSourceJavascript  ConsoleMessageSource = "javascript"
+	ConsoleMessageSourceNetwork     ConsoleMessageSource = "network"
+	ConsoleMessageSourceConsoleApi  ConsoleMessageSource = "console-api"
+	ConsoleMessageSourceStorage     ConsoleMessageSource = "storage"
+	ConsoleMessageSourceAppcache    ConsoleMessageSource = "appcache"
+	ConsoleMessageSourceRendering   ConsoleMessageSource = "rendering"
+	ConsoleMessageSourceSecurity    ConsoleMessageSource = "security"
+	ConsoleMessageSourceOther       ConsoleMessageSource = "other"
+	ConsoleMessageSourceDeprecation ConsoleMessageSource = "deprecation"
+	ConsoleMessageSourceWorker      ConsoleMessageSource = "worker"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ConsoleMessageSource) IsValid() bool {
+	switch v {
+	case ConsoleMessageSourceXml, ConsoleMessageSourceJavascript, ConsoleMessageSourceNetwork, ConsoleMessageSourceConsoleApi, ConsoleMessageSourceStorage, ConsoleMessageSourceAppcache, ConsoleMessageSourceRendering, ConsoleMessageSourceSecurity, ConsoleMessageSourceOther, ConsoleMessageSourceDeprecation, ConsoleMessageSourceWorker:
+		return true
+	}
+	return false
+}
+
+// Values of the level property of ConsoleMessage.
+
+type ConsoleMessageLevel string
+
+const (
+	ConsoleMessageLevelLog     ConsoleMessageLevel = "log"
+	ConsoleMessageLevelWarning ConsoleMessageLevel = "warning"
+	ConsoleMessageLevelError   ConsoleMessageLevel = "error"
+	ConsoleMessageLevelDebug   ConsoleMessageLevel = "debug"
+	ConsoleMessageLevelInfo    ConsoleMessageLevel = "info"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ConsoleMessageLevel) IsValid() bool {
+	switch v {
+	case ConsoleMessageLevelLog, ConsoleMessageLevelWarning, ConsoleMessageLevelError, ConsoleMessageLevelDebug, ConsoleMessageLevelInfo:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Console.enable.
+type EnableParams struct {
 }
 
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables console domain, sends the messages collected so far to the client by means of the <code>messageAdded</code> notification.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Console.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Console.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Console", r.params)
+}
+
+// DisableParams are the parameters of Console.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables console domain, prevents further console messages from being reported to the client.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Console.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Console.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Console", r.params)
+}
+
+// ClearMessagesParams are the parameters of Console.clearMessages.
+type ClearMessagesParams struct {
 }
 
 type ClearMessagesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearMessagesParams
 }
 
 // Does nothing.
 func (d *Client) ClearMessages() *ClearMessagesRequest {
-	return &ClearMessagesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearMessagesRequest{client: d.Client, params: &ClearMessagesParams{}}
+}
+
+// DoClearMessages sends Console.clearMessages with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearMessages(ctx context.Context, params *ClearMessagesParams) error {
+	if params == nil {
+		params = new(ClearMessagesParams)
+	}
+	return (&ClearMessagesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearMessagesRequest) Do() error {
-	return r.client.Call("Console.clearMessages", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearMessagesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Console.clearMessages", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearMessagesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Console.clearMessages", r.params, nil)
 }
 
 func init() {
@@ -83,3 +198,42 @@ type MessageAddedEvent struct {
 	// Console message that has been added.
 	Message *ConsoleMessage `json:"message"`
 }
+
+// Issued when new console message is added.
+func (d *Client) OnMessageAdded(fn func(*MessageAddedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Console.messageAdded", func(e interface{}) {
+		fn(e.(*MessageAddedEvent))
+	}, opts...)
+}
+
+// FilterMessageAdded is rpc.WithFilter for OnMessageAdded.
+func FilterMessageAdded(fn func(*MessageAddedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*MessageAddedEvent))
+	})
+}
+
+// MessageAddedWaiter waits for a Console.messageAdded event. It is created by WaitForMessageAdded.
+type MessageAddedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *MessageAddedWaiter) Wait() (*MessageAddedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*MessageAddedEvent), nil
+}
+
+// WaitForMessageAdded arms a waiter for the first Console.messageAdded event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForMessageAdded(ctx context.Context, predicate func(*MessageAddedEvent) bool) *MessageAddedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*MessageAddedEvent))
+		}
+	}
+	return &MessageAddedWaiter{d.Client.WaitFor(ctx, "Console.messageAdded", p)}
+}
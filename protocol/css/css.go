@@ -2,6 +2,8 @@
 package css
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/dom"
@@ -18,6 +20,22 @@ type StyleSheetId string
 
 type StyleSheetOrigin string
 
+const (
+	StyleSheetOriginInjected  StyleSheetOrigin = "injected"
+	StyleSheetOriginUserAgent StyleSheetOrigin = "user-agent"
+	StyleSheetOriginInspector StyleSheetOrigin = "inspector"
+	StyleSheetOriginRegular   StyleSheetOrigin = "regular"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v StyleSheetOrigin) IsValid() bool {
+	switch v {
+	case StyleSheetOriginInjected, StyleSheetOriginUserAgent, StyleSheetOriginInspector, StyleSheetOriginRegular:
+		return true
+	}
+	return false
+}
+
 // CSS rule collection for a single pseudo style.
 
 type PseudoElementMatches struct {
@@ -81,7 +99,7 @@ type CSSStyleSheetHeader struct {
 	SourceURL string `json:"sourceURL"`
 
 	// URL of source map associated with the stylesheet (if any). (optional)
-	SourceMapURL string `json:"sourceMapURL,omitempty"`
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
 
 	// Stylesheet origin.
 	Origin StyleSheetOrigin `json:"origin"`
@@ -90,13 +108,13 @@ type CSSStyleSheetHeader struct {
 	Title string `json:"title"`
 
 	// The backend id for the owner node of the stylesheet. (optional)
-	OwnerNode dom.BackendNodeId `json:"ownerNode,omitempty"`
+	OwnerNode *dom.BackendNodeId `json:"ownerNode,omitempty"`
 
 	// Denotes whether the stylesheet is disabled.
 	Disabled bool `json:"disabled"`
 
 	// Whether the sourceURL field value comes from the sourceURL comment. (optional)
-	HasSourceURL bool `json:"hasSourceURL,omitempty"`
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
 
 	// Whether this stylesheet is created for STYLE tag by parser. This flag is not set for document.written STYLE tags.
 	IsInline bool `json:"isInline"`
@@ -115,7 +133,7 @@ type CSSStyleSheetHeader struct {
 
 type CSSRule struct {
 	// The css style sheet identifier (absent for user agent stylesheet and user-specified stylesheet rules) this rule came from. (optional)
-	StyleSheetId StyleSheetId `json:"styleSheetId,omitempty"`
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
 
 	// Rule selector data.
 	SelectorList *SelectorList `json:"selectorList"`
@@ -170,7 +188,7 @@ type ShorthandEntry struct {
 	Value string `json:"value"`
 
 	// Whether the property has "!important" annotation (implies <code>false</code> if absent). (optional)
-	Important bool `json:"important,omitempty"`
+	Important *bool `json:"important,omitempty"`
 }
 
 type CSSComputedStyleProperty struct {
@@ -185,7 +203,7 @@ type CSSComputedStyleProperty struct {
 
 type CSSStyle struct {
 	// The css style sheet identifier (absent for user agent stylesheet and user-specified stylesheet rules) this rule came from. (optional)
-	StyleSheetId StyleSheetId `json:"styleSheetId,omitempty"`
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
 
 	// CSS properties in the style.
 	CssProperties []*CSSProperty `json:"cssProperties"`
@@ -194,7 +212,7 @@ type CSSStyle struct {
 	ShorthandEntries []*ShorthandEntry `json:"shorthandEntries"`
 
 	// Style declaration text (if available). (optional)
-	CssText string `json:"cssText,omitempty"`
+	CssText *string `json:"cssText,omitempty"`
 
 	// Style declaration range in the enclosing stylesheet (if available). (optional)
 	Range *SourceRange `json:"range,omitempty"`
@@ -210,19 +228,19 @@ type CSSProperty struct {
 	Value string `json:"value"`
 
 	// Whether the property has "!important" annotation (implies <code>false</code> if absent). (optional)
-	Important bool `json:"important,omitempty"`
+	Important *bool `json:"important,omitempty"`
 
 	// Whether the property is implicit (implies <code>false</code> if absent). (optional)
-	Implicit bool `json:"implicit,omitempty"`
+	Implicit *bool `json:"implicit,omitempty"`
 
 	// The full property text as specified in the style. (optional)
-	Text string `json:"text,omitempty"`
+	Text *string `json:"text,omitempty"`
 
 	// Whether the property is understood by the browser (implies <code>true</code> if absent). (optional)
-	ParsedOk bool `json:"parsedOk,omitempty"`
+	ParsedOk *bool `json:"parsedOk,omitempty"`
 
 	// Whether the property is disabled by the user (present for source-based properties only). (optional)
-	Disabled bool `json:"disabled,omitempty"`
+	Disabled *bool `json:"disabled,omitempty"`
 
 	// The entire property range in the enclosing style declaration (if available). (optional)
 	Range *SourceRange `json:"range,omitempty"`
@@ -235,16 +253,16 @@ type CSSMedia struct {
 	Text string `json:"text"`
 
 	// Source of the media query: "mediaRule" if specified by a @media rule, "importRule" if specified by an @import rule, "linkedSheet" if specified by a "media" attribute in a linked stylesheet's LINK tag, "inlineSheet" if specified by a "media" attribute in an inline stylesheet's STYLE tag.
-	Source string `json:"source"`
+	Source CSSMediaSource `json:"source"`
 
 	// URL of the document containing the media query description. (optional)
-	SourceURL string `json:"sourceURL,omitempty"`
+	SourceURL *string `json:"sourceURL,omitempty"`
 
 	// The associated rule (@media or @import) header range in the enclosing stylesheet (if available). (optional)
 	Range *SourceRange `json:"range,omitempty"`
 
 	// Identifier of the stylesheet containing this object (if exists). (optional)
-	StyleSheetId StyleSheetId `json:"styleSheetId,omitempty"`
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
 
 	// Array of media queries. (optional, experimental)
 	MediaList []*MediaQuery `json:"mediaList,omitempty"`
@@ -276,7 +294,7 @@ type MediaQueryExpression struct {
 	ValueRange *SourceRange `json:"valueRange,omitempty"`
 
 	// Computed length of media query expression (if applicable). (optional)
-	ComputedLength float64 `json:"computedLength,omitempty"`
+	ComputedLength *float64 `json:"computedLength,omitempty"`
 }
 
 // Information about amount of glyphs that were rendered with given font. (experimental)
@@ -306,7 +324,7 @@ type CSSKeyframesRule struct {
 
 type CSSKeyframeRule struct {
 	// The css style sheet identifier (absent for user agent stylesheet and user-specified stylesheet rules) this rule came from. (optional)
-	StyleSheetId StyleSheetId `json:"styleSheetId,omitempty"`
+	StyleSheetId *StyleSheetId `json:"styleSheetId,omitempty"`
 
 	// Parent stylesheet's origin.
 	Origin StyleSheetOrigin `json:"origin"`
@@ -354,13 +372,13 @@ type LayoutTreeNode struct {
 	BoundingBox *dom.Rect `json:"boundingBox"`
 
 	// Contents of the LayoutText if any (optional)
-	LayoutText string `json:"layoutText,omitempty"`
+	LayoutText *string `json:"layoutText,omitempty"`
 
 	// The post layout inline text nodes, if any. (optional)
 	InlineTextNodes []*InlineTextBox `json:"inlineTextNodes,omitempty"`
 
 	// Index into the computedStyles array returned by getLayoutTreeAndStyles. (optional)
-	StyleIndex int `json:"styleIndex,omitempty"`
+	StyleIndex *int `json:"styleIndex,omitempty"`
 }
 
 // A subset of the full ComputedStyle as defined by the request whitelist. (experimental)
@@ -369,116 +387,317 @@ type ComputedStyle struct {
 	Properties []*CSSComputedStyleProperty `json:"properties"`
 }
 
+// Values of the source property of CSSMedia.
+
+type CSSMediaSource string
+
+const (
+	CSSMediaSourceMediaRule   CSSMediaSource = "mediaRule"
+	CSSMediaSourceImportRule  CSSMediaSource = "importRule"
+	CSSMediaSourceLinkedSheet CSSMediaSource = "linkedSheet"
+	CSSMediaSourceInlineSheet CSSMediaSource = "inlineSheet"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v CSSMediaSource) IsValid() bool {
+	switch v {
+	case CSSMediaSourceMediaRule, CSSMediaSourceImportRule, CSSMediaSourceLinkedSheet, CSSMediaSourceInlineSheet:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of CSS.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables the CSS agent for the given page. Clients should not assume that the CSS agent has been enabled until the result of this command is received.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends CSS.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("CSS.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "CSS", r.params)
+}
+
+// DisableParams are the parameters of CSS.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables the CSS agent for the given page.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends CSS.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("CSS.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "CSS", r.params)
+}
+
+// GetMatchedStylesForNodeParams are the parameters of CSS.getMatchedStylesForNode.
+type GetMatchedStylesForNodeParams struct {
+	NodeId dom.NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetMatchedStylesForNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.getMatchedStylesForNode", Missing: missing, Invalid: invalid}
 }
 
 type GetMatchedStylesForNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetMatchedStylesForNodeParams
 }
 
 // Returns requested styles for a DOM node identified by <code>nodeId</code>.
 func (d *Client) GetMatchedStylesForNode() *GetMatchedStylesForNodeRequest {
-	return &GetMatchedStylesForNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetMatchedStylesForNodeRequest{client: d.Client, params: &GetMatchedStylesForNodeParams{set: 1}}
+}
+
+// DoGetMatchedStylesForNode sends CSS.getMatchedStylesForNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetMatchedStylesForNode(ctx context.Context, params *GetMatchedStylesForNodeParams) (*GetMatchedStylesForNodeResult, error) {
+	if params == nil {
+		params = new(GetMatchedStylesForNodeParams)
+	}
+	return (&GetMatchedStylesForNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetMatchedStylesForNodeRequest) NodeId(v dom.NodeId) *GetMatchedStylesForNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 type GetMatchedStylesForNodeResult struct {
 	// Inline style for the specified DOM node. (optional)
-	InlineStyle *CSSStyle `json:"inlineStyle"`
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
 
 	// Attribute-defined element style (e.g. resulting from "width=20 height=100%"). (optional)
-	AttributesStyle *CSSStyle `json:"attributesStyle"`
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
 
 	// CSS rules matching this node, from all applicable stylesheets. (optional)
-	MatchedCSSRules []*RuleMatch `json:"matchedCSSRules"`
+	MatchedCSSRules []*RuleMatch `json:"matchedCSSRules,omitempty"`
 
 	// Pseudo style matches for this node. (optional)
-	PseudoElements []*PseudoElementMatches `json:"pseudoElements"`
+	PseudoElements []*PseudoElementMatches `json:"pseudoElements,omitempty"`
 
 	// A chain of inherited styles (from the immediate node parent up to the DOM tree root). (optional)
-	Inherited []*InheritedStyleEntry `json:"inherited"`
+	Inherited []*InheritedStyleEntry `json:"inherited,omitempty"`
 
 	// A list of CSS keyframed animations matching this node. (optional)
-	CssKeyframesRules []*CSSKeyframesRule `json:"cssKeyframesRules"`
+	CssKeyframesRules []*CSSKeyframesRule `json:"cssKeyframesRules,omitempty"`
 }
 
 func (r *GetMatchedStylesForNodeRequest) Do() (*GetMatchedStylesForNodeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetMatchedStylesForNodeRequest) DoContext(ctx context.Context) (*GetMatchedStylesForNodeResult, error) {
 	var result GetMatchedStylesForNodeResult
-	err := r.client.Call("CSS.getMatchedStylesForNode", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getMatchedStylesForNode", r.params, &result)
 	return &result, err
 }
 
+type GetMatchedStylesForNodeFuture struct {
+	*rpc.Call
+	result GetMatchedStylesForNodeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetMatchedStylesForNodeFuture) Get() (*GetMatchedStylesForNodeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetMatchedStylesForNodeRequest) DoAsync(ctx context.Context) *GetMatchedStylesForNodeFuture {
+	f := new(GetMatchedStylesForNodeFuture)
+	f.Call = r.client.Go(ctx, "CSS.getMatchedStylesForNode", r.params, &f.result)
+	return f
+}
+
+// GetInlineStylesForNodeParams are the parameters of CSS.getInlineStylesForNode.
+type GetInlineStylesForNodeParams struct {
+	NodeId dom.NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetInlineStylesForNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.getInlineStylesForNode", Missing: missing, Invalid: invalid}
+}
+
 type GetInlineStylesForNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetInlineStylesForNodeParams
 }
 
 // Returns the styles defined inline (explicitly in the "style" attribute and implicitly, using DOM attributes) for a DOM node identified by <code>nodeId</code>.
 func (d *Client) GetInlineStylesForNode() *GetInlineStylesForNodeRequest {
-	return &GetInlineStylesForNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetInlineStylesForNodeRequest{client: d.Client, params: &GetInlineStylesForNodeParams{set: 1}}
+}
+
+// DoGetInlineStylesForNode sends CSS.getInlineStylesForNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetInlineStylesForNode(ctx context.Context, params *GetInlineStylesForNodeParams) (*GetInlineStylesForNodeResult, error) {
+	if params == nil {
+		params = new(GetInlineStylesForNodeParams)
+	}
+	return (&GetInlineStylesForNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetInlineStylesForNodeRequest) NodeId(v dom.NodeId) *GetInlineStylesForNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 type GetInlineStylesForNodeResult struct {
 	// Inline style for the specified DOM node. (optional)
-	InlineStyle *CSSStyle `json:"inlineStyle"`
+	InlineStyle *CSSStyle `json:"inlineStyle,omitempty"`
 
 	// Attribute-defined element style (e.g. resulting from "width=20 height=100%"). (optional)
-	AttributesStyle *CSSStyle `json:"attributesStyle"`
+	AttributesStyle *CSSStyle `json:"attributesStyle,omitempty"`
 }
 
 func (r *GetInlineStylesForNodeRequest) Do() (*GetInlineStylesForNodeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetInlineStylesForNodeRequest) DoContext(ctx context.Context) (*GetInlineStylesForNodeResult, error) {
 	var result GetInlineStylesForNodeResult
-	err := r.client.Call("CSS.getInlineStylesForNode", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getInlineStylesForNode", r.params, &result)
 	return &result, err
 }
 
+type GetInlineStylesForNodeFuture struct {
+	*rpc.Call
+	result GetInlineStylesForNodeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetInlineStylesForNodeFuture) Get() (*GetInlineStylesForNodeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetInlineStylesForNodeRequest) DoAsync(ctx context.Context) *GetInlineStylesForNodeFuture {
+	f := new(GetInlineStylesForNodeFuture)
+	f.Call = r.client.Go(ctx, "CSS.getInlineStylesForNode", r.params, &f.result)
+	return f
+}
+
+// GetComputedStyleForNodeParams are the parameters of CSS.getComputedStyleForNode.
+type GetComputedStyleForNodeParams struct {
+	NodeId dom.NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetComputedStyleForNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.getComputedStyleForNode", Missing: missing, Invalid: invalid}
+}
+
 type GetComputedStyleForNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetComputedStyleForNodeParams
 }
 
 // Returns the computed style for a DOM node identified by <code>nodeId</code>.
 func (d *Client) GetComputedStyleForNode() *GetComputedStyleForNodeRequest {
-	return &GetComputedStyleForNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetComputedStyleForNodeRequest{client: d.Client, params: &GetComputedStyleForNodeParams{set: 1}}
+}
+
+// DoGetComputedStyleForNode sends CSS.getComputedStyleForNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetComputedStyleForNode(ctx context.Context, params *GetComputedStyleForNodeParams) (*GetComputedStyleForNodeResult, error) {
+	if params == nil {
+		params = new(GetComputedStyleForNodeParams)
+	}
+	return (&GetComputedStyleForNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetComputedStyleForNodeRequest) NodeId(v dom.NodeId) *GetComputedStyleForNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -488,23 +707,76 @@ type GetComputedStyleForNodeResult struct {
 }
 
 func (r *GetComputedStyleForNodeRequest) Do() (*GetComputedStyleForNodeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetComputedStyleForNodeRequest) DoContext(ctx context.Context) (*GetComputedStyleForNodeResult, error) {
 	var result GetComputedStyleForNodeResult
-	err := r.client.Call("CSS.getComputedStyleForNode", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getComputedStyleForNode", r.params, &result)
 	return &result, err
 }
 
+type GetComputedStyleForNodeFuture struct {
+	*rpc.Call
+	result GetComputedStyleForNodeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetComputedStyleForNodeFuture) Get() (*GetComputedStyleForNodeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetComputedStyleForNodeRequest) DoAsync(ctx context.Context) *GetComputedStyleForNodeFuture {
+	f := new(GetComputedStyleForNodeFuture)
+	f.Call = r.client.Go(ctx, "CSS.getComputedStyleForNode", r.params, &f.result)
+	return f
+}
+
+// GetPlatformFontsForNodeParams are the parameters of CSS.getPlatformFontsForNode.
+type GetPlatformFontsForNodeParams struct {
+	NodeId dom.NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetPlatformFontsForNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.getPlatformFontsForNode", Missing: missing, Invalid: invalid}
+}
+
 type GetPlatformFontsForNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetPlatformFontsForNodeParams
 }
 
 // Requests information about platform fonts which we used to render child TextNodes in the given node. (experimental)
 func (d *Client) GetPlatformFontsForNode() *GetPlatformFontsForNodeRequest {
-	return &GetPlatformFontsForNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetPlatformFontsForNodeRequest{client: d.Client, params: &GetPlatformFontsForNodeParams{set: 1}}
+}
+
+// DoGetPlatformFontsForNode sends CSS.getPlatformFontsForNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetPlatformFontsForNode(ctx context.Context, params *GetPlatformFontsForNodeParams) (*GetPlatformFontsForNodeResult, error) {
+	if params == nil {
+		params = new(GetPlatformFontsForNodeParams)
+	}
+	return (&GetPlatformFontsForNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetPlatformFontsForNodeRequest) NodeId(v dom.NodeId) *GetPlatformFontsForNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -514,23 +786,76 @@ type GetPlatformFontsForNodeResult struct {
 }
 
 func (r *GetPlatformFontsForNodeRequest) Do() (*GetPlatformFontsForNodeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetPlatformFontsForNodeRequest) DoContext(ctx context.Context) (*GetPlatformFontsForNodeResult, error) {
 	var result GetPlatformFontsForNodeResult
-	err := r.client.Call("CSS.getPlatformFontsForNode", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getPlatformFontsForNode", r.params, &result)
 	return &result, err
 }
 
+type GetPlatformFontsForNodeFuture struct {
+	*rpc.Call
+	result GetPlatformFontsForNodeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetPlatformFontsForNodeFuture) Get() (*GetPlatformFontsForNodeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetPlatformFontsForNodeRequest) DoAsync(ctx context.Context) *GetPlatformFontsForNodeFuture {
+	f := new(GetPlatformFontsForNodeFuture)
+	f.Call = r.client.Go(ctx, "CSS.getPlatformFontsForNode", r.params, &f.result)
+	return f
+}
+
+// GetStyleSheetTextParams are the parameters of CSS.getStyleSheetText.
+type GetStyleSheetTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetStyleSheetTextParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.StyleSheetId == "" {
+		missing = append(missing, "styleSheetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.getStyleSheetText", Missing: missing, Invalid: invalid}
+}
+
 type GetStyleSheetTextRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetStyleSheetTextParams
 }
 
 // Returns the current textual content and the URL for a stylesheet.
 func (d *Client) GetStyleSheetText() *GetStyleSheetTextRequest {
-	return &GetStyleSheetTextRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetStyleSheetTextRequest{client: d.Client, params: &GetStyleSheetTextParams{set: 1}}
+}
+
+// DoGetStyleSheetText sends CSS.getStyleSheetText with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetStyleSheetText(ctx context.Context, params *GetStyleSheetTextParams) (*GetStyleSheetTextResult, error) {
+	if params == nil {
+		params = new(GetStyleSheetTextParams)
+	}
+	return (&GetStyleSheetTextRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetStyleSheetTextRequest) StyleSheetId(v StyleSheetId) *GetStyleSheetTextRequest {
-	r.opts["styleSheetId"] = v
+	r.params.StyleSheetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -540,23 +865,76 @@ type GetStyleSheetTextResult struct {
 }
 
 func (r *GetStyleSheetTextRequest) Do() (*GetStyleSheetTextResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetStyleSheetTextRequest) DoContext(ctx context.Context) (*GetStyleSheetTextResult, error) {
 	var result GetStyleSheetTextResult
-	err := r.client.Call("CSS.getStyleSheetText", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getStyleSheetText", r.params, &result)
 	return &result, err
 }
 
+type GetStyleSheetTextFuture struct {
+	*rpc.Call
+	result GetStyleSheetTextResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetStyleSheetTextFuture) Get() (*GetStyleSheetTextResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetStyleSheetTextRequest) DoAsync(ctx context.Context) *GetStyleSheetTextFuture {
+	f := new(GetStyleSheetTextFuture)
+	f.Call = r.client.Go(ctx, "CSS.getStyleSheetText", r.params, &f.result)
+	return f
+}
+
+// CollectClassNamesParams are the parameters of CSS.collectClassNames.
+type CollectClassNamesParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CollectClassNamesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.StyleSheetId == "" {
+		missing = append(missing, "styleSheetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.collectClassNames", Missing: missing, Invalid: invalid}
+}
+
 type CollectClassNamesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CollectClassNamesParams
 }
 
 // Returns all class names from specified stylesheet. (experimental)
 func (d *Client) CollectClassNames() *CollectClassNamesRequest {
-	return &CollectClassNamesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CollectClassNamesRequest{client: d.Client, params: &CollectClassNamesParams{set: 1}}
+}
+
+// DoCollectClassNames sends CSS.collectClassNames with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCollectClassNames(ctx context.Context, params *CollectClassNamesParams) (*CollectClassNamesResult, error) {
+	if params == nil {
+		params = new(CollectClassNamesParams)
+	}
+	return (&CollectClassNamesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *CollectClassNamesRequest) StyleSheetId(v StyleSheetId) *CollectClassNamesRequest {
-	r.opts["styleSheetId"] = v
+	r.params.StyleSheetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -566,64 +944,188 @@ type CollectClassNamesResult struct {
 }
 
 func (r *CollectClassNamesRequest) Do() (*CollectClassNamesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CollectClassNamesRequest) DoContext(ctx context.Context) (*CollectClassNamesResult, error) {
 	var result CollectClassNamesResult
-	err := r.client.Call("CSS.collectClassNames", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.collectClassNames", r.params, &result)
 	return &result, err
 }
 
+type CollectClassNamesFuture struct {
+	*rpc.Call
+	result CollectClassNamesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CollectClassNamesFuture) Get() (*CollectClassNamesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CollectClassNamesRequest) DoAsync(ctx context.Context) *CollectClassNamesFuture {
+	f := new(CollectClassNamesFuture)
+	f.Call = r.client.Go(ctx, "CSS.collectClassNames", r.params, &f.result)
+	return f
+}
+
+// SetStyleSheetTextParams are the parameters of CSS.setStyleSheetText.
+type SetStyleSheetTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+
+	Text string `json:"text"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetStyleSheetTextParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.StyleSheetId == "" {
+		missing = append(missing, "styleSheetId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Text == "" {
+		missing = append(missing, "text")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.setStyleSheetText", Missing: missing, Invalid: invalid}
+}
+
 type SetStyleSheetTextRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetStyleSheetTextParams
 }
 
 // Sets the new stylesheet text.
 func (d *Client) SetStyleSheetText() *SetStyleSheetTextRequest {
-	return &SetStyleSheetTextRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetStyleSheetTextRequest{client: d.Client, params: &SetStyleSheetTextParams{set: 1}}
+}
+
+// DoSetStyleSheetText sends CSS.setStyleSheetText with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetStyleSheetText(ctx context.Context, params *SetStyleSheetTextParams) (*SetStyleSheetTextResult, error) {
+	if params == nil {
+		params = new(SetStyleSheetTextParams)
+	}
+	return (&SetStyleSheetTextRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetStyleSheetTextRequest) StyleSheetId(v StyleSheetId) *SetStyleSheetTextRequest {
-	r.opts["styleSheetId"] = v
+	r.params.StyleSheetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetStyleSheetTextRequest) Text(v string) *SetStyleSheetTextRequest {
-	r.opts["text"] = v
+	r.params.Text = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 type SetStyleSheetTextResult struct {
 	// URL of source map associated with script (if any). (optional)
-	SourceMapURL string `json:"sourceMapURL"`
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
 }
 
 func (r *SetStyleSheetTextRequest) Do() (*SetStyleSheetTextResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetStyleSheetTextRequest) DoContext(ctx context.Context) (*SetStyleSheetTextResult, error) {
 	var result SetStyleSheetTextResult
-	err := r.client.Call("CSS.setStyleSheetText", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.setStyleSheetText", r.params, &result)
 	return &result, err
 }
 
+type SetStyleSheetTextFuture struct {
+	*rpc.Call
+	result SetStyleSheetTextResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetStyleSheetTextFuture) Get() (*SetStyleSheetTextResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetStyleSheetTextRequest) DoAsync(ctx context.Context) *SetStyleSheetTextFuture {
+	f := new(SetStyleSheetTextFuture)
+	f.Call = r.client.Go(ctx, "CSS.setStyleSheetText", r.params, &f.result)
+	return f
+}
+
+// SetRuleSelectorParams are the parameters of CSS.setRuleSelector.
+type SetRuleSelectorParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+
+	Range *SourceRange `json:"range"`
+
+	Selector string `json:"selector"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetRuleSelectorParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.StyleSheetId == "" {
+		missing = append(missing, "styleSheetId")
+	}
+	if p.Range == nil {
+		missing = append(missing, "range")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Selector == "" {
+		missing = append(missing, "selector")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.setRuleSelector", Missing: missing, Invalid: invalid}
+}
+
 type SetRuleSelectorRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetRuleSelectorParams
 }
 
 // Modifies the rule selector.
 func (d *Client) SetRuleSelector() *SetRuleSelectorRequest {
-	return &SetRuleSelectorRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetRuleSelectorRequest{client: d.Client, params: &SetRuleSelectorParams{set: 1}}
+}
+
+// DoSetRuleSelector sends CSS.setRuleSelector with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetRuleSelector(ctx context.Context, params *SetRuleSelectorParams) (*SetRuleSelectorResult, error) {
+	if params == nil {
+		params = new(SetRuleSelectorParams)
+	}
+	return (&SetRuleSelectorRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetRuleSelectorRequest) StyleSheetId(v StyleSheetId) *SetRuleSelectorRequest {
-	r.opts["styleSheetId"] = v
+	r.params.StyleSheetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetRuleSelectorRequest) Range(v *SourceRange) *SetRuleSelectorRequest {
-	r.opts["range"] = v
+	r.params.Range = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetRuleSelectorRequest) Selector(v string) *SetRuleSelectorRequest {
-	r.opts["selector"] = v
+	r.params.Selector = v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -633,33 +1135,98 @@ type SetRuleSelectorResult struct {
 }
 
 func (r *SetRuleSelectorRequest) Do() (*SetRuleSelectorResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetRuleSelectorRequest) DoContext(ctx context.Context) (*SetRuleSelectorResult, error) {
 	var result SetRuleSelectorResult
-	err := r.client.Call("CSS.setRuleSelector", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.setRuleSelector", r.params, &result)
 	return &result, err
 }
 
+type SetRuleSelectorFuture struct {
+	*rpc.Call
+	result SetRuleSelectorResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetRuleSelectorFuture) Get() (*SetRuleSelectorResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetRuleSelectorRequest) DoAsync(ctx context.Context) *SetRuleSelectorFuture {
+	f := new(SetRuleSelectorFuture)
+	f.Call = r.client.Go(ctx, "CSS.setRuleSelector", r.params, &f.result)
+	return f
+}
+
+// SetKeyframeKeyParams are the parameters of CSS.setKeyframeKey.
+type SetKeyframeKeyParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+
+	Range *SourceRange `json:"range"`
+
+	KeyText string `json:"keyText"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetKeyframeKeyParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.StyleSheetId == "" {
+		missing = append(missing, "styleSheetId")
+	}
+	if p.Range == nil {
+		missing = append(missing, "range")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.KeyText == "" {
+		missing = append(missing, "keyText")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.setKeyframeKey", Missing: missing, Invalid: invalid}
+}
+
 type SetKeyframeKeyRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetKeyframeKeyParams
 }
 
 // Modifies the keyframe rule key text.
 func (d *Client) SetKeyframeKey() *SetKeyframeKeyRequest {
-	return &SetKeyframeKeyRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetKeyframeKeyRequest{client: d.Client, params: &SetKeyframeKeyParams{set: 1}}
+}
+
+// DoSetKeyframeKey sends CSS.setKeyframeKey with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetKeyframeKey(ctx context.Context, params *SetKeyframeKeyParams) (*SetKeyframeKeyResult, error) {
+	if params == nil {
+		params = new(SetKeyframeKeyParams)
+	}
+	return (&SetKeyframeKeyRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetKeyframeKeyRequest) StyleSheetId(v StyleSheetId) *SetKeyframeKeyRequest {
-	r.opts["styleSheetId"] = v
+	r.params.StyleSheetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetKeyframeKeyRequest) Range(v *SourceRange) *SetKeyframeKeyRequest {
-	r.opts["range"] = v
+	r.params.Range = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetKeyframeKeyRequest) KeyText(v string) *SetKeyframeKeyRequest {
-	r.opts["keyText"] = v
+	r.params.KeyText = v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -669,23 +1236,76 @@ type SetKeyframeKeyResult struct {
 }
 
 func (r *SetKeyframeKeyRequest) Do() (*SetKeyframeKeyResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetKeyframeKeyRequest) DoContext(ctx context.Context) (*SetKeyframeKeyResult, error) {
 	var result SetKeyframeKeyResult
-	err := r.client.Call("CSS.setKeyframeKey", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.setKeyframeKey", r.params, &result)
 	return &result, err
 }
 
+type SetKeyframeKeyFuture struct {
+	*rpc.Call
+	result SetKeyframeKeyResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetKeyframeKeyFuture) Get() (*SetKeyframeKeyResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetKeyframeKeyRequest) DoAsync(ctx context.Context) *SetKeyframeKeyFuture {
+	f := new(SetKeyframeKeyFuture)
+	f.Call = r.client.Go(ctx, "CSS.setKeyframeKey", r.params, &f.result)
+	return f
+}
+
+// SetStyleTextsParams are the parameters of CSS.setStyleTexts.
+type SetStyleTextsParams struct {
+	Edits []*StyleDeclarationEdit `json:"edits"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetStyleTextsParams) Validate() error {
+	var missing, invalid []string
+	if p.Edits == nil {
+		missing = append(missing, "edits")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.setStyleTexts", Missing: missing, Invalid: invalid}
+}
+
 type SetStyleTextsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetStyleTextsParams
 }
 
 // Applies specified style edits one after another in the given order.
 func (d *Client) SetStyleTexts() *SetStyleTextsRequest {
-	return &SetStyleTextsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetStyleTextsRequest{client: d.Client, params: &SetStyleTextsParams{set: 1}}
+}
+
+// DoSetStyleTexts sends CSS.setStyleTexts with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetStyleTexts(ctx context.Context, params *SetStyleTextsParams) (*SetStyleTextsResult, error) {
+	if params == nil {
+		params = new(SetStyleTextsParams)
+	}
+	return (&SetStyleTextsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetStyleTextsRequest) Edits(v []*StyleDeclarationEdit) *SetStyleTextsRequest {
-	r.opts["edits"] = v
+	r.params.Edits = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -695,33 +1315,98 @@ type SetStyleTextsResult struct {
 }
 
 func (r *SetStyleTextsRequest) Do() (*SetStyleTextsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetStyleTextsRequest) DoContext(ctx context.Context) (*SetStyleTextsResult, error) {
 	var result SetStyleTextsResult
-	err := r.client.Call("CSS.setStyleTexts", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.setStyleTexts", r.params, &result)
 	return &result, err
 }
 
+type SetStyleTextsFuture struct {
+	*rpc.Call
+	result SetStyleTextsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetStyleTextsFuture) Get() (*SetStyleTextsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetStyleTextsRequest) DoAsync(ctx context.Context) *SetStyleTextsFuture {
+	f := new(SetStyleTextsFuture)
+	f.Call = r.client.Go(ctx, "CSS.setStyleTexts", r.params, &f.result)
+	return f
+}
+
+// SetMediaTextParams are the parameters of CSS.setMediaText.
+type SetMediaTextParams struct {
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+
+	Range *SourceRange `json:"range"`
+
+	Text string `json:"text"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetMediaTextParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.StyleSheetId == "" {
+		missing = append(missing, "styleSheetId")
+	}
+	if p.Range == nil {
+		missing = append(missing, "range")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Text == "" {
+		missing = append(missing, "text")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.setMediaText", Missing: missing, Invalid: invalid}
+}
+
 type SetMediaTextRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetMediaTextParams
 }
 
 // Modifies the rule selector.
 func (d *Client) SetMediaText() *SetMediaTextRequest {
-	return &SetMediaTextRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetMediaTextRequest{client: d.Client, params: &SetMediaTextParams{set: 1}}
+}
+
+// DoSetMediaText sends CSS.setMediaText with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetMediaText(ctx context.Context, params *SetMediaTextParams) (*SetMediaTextResult, error) {
+	if params == nil {
+		params = new(SetMediaTextParams)
+	}
+	return (&SetMediaTextRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetMediaTextRequest) StyleSheetId(v StyleSheetId) *SetMediaTextRequest {
-	r.opts["styleSheetId"] = v
+	r.params.StyleSheetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetMediaTextRequest) Range(v *SourceRange) *SetMediaTextRequest {
-	r.opts["range"] = v
+	r.params.Range = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetMediaTextRequest) Text(v string) *SetMediaTextRequest {
-	r.opts["text"] = v
+	r.params.Text = v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -731,24 +1416,78 @@ type SetMediaTextResult struct {
 }
 
 func (r *SetMediaTextRequest) Do() (*SetMediaTextResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetMediaTextRequest) DoContext(ctx context.Context) (*SetMediaTextResult, error) {
 	var result SetMediaTextResult
-	err := r.client.Call("CSS.setMediaText", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.setMediaText", r.params, &result)
 	return &result, err
 }
 
+type SetMediaTextFuture struct {
+	*rpc.Call
+	result SetMediaTextResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetMediaTextFuture) Get() (*SetMediaTextResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetMediaTextRequest) DoAsync(ctx context.Context) *SetMediaTextFuture {
+	f := new(SetMediaTextFuture)
+	f.Call = r.client.Go(ctx, "CSS.setMediaText", r.params, &f.result)
+	return f
+}
+
+// CreateStyleSheetParams are the parameters of CSS.createStyleSheet.
+type CreateStyleSheetParams struct {
+	// Identifier of the frame where "via-inspector" stylesheet should be created.
+	FrameId string `json:"frameId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CreateStyleSheetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.createStyleSheet", Missing: missing, Invalid: invalid}
+}
+
 type CreateStyleSheetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CreateStyleSheetParams
 }
 
 // Creates a new special "via-inspector" stylesheet in the frame with given <code>frameId</code>.
 func (d *Client) CreateStyleSheet() *CreateStyleSheetRequest {
-	return &CreateStyleSheetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CreateStyleSheetRequest{client: d.Client, params: &CreateStyleSheetParams{set: 1}}
+}
+
+// DoCreateStyleSheet sends CSS.createStyleSheet with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCreateStyleSheet(ctx context.Context, params *CreateStyleSheetParams) (*CreateStyleSheetResult, error) {
+	if params == nil {
+		params = new(CreateStyleSheetParams)
+	}
+	return (&CreateStyleSheetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the frame where "via-inspector" stylesheet should be created.
 func (r *CreateStyleSheetRequest) FrameId(v string) *CreateStyleSheetRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -758,36 +1497,104 @@ type CreateStyleSheetResult struct {
 }
 
 func (r *CreateStyleSheetRequest) Do() (*CreateStyleSheetResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CreateStyleSheetRequest) DoContext(ctx context.Context) (*CreateStyleSheetResult, error) {
 	var result CreateStyleSheetResult
-	err := r.client.Call("CSS.createStyleSheet", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.createStyleSheet", r.params, &result)
 	return &result, err
 }
 
+type CreateStyleSheetFuture struct {
+	*rpc.Call
+	result CreateStyleSheetResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CreateStyleSheetFuture) Get() (*CreateStyleSheetResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CreateStyleSheetRequest) DoAsync(ctx context.Context) *CreateStyleSheetFuture {
+	f := new(CreateStyleSheetFuture)
+	f.Call = r.client.Go(ctx, "CSS.createStyleSheet", r.params, &f.result)
+	return f
+}
+
+// AddRuleParams are the parameters of CSS.addRule.
+type AddRuleParams struct {
+	// The css style sheet identifier where a new rule should be inserted.
+	StyleSheetId StyleSheetId `json:"styleSheetId"`
+
+	// The text of a new rule.
+	RuleText string `json:"ruleText"`
+
+	// Text position of a new rule in the target style sheet.
+	Location *SourceRange `json:"location"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *AddRuleParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.StyleSheetId == "" {
+		missing = append(missing, "styleSheetId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.RuleText == "" {
+		missing = append(missing, "ruleText")
+	}
+	if p.Location == nil {
+		missing = append(missing, "location")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.addRule", Missing: missing, Invalid: invalid}
+}
+
 type AddRuleRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *AddRuleParams
 }
 
 // Inserts a new rule with the given <code>ruleText</code> in a stylesheet with given <code>styleSheetId</code>, at the position specified by <code>location</code>.
 func (d *Client) AddRule() *AddRuleRequest {
-	return &AddRuleRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &AddRuleRequest{client: d.Client, params: &AddRuleParams{set: 1}}
+}
+
+// DoAddRule sends CSS.addRule with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoAddRule(ctx context.Context, params *AddRuleParams) (*AddRuleResult, error) {
+	if params == nil {
+		params = new(AddRuleParams)
+	}
+	return (&AddRuleRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The css style sheet identifier where a new rule should be inserted.
 func (r *AddRuleRequest) StyleSheetId(v StyleSheetId) *AddRuleRequest {
-	r.opts["styleSheetId"] = v
+	r.params.StyleSheetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The text of a new rule.
 func (r *AddRuleRequest) RuleText(v string) *AddRuleRequest {
-	r.opts["ruleText"] = v
+	r.params.RuleText = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Text position of a new rule in the target style sheet.
 func (r *AddRuleRequest) Location(v *SourceRange) *AddRuleRequest {
-	r.opts["location"] = v
+	r.params.Location = v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -797,45 +1604,127 @@ type AddRuleResult struct {
 }
 
 func (r *AddRuleRequest) Do() (*AddRuleResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *AddRuleRequest) DoContext(ctx context.Context) (*AddRuleResult, error) {
 	var result AddRuleResult
-	err := r.client.Call("CSS.addRule", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.addRule", r.params, &result)
 	return &result, err
 }
 
+type AddRuleFuture struct {
+	*rpc.Call
+	result AddRuleResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *AddRuleFuture) Get() (*AddRuleResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *AddRuleRequest) DoAsync(ctx context.Context) *AddRuleFuture {
+	f := new(AddRuleFuture)
+	f.Call = r.client.Go(ctx, "CSS.addRule", r.params, &f.result)
+	return f
+}
+
+// ForcePseudoStateParams are the parameters of CSS.forcePseudoState.
+type ForcePseudoStateParams struct {
+	// The element id for which to force the pseudo state.
+	NodeId dom.NodeId `json:"nodeId"`
+
+	// Element pseudo classes to force when computing the element's style.
+	ForcedPseudoClasses []string `json:"forcedPseudoClasses"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ForcePseudoStateParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.ForcedPseudoClasses == nil {
+		missing = append(missing, "forcedPseudoClasses")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.forcePseudoState", Missing: missing, Invalid: invalid}
+}
+
 type ForcePseudoStateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ForcePseudoStateParams
 }
 
 // Ensures that the given node will have specified pseudo-classes whenever its style is computed by the browser.
 func (d *Client) ForcePseudoState() *ForcePseudoStateRequest {
-	return &ForcePseudoStateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ForcePseudoStateRequest{client: d.Client, params: &ForcePseudoStateParams{set: 1}}
+}
+
+// DoForcePseudoState sends CSS.forcePseudoState with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoForcePseudoState(ctx context.Context, params *ForcePseudoStateParams) error {
+	if params == nil {
+		params = new(ForcePseudoStateParams)
+	}
+	return (&ForcePseudoStateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The element id for which to force the pseudo state.
 func (r *ForcePseudoStateRequest) NodeId(v dom.NodeId) *ForcePseudoStateRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Element pseudo classes to force when computing the element's style.
 func (r *ForcePseudoStateRequest) ForcedPseudoClasses(v []string) *ForcePseudoStateRequest {
-	r.opts["forcedPseudoClasses"] = v
+	r.params.ForcedPseudoClasses = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *ForcePseudoStateRequest) Do() error {
-	return r.client.Call("CSS.forcePseudoState", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ForcePseudoStateRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "CSS.forcePseudoState", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ForcePseudoStateRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "CSS.forcePseudoState", r.params, nil)
+}
+
+// GetMediaQueriesParams are the parameters of CSS.getMediaQueries.
+type GetMediaQueriesParams struct {
 }
 
 type GetMediaQueriesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetMediaQueriesParams
 }
 
 // Returns all media queries parsed by the rendering engine. (experimental)
 func (d *Client) GetMediaQueries() *GetMediaQueriesRequest {
-	return &GetMediaQueriesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetMediaQueriesRequest{client: d.Client, params: &GetMediaQueriesParams{}}
+}
+
+// DoGetMediaQueries sends CSS.getMediaQueries with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetMediaQueries(ctx context.Context, params *GetMediaQueriesParams) (*GetMediaQueriesResult, error) {
+	if params == nil {
+		params = new(GetMediaQueriesParams)
+	}
+	return (&GetMediaQueriesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetMediaQueriesResult struct {
@@ -843,81 +1732,242 @@ type GetMediaQueriesResult struct {
 }
 
 func (r *GetMediaQueriesRequest) Do() (*GetMediaQueriesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetMediaQueriesRequest) DoContext(ctx context.Context) (*GetMediaQueriesResult, error) {
 	var result GetMediaQueriesResult
-	err := r.client.Call("CSS.getMediaQueries", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getMediaQueries", r.params, &result)
 	return &result, err
 }
 
+type GetMediaQueriesFuture struct {
+	*rpc.Call
+	result GetMediaQueriesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetMediaQueriesFuture) Get() (*GetMediaQueriesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetMediaQueriesRequest) DoAsync(ctx context.Context) *GetMediaQueriesFuture {
+	f := new(GetMediaQueriesFuture)
+	f.Call = r.client.Go(ctx, "CSS.getMediaQueries", r.params, &f.result)
+	return f
+}
+
+// SetEffectivePropertyValueForNodeParams are the parameters of CSS.setEffectivePropertyValueForNode.
+type SetEffectivePropertyValueForNodeParams struct {
+	// The element id for which to set property.
+	NodeId dom.NodeId `json:"nodeId"`
+
+	PropertyName string `json:"propertyName"`
+
+	Value string `json:"value"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetEffectivePropertyValueForNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.PropertyName == "" {
+		missing = append(missing, "propertyName")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Value == "" {
+		missing = append(missing, "value")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.setEffectivePropertyValueForNode", Missing: missing, Invalid: invalid}
+}
+
 type SetEffectivePropertyValueForNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetEffectivePropertyValueForNodeParams
 }
 
 // Find a rule with the given active property for the given node and set the new value for this property (experimental)
 func (d *Client) SetEffectivePropertyValueForNode() *SetEffectivePropertyValueForNodeRequest {
-	return &SetEffectivePropertyValueForNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetEffectivePropertyValueForNodeRequest{client: d.Client, params: &SetEffectivePropertyValueForNodeParams{set: 1}}
+}
+
+// DoSetEffectivePropertyValueForNode sends CSS.setEffectivePropertyValueForNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetEffectivePropertyValueForNode(ctx context.Context, params *SetEffectivePropertyValueForNodeParams) error {
+	if params == nil {
+		params = new(SetEffectivePropertyValueForNodeParams)
+	}
+	return (&SetEffectivePropertyValueForNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The element id for which to set property.
 func (r *SetEffectivePropertyValueForNodeRequest) NodeId(v dom.NodeId) *SetEffectivePropertyValueForNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetEffectivePropertyValueForNodeRequest) PropertyName(v string) *SetEffectivePropertyValueForNodeRequest {
-	r.opts["propertyName"] = v
+	r.params.PropertyName = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetEffectivePropertyValueForNodeRequest) Value(v string) *SetEffectivePropertyValueForNodeRequest {
-	r.opts["value"] = v
+	r.params.Value = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetEffectivePropertyValueForNodeRequest) Do() error {
-	return r.client.Call("CSS.setEffectivePropertyValueForNode", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetEffectivePropertyValueForNodeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "CSS.setEffectivePropertyValueForNode", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetEffectivePropertyValueForNodeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "CSS.setEffectivePropertyValueForNode", r.params, nil)
+}
+
+// GetBackgroundColorsParams are the parameters of CSS.getBackgroundColors.
+type GetBackgroundColorsParams struct {
+	// Id of the node to get background colors for.
+	NodeId dom.NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetBackgroundColorsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.getBackgroundColors", Missing: missing, Invalid: invalid}
 }
 
 type GetBackgroundColorsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetBackgroundColorsParams
 }
 
 // (experimental)
 func (d *Client) GetBackgroundColors() *GetBackgroundColorsRequest {
-	return &GetBackgroundColorsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetBackgroundColorsRequest{client: d.Client, params: &GetBackgroundColorsParams{set: 1}}
+}
+
+// DoGetBackgroundColors sends CSS.getBackgroundColors with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetBackgroundColors(ctx context.Context, params *GetBackgroundColorsParams) (*GetBackgroundColorsResult, error) {
+	if params == nil {
+		params = new(GetBackgroundColorsParams)
+	}
+	return (&GetBackgroundColorsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to get background colors for.
 func (r *GetBackgroundColorsRequest) NodeId(v dom.NodeId) *GetBackgroundColorsRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 type GetBackgroundColorsResult struct {
 	// The range of background colors behind this element, if it contains any visible text. If no visible text is present, this will be undefined. In the case of a flat background color, this will consist of simply that color. In the case of a gradient, this will consist of each of the color stops. For anything more complicated, this will be an empty array. Images will be ignored (as if the image had failed to load). (optional)
-	BackgroundColors []string `json:"backgroundColors"`
+	BackgroundColors []string `json:"backgroundColors,omitempty"`
 }
 
 func (r *GetBackgroundColorsRequest) Do() (*GetBackgroundColorsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetBackgroundColorsRequest) DoContext(ctx context.Context) (*GetBackgroundColorsResult, error) {
 	var result GetBackgroundColorsResult
-	err := r.client.Call("CSS.getBackgroundColors", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getBackgroundColors", r.params, &result)
 	return &result, err
 }
 
+type GetBackgroundColorsFuture struct {
+	*rpc.Call
+	result GetBackgroundColorsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetBackgroundColorsFuture) Get() (*GetBackgroundColorsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetBackgroundColorsRequest) DoAsync(ctx context.Context) *GetBackgroundColorsFuture {
+	f := new(GetBackgroundColorsFuture)
+	f.Call = r.client.Go(ctx, "CSS.getBackgroundColors", r.params, &f.result)
+	return f
+}
+
+// GetLayoutTreeAndStylesParams are the parameters of CSS.getLayoutTreeAndStyles.
+type GetLayoutTreeAndStylesParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetLayoutTreeAndStylesParams) Validate() error {
+	var missing, invalid []string
+	if p.ComputedStyleWhitelist == nil {
+		missing = append(missing, "computedStyleWhitelist")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "CSS.getLayoutTreeAndStyles", Missing: missing, Invalid: invalid}
+}
+
 type GetLayoutTreeAndStylesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetLayoutTreeAndStylesParams
 }
 
 // For the main document and any content documents, return the LayoutTreeNodes and a whitelisted subset of the computed style. It only returns pushed nodes, on way to pull all nodes is to call DOM.getDocument with a depth of -1. (experimental)
 func (d *Client) GetLayoutTreeAndStyles() *GetLayoutTreeAndStylesRequest {
-	return &GetLayoutTreeAndStylesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetLayoutTreeAndStylesRequest{client: d.Client, params: &GetLayoutTreeAndStylesParams{set: 1}}
+}
+
+// DoGetLayoutTreeAndStyles sends CSS.getLayoutTreeAndStyles with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetLayoutTreeAndStyles(ctx context.Context, params *GetLayoutTreeAndStylesParams) (*GetLayoutTreeAndStylesResult, error) {
+	if params == nil {
+		params = new(GetLayoutTreeAndStylesParams)
+	}
+	return (&GetLayoutTreeAndStylesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whitelist of computed styles to return.
 func (r *GetLayoutTreeAndStylesRequest) ComputedStyleWhitelist(v []string) *GetLayoutTreeAndStylesRequest {
-	r.opts["computedStyleWhitelist"] = v
+	r.params.ComputedStyleWhitelist = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -928,33 +1978,88 @@ type GetLayoutTreeAndStylesResult struct {
 }
 
 func (r *GetLayoutTreeAndStylesRequest) Do() (*GetLayoutTreeAndStylesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetLayoutTreeAndStylesRequest) DoContext(ctx context.Context) (*GetLayoutTreeAndStylesResult, error) {
 	var result GetLayoutTreeAndStylesResult
-	err := r.client.Call("CSS.getLayoutTreeAndStyles", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.getLayoutTreeAndStyles", r.params, &result)
 	return &result, err
 }
 
+type GetLayoutTreeAndStylesFuture struct {
+	*rpc.Call
+	result GetLayoutTreeAndStylesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetLayoutTreeAndStylesFuture) Get() (*GetLayoutTreeAndStylesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetLayoutTreeAndStylesRequest) DoAsync(ctx context.Context) *GetLayoutTreeAndStylesFuture {
+	f := new(GetLayoutTreeAndStylesFuture)
+	f.Call = r.client.Go(ctx, "CSS.getLayoutTreeAndStyles", r.params, &f.result)
+	return f
+}
+
+// StartRuleUsageTrackingParams are the parameters of CSS.startRuleUsageTracking.
+type StartRuleUsageTrackingParams struct {
+}
+
 type StartRuleUsageTrackingRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartRuleUsageTrackingParams
 }
 
 // Enables the selector recording. (experimental)
 func (d *Client) StartRuleUsageTracking() *StartRuleUsageTrackingRequest {
-	return &StartRuleUsageTrackingRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartRuleUsageTrackingRequest{client: d.Client, params: &StartRuleUsageTrackingParams{}}
+}
+
+// DoStartRuleUsageTracking sends CSS.startRuleUsageTracking with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStartRuleUsageTracking(ctx context.Context, params *StartRuleUsageTrackingParams) error {
+	if params == nil {
+		params = new(StartRuleUsageTrackingParams)
+	}
+	return (&StartRuleUsageTrackingRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StartRuleUsageTrackingRequest) Do() error {
-	return r.client.Call("CSS.startRuleUsageTracking", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartRuleUsageTrackingRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "CSS.startRuleUsageTracking", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartRuleUsageTrackingRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "CSS.startRuleUsageTracking", r.params, nil)
+}
+
+// TakeCoverageDeltaParams are the parameters of CSS.takeCoverageDelta.
+type TakeCoverageDeltaParams struct {
 }
 
 type TakeCoverageDeltaRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *TakeCoverageDeltaParams
 }
 
 // Obtain list of rules that became used since last call to this method (or since start of coverage instrumentation) (experimental)
 func (d *Client) TakeCoverageDelta() *TakeCoverageDeltaRequest {
-	return &TakeCoverageDeltaRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &TakeCoverageDeltaRequest{client: d.Client, params: &TakeCoverageDeltaParams{}}
+}
+
+// DoTakeCoverageDelta sends CSS.takeCoverageDelta with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoTakeCoverageDelta(ctx context.Context, params *TakeCoverageDeltaParams) (*TakeCoverageDeltaResult, error) {
+	if params == nil {
+		params = new(TakeCoverageDeltaParams)
+	}
+	return (&TakeCoverageDeltaRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type TakeCoverageDeltaResult struct {
@@ -962,19 +2067,53 @@ type TakeCoverageDeltaResult struct {
 }
 
 func (r *TakeCoverageDeltaRequest) Do() (*TakeCoverageDeltaResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *TakeCoverageDeltaRequest) DoContext(ctx context.Context) (*TakeCoverageDeltaResult, error) {
 	var result TakeCoverageDeltaResult
-	err := r.client.Call("CSS.takeCoverageDelta", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.takeCoverageDelta", r.params, &result)
 	return &result, err
 }
 
+type TakeCoverageDeltaFuture struct {
+	*rpc.Call
+	result TakeCoverageDeltaResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *TakeCoverageDeltaFuture) Get() (*TakeCoverageDeltaResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *TakeCoverageDeltaRequest) DoAsync(ctx context.Context) *TakeCoverageDeltaFuture {
+	f := new(TakeCoverageDeltaFuture)
+	f.Call = r.client.Go(ctx, "CSS.takeCoverageDelta", r.params, &f.result)
+	return f
+}
+
+// StopRuleUsageTrackingParams are the parameters of CSS.stopRuleUsageTracking.
+type StopRuleUsageTrackingParams struct {
+}
+
 type StopRuleUsageTrackingRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopRuleUsageTrackingParams
 }
 
 // The list of rules with an indication of whether these were used (experimental)
 func (d *Client) StopRuleUsageTracking() *StopRuleUsageTrackingRequest {
-	return &StopRuleUsageTrackingRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopRuleUsageTrackingRequest{client: d.Client, params: &StopRuleUsageTrackingParams{}}
+}
+
+// DoStopRuleUsageTracking sends CSS.stopRuleUsageTracking with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopRuleUsageTracking(ctx context.Context, params *StopRuleUsageTrackingParams) (*StopRuleUsageTrackingResult, error) {
+	if params == nil {
+		params = new(StopRuleUsageTrackingParams)
+	}
+	return (&StopRuleUsageTrackingRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type StopRuleUsageTrackingResult struct {
@@ -982,11 +2121,33 @@ type StopRuleUsageTrackingResult struct {
 }
 
 func (r *StopRuleUsageTrackingRequest) Do() (*StopRuleUsageTrackingResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *StopRuleUsageTrackingRequest) DoContext(ctx context.Context) (*StopRuleUsageTrackingResult, error) {
 	var result StopRuleUsageTrackingResult
-	err := r.client.Call("CSS.stopRuleUsageTracking", r.opts, &result)
+	err := r.client.CallContext(ctx, "CSS.stopRuleUsageTracking", r.params, &result)
 	return &result, err
 }
 
+type StopRuleUsageTrackingFuture struct {
+	*rpc.Call
+	result StopRuleUsageTrackingResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *StopRuleUsageTrackingFuture) Get() (*StopRuleUsageTrackingResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *StopRuleUsageTrackingRequest) DoAsync(ctx context.Context) *StopRuleUsageTrackingFuture {
+	f := new(StopRuleUsageTrackingFuture)
+	f.Call = r.client.Go(ctx, "CSS.stopRuleUsageTracking", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["CSS.mediaQueryResultChanged"] = func() interface{} { return new(MediaQueryResultChangedEvent) }
 	rpc.EventTypes["CSS.fontsUpdated"] = func() interface{} { return new(FontsUpdatedEvent) }
@@ -999,23 +2160,218 @@ func init() {
 type MediaQueryResultChangedEvent struct {
 }
 
+// Fires whenever a MediaQuery result changes (for example, after a browser window has been resized.) The current implementation considers only viewport-dependent media features.
+func (d *Client) OnMediaQueryResultChanged(fn func(*MediaQueryResultChangedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("CSS.mediaQueryResultChanged", func(e interface{}) {
+		fn(e.(*MediaQueryResultChangedEvent))
+	}, opts...)
+}
+
+// FilterMediaQueryResultChanged is rpc.WithFilter for OnMediaQueryResultChanged.
+func FilterMediaQueryResultChanged(fn func(*MediaQueryResultChangedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*MediaQueryResultChangedEvent))
+	})
+}
+
+// MediaQueryResultChangedWaiter waits for a CSS.mediaQueryResultChanged event. It is created by WaitForMediaQueryResultChanged.
+type MediaQueryResultChangedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *MediaQueryResultChangedWaiter) Wait() (*MediaQueryResultChangedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*MediaQueryResultChangedEvent), nil
+}
+
+// WaitForMediaQueryResultChanged arms a waiter for the first CSS.mediaQueryResultChanged event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForMediaQueryResultChanged(ctx context.Context, predicate func(*MediaQueryResultChangedEvent) bool) *MediaQueryResultChangedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*MediaQueryResultChangedEvent))
+		}
+	}
+	return &MediaQueryResultChangedWaiter{d.Client.WaitFor(ctx, "CSS.mediaQueryResultChanged", p)}
+}
+
 // Fires whenever a web font gets loaded.
 type FontsUpdatedEvent struct {
 }
 
+// Fires whenever a web font gets loaded.
+func (d *Client) OnFontsUpdated(fn func(*FontsUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("CSS.fontsUpdated", func(e interface{}) {
+		fn(e.(*FontsUpdatedEvent))
+	}, opts...)
+}
+
+// FilterFontsUpdated is rpc.WithFilter for OnFontsUpdated.
+func FilterFontsUpdated(fn func(*FontsUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FontsUpdatedEvent))
+	})
+}
+
+// FontsUpdatedWaiter waits for a CSS.fontsUpdated event. It is created by WaitForFontsUpdated.
+type FontsUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FontsUpdatedWaiter) Wait() (*FontsUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FontsUpdatedEvent), nil
+}
+
+// WaitForFontsUpdated arms a waiter for the first CSS.fontsUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFontsUpdated(ctx context.Context, predicate func(*FontsUpdatedEvent) bool) *FontsUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FontsUpdatedEvent))
+		}
+	}
+	return &FontsUpdatedWaiter{d.Client.WaitFor(ctx, "CSS.fontsUpdated", p)}
+}
+
 // Fired whenever a stylesheet is changed as a result of the client operation.
 type StyleSheetChangedEvent struct {
 	StyleSheetId StyleSheetId `json:"styleSheetId"`
 }
 
+// Fired whenever a stylesheet is changed as a result of the client operation.
+func (d *Client) OnStyleSheetChanged(fn func(*StyleSheetChangedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("CSS.styleSheetChanged", func(e interface{}) {
+		fn(e.(*StyleSheetChangedEvent))
+	}, opts...)
+}
+
+// FilterStyleSheetChanged is rpc.WithFilter for OnStyleSheetChanged.
+func FilterStyleSheetChanged(fn func(*StyleSheetChangedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*StyleSheetChangedEvent))
+	})
+}
+
+// StyleSheetChangedWaiter waits for a CSS.styleSheetChanged event. It is created by WaitForStyleSheetChanged.
+type StyleSheetChangedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *StyleSheetChangedWaiter) Wait() (*StyleSheetChangedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*StyleSheetChangedEvent), nil
+}
+
+// WaitForStyleSheetChanged arms a waiter for the first CSS.styleSheetChanged event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForStyleSheetChanged(ctx context.Context, predicate func(*StyleSheetChangedEvent) bool) *StyleSheetChangedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*StyleSheetChangedEvent))
+		}
+	}
+	return &StyleSheetChangedWaiter{d.Client.WaitFor(ctx, "CSS.styleSheetChanged", p)}
+}
+
 // Fired whenever an active document stylesheet is added.
 type StyleSheetAddedEvent struct {
 	// Added stylesheet metainfo.
 	Header *CSSStyleSheetHeader `json:"header"`
 }
 
+// Fired whenever an active document stylesheet is added.
+func (d *Client) OnStyleSheetAdded(fn func(*StyleSheetAddedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("CSS.styleSheetAdded", func(e interface{}) {
+		fn(e.(*StyleSheetAddedEvent))
+	}, opts...)
+}
+
+// FilterStyleSheetAdded is rpc.WithFilter for OnStyleSheetAdded.
+func FilterStyleSheetAdded(fn func(*StyleSheetAddedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*StyleSheetAddedEvent))
+	})
+}
+
+// StyleSheetAddedWaiter waits for a CSS.styleSheetAdded event. It is created by WaitForStyleSheetAdded.
+type StyleSheetAddedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *StyleSheetAddedWaiter) Wait() (*StyleSheetAddedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*StyleSheetAddedEvent), nil
+}
+
+// WaitForStyleSheetAdded arms a waiter for the first CSS.styleSheetAdded event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForStyleSheetAdded(ctx context.Context, predicate func(*StyleSheetAddedEvent) bool) *StyleSheetAddedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*StyleSheetAddedEvent))
+		}
+	}
+	return &StyleSheetAddedWaiter{d.Client.WaitFor(ctx, "CSS.styleSheetAdded", p)}
+}
+
 // Fired whenever an active document stylesheet is removed.
 type StyleSheetRemovedEvent struct {
 	// Identifier of the removed stylesheet.
 	StyleSheetId StyleSheetId `json:"styleSheetId"`
 }
+
+// Fired whenever an active document stylesheet is removed.
+func (d *Client) OnStyleSheetRemoved(fn func(*StyleSheetRemovedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("CSS.styleSheetRemoved", func(e interface{}) {
+		fn(e.(*StyleSheetRemovedEvent))
+	}, opts...)
+}
+
+// FilterStyleSheetRemoved is rpc.WithFilter for OnStyleSheetRemoved.
+func FilterStyleSheetRemoved(fn func(*StyleSheetRemovedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*StyleSheetRemovedEvent))
+	})
+}
+
+// StyleSheetRemovedWaiter waits for a CSS.styleSheetRemoved event. It is created by WaitForStyleSheetRemoved.
+type StyleSheetRemovedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *StyleSheetRemovedWaiter) Wait() (*StyleSheetRemovedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*StyleSheetRemovedEvent), nil
+}
+
+// WaitForStyleSheetRemoved arms a waiter for the first CSS.styleSheetRemoved event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForStyleSheetRemoved(ctx context.Context, predicate func(*StyleSheetRemovedEvent) bool) *StyleSheetRemovedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*StyleSheetRemovedEvent))
+		}
+	}
+	return &StyleSheetRemovedWaiter{d.Client.WaitFor(ctx, "CSS.styleSheetRemoved", p)}
+}
@@ -2,6 +2,8 @@
 package database
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -40,45 +42,120 @@ type Error struct {
 	Code int `json:"code"`
 }
 
+// EnableParams are the parameters of Database.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables database tracking, database events will now be delivered to the client.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Database.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Database.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Database", r.params)
+}
+
+// DisableParams are the parameters of Database.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables database tracking, prevents database events from being sent to the client.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Database.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Database.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Database", r.params)
+}
+
+// GetDatabaseTableNamesParams are the parameters of Database.getDatabaseTableNames.
+type GetDatabaseTableNamesParams struct {
+	DatabaseId DatabaseId `json:"databaseId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetDatabaseTableNamesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.DatabaseId == "" {
+		missing = append(missing, "databaseId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Database.getDatabaseTableNames", Missing: missing, Invalid: invalid}
 }
 
 type GetDatabaseTableNamesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetDatabaseTableNamesParams
 }
 
 func (d *Client) GetDatabaseTableNames() *GetDatabaseTableNamesRequest {
-	return &GetDatabaseTableNamesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetDatabaseTableNamesRequest{client: d.Client, params: &GetDatabaseTableNamesParams{set: 1}}
+}
+
+// DoGetDatabaseTableNames sends Database.getDatabaseTableNames with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetDatabaseTableNames(ctx context.Context, params *GetDatabaseTableNamesParams) (*GetDatabaseTableNamesResult, error) {
+	if params == nil {
+		params = new(GetDatabaseTableNamesParams)
+	}
+	return (&GetDatabaseTableNamesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetDatabaseTableNamesRequest) DatabaseId(v DatabaseId) *GetDatabaseTableNamesRequest {
-	r.opts["databaseId"] = v
+	r.params.DatabaseId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -87,47 +164,128 @@ type GetDatabaseTableNamesResult struct {
 }
 
 func (r *GetDatabaseTableNamesRequest) Do() (*GetDatabaseTableNamesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetDatabaseTableNamesRequest) DoContext(ctx context.Context) (*GetDatabaseTableNamesResult, error) {
 	var result GetDatabaseTableNamesResult
-	err := r.client.Call("Database.getDatabaseTableNames", r.opts, &result)
+	err := r.client.CallContext(ctx, "Database.getDatabaseTableNames", r.params, &result)
 	return &result, err
 }
 
+type GetDatabaseTableNamesFuture struct {
+	*rpc.Call
+	result GetDatabaseTableNamesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetDatabaseTableNamesFuture) Get() (*GetDatabaseTableNamesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetDatabaseTableNamesRequest) DoAsync(ctx context.Context) *GetDatabaseTableNamesFuture {
+	f := new(GetDatabaseTableNamesFuture)
+	f.Call = r.client.Go(ctx, "Database.getDatabaseTableNames", r.params, &f.result)
+	return f
+}
+
+// ExecuteSQLParams are the parameters of Database.executeSQL.
+type ExecuteSQLParams struct {
+	DatabaseId DatabaseId `json:"databaseId"`
+
+	Query string `json:"query"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ExecuteSQLParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.DatabaseId == "" {
+		missing = append(missing, "databaseId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Query == "" {
+		missing = append(missing, "query")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Database.executeSQL", Missing: missing, Invalid: invalid}
+}
+
 type ExecuteSQLRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ExecuteSQLParams
 }
 
 func (d *Client) ExecuteSQL() *ExecuteSQLRequest {
-	return &ExecuteSQLRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ExecuteSQLRequest{client: d.Client, params: &ExecuteSQLParams{set: 1}}
+}
+
+// DoExecuteSQL sends Database.executeSQL with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoExecuteSQL(ctx context.Context, params *ExecuteSQLParams) (*ExecuteSQLResult, error) {
+	if params == nil {
+		params = new(ExecuteSQLParams)
+	}
+	return (&ExecuteSQLRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ExecuteSQLRequest) DatabaseId(v DatabaseId) *ExecuteSQLRequest {
-	r.opts["databaseId"] = v
+	r.params.DatabaseId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ExecuteSQLRequest) Query(v string) *ExecuteSQLRequest {
-	r.opts["query"] = v
+	r.params.Query = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 type ExecuteSQLResult struct {
 	// (optional)
-	ColumnNames []string `json:"columnNames"`
+	ColumnNames []string `json:"columnNames,omitempty"`
 
 	// (optional)
-	Values []interface{} `json:"values"`
+	Values []interface{} `json:"values,omitempty"`
 
 	// (optional)
-	SqlError *Error `json:"sqlError"`
+	SqlError *Error `json:"sqlError,omitempty"`
 }
 
 func (r *ExecuteSQLRequest) Do() (*ExecuteSQLResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *ExecuteSQLRequest) DoContext(ctx context.Context) (*ExecuteSQLResult, error) {
 	var result ExecuteSQLResult
-	err := r.client.Call("Database.executeSQL", r.opts, &result)
+	err := r.client.CallContext(ctx, "Database.executeSQL", r.params, &result)
 	return &result, err
 }
 
+type ExecuteSQLFuture struct {
+	*rpc.Call
+	result ExecuteSQLResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *ExecuteSQLFuture) Get() (*ExecuteSQLResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *ExecuteSQLRequest) DoAsync(ctx context.Context) *ExecuteSQLFuture {
+	f := new(ExecuteSQLFuture)
+	f.Call = r.client.Go(ctx, "Database.executeSQL", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["Database.addDatabase"] = func() interface{} { return new(AddDatabaseEvent) }
 }
@@ -135,3 +293,41 @@ func init() {
 type AddDatabaseEvent struct {
 	Database *Database `json:"database"`
 }
+
+func (d *Client) OnAddDatabase(fn func(*AddDatabaseEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Database.addDatabase", func(e interface{}) {
+		fn(e.(*AddDatabaseEvent))
+	}, opts...)
+}
+
+// FilterAddDatabase is rpc.WithFilter for OnAddDatabase.
+func FilterAddDatabase(fn func(*AddDatabaseEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AddDatabaseEvent))
+	})
+}
+
+// AddDatabaseWaiter waits for a Database.addDatabase event. It is created by WaitForAddDatabase.
+type AddDatabaseWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AddDatabaseWaiter) Wait() (*AddDatabaseEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AddDatabaseEvent), nil
+}
+
+// WaitForAddDatabase arms a waiter for the first Database.addDatabase event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAddDatabase(ctx context.Context, predicate func(*AddDatabaseEvent) bool) *AddDatabaseWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AddDatabaseEvent))
+		}
+	}
+	return &AddDatabaseWaiter{d.Client.WaitFor(ctx, "Database.addDatabase", p)}
+}
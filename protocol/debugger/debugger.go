@@ -2,6 +2,8 @@
 package debugger
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/runtime"
@@ -30,7 +32,7 @@ type Location struct {
 	LineNumber int `json:"lineNumber"`
 
 	// Column number in the script (0-based). (optional)
-	ColumnNumber int `json:"columnNumber,omitempty"`
+	ColumnNumber *int `json:"columnNumber,omitempty"`
 }
 
 // Location in the source code. (experimental)
@@ -70,13 +72,13 @@ type CallFrame struct {
 
 type Scope struct {
 	// Scope type.
-	Type string `json:"type"`
+	Type ScopeType `json:"type"`
 
 	// Object representing the scope. For <code>global</code> and <code>with</code> scopes it represents the actual object; for the rest of the scopes, it is artificial transient object enumerating scope variables as its properties.
 	Object *runtime.RemoteObject `json:"object"`
 
 	// (optional)
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	// Location in the source code where scope starts (optional)
 	StartLocation *Location `json:"startLocation,omitempty"`
@@ -105,117 +107,403 @@ type BreakLocation struct {
 	LineNumber int `json:"lineNumber"`
 
 	// Column number in the script (0-based). (optional)
-	ColumnNumber int `json:"columnNumber,omitempty"`
+	ColumnNumber *int `json:"columnNumber,omitempty"`
 
 	// (optional)
-	Type string `json:"type,omitempty"`
+	Type *BreakLocationType `json:"type,omitempty"`
+}
+
+// Values of the type property of Scope.
+
+type ScopeType string
+
+const (
+	ScopeTypeGlobal              ScopeType = "global"
+	ScopeTypeLocal               ScopeType = "local"
+	ScopeTypeWith                ScopeType = "with"
+	ScopeTypeClosure             ScopeType = "closure"
+	ScopeTypeCatch               ScopeType = "catch"
+	ScopeTypeBlock               ScopeType = "block"
+	ScopeTypeScript              ScopeType = "script"
+	ScopeTypeEval                ScopeType = "eval"
+	ScopeTypeModule              ScopeType = "module"
+	ScopeTypeWasmExpressionStack ScopeType = "wasm-expression-stack"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ScopeType) IsValid() bool {
+	switch v {
+	case ScopeTypeGlobal, ScopeTypeLocal, ScopeTypeWith, ScopeTypeClosure, ScopeTypeCatch, ScopeTypeBlock, ScopeTypeScript, ScopeTypeEval, ScopeTypeModule, ScopeTypeWasmExpressionStack:
+		return true
+	}
+	return false
+}
+
+// Values of the type property of BreakLocation.
+
+type BreakLocationType string
+
+const (
+	BreakLocationTypeDebuggerStatement BreakLocationType = "debuggerStatement"
+	BreakLocationTypeCall              BreakLocationType = "call"
+	BreakLocationTypeReturn            BreakLocationType = "return"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v BreakLocationType) IsValid() bool {
+	switch v {
+	case BreakLocationTypeDebuggerStatement, BreakLocationTypeCall, BreakLocationTypeReturn:
+		return true
+	}
+	return false
+}
+
+// Values of the targetCallFrames parameter of Debugger.continueToLocation.
+
+type ContinueToLocationTargetCallFrames string
+
+const (
+	ContinueToLocationTargetCallFramesAny     ContinueToLocationTargetCallFrames = "any"
+	ContinueToLocationTargetCallFramesCurrent ContinueToLocationTargetCallFrames = "current"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ContinueToLocationTargetCallFrames) IsValid() bool {
+	switch v {
+	case ContinueToLocationTargetCallFramesAny, ContinueToLocationTargetCallFramesCurrent:
+		return true
+	}
+	return false
+}
+
+// Values of the state parameter of Debugger.setPauseOnExceptions.
+
+type SetPauseOnExceptionsState string
+
+const (
+	SetPauseOnExceptionsStateNone     SetPauseOnExceptionsState = "none"
+	SetPauseOnExceptionsStateCaught   SetPauseOnExceptionsState = "caught"
+	SetPauseOnExceptionsStateUncaught SetPauseOnExceptionsState = "uncaught"
+	SetPauseOnExceptionsStateAll      SetPauseOnExceptionsState = "all"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v SetPauseOnExceptionsState) IsValid() bool {
+	switch v {
+	case SetPauseOnExceptionsStateNone, SetPauseOnExceptionsStateCaught, SetPauseOnExceptionsStateUncaught, SetPauseOnExceptionsStateAll:
+		return true
+	}
+	return false
+}
+
+// Values of the reason parameter of Debugger.paused.
+
+type PausedReason string
+
+const (
+	PausedReasonAmbiguous        PausedReason = "ambiguous"
+	PausedReasonAssert           PausedReason = "assert"
+	PausedReasonCSPViolation     PausedReason = "CSPViolation"
+	PausedReasonDebugCommand     PausedReason = "debugCommand"
+	PausedReasonDOM              PausedReason = "DOM"
+	PausedReasonEventListener    PausedReason = "EventListener"
+	PausedReasonException        PausedReason = "exception"
+	PausedReasonInstrumentation  PausedReason = "instrumentation"
+	PausedReasonOOM              PausedReason = "OOM"
+	PausedReasonOther            PausedReason = "other"
+	PausedReasonPromiseRejection PausedReason = "promiseRejection"
+	PausedReasonXHR              PausedReason = "XHR"
+	PausedReasonStep             PausedReason = "step"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v PausedReason) IsValid() bool {
+	switch v {
+	case PausedReasonAmbiguous, PausedReasonAssert, PausedReasonCSPViolation, PausedReasonDebugCommand, PausedReasonDOM, PausedReasonEventListener, PausedReasonException, PausedReasonInstrumentation, PausedReasonOOM, PausedReasonOther, PausedReasonPromiseRejection, PausedReasonXHR, PausedReasonStep:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Debugger.enable.
+type EnableParams struct {
 }
 
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables debugger for the given page. Clients should not assume that the debugging has been enabled until the result for this command is received.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Debugger.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Debugger.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Debugger", r.params)
+}
+
+// DisableParams are the parameters of Debugger.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables debugger for given page.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Debugger.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Debugger.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Debugger", r.params)
+}
+
+// SetBreakpointsActiveParams are the parameters of Debugger.setBreakpointsActive.
+type SetBreakpointsActiveParams struct {
+	// New value for breakpoints active state.
+	Active bool `json:"active"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetBreakpointsActiveParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "active")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setBreakpointsActive", Missing: missing, Invalid: invalid}
 }
 
 type SetBreakpointsActiveRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetBreakpointsActiveParams
 }
 
 // Activates / deactivates all breakpoints on the page.
 func (d *Client) SetBreakpointsActive() *SetBreakpointsActiveRequest {
-	return &SetBreakpointsActiveRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetBreakpointsActiveRequest{client: d.Client, params: &SetBreakpointsActiveParams{set: 1}}
+}
+
+// DoSetBreakpointsActive sends Debugger.setBreakpointsActive with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetBreakpointsActive(ctx context.Context, params *SetBreakpointsActiveParams) error {
+	if params == nil {
+		params = new(SetBreakpointsActiveParams)
+	}
+	return (&SetBreakpointsActiveRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // New value for breakpoints active state.
 func (r *SetBreakpointsActiveRequest) Active(v bool) *SetBreakpointsActiveRequest {
-	r.opts["active"] = v
+	r.params.Active = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetBreakpointsActiveRequest) Do() error {
-	return r.client.Call("Debugger.setBreakpointsActive", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetBreakpointsActiveRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.setBreakpointsActive", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetBreakpointsActiveRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.setBreakpointsActive", r.params, nil)
+}
+
+// SetSkipAllPausesParams are the parameters of Debugger.setSkipAllPauses.
+type SetSkipAllPausesParams struct {
+	// New value for skip pauses state.
+	Skip bool `json:"skip"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetSkipAllPausesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "skip")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setSkipAllPauses", Missing: missing, Invalid: invalid}
 }
 
 type SetSkipAllPausesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetSkipAllPausesParams
 }
 
 // Makes page not interrupt on any pauses (breakpoint, exception, dom exception etc).
 func (d *Client) SetSkipAllPauses() *SetSkipAllPausesRequest {
-	return &SetSkipAllPausesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetSkipAllPausesRequest{client: d.Client, params: &SetSkipAllPausesParams{set: 1}}
+}
+
+// DoSetSkipAllPauses sends Debugger.setSkipAllPauses with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetSkipAllPauses(ctx context.Context, params *SetSkipAllPausesParams) error {
+	if params == nil {
+		params = new(SetSkipAllPausesParams)
+	}
+	return (&SetSkipAllPausesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // New value for skip pauses state.
 func (r *SetSkipAllPausesRequest) Skip(v bool) *SetSkipAllPausesRequest {
-	r.opts["skip"] = v
+	r.params.Skip = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetSkipAllPausesRequest) Do() error {
-	return r.client.Call("Debugger.setSkipAllPauses", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetSkipAllPausesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.setSkipAllPauses", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetSkipAllPausesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.setSkipAllPauses", r.params, nil)
+}
+
+// SetBreakpointByUrlParams are the parameters of Debugger.setBreakpointByUrl.
+type SetBreakpointByUrlParams struct {
+	// Line number to set breakpoint at.
+	LineNumber int `json:"lineNumber"`
+
+	// URL of the resources to set breakpoint on. (optional)
+	URL *string `json:"url,omitempty"`
+
+	// Regex pattern for the URLs of the resources to set breakpoints on. Either <code>url</code> or <code>urlRegex</code> must be specified. (optional)
+	UrlRegex *string `json:"urlRegex,omitempty"`
+
+	// Offset in the line to set breakpoint at. (optional)
+	ColumnNumber *int `json:"columnNumber,omitempty"`
+
+	// Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true. (optional)
+	Condition *string `json:"condition,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetBreakpointByUrlParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "lineNumber")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setBreakpointByUrl", Missing: missing, Invalid: invalid}
 }
 
 type SetBreakpointByUrlRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetBreakpointByUrlParams
 }
 
 // Sets JavaScript breakpoint at given location specified either by URL or URL regex. Once this command is issued, all existing parsed scripts will have breakpoints resolved and returned in <code>locations</code> property. Further matching script parsing will result in subsequent <code>breakpointResolved</code> events issued. This logical breakpoint will survive page reloads.
 func (d *Client) SetBreakpointByUrl() *SetBreakpointByUrlRequest {
-	return &SetBreakpointByUrlRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetBreakpointByUrlRequest{client: d.Client, params: &SetBreakpointByUrlParams{set: 1}}
+}
+
+// DoSetBreakpointByUrl sends Debugger.setBreakpointByUrl with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetBreakpointByUrl(ctx context.Context, params *SetBreakpointByUrlParams) (*SetBreakpointByUrlResult, error) {
+	if params == nil {
+		params = new(SetBreakpointByUrlParams)
+	}
+	return (&SetBreakpointByUrlRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Line number to set breakpoint at.
 func (r *SetBreakpointByUrlRequest) LineNumber(v int) *SetBreakpointByUrlRequest {
-	r.opts["lineNumber"] = v
+	r.params.LineNumber = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // URL of the resources to set breakpoint on. (optional)
 func (r *SetBreakpointByUrlRequest) URL(v string) *SetBreakpointByUrlRequest {
-	r.opts["url"] = v
+	r.params.URL = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Regex pattern for the URLs of the resources to set breakpoints on. Either <code>url</code> or <code>urlRegex</code> must be specified. (optional)
 func (r *SetBreakpointByUrlRequest) UrlRegex(v string) *SetBreakpointByUrlRequest {
-	r.opts["urlRegex"] = v
+	r.params.UrlRegex = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Offset in the line to set breakpoint at. (optional)
 func (r *SetBreakpointByUrlRequest) ColumnNumber(v int) *SetBreakpointByUrlRequest {
-	r.opts["columnNumber"] = v
+	r.params.ColumnNumber = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true. (optional)
 func (r *SetBreakpointByUrlRequest) Condition(v string) *SetBreakpointByUrlRequest {
-	r.opts["condition"] = v
+	r.params.Condition = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
@@ -228,30 +516,88 @@ type SetBreakpointByUrlResult struct {
 }
 
 func (r *SetBreakpointByUrlRequest) Do() (*SetBreakpointByUrlResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetBreakpointByUrlRequest) DoContext(ctx context.Context) (*SetBreakpointByUrlResult, error) {
 	var result SetBreakpointByUrlResult
-	err := r.client.Call("Debugger.setBreakpointByUrl", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.setBreakpointByUrl", r.params, &result)
 	return &result, err
 }
 
+type SetBreakpointByUrlFuture struct {
+	*rpc.Call
+	result SetBreakpointByUrlResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetBreakpointByUrlFuture) Get() (*SetBreakpointByUrlResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetBreakpointByUrlRequest) DoAsync(ctx context.Context) *SetBreakpointByUrlFuture {
+	f := new(SetBreakpointByUrlFuture)
+	f.Call = r.client.Go(ctx, "Debugger.setBreakpointByUrl", r.params, &f.result)
+	return f
+}
+
+// SetBreakpointParams are the parameters of Debugger.setBreakpoint.
+type SetBreakpointParams struct {
+	// Location to set breakpoint in.
+	Location *Location `json:"location"`
+
+	// Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true. (optional)
+	Condition *string `json:"condition,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.Location == nil {
+		missing = append(missing, "location")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setBreakpoint", Missing: missing, Invalid: invalid}
+}
+
 type SetBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetBreakpointParams
 }
 
 // Sets JavaScript breakpoint at a given location.
 func (d *Client) SetBreakpoint() *SetBreakpointRequest {
-	return &SetBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetBreakpointRequest{client: d.Client, params: &SetBreakpointParams{set: 1}}
+}
+
+// DoSetBreakpoint sends Debugger.setBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetBreakpoint(ctx context.Context, params *SetBreakpointParams) (*SetBreakpointResult, error) {
+	if params == nil {
+		params = new(SetBreakpointParams)
+	}
+	return (&SetBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Location to set breakpoint in.
 func (r *SetBreakpointRequest) Location(v *Location) *SetBreakpointRequest {
-	r.opts["location"] = v
+	r.params.Location = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Expression to use as a breakpoint condition. When specified, debugger will only stop on the breakpoint if this expression evaluates to true. (optional)
 func (r *SetBreakpointRequest) Condition(v string) *SetBreakpointRequest {
-	r.opts["condition"] = v
+	r.params.Condition = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -264,55 +610,157 @@ type SetBreakpointResult struct {
 }
 
 func (r *SetBreakpointRequest) Do() (*SetBreakpointResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetBreakpointRequest) DoContext(ctx context.Context) (*SetBreakpointResult, error) {
 	var result SetBreakpointResult
-	err := r.client.Call("Debugger.setBreakpoint", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.setBreakpoint", r.params, &result)
 	return &result, err
 }
 
+type SetBreakpointFuture struct {
+	*rpc.Call
+	result SetBreakpointResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetBreakpointFuture) Get() (*SetBreakpointResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetBreakpointRequest) DoAsync(ctx context.Context) *SetBreakpointFuture {
+	f := new(SetBreakpointFuture)
+	f.Call = r.client.Go(ctx, "Debugger.setBreakpoint", r.params, &f.result)
+	return f
+}
+
+// RemoveBreakpointParams are the parameters of Debugger.removeBreakpoint.
+type RemoveBreakpointParams struct {
+	BreakpointId BreakpointId `json:"breakpointId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.BreakpointId == "" {
+		missing = append(missing, "breakpointId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.removeBreakpoint", Missing: missing, Invalid: invalid}
+}
+
 type RemoveBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveBreakpointParams
 }
 
 // Removes JavaScript breakpoint.
 func (d *Client) RemoveBreakpoint() *RemoveBreakpointRequest {
-	return &RemoveBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveBreakpointRequest{client: d.Client, params: &RemoveBreakpointParams{set: 1}}
+}
+
+// DoRemoveBreakpoint sends Debugger.removeBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveBreakpoint(ctx context.Context, params *RemoveBreakpointParams) error {
+	if params == nil {
+		params = new(RemoveBreakpointParams)
+	}
+	return (&RemoveBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *RemoveBreakpointRequest) BreakpointId(v BreakpointId) *RemoveBreakpointRequest {
-	r.opts["breakpointId"] = v
+	r.params.BreakpointId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *RemoveBreakpointRequest) Do() error {
-	return r.client.Call("Debugger.removeBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.removeBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.removeBreakpoint", r.params, nil)
+}
+
+// GetPossibleBreakpointsParams are the parameters of Debugger.getPossibleBreakpoints.
+type GetPossibleBreakpointsParams struct {
+	// Start of range to search possible breakpoint locations in.
+	Start *Location `json:"start"`
+
+	// End of range to search possible breakpoint locations in (excluding). When not specified, end of scripts is used as end of range. (optional)
+	End *Location `json:"end,omitempty"`
+
+	// Only consider locations which are in the same (non-nested) function as start. (optional)
+	RestrictToFunction *bool `json:"restrictToFunction,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetPossibleBreakpointsParams) Validate() error {
+	var missing, invalid []string
+	if p.Start == nil {
+		missing = append(missing, "start")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.getPossibleBreakpoints", Missing: missing, Invalid: invalid}
 }
 
 type GetPossibleBreakpointsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetPossibleBreakpointsParams
 }
 
 // Returns possible locations for breakpoint. scriptId in start and end range locations should be the same. (experimental)
 func (d *Client) GetPossibleBreakpoints() *GetPossibleBreakpointsRequest {
-	return &GetPossibleBreakpointsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetPossibleBreakpointsRequest{client: d.Client, params: &GetPossibleBreakpointsParams{set: 1}}
+}
+
+// DoGetPossibleBreakpoints sends Debugger.getPossibleBreakpoints with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetPossibleBreakpoints(ctx context.Context, params *GetPossibleBreakpointsParams) (*GetPossibleBreakpointsResult, error) {
+	if params == nil {
+		params = new(GetPossibleBreakpointsParams)
+	}
+	return (&GetPossibleBreakpointsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Start of range to search possible breakpoint locations in.
 func (r *GetPossibleBreakpointsRequest) Start(v *Location) *GetPossibleBreakpointsRequest {
-	r.opts["start"] = v
+	r.params.Start = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // End of range to search possible breakpoint locations in (excluding). When not specified, end of scripts is used as end of range. (optional)
 func (r *GetPossibleBreakpointsRequest) End(v *Location) *GetPossibleBreakpointsRequest {
-	r.opts["end"] = v
+	r.params.End = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Only consider locations which are in the same (non-nested) function as start. (optional)
 func (r *GetPossibleBreakpointsRequest) RestrictToFunction(v bool) *GetPossibleBreakpointsRequest {
-	r.opts["restrictToFunction"] = v
+	r.params.RestrictToFunction = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -322,152 +770,395 @@ type GetPossibleBreakpointsResult struct {
 }
 
 func (r *GetPossibleBreakpointsRequest) Do() (*GetPossibleBreakpointsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetPossibleBreakpointsRequest) DoContext(ctx context.Context) (*GetPossibleBreakpointsResult, error) {
 	var result GetPossibleBreakpointsResult
-	err := r.client.Call("Debugger.getPossibleBreakpoints", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.getPossibleBreakpoints", r.params, &result)
 	return &result, err
 }
 
+type GetPossibleBreakpointsFuture struct {
+	*rpc.Call
+	result GetPossibleBreakpointsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetPossibleBreakpointsFuture) Get() (*GetPossibleBreakpointsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetPossibleBreakpointsRequest) DoAsync(ctx context.Context) *GetPossibleBreakpointsFuture {
+	f := new(GetPossibleBreakpointsFuture)
+	f.Call = r.client.Go(ctx, "Debugger.getPossibleBreakpoints", r.params, &f.result)
+	return f
+}
+
+// ContinueToLocationParams are the parameters of Debugger.continueToLocation.
+type ContinueToLocationParams struct {
+	// Location to continue to.
+	Location *Location `json:"location"`
+
+	// (optional, experimental)
+	TargetCallFrames *ContinueToLocationTargetCallFrames `json:"targetCallFrames,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ContinueToLocationParams) Validate() error {
+	var missing, invalid []string
+	if p.Location == nil {
+		missing = append(missing, "location")
+	}
+	if p.TargetCallFrames != nil && !p.TargetCallFrames.IsValid() {
+		invalid = append(invalid, "targetCallFrames")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.continueToLocation", Missing: missing, Invalid: invalid}
+}
+
 type ContinueToLocationRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ContinueToLocationParams
 }
 
 // Continues execution until specific location is reached.
 func (d *Client) ContinueToLocation() *ContinueToLocationRequest {
-	return &ContinueToLocationRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ContinueToLocationRequest{client: d.Client, params: &ContinueToLocationParams{set: 1}}
+}
+
+// DoContinueToLocation sends Debugger.continueToLocation with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoContinueToLocation(ctx context.Context, params *ContinueToLocationParams) error {
+	if params == nil {
+		params = new(ContinueToLocationParams)
+	}
+	return (&ContinueToLocationRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Location to continue to.
 func (r *ContinueToLocationRequest) Location(v *Location) *ContinueToLocationRequest {
-	r.opts["location"] = v
+	r.params.Location = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // (optional, experimental)
-func (r *ContinueToLocationRequest) TargetCallFrames(v string) *ContinueToLocationRequest {
-	r.opts["targetCallFrames"] = v
+func (r *ContinueToLocationRequest) TargetCallFrames(v ContinueToLocationTargetCallFrames) *ContinueToLocationRequest {
+	r.params.TargetCallFrames = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *ContinueToLocationRequest) Do() error {
-	return r.client.Call("Debugger.continueToLocation", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ContinueToLocationRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.continueToLocation", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ContinueToLocationRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.continueToLocation", r.params, nil)
+}
+
+// StepOverParams are the parameters of Debugger.stepOver.
+type StepOverParams struct {
 }
 
 type StepOverRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StepOverParams
 }
 
 // Steps over the statement.
 func (d *Client) StepOver() *StepOverRequest {
-	return &StepOverRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StepOverRequest{client: d.Client, params: &StepOverParams{}}
+}
+
+// DoStepOver sends Debugger.stepOver with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStepOver(ctx context.Context, params *StepOverParams) error {
+	if params == nil {
+		params = new(StepOverParams)
+	}
+	return (&StepOverRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StepOverRequest) Do() error {
-	return r.client.Call("Debugger.stepOver", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StepOverRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.stepOver", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StepOverRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.stepOver", r.params, nil)
+}
+
+// StepIntoParams are the parameters of Debugger.stepInto.
+type StepIntoParams struct {
 }
 
 type StepIntoRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StepIntoParams
 }
 
 // Steps into the function call.
 func (d *Client) StepInto() *StepIntoRequest {
-	return &StepIntoRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StepIntoRequest{client: d.Client, params: &StepIntoParams{}}
+}
+
+// DoStepInto sends Debugger.stepInto with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStepInto(ctx context.Context, params *StepIntoParams) error {
+	if params == nil {
+		params = new(StepIntoParams)
+	}
+	return (&StepIntoRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StepIntoRequest) Do() error {
-	return r.client.Call("Debugger.stepInto", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StepIntoRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.stepInto", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StepIntoRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.stepInto", r.params, nil)
+}
+
+// StepOutParams are the parameters of Debugger.stepOut.
+type StepOutParams struct {
 }
 
 type StepOutRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StepOutParams
 }
 
 // Steps out of the function call.
 func (d *Client) StepOut() *StepOutRequest {
-	return &StepOutRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StepOutRequest{client: d.Client, params: &StepOutParams{}}
+}
+
+// DoStepOut sends Debugger.stepOut with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStepOut(ctx context.Context, params *StepOutParams) error {
+	if params == nil {
+		params = new(StepOutParams)
+	}
+	return (&StepOutRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StepOutRequest) Do() error {
-	return r.client.Call("Debugger.stepOut", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StepOutRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.stepOut", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StepOutRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.stepOut", r.params, nil)
+}
+
+// PauseParams are the parameters of Debugger.pause.
+type PauseParams struct {
 }
 
 type PauseRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *PauseParams
 }
 
 // Stops on the next JavaScript statement.
 func (d *Client) Pause() *PauseRequest {
-	return &PauseRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &PauseRequest{client: d.Client, params: &PauseParams{}}
+}
+
+// DoPause sends Debugger.pause with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoPause(ctx context.Context, params *PauseParams) error {
+	if params == nil {
+		params = new(PauseParams)
+	}
+	return (&PauseRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *PauseRequest) Do() error {
-	return r.client.Call("Debugger.pause", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *PauseRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.pause", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *PauseRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.pause", r.params, nil)
+}
+
+// ScheduleStepIntoAsyncParams are the parameters of Debugger.scheduleStepIntoAsync.
+type ScheduleStepIntoAsyncParams struct {
 }
 
 type ScheduleStepIntoAsyncRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ScheduleStepIntoAsyncParams
 }
 
 // Steps into next scheduled async task if any is scheduled before next pause. Returns success when async task is actually scheduled, returns error if no task were scheduled or another scheduleStepIntoAsync was called. (experimental)
 func (d *Client) ScheduleStepIntoAsync() *ScheduleStepIntoAsyncRequest {
-	return &ScheduleStepIntoAsyncRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ScheduleStepIntoAsyncRequest{client: d.Client, params: &ScheduleStepIntoAsyncParams{}}
+}
+
+// DoScheduleStepIntoAsync sends Debugger.scheduleStepIntoAsync with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoScheduleStepIntoAsync(ctx context.Context, params *ScheduleStepIntoAsyncParams) error {
+	if params == nil {
+		params = new(ScheduleStepIntoAsyncParams)
+	}
+	return (&ScheduleStepIntoAsyncRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ScheduleStepIntoAsyncRequest) Do() error {
-	return r.client.Call("Debugger.scheduleStepIntoAsync", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ScheduleStepIntoAsyncRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.scheduleStepIntoAsync", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ScheduleStepIntoAsyncRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.scheduleStepIntoAsync", r.params, nil)
+}
+
+// ResumeParams are the parameters of Debugger.resume.
+type ResumeParams struct {
 }
 
 type ResumeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ResumeParams
 }
 
 // Resumes JavaScript execution.
 func (d *Client) Resume() *ResumeRequest {
-	return &ResumeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ResumeRequest{client: d.Client, params: &ResumeParams{}}
+}
+
+// DoResume sends Debugger.resume with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoResume(ctx context.Context, params *ResumeParams) error {
+	if params == nil {
+		params = new(ResumeParams)
+	}
+	return (&ResumeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ResumeRequest) Do() error {
-	return r.client.Call("Debugger.resume", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ResumeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.resume", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ResumeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.resume", r.params, nil)
+}
+
+// SearchInContentParams are the parameters of Debugger.searchInContent.
+type SearchInContentParams struct {
+	// Id of the script to search in.
+	ScriptId runtime.ScriptId `json:"scriptId"`
+
+	// String to search for.
+	Query string `json:"query"`
+
+	// If true, search is case sensitive. (optional)
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+
+	// If true, treats string parameter as regex. (optional)
+	IsRegex *bool `json:"isRegex,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SearchInContentParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScriptId == "" {
+		missing = append(missing, "scriptId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Query == "" {
+		missing = append(missing, "query")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.searchInContent", Missing: missing, Invalid: invalid}
 }
 
 type SearchInContentRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SearchInContentParams
 }
 
 // Searches for given string in script content. (experimental)
 func (d *Client) SearchInContent() *SearchInContentRequest {
-	return &SearchInContentRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SearchInContentRequest{client: d.Client, params: &SearchInContentParams{set: 1}}
+}
+
+// DoSearchInContent sends Debugger.searchInContent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSearchInContent(ctx context.Context, params *SearchInContentParams) (*SearchInContentResult, error) {
+	if params == nil {
+		params = new(SearchInContentParams)
+	}
+	return (&SearchInContentRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the script to search in.
 func (r *SearchInContentRequest) ScriptId(v runtime.ScriptId) *SearchInContentRequest {
-	r.opts["scriptId"] = v
+	r.params.ScriptId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // String to search for.
 func (r *SearchInContentRequest) Query(v string) *SearchInContentRequest {
-	r.opts["query"] = v
+	r.params.Query = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // If true, search is case sensitive. (optional)
 func (r *SearchInContentRequest) CaseSensitive(v bool) *SearchInContentRequest {
-	r.opts["caseSensitive"] = v
+	r.params.CaseSensitive = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // If true, treats string parameter as regex. (optional)
 func (r *SearchInContentRequest) IsRegex(v bool) *SearchInContentRequest {
-	r.opts["isRegex"] = v
+	r.params.IsRegex = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
@@ -477,72 +1168,191 @@ type SearchInContentResult struct {
 }
 
 func (r *SearchInContentRequest) Do() (*SearchInContentResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SearchInContentRequest) DoContext(ctx context.Context) (*SearchInContentResult, error) {
 	var result SearchInContentResult
-	err := r.client.Call("Debugger.searchInContent", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.searchInContent", r.params, &result)
 	return &result, err
 }
 
+type SearchInContentFuture struct {
+	*rpc.Call
+	result SearchInContentResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SearchInContentFuture) Get() (*SearchInContentResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SearchInContentRequest) DoAsync(ctx context.Context) *SearchInContentFuture {
+	f := new(SearchInContentFuture)
+	f.Call = r.client.Go(ctx, "Debugger.searchInContent", r.params, &f.result)
+	return f
+}
+
+// SetScriptSourceParams are the parameters of Debugger.setScriptSource.
+type SetScriptSourceParams struct {
+	// Id of the script to edit.
+	ScriptId runtime.ScriptId `json:"scriptId"`
+
+	// New content of the script.
+	ScriptSource string `json:"scriptSource"`
+
+	// If true the change will not actually be applied. Dry run may be used to get result description without actually modifying the code. (optional)
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetScriptSourceParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScriptId == "" {
+		missing = append(missing, "scriptId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.ScriptSource == "" {
+		missing = append(missing, "scriptSource")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setScriptSource", Missing: missing, Invalid: invalid}
+}
+
 type SetScriptSourceRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetScriptSourceParams
 }
 
 // Edits JavaScript source live.
 func (d *Client) SetScriptSource() *SetScriptSourceRequest {
-	return &SetScriptSourceRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetScriptSourceRequest{client: d.Client, params: &SetScriptSourceParams{set: 1}}
+}
+
+// DoSetScriptSource sends Debugger.setScriptSource with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetScriptSource(ctx context.Context, params *SetScriptSourceParams) (*SetScriptSourceResult, error) {
+	if params == nil {
+		params = new(SetScriptSourceParams)
+	}
+	return (&SetScriptSourceRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the script to edit.
 func (r *SetScriptSourceRequest) ScriptId(v runtime.ScriptId) *SetScriptSourceRequest {
-	r.opts["scriptId"] = v
+	r.params.ScriptId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // New content of the script.
 func (r *SetScriptSourceRequest) ScriptSource(v string) *SetScriptSourceRequest {
-	r.opts["scriptSource"] = v
+	r.params.ScriptSource = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // If true the change will not actually be applied. Dry run may be used to get result description without actually modifying the code. (optional)
 func (r *SetScriptSourceRequest) DryRun(v bool) *SetScriptSourceRequest {
-	r.opts["dryRun"] = v
+	r.params.DryRun = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 type SetScriptSourceResult struct {
 	// New stack trace in case editing has happened while VM was stopped. (optional)
-	CallFrames []*CallFrame `json:"callFrames"`
+	CallFrames []*CallFrame `json:"callFrames,omitempty"`
 
 	// Whether current call stack  was modified after applying the changes. (optional)
-	StackChanged bool `json:"stackChanged"`
+	StackChanged *bool `json:"stackChanged,omitempty"`
 
 	// Async stack trace, if any. (optional)
-	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace"`
+	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
 
 	// Exception details if any. (optional)
-	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *SetScriptSourceRequest) Do() (*SetScriptSourceResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetScriptSourceRequest) DoContext(ctx context.Context) (*SetScriptSourceResult, error) {
 	var result SetScriptSourceResult
-	err := r.client.Call("Debugger.setScriptSource", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.setScriptSource", r.params, &result)
 	return &result, err
 }
 
+type SetScriptSourceFuture struct {
+	*rpc.Call
+	result SetScriptSourceResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetScriptSourceFuture) Get() (*SetScriptSourceResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetScriptSourceRequest) DoAsync(ctx context.Context) *SetScriptSourceFuture {
+	f := new(SetScriptSourceFuture)
+	f.Call = r.client.Go(ctx, "Debugger.setScriptSource", r.params, &f.result)
+	return f
+}
+
+// RestartFrameParams are the parameters of Debugger.restartFrame.
+type RestartFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameId CallFrameId `json:"callFrameId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RestartFrameParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.CallFrameId == "" {
+		missing = append(missing, "callFrameId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.restartFrame", Missing: missing, Invalid: invalid}
+}
+
 type RestartFrameRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RestartFrameParams
 }
 
 // Restarts particular call frame from the beginning.
 func (d *Client) RestartFrame() *RestartFrameRequest {
-	return &RestartFrameRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RestartFrameRequest{client: d.Client, params: &RestartFrameParams{set: 1}}
+}
+
+// DoRestartFrame sends Debugger.restartFrame with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRestartFrame(ctx context.Context, params *RestartFrameParams) (*RestartFrameResult, error) {
+	if params == nil {
+		params = new(RestartFrameParams)
+	}
+	return (&RestartFrameRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Call frame identifier to evaluate on.
 func (r *RestartFrameRequest) CallFrameId(v CallFrameId) *RestartFrameRequest {
-	r.opts["callFrameId"] = v
+	r.params.CallFrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -551,28 +1361,82 @@ type RestartFrameResult struct {
 	CallFrames []*CallFrame `json:"callFrames"`
 
 	// Async stack trace, if any. (optional)
-	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace"`
+	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
 }
 
 func (r *RestartFrameRequest) Do() (*RestartFrameResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RestartFrameRequest) DoContext(ctx context.Context) (*RestartFrameResult, error) {
 	var result RestartFrameResult
-	err := r.client.Call("Debugger.restartFrame", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.restartFrame", r.params, &result)
 	return &result, err
 }
 
+type RestartFrameFuture struct {
+	*rpc.Call
+	result RestartFrameResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RestartFrameFuture) Get() (*RestartFrameResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RestartFrameRequest) DoAsync(ctx context.Context) *RestartFrameFuture {
+	f := new(RestartFrameFuture)
+	f.Call = r.client.Go(ctx, "Debugger.restartFrame", r.params, &f.result)
+	return f
+}
+
+// GetScriptSourceParams are the parameters of Debugger.getScriptSource.
+type GetScriptSourceParams struct {
+	// Id of the script to get source for.
+	ScriptId runtime.ScriptId `json:"scriptId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetScriptSourceParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScriptId == "" {
+		missing = append(missing, "scriptId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.getScriptSource", Missing: missing, Invalid: invalid}
+}
+
 type GetScriptSourceRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetScriptSourceParams
 }
 
 // Returns source for the script with given id.
 func (d *Client) GetScriptSource() *GetScriptSourceRequest {
-	return &GetScriptSourceRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetScriptSourceRequest{client: d.Client, params: &GetScriptSourceParams{set: 1}}
+}
+
+// DoGetScriptSource sends Debugger.getScriptSource with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetScriptSource(ctx context.Context, params *GetScriptSourceParams) (*GetScriptSourceResult, error) {
+	if params == nil {
+		params = new(GetScriptSourceParams)
+	}
+	return (&GetScriptSourceRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the script to get source for.
 func (r *GetScriptSourceRequest) ScriptId(v runtime.ScriptId) *GetScriptSourceRequest {
-	r.opts["scriptId"] = v
+	r.params.ScriptId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -582,86 +1446,214 @@ type GetScriptSourceResult struct {
 }
 
 func (r *GetScriptSourceRequest) Do() (*GetScriptSourceResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetScriptSourceRequest) DoContext(ctx context.Context) (*GetScriptSourceResult, error) {
 	var result GetScriptSourceResult
-	err := r.client.Call("Debugger.getScriptSource", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.getScriptSource", r.params, &result)
 	return &result, err
 }
 
+type GetScriptSourceFuture struct {
+	*rpc.Call
+	result GetScriptSourceResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetScriptSourceFuture) Get() (*GetScriptSourceResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetScriptSourceRequest) DoAsync(ctx context.Context) *GetScriptSourceFuture {
+	f := new(GetScriptSourceFuture)
+	f.Call = r.client.Go(ctx, "Debugger.getScriptSource", r.params, &f.result)
+	return f
+}
+
+// SetPauseOnExceptionsParams are the parameters of Debugger.setPauseOnExceptions.
+type SetPauseOnExceptionsParams struct {
+	// Pause on exceptions mode.
+	State SetPauseOnExceptionsState `json:"state"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetPauseOnExceptionsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.State == "" {
+		missing = append(missing, "state")
+	} else if !p.State.IsValid() {
+		invalid = append(invalid, "state")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setPauseOnExceptions", Missing: missing, Invalid: invalid}
+}
+
 type SetPauseOnExceptionsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetPauseOnExceptionsParams
 }
 
 // Defines pause on exceptions state. Can be set to stop on all exceptions, uncaught exceptions or no exceptions. Initial pause on exceptions state is <code>none</code>.
 func (d *Client) SetPauseOnExceptions() *SetPauseOnExceptionsRequest {
-	return &SetPauseOnExceptionsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetPauseOnExceptionsRequest{client: d.Client, params: &SetPauseOnExceptionsParams{set: 1}}
+}
+
+// DoSetPauseOnExceptions sends Debugger.setPauseOnExceptions with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetPauseOnExceptions(ctx context.Context, params *SetPauseOnExceptionsParams) error {
+	if params == nil {
+		params = new(SetPauseOnExceptionsParams)
+	}
+	return (&SetPauseOnExceptionsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Pause on exceptions mode.
-func (r *SetPauseOnExceptionsRequest) State(v string) *SetPauseOnExceptionsRequest {
-	r.opts["state"] = v
+func (r *SetPauseOnExceptionsRequest) State(v SetPauseOnExceptionsState) *SetPauseOnExceptionsRequest {
+	r.params.State = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetPauseOnExceptionsRequest) Do() error {
-	return r.client.Call("Debugger.setPauseOnExceptions", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetPauseOnExceptionsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.setPauseOnExceptions", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetPauseOnExceptionsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.setPauseOnExceptions", r.params, nil)
+}
+
+// EvaluateOnCallFrameParams are the parameters of Debugger.evaluateOnCallFrame.
+type EvaluateOnCallFrameParams struct {
+	// Call frame identifier to evaluate on.
+	CallFrameId CallFrameId `json:"callFrameId"`
+
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+
+	// String object group name to put result into (allows rapid releasing resulting object handles using <code>releaseObjectGroup</code>). (optional)
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	// Specifies whether command line API should be available to the evaluated expression, defaults to false. (optional)
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
+	Silent *bool `json:"silent,omitempty"`
+
+	// Whether the result is expected to be a JSON object that should be sent by value. (optional)
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+
+	// Whether preview should be generated for the result. (optional, experimental)
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	// Whether to throw an exception if side effect cannot be ruled out during evaluation. (optional, experimental)
+	ThrowOnSideEffect *bool `json:"throwOnSideEffect,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *EvaluateOnCallFrameParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.CallFrameId == "" {
+		missing = append(missing, "callFrameId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Expression == "" {
+		missing = append(missing, "expression")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.evaluateOnCallFrame", Missing: missing, Invalid: invalid}
 }
 
 type EvaluateOnCallFrameRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EvaluateOnCallFrameParams
 }
 
 // Evaluates expression on a given call frame.
 func (d *Client) EvaluateOnCallFrame() *EvaluateOnCallFrameRequest {
-	return &EvaluateOnCallFrameRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EvaluateOnCallFrameRequest{client: d.Client, params: &EvaluateOnCallFrameParams{set: 1}}
+}
+
+// DoEvaluateOnCallFrame sends Debugger.evaluateOnCallFrame with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEvaluateOnCallFrame(ctx context.Context, params *EvaluateOnCallFrameParams) (*EvaluateOnCallFrameResult, error) {
+	if params == nil {
+		params = new(EvaluateOnCallFrameParams)
+	}
+	return (&EvaluateOnCallFrameRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Call frame identifier to evaluate on.
 func (r *EvaluateOnCallFrameRequest) CallFrameId(v CallFrameId) *EvaluateOnCallFrameRequest {
-	r.opts["callFrameId"] = v
+	r.params.CallFrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Expression to evaluate.
 func (r *EvaluateOnCallFrameRequest) Expression(v string) *EvaluateOnCallFrameRequest {
-	r.opts["expression"] = v
+	r.params.Expression = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // String object group name to put result into (allows rapid releasing resulting object handles using <code>releaseObjectGroup</code>). (optional)
 func (r *EvaluateOnCallFrameRequest) ObjectGroup(v string) *EvaluateOnCallFrameRequest {
-	r.opts["objectGroup"] = v
+	r.params.ObjectGroup = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Specifies whether command line API should be available to the evaluated expression, defaults to false. (optional)
 func (r *EvaluateOnCallFrameRequest) IncludeCommandLineAPI(v bool) *EvaluateOnCallFrameRequest {
-	r.opts["includeCommandLineAPI"] = v
+	r.params.IncludeCommandLineAPI = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
 func (r *EvaluateOnCallFrameRequest) Silent(v bool) *EvaluateOnCallFrameRequest {
-	r.opts["silent"] = v
+	r.params.Silent = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Whether the result is expected to be a JSON object that should be sent by value. (optional)
 func (r *EvaluateOnCallFrameRequest) ReturnByValue(v bool) *EvaluateOnCallFrameRequest {
-	r.opts["returnByValue"] = v
+	r.params.ReturnByValue = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Whether preview should be generated for the result. (optional, experimental)
 func (r *EvaluateOnCallFrameRequest) GeneratePreview(v bool) *EvaluateOnCallFrameRequest {
-	r.opts["generatePreview"] = v
+	r.params.GeneratePreview = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Whether to throw an exception if side effect cannot be ruled out during evaluation. (optional, experimental)
 func (r *EvaluateOnCallFrameRequest) ThrowOnSideEffect(v bool) *EvaluateOnCallFrameRequest {
-	r.opts["throwOnSideEffect"] = v
+	r.params.ThrowOnSideEffect = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
@@ -670,116 +1662,329 @@ type EvaluateOnCallFrameResult struct {
 	Result *runtime.RemoteObject `json:"result"`
 
 	// Exception details. (optional)
-	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *runtime.ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *EvaluateOnCallFrameRequest) Do() (*EvaluateOnCallFrameResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *EvaluateOnCallFrameRequest) DoContext(ctx context.Context) (*EvaluateOnCallFrameResult, error) {
 	var result EvaluateOnCallFrameResult
-	err := r.client.Call("Debugger.evaluateOnCallFrame", r.opts, &result)
+	err := r.client.CallContext(ctx, "Debugger.evaluateOnCallFrame", r.params, &result)
 	return &result, err
 }
 
+type EvaluateOnCallFrameFuture struct {
+	*rpc.Call
+	result EvaluateOnCallFrameResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *EvaluateOnCallFrameFuture) Get() (*EvaluateOnCallFrameResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *EvaluateOnCallFrameRequest) DoAsync(ctx context.Context) *EvaluateOnCallFrameFuture {
+	f := new(EvaluateOnCallFrameFuture)
+	f.Call = r.client.Go(ctx, "Debugger.evaluateOnCallFrame", r.params, &f.result)
+	return f
+}
+
+// SetVariableValueParams are the parameters of Debugger.setVariableValue.
+type SetVariableValueParams struct {
+	// 0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch' scope types are allowed. Other scopes could be manipulated manually.
+	ScopeNumber int `json:"scopeNumber"`
+
+	// Variable name.
+	VariableName string `json:"variableName"`
+
+	// New variable value.
+	NewValue *runtime.CallArgument `json:"newValue"`
+
+	// Id of callframe that holds variable.
+	CallFrameId CallFrameId `json:"callFrameId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetVariableValueParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "scopeNumber")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.VariableName == "" {
+		missing = append(missing, "variableName")
+	}
+	if p.NewValue == nil {
+		missing = append(missing, "newValue")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 || p.set&1 == 0 && p.CallFrameId == "" {
+		missing = append(missing, "callFrameId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setVariableValue", Missing: missing, Invalid: invalid}
+}
+
 type SetVariableValueRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetVariableValueParams
 }
 
 // Changes value of variable in a callframe. Object-based scopes are not supported and must be mutated manually.
 func (d *Client) SetVariableValue() *SetVariableValueRequest {
-	return &SetVariableValueRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetVariableValueRequest{client: d.Client, params: &SetVariableValueParams{set: 1}}
+}
+
+// DoSetVariableValue sends Debugger.setVariableValue with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetVariableValue(ctx context.Context, params *SetVariableValueParams) error {
+	if params == nil {
+		params = new(SetVariableValueParams)
+	}
+	return (&SetVariableValueRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // 0-based number of scope as was listed in scope chain. Only 'local', 'closure' and 'catch' scope types are allowed. Other scopes could be manipulated manually.
 func (r *SetVariableValueRequest) ScopeNumber(v int) *SetVariableValueRequest {
-	r.opts["scopeNumber"] = v
+	r.params.ScopeNumber = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Variable name.
 func (r *SetVariableValueRequest) VariableName(v string) *SetVariableValueRequest {
-	r.opts["variableName"] = v
+	r.params.VariableName = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // New variable value.
 func (r *SetVariableValueRequest) NewValue(v *runtime.CallArgument) *SetVariableValueRequest {
-	r.opts["newValue"] = v
+	r.params.NewValue = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Id of callframe that holds variable.
 func (r *SetVariableValueRequest) CallFrameId(v CallFrameId) *SetVariableValueRequest {
-	r.opts["callFrameId"] = v
+	r.params.CallFrameId = v
+	r.params.set |= 1 << 4
 	return r
 }
 
 func (r *SetVariableValueRequest) Do() error {
-	return r.client.Call("Debugger.setVariableValue", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetVariableValueRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.setVariableValue", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetVariableValueRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.setVariableValue", r.params, nil)
+}
+
+// SetAsyncCallStackDepthParams are the parameters of Debugger.setAsyncCallStackDepth.
+type SetAsyncCallStackDepthParams struct {
+	// Maximum depth of async call stacks. Setting to <code>0</code> will effectively disable collecting async call stacks (default).
+	MaxDepth int `json:"maxDepth"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetAsyncCallStackDepthParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "maxDepth")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setAsyncCallStackDepth", Missing: missing, Invalid: invalid}
 }
 
 type SetAsyncCallStackDepthRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetAsyncCallStackDepthParams
 }
 
 // Enables or disables async call stacks tracking.
 func (d *Client) SetAsyncCallStackDepth() *SetAsyncCallStackDepthRequest {
-	return &SetAsyncCallStackDepthRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetAsyncCallStackDepthRequest{client: d.Client, params: &SetAsyncCallStackDepthParams{set: 1}}
+}
+
+// DoSetAsyncCallStackDepth sends Debugger.setAsyncCallStackDepth with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetAsyncCallStackDepth(ctx context.Context, params *SetAsyncCallStackDepthParams) error {
+	if params == nil {
+		params = new(SetAsyncCallStackDepthParams)
+	}
+	return (&SetAsyncCallStackDepthRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Maximum depth of async call stacks. Setting to <code>0</code> will effectively disable collecting async call stacks (default).
 func (r *SetAsyncCallStackDepthRequest) MaxDepth(v int) *SetAsyncCallStackDepthRequest {
-	r.opts["maxDepth"] = v
+	r.params.MaxDepth = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetAsyncCallStackDepthRequest) Do() error {
-	return r.client.Call("Debugger.setAsyncCallStackDepth", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetAsyncCallStackDepthRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.setAsyncCallStackDepth", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetAsyncCallStackDepthRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.setAsyncCallStackDepth", r.params, nil)
+}
+
+// SetBlackboxPatternsParams are the parameters of Debugger.setBlackboxPatterns.
+type SetBlackboxPatternsParams struct {
+	// Array of regexps that will be used to check script url for blackbox state.
+	Patterns []string `json:"patterns"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetBlackboxPatternsParams) Validate() error {
+	var missing, invalid []string
+	if p.Patterns == nil {
+		missing = append(missing, "patterns")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setBlackboxPatterns", Missing: missing, Invalid: invalid}
 }
 
 type SetBlackboxPatternsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetBlackboxPatternsParams
 }
 
 // Replace previous blackbox patterns with passed ones. Forces backend to skip stepping/pausing in scripts with url matching one of the patterns. VM will try to leave blackboxed script by performing 'step in' several times, finally resorting to 'step out' if unsuccessful. (experimental)
 func (d *Client) SetBlackboxPatterns() *SetBlackboxPatternsRequest {
-	return &SetBlackboxPatternsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetBlackboxPatternsRequest{client: d.Client, params: &SetBlackboxPatternsParams{set: 1}}
+}
+
+// DoSetBlackboxPatterns sends Debugger.setBlackboxPatterns with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetBlackboxPatterns(ctx context.Context, params *SetBlackboxPatternsParams) error {
+	if params == nil {
+		params = new(SetBlackboxPatternsParams)
+	}
+	return (&SetBlackboxPatternsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Array of regexps that will be used to check script url for blackbox state.
 func (r *SetBlackboxPatternsRequest) Patterns(v []string) *SetBlackboxPatternsRequest {
-	r.opts["patterns"] = v
+	r.params.Patterns = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetBlackboxPatternsRequest) Do() error {
-	return r.client.Call("Debugger.setBlackboxPatterns", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetBlackboxPatternsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.setBlackboxPatterns", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetBlackboxPatternsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.setBlackboxPatterns", r.params, nil)
+}
+
+// SetBlackboxedRangesParams are the parameters of Debugger.setBlackboxedRanges.
+type SetBlackboxedRangesParams struct {
+	// Id of the script.
+	ScriptId runtime.ScriptId `json:"scriptId"`
+
+	Positions []*ScriptPosition `json:"positions"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetBlackboxedRangesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScriptId == "" {
+		missing = append(missing, "scriptId")
+	}
+	if p.Positions == nil {
+		missing = append(missing, "positions")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Debugger.setBlackboxedRanges", Missing: missing, Invalid: invalid}
 }
 
 type SetBlackboxedRangesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetBlackboxedRangesParams
 }
 
 // Makes backend skip steps in the script in blackboxed ranges. VM will try leave blacklisted scripts by performing 'step in' several times, finally resorting to 'step out' if unsuccessful. Positions array contains positions where blackbox state is changed. First interval isn't blackboxed. Array should be sorted. (experimental)
 func (d *Client) SetBlackboxedRanges() *SetBlackboxedRangesRequest {
-	return &SetBlackboxedRangesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetBlackboxedRangesRequest{client: d.Client, params: &SetBlackboxedRangesParams{set: 1}}
+}
+
+// DoSetBlackboxedRanges sends Debugger.setBlackboxedRanges with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetBlackboxedRanges(ctx context.Context, params *SetBlackboxedRangesParams) error {
+	if params == nil {
+		params = new(SetBlackboxedRangesParams)
+	}
+	return (&SetBlackboxedRangesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the script.
 func (r *SetBlackboxedRangesRequest) ScriptId(v runtime.ScriptId) *SetBlackboxedRangesRequest {
-	r.opts["scriptId"] = v
+	r.params.ScriptId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetBlackboxedRangesRequest) Positions(v []*ScriptPosition) *SetBlackboxedRangesRequest {
-	r.opts["positions"] = v
+	r.params.Positions = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetBlackboxedRangesRequest) Do() error {
-	return r.client.Call("Debugger.setBlackboxedRanges", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetBlackboxedRangesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Debugger.setBlackboxedRanges", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetBlackboxedRangesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Debugger.setBlackboxedRanges", r.params, nil)
 }
 
 func init() {
@@ -817,25 +2022,64 @@ type ScriptParsedEvent struct {
 	Hash string `json:"hash"`
 
 	// Embedder-specific auxiliary data. (optional)
-	ExecutionContextAuxData interface{} `json:"executionContextAuxData"`
+	ExecutionContextAuxData interface{} `json:"executionContextAuxData,omitempty"`
 
 	// True, if this script is generated as a result of the live edit operation. (optional, experimental)
-	IsLiveEdit bool `json:"isLiveEdit"`
+	IsLiveEdit *bool `json:"isLiveEdit,omitempty"`
 
 	// URL of source map associated with script (if any). (optional)
-	SourceMapURL string `json:"sourceMapURL"`
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
 
 	// True, if this script has sourceURL. (optional, experimental)
-	HasSourceURL bool `json:"hasSourceURL"`
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
 
 	// True, if this script is ES6 module. (optional, experimental)
-	IsModule bool `json:"isModule"`
+	IsModule *bool `json:"isModule,omitempty"`
 
 	// This script length. (optional, experimental)
-	Length int `json:"length"`
+	Length *int `json:"length,omitempty"`
 
 	// JavaScript top stack frame of where the script parsed event was triggered if available. (optional, experimental)
-	StackTrace *runtime.StackTrace `json:"stackTrace"`
+	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
+}
+
+// Fired when virtual machine parses script. This event is also fired for all known and uncollected scripts upon enabling debugger.
+func (d *Client) OnScriptParsed(fn func(*ScriptParsedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Debugger.scriptParsed", func(e interface{}) {
+		fn(e.(*ScriptParsedEvent))
+	}, opts...)
+}
+
+// FilterScriptParsed is rpc.WithFilter for OnScriptParsed.
+func FilterScriptParsed(fn func(*ScriptParsedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ScriptParsedEvent))
+	})
+}
+
+// ScriptParsedWaiter waits for a Debugger.scriptParsed event. It is created by WaitForScriptParsed.
+type ScriptParsedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ScriptParsedWaiter) Wait() (*ScriptParsedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ScriptParsedEvent), nil
+}
+
+// WaitForScriptParsed arms a waiter for the first Debugger.scriptParsed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForScriptParsed(ctx context.Context, predicate func(*ScriptParsedEvent) bool) *ScriptParsedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ScriptParsedEvent))
+		}
+	}
+	return &ScriptParsedWaiter{d.Client.WaitFor(ctx, "Debugger.scriptParsed", p)}
 }
 
 // Fired when virtual machine fails to parse the script.
@@ -865,22 +2109,61 @@ type ScriptFailedToParseEvent struct {
 	Hash string `json:"hash"`
 
 	// Embedder-specific auxiliary data. (optional)
-	ExecutionContextAuxData interface{} `json:"executionContextAuxData"`
+	ExecutionContextAuxData interface{} `json:"executionContextAuxData,omitempty"`
 
 	// URL of source map associated with script (if any). (optional)
-	SourceMapURL string `json:"sourceMapURL"`
+	SourceMapURL *string `json:"sourceMapURL,omitempty"`
 
 	// True, if this script has sourceURL. (optional, experimental)
-	HasSourceURL bool `json:"hasSourceURL"`
+	HasSourceURL *bool `json:"hasSourceURL,omitempty"`
 
 	// True, if this script is ES6 module. (optional, experimental)
-	IsModule bool `json:"isModule"`
+	IsModule *bool `json:"isModule,omitempty"`
 
 	// This script length. (optional, experimental)
-	Length int `json:"length"`
+	Length *int `json:"length,omitempty"`
 
 	// JavaScript top stack frame of where the script parsed event was triggered if available. (optional, experimental)
-	StackTrace *runtime.StackTrace `json:"stackTrace"`
+	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
+}
+
+// Fired when virtual machine fails to parse the script.
+func (d *Client) OnScriptFailedToParse(fn func(*ScriptFailedToParseEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Debugger.scriptFailedToParse", func(e interface{}) {
+		fn(e.(*ScriptFailedToParseEvent))
+	}, opts...)
+}
+
+// FilterScriptFailedToParse is rpc.WithFilter for OnScriptFailedToParse.
+func FilterScriptFailedToParse(fn func(*ScriptFailedToParseEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ScriptFailedToParseEvent))
+	})
+}
+
+// ScriptFailedToParseWaiter waits for a Debugger.scriptFailedToParse event. It is created by WaitForScriptFailedToParse.
+type ScriptFailedToParseWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ScriptFailedToParseWaiter) Wait() (*ScriptFailedToParseEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ScriptFailedToParseEvent), nil
+}
+
+// WaitForScriptFailedToParse arms a waiter for the first Debugger.scriptFailedToParse event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForScriptFailedToParse(ctx context.Context, predicate func(*ScriptFailedToParseEvent) bool) *ScriptFailedToParseWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ScriptFailedToParseEvent))
+		}
+	}
+	return &ScriptFailedToParseWaiter{d.Client.WaitFor(ctx, "Debugger.scriptFailedToParse", p)}
 }
 
 // Fired when breakpoint is resolved to an actual script and location.
@@ -892,24 +2175,141 @@ type BreakpointResolvedEvent struct {
 	Location *Location `json:"location"`
 }
 
+// Fired when breakpoint is resolved to an actual script and location.
+func (d *Client) OnBreakpointResolved(fn func(*BreakpointResolvedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Debugger.breakpointResolved", func(e interface{}) {
+		fn(e.(*BreakpointResolvedEvent))
+	}, opts...)
+}
+
+// FilterBreakpointResolved is rpc.WithFilter for OnBreakpointResolved.
+func FilterBreakpointResolved(fn func(*BreakpointResolvedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*BreakpointResolvedEvent))
+	})
+}
+
+// BreakpointResolvedWaiter waits for a Debugger.breakpointResolved event. It is created by WaitForBreakpointResolved.
+type BreakpointResolvedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *BreakpointResolvedWaiter) Wait() (*BreakpointResolvedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*BreakpointResolvedEvent), nil
+}
+
+// WaitForBreakpointResolved arms a waiter for the first Debugger.breakpointResolved event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForBreakpointResolved(ctx context.Context, predicate func(*BreakpointResolvedEvent) bool) *BreakpointResolvedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*BreakpointResolvedEvent))
+		}
+	}
+	return &BreakpointResolvedWaiter{d.Client.WaitFor(ctx, "Debugger.breakpointResolved", p)}
+}
+
 // Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.
 type PausedEvent struct {
 	// Call stack the virtual machine stopped on.
 	CallFrames []*CallFrame `json:"callFrames"`
 
 	// Pause reason.
-	Reason string `json:"reason"`
+	Reason PausedReason `json:"reason"`
 
 	// Object containing break-specific auxiliary properties. (optional)
-	Data interface{} `json:"data"`
+	Data interface{} `json:"data,omitempty"`
 
 	// Hit breakpoints IDs (optional)
-	HitBreakpoints []string `json:"hitBreakpoints"`
+	HitBreakpoints []string `json:"hitBreakpoints,omitempty"`
 
 	// Async stack trace, if any. (optional)
-	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace"`
+	AsyncStackTrace *runtime.StackTrace `json:"asyncStackTrace,omitempty"`
+}
+
+// Fired when the virtual machine stopped on breakpoint or exception or any other stop criteria.
+func (d *Client) OnPaused(fn func(*PausedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Debugger.paused", func(e interface{}) {
+		fn(e.(*PausedEvent))
+	}, opts...)
+}
+
+// FilterPaused is rpc.WithFilter for OnPaused.
+func FilterPaused(fn func(*PausedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*PausedEvent))
+	})
+}
+
+// PausedWaiter waits for a Debugger.paused event. It is created by WaitForPaused.
+type PausedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *PausedWaiter) Wait() (*PausedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*PausedEvent), nil
+}
+
+// WaitForPaused arms a waiter for the first Debugger.paused event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForPaused(ctx context.Context, predicate func(*PausedEvent) bool) *PausedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*PausedEvent))
+		}
+	}
+	return &PausedWaiter{d.Client.WaitFor(ctx, "Debugger.paused", p)}
 }
 
 // Fired when the virtual machine resumed execution.
 type ResumedEvent struct {
 }
+
+// Fired when the virtual machine resumed execution.
+func (d *Client) OnResumed(fn func(*ResumedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Debugger.resumed", func(e interface{}) {
+		fn(e.(*ResumedEvent))
+	}, opts...)
+}
+
+// FilterResumed is rpc.WithFilter for OnResumed.
+func FilterResumed(fn func(*ResumedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ResumedEvent))
+	})
+}
+
+// ResumedWaiter waits for a Debugger.resumed event. It is created by WaitForResumed.
+type ResumedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ResumedWaiter) Wait() (*ResumedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ResumedEvent), nil
+}
+
+// WaitForResumed arms a waiter for the first Debugger.resumed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForResumed(ctx context.Context, predicate func(*ResumedEvent) bool) *ResumedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ResumedEvent))
+		}
+	}
+	return &ResumedWaiter{d.Client.WaitFor(ctx, "Debugger.resumed", p)}
+}
@@ -2,6 +2,8 @@
 package deviceorientation
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -10,50 +12,126 @@ type Client struct {
 	*rpc.Client
 }
 
+// SetDeviceOrientationOverrideParams are the parameters of DeviceOrientation.setDeviceOrientationOverride.
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+
+	// Mock beta
+	Beta float64 `json:"beta"`
+
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDeviceOrientationOverrideParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "alpha")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "beta")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "gamma")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DeviceOrientation.setDeviceOrientationOverride", Missing: missing, Invalid: invalid}
+}
+
 type SetDeviceOrientationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDeviceOrientationOverrideParams
 }
 
 // Overrides the Device Orientation.
 func (d *Client) SetDeviceOrientationOverride() *SetDeviceOrientationOverrideRequest {
-	return &SetDeviceOrientationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDeviceOrientationOverrideRequest{client: d.Client, params: &SetDeviceOrientationOverrideParams{set: 1}}
+}
+
+// DoSetDeviceOrientationOverride sends DeviceOrientation.setDeviceOrientationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDeviceOrientationOverride(ctx context.Context, params *SetDeviceOrientationOverrideParams) error {
+	if params == nil {
+		params = new(SetDeviceOrientationOverrideParams)
+	}
+	return (&SetDeviceOrientationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Mock alpha
 func (r *SetDeviceOrientationOverrideRequest) Alpha(v float64) *SetDeviceOrientationOverrideRequest {
-	r.opts["alpha"] = v
+	r.params.Alpha = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Mock beta
 func (r *SetDeviceOrientationOverrideRequest) Beta(v float64) *SetDeviceOrientationOverrideRequest {
-	r.opts["beta"] = v
+	r.params.Beta = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Mock gamma
 func (r *SetDeviceOrientationOverrideRequest) Gamma(v float64) *SetDeviceOrientationOverrideRequest {
-	r.opts["gamma"] = v
+	r.params.Gamma = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetDeviceOrientationOverrideRequest) Do() error {
-	return r.client.Call("DeviceOrientation.setDeviceOrientationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDeviceOrientationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DeviceOrientation.setDeviceOrientationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDeviceOrientationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DeviceOrientation.setDeviceOrientationOverride", r.params, nil)
+}
+
+// ClearDeviceOrientationOverrideParams are the parameters of DeviceOrientation.clearDeviceOrientationOverride.
+type ClearDeviceOrientationOverrideParams struct {
 }
 
 type ClearDeviceOrientationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearDeviceOrientationOverrideParams
 }
 
 // Clears the overridden Device Orientation.
 func (d *Client) ClearDeviceOrientationOverride() *ClearDeviceOrientationOverrideRequest {
-	return &ClearDeviceOrientationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearDeviceOrientationOverrideRequest{client: d.Client, params: &ClearDeviceOrientationOverrideParams{}}
+}
+
+// DoClearDeviceOrientationOverride sends DeviceOrientation.clearDeviceOrientationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearDeviceOrientationOverride(ctx context.Context, params *ClearDeviceOrientationOverrideParams) error {
+	if params == nil {
+		params = new(ClearDeviceOrientationOverrideParams)
+	}
+	return (&ClearDeviceOrientationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearDeviceOrientationOverrideRequest) Do() error {
-	return r.client.Call("DeviceOrientation.clearDeviceOrientationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearDeviceOrientationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DeviceOrientation.clearDeviceOrientationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearDeviceOrientationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DeviceOrientation.clearDeviceOrientationOverride", r.params, nil)
 }
 
 func init() {
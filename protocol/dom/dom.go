@@ -2,6 +2,8 @@
 package dom
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/runtime"
@@ -36,10 +38,75 @@ type BackendNode struct {
 
 type PseudoType string
 
+const (
+	PseudoTypeFirstLine                   PseudoType = "first-line"
+	PseudoTypeFirstLetter                 PseudoType = "first-letter"
+	PseudoTypeCheckmark                   PseudoType = "checkmark"
+	PseudoTypeBefore                      PseudoType = "before"
+	PseudoTypeAfter                       PseudoType = "after"
+	PseudoTypePickerIcon                  PseudoType = "picker-icon"
+	PseudoTypeMarker                      PseudoType = "marker"
+	PseudoTypeBackdrop                    PseudoType = "backdrop"
+	PseudoTypeColumn                      PseudoType = "column"
+	PseudoTypeSelection                   PseudoType = "selection"
+	PseudoTypeSearchText                  PseudoType = "search-text"
+	PseudoTypeTargetText                  PseudoType = "target-text"
+	PseudoTypeSpellingError               PseudoType = "spelling-error"
+	PseudoTypeGrammarError                PseudoType = "grammar-error"
+	PseudoTypeHighlight                   PseudoType = "highlight"
+	PseudoTypeFirstLineInherited          PseudoType = "first-line-inherited"
+	PseudoTypeScrollMarker                PseudoType = "scroll-marker"
+	PseudoTypeScrollMarkerGroup           PseudoType = "scroll-marker-group"
+	PseudoTypeScrollButton                PseudoType = "scroll-button"
+	PseudoTypeScrollbar                   PseudoType = "scrollbar"
+	PseudoTypeScrollbarThumb              PseudoType = "scrollbar-thumb"
+	PseudoTypeScrollbarButton             PseudoType = "scrollbar-button"
+	PseudoTypeScrollbarTrack              PseudoType = "scrollbar-track"
+	PseudoTypeScrollbarTrackPiece         PseudoType = "scrollbar-track-piece"
+	PseudoTypeScrollbarCorner             PseudoType = "scrollbar-corner"
+	PseudoTypeResizer                     PseudoType = "resizer"
+	PseudoTypeInputListButton             PseudoType = "input-list-button"
+	PseudoTypeViewTransition              PseudoType = "view-transition"
+	PseudoTypeViewTransitionGroup         PseudoType = "view-transition-group"
+	PseudoTypeViewTransitionImagePair     PseudoType = "view-transition-image-pair"
+	PseudoTypeViewTransitionGroupChildren PseudoType = "view-transition-group-children"
+	PseudoTypeViewTransitionOld           PseudoType = "view-transition-old"
+	PseudoTypeViewTransitionNew           PseudoType = "view-transition-new"
+	PseudoTypePlaceholder                 PseudoType = "placeholder"
+	PseudoTypeFileSelectorButton          PseudoType = "file-selector-button"
+	PseudoTypeDetailsContent              PseudoType = "details-content"
+	PseudoTypePicker                      PseudoType = "picker"
+	PseudoTypePermissionIcon              PseudoType = "permission-icon"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v PseudoType) IsValid() bool {
+	switch v {
+	case PseudoTypeFirstLine, PseudoTypeFirstLetter, PseudoTypeCheckmark, PseudoTypeBefore, PseudoTypeAfter, PseudoTypePickerIcon, PseudoTypeMarker, PseudoTypeBackdrop, PseudoTypeColumn, PseudoTypeSelection, PseudoTypeSearchText, PseudoTypeTargetText, PseudoTypeSpellingError, PseudoTypeGrammarError, PseudoTypeHighlight, PseudoTypeFirstLineInherited, PseudoTypeScrollMarker, PseudoTypeScrollMarkerGroup, PseudoTypeScrollButton, PseudoTypeScrollbar, PseudoTypeScrollbarThumb, PseudoTypeScrollbarButton, PseudoTypeScrollbarTrack, PseudoTypeScrollbarTrackPiece, PseudoTypeScrollbarCorner, PseudoTypeResizer, PseudoTypeInputListButton, PseudoTypeViewTransition, PseudoTypeViewTransitionGroup, PseudoTypeViewTransitionImagePair, PseudoTypeViewTransitionGroupChildren, PseudoTypeViewTransitionOld, PseudoTypeViewTransitionNew, PseudoTypePlaceholder, PseudoTypeFileSelectorButton, PseudoTypeDetailsContent, PseudoTypePicker, PseudoTypePermissionIcon:
+		return true
+	}
+	return false
+}
+
 // Shadow root type.
 
 type ShadowRootType string
 
+const (
+	ShadowRootTypeUserAgent ShadowRootType = "user-agent"
+	ShadowRootTypeOpen      ShadowRootType = "open"
+	ShadowRootTypeClosed    ShadowRootType = "closed"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ShadowRootType) IsValid() bool {
+	switch v {
+	case ShadowRootTypeUserAgent, ShadowRootTypeOpen, ShadowRootTypeClosed:
+		return true
+	}
+	return false
+}
+
 // DOM interaction is implemented in terms of mirror objects that represent the actual DOM nodes. DOMNode is a base node mirror type.
 
 type Node struct {
@@ -47,7 +114,7 @@ type Node struct {
 	NodeId NodeId `json:"nodeId"`
 
 	// The id of the parent node if any. (optional, experimental)
-	ParentId NodeId `json:"parentId,omitempty"`
+	ParentId *NodeId `json:"parentId,omitempty"`
 
 	// The BackendNodeId for this node.
 	BackendNodeId BackendNodeId `json:"backendNodeId"`
@@ -65,7 +132,7 @@ type Node struct {
 	NodeValue string `json:"nodeValue"`
 
 	// Child count for <code>Container</code> nodes. (optional)
-	ChildNodeCount int `json:"childNodeCount,omitempty"`
+	ChildNodeCount *int `json:"childNodeCount,omitempty"`
 
 	// Child nodes of this node when requested with children. (optional)
 	Children []*Node `json:"children,omitempty"`
@@ -74,37 +141,37 @@ type Node struct {
 	Attributes []string `json:"attributes,omitempty"`
 
 	// Document URL that <code>Document</code> or <code>FrameOwner</code> node points to. (optional)
-	DocumentURL string `json:"documentURL,omitempty"`
+	DocumentURL *string `json:"documentURL,omitempty"`
 
 	// Base URL that <code>Document</code> or <code>FrameOwner</code> node uses for URL completion. (optional, experimental)
-	BaseURL string `json:"baseURL,omitempty"`
+	BaseURL *string `json:"baseURL,omitempty"`
 
 	// <code>DocumentType</code>'s publicId. (optional)
-	PublicId string `json:"publicId,omitempty"`
+	PublicId *string `json:"publicId,omitempty"`
 
 	// <code>DocumentType</code>'s systemId. (optional)
-	SystemId string `json:"systemId,omitempty"`
+	SystemId *string `json:"systemId,omitempty"`
 
 	// <code>DocumentType</code>'s internalSubset. (optional)
-	InternalSubset string `json:"internalSubset,omitempty"`
+	InternalSubset *string `json:"internalSubset,omitempty"`
 
 	// <code>Document</code>'s XML version in case of XML documents. (optional)
-	XmlVersion string `json:"xmlVersion,omitempty"`
+	XmlVersion *string `json:"xmlVersion,omitempty"`
 
 	// <code>Attr</code>'s name. (optional)
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	// <code>Attr</code>'s value. (optional)
-	Value string `json:"value,omitempty"`
+	Value *string `json:"value,omitempty"`
 
 	// Pseudo element type for this node. (optional)
-	PseudoType PseudoType `json:"pseudoType,omitempty"`
+	PseudoType *PseudoType `json:"pseudoType,omitempty"`
 
 	// Shadow root type. (optional)
-	ShadowRootType ShadowRootType `json:"shadowRootType,omitempty"`
+	ShadowRootType *ShadowRootType `json:"shadowRootType,omitempty"`
 
 	// Frame ID for frame owner elements. (optional, experimental)
-	FrameId string `json:"frameId,omitempty"`
+	FrameId *string `json:"frameId,omitempty"`
 
 	// Content document for frame owner elements. (optional)
 	ContentDocument *Node `json:"contentDocument,omitempty"`
@@ -125,7 +192,7 @@ type Node struct {
 	DistributedNodes []*BackendNode `json:"distributedNodes,omitempty"`
 
 	// Whether the node is SVG. (optional, experimental)
-	IsSVG bool `json:"isSVG,omitempty"`
+	IsSVG *bool `json:"isSVG,omitempty"`
 }
 
 // A structure holding an RGBA color.
@@ -141,7 +208,7 @@ type RGBA struct {
 	B int `json:"b"`
 
 	// The alpha component, in the [0-1] range (default: 1). (optional)
-	A float64 `json:"a,omitempty"`
+	A *float64 `json:"a,omitempty"`
 }
 
 // An array of quad vertices, x immediately followed by y for each point, points clock-wise. (experimental)
@@ -202,53 +269,130 @@ type Rect struct {
 	Height float64 `json:"height"`
 }
 
+// EnableParams are the parameters of DOM.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables DOM agent for the given page.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends DOM.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("DOM.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "DOM", r.params)
+}
+
+// DisableParams are the parameters of DOM.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables DOM agent for the given page.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends DOM.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("DOM.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "DOM", r.params)
+}
+
+// GetDocumentParams are the parameters of DOM.getDocument.
+type GetDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
+	Depth *int `json:"depth,omitempty"`
+
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false). (optional, experimental)
+	Pierce *bool `json:"pierce,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetDocumentParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getDocument", Missing: missing, Invalid: invalid}
 }
 
 type GetDocumentRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetDocumentParams
 }
 
 // Returns the root DOM node (and optionally the subtree) to the caller.
 func (d *Client) GetDocument() *GetDocumentRequest {
-	return &GetDocumentRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetDocumentRequest{client: d.Client, params: &GetDocumentParams{set: 1}}
+}
+
+// DoGetDocument sends DOM.getDocument with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetDocument(ctx context.Context, params *GetDocumentParams) (*GetDocumentResult, error) {
+	if params == nil {
+		params = new(GetDocumentParams)
+	}
+	return (&GetDocumentRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
 func (r *GetDocumentRequest) Depth(v int) *GetDocumentRequest {
-	r.opts["depth"] = v
+	r.params.Depth = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false). (optional, experimental)
 func (r *GetDocumentRequest) Pierce(v bool) *GetDocumentRequest {
-	r.opts["pierce"] = v
+	r.params.Pierce = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -258,30 +402,85 @@ type GetDocumentResult struct {
 }
 
 func (r *GetDocumentRequest) Do() (*GetDocumentResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetDocumentRequest) DoContext(ctx context.Context) (*GetDocumentResult, error) {
 	var result GetDocumentResult
-	err := r.client.Call("DOM.getDocument", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getDocument", r.params, &result)
 	return &result, err
 }
 
+type GetDocumentFuture struct {
+	*rpc.Call
+	result GetDocumentResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetDocumentFuture) Get() (*GetDocumentResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetDocumentRequest) DoAsync(ctx context.Context) *GetDocumentFuture {
+	f := new(GetDocumentFuture)
+	f.Call = r.client.Go(ctx, "DOM.getDocument", r.params, &f.result)
+	return f
+}
+
+// GetFlattenedDocumentParams are the parameters of DOM.getFlattenedDocument.
+type GetFlattenedDocumentParams struct {
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
+	Depth *int `json:"depth,omitempty"`
+
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false). (optional, experimental)
+	Pierce *bool `json:"pierce,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetFlattenedDocumentParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getFlattenedDocument", Missing: missing, Invalid: invalid}
+}
+
 type GetFlattenedDocumentRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetFlattenedDocumentParams
 }
 
 // Returns the root DOM node (and optionally the subtree) to the caller.
 func (d *Client) GetFlattenedDocument() *GetFlattenedDocumentRequest {
-	return &GetFlattenedDocumentRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetFlattenedDocumentRequest{client: d.Client, params: &GetFlattenedDocumentParams{set: 1}}
+}
+
+// DoGetFlattenedDocument sends DOM.getFlattenedDocument with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetFlattenedDocument(ctx context.Context, params *GetFlattenedDocumentParams) (*GetFlattenedDocumentResult, error) {
+	if params == nil {
+		params = new(GetFlattenedDocumentParams)
+	}
+	return (&GetFlattenedDocumentRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
 func (r *GetFlattenedDocumentRequest) Depth(v int) *GetFlattenedDocumentRequest {
-	r.opts["depth"] = v
+	r.params.Depth = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false). (optional, experimental)
 func (r *GetFlattenedDocumentRequest) Pierce(v bool) *GetFlattenedDocumentRequest {
-	r.opts["pierce"] = v
+	r.params.Pierce = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -291,24 +490,78 @@ type GetFlattenedDocumentResult struct {
 }
 
 func (r *GetFlattenedDocumentRequest) Do() (*GetFlattenedDocumentResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetFlattenedDocumentRequest) DoContext(ctx context.Context) (*GetFlattenedDocumentResult, error) {
 	var result GetFlattenedDocumentResult
-	err := r.client.Call("DOM.getFlattenedDocument", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getFlattenedDocument", r.params, &result)
 	return &result, err
 }
 
+type GetFlattenedDocumentFuture struct {
+	*rpc.Call
+	result GetFlattenedDocumentResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetFlattenedDocumentFuture) Get() (*GetFlattenedDocumentResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetFlattenedDocumentRequest) DoAsync(ctx context.Context) *GetFlattenedDocumentFuture {
+	f := new(GetFlattenedDocumentFuture)
+	f.Call = r.client.Go(ctx, "DOM.getFlattenedDocument", r.params, &f.result)
+	return f
+}
+
+// CollectClassNamesFromSubtreeParams are the parameters of DOM.collectClassNamesFromSubtree.
+type CollectClassNamesFromSubtreeParams struct {
+	// Id of the node to collect class names.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CollectClassNamesFromSubtreeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.collectClassNamesFromSubtree", Missing: missing, Invalid: invalid}
+}
+
 type CollectClassNamesFromSubtreeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CollectClassNamesFromSubtreeParams
 }
 
 // Collects class names for the node with given id and all of it's child nodes. (experimental)
 func (d *Client) CollectClassNamesFromSubtree() *CollectClassNamesFromSubtreeRequest {
-	return &CollectClassNamesFromSubtreeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CollectClassNamesFromSubtreeRequest{client: d.Client, params: &CollectClassNamesFromSubtreeParams{set: 1}}
+}
+
+// DoCollectClassNamesFromSubtree sends DOM.collectClassNamesFromSubtree with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCollectClassNamesFromSubtree(ctx context.Context, params *CollectClassNamesFromSubtreeParams) (*CollectClassNamesFromSubtreeResult, error) {
+	if params == nil {
+		params = new(CollectClassNamesFromSubtreeParams)
+	}
+	return (&CollectClassNamesFromSubtreeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to collect class names.
 func (r *CollectClassNamesFromSubtreeRequest) NodeId(v NodeId) *CollectClassNamesFromSubtreeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -318,62 +571,172 @@ type CollectClassNamesFromSubtreeResult struct {
 }
 
 func (r *CollectClassNamesFromSubtreeRequest) Do() (*CollectClassNamesFromSubtreeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CollectClassNamesFromSubtreeRequest) DoContext(ctx context.Context) (*CollectClassNamesFromSubtreeResult, error) {
 	var result CollectClassNamesFromSubtreeResult
-	err := r.client.Call("DOM.collectClassNamesFromSubtree", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.collectClassNamesFromSubtree", r.params, &result)
 	return &result, err
 }
 
+type CollectClassNamesFromSubtreeFuture struct {
+	*rpc.Call
+	result CollectClassNamesFromSubtreeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CollectClassNamesFromSubtreeFuture) Get() (*CollectClassNamesFromSubtreeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CollectClassNamesFromSubtreeRequest) DoAsync(ctx context.Context) *CollectClassNamesFromSubtreeFuture {
+	f := new(CollectClassNamesFromSubtreeFuture)
+	f.Call = r.client.Go(ctx, "DOM.collectClassNamesFromSubtree", r.params, &f.result)
+	return f
+}
+
+// RequestChildNodesParams are the parameters of DOM.requestChildNodes.
+type RequestChildNodesParams struct {
+	// Id of the node to get children for.
+	NodeId NodeId `json:"nodeId"`
+
+	// The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
+	Depth *int `json:"depth,omitempty"`
+
+	// Whether or not iframes and shadow roots should be traversed when returning the sub-tree (default is false). (optional, experimental)
+	Pierce *bool `json:"pierce,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RequestChildNodesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.requestChildNodes", Missing: missing, Invalid: invalid}
+}
+
 type RequestChildNodesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestChildNodesParams
 }
 
 // Requests that children of the node with given id are returned to the caller in form of <code>setChildNodes</code> events where not only immediate children are retrieved, but all children down to the specified depth.
 func (d *Client) RequestChildNodes() *RequestChildNodesRequest {
-	return &RequestChildNodesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestChildNodesRequest{client: d.Client, params: &RequestChildNodesParams{set: 1}}
+}
+
+// DoRequestChildNodes sends DOM.requestChildNodes with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestChildNodes(ctx context.Context, params *RequestChildNodesParams) error {
+	if params == nil {
+		params = new(RequestChildNodesParams)
+	}
+	return (&RequestChildNodesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to get children for.
 func (r *RequestChildNodesRequest) NodeId(v NodeId) *RequestChildNodesRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The maximum depth at which children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
 func (r *RequestChildNodesRequest) Depth(v int) *RequestChildNodesRequest {
-	r.opts["depth"] = v
+	r.params.Depth = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Whether or not iframes and shadow roots should be traversed when returning the sub-tree (default is false). (optional, experimental)
 func (r *RequestChildNodesRequest) Pierce(v bool) *RequestChildNodesRequest {
-	r.opts["pierce"] = v
+	r.params.Pierce = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *RequestChildNodesRequest) Do() error {
-	return r.client.Call("DOM.requestChildNodes", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestChildNodesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.requestChildNodes", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RequestChildNodesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.requestChildNodes", r.params, nil)
+}
+
+// QuerySelectorParams are the parameters of DOM.querySelector.
+type QuerySelectorParams struct {
+	// Id of the node to query upon.
+	NodeId NodeId `json:"nodeId"`
+
+	// Selector string.
+	Selector string `json:"selector"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *QuerySelectorParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Selector == "" {
+		missing = append(missing, "selector")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.querySelector", Missing: missing, Invalid: invalid}
 }
 
 type QuerySelectorRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *QuerySelectorParams
 }
 
 // Executes <code>querySelector</code> on a given node.
 func (d *Client) QuerySelector() *QuerySelectorRequest {
-	return &QuerySelectorRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &QuerySelectorRequest{client: d.Client, params: &QuerySelectorParams{set: 1}}
+}
+
+// DoQuerySelector sends DOM.querySelector with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoQuerySelector(ctx context.Context, params *QuerySelectorParams) (*QuerySelectorResult, error) {
+	if params == nil {
+		params = new(QuerySelectorParams)
+	}
+	return (&QuerySelectorRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to query upon.
 func (r *QuerySelectorRequest) NodeId(v NodeId) *QuerySelectorRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Selector string.
 func (r *QuerySelectorRequest) Selector(v string) *QuerySelectorRequest {
-	r.opts["selector"] = v
+	r.params.Selector = v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -383,30 +746,91 @@ type QuerySelectorResult struct {
 }
 
 func (r *QuerySelectorRequest) Do() (*QuerySelectorResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *QuerySelectorRequest) DoContext(ctx context.Context) (*QuerySelectorResult, error) {
 	var result QuerySelectorResult
-	err := r.client.Call("DOM.querySelector", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.querySelector", r.params, &result)
 	return &result, err
 }
 
+type QuerySelectorFuture struct {
+	*rpc.Call
+	result QuerySelectorResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *QuerySelectorFuture) Get() (*QuerySelectorResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *QuerySelectorRequest) DoAsync(ctx context.Context) *QuerySelectorFuture {
+	f := new(QuerySelectorFuture)
+	f.Call = r.client.Go(ctx, "DOM.querySelector", r.params, &f.result)
+	return f
+}
+
+// QuerySelectorAllParams are the parameters of DOM.querySelectorAll.
+type QuerySelectorAllParams struct {
+	// Id of the node to query upon.
+	NodeId NodeId `json:"nodeId"`
+
+	// Selector string.
+	Selector string `json:"selector"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *QuerySelectorAllParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Selector == "" {
+		missing = append(missing, "selector")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.querySelectorAll", Missing: missing, Invalid: invalid}
+}
+
 type QuerySelectorAllRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *QuerySelectorAllParams
 }
 
 // Executes <code>querySelectorAll</code> on a given node.
 func (d *Client) QuerySelectorAll() *QuerySelectorAllRequest {
-	return &QuerySelectorAllRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &QuerySelectorAllRequest{client: d.Client, params: &QuerySelectorAllParams{set: 1}}
+}
+
+// DoQuerySelectorAll sends DOM.querySelectorAll with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoQuerySelectorAll(ctx context.Context, params *QuerySelectorAllParams) (*QuerySelectorAllResult, error) {
+	if params == nil {
+		params = new(QuerySelectorAllParams)
+	}
+	return (&QuerySelectorAllRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to query upon.
 func (r *QuerySelectorAllRequest) NodeId(v NodeId) *QuerySelectorAllRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Selector string.
 func (r *QuerySelectorAllRequest) Selector(v string) *QuerySelectorAllRequest {
-	r.opts["selector"] = v
+	r.params.Selector = v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -416,30 +840,91 @@ type QuerySelectorAllResult struct {
 }
 
 func (r *QuerySelectorAllRequest) Do() (*QuerySelectorAllResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *QuerySelectorAllRequest) DoContext(ctx context.Context) (*QuerySelectorAllResult, error) {
 	var result QuerySelectorAllResult
-	err := r.client.Call("DOM.querySelectorAll", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.querySelectorAll", r.params, &result)
 	return &result, err
 }
 
+type QuerySelectorAllFuture struct {
+	*rpc.Call
+	result QuerySelectorAllResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *QuerySelectorAllFuture) Get() (*QuerySelectorAllResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *QuerySelectorAllRequest) DoAsync(ctx context.Context) *QuerySelectorAllFuture {
+	f := new(QuerySelectorAllFuture)
+	f.Call = r.client.Go(ctx, "DOM.querySelectorAll", r.params, &f.result)
+	return f
+}
+
+// SetNodeNameParams are the parameters of DOM.setNodeName.
+type SetNodeNameParams struct {
+	// Id of the node to set name for.
+	NodeId NodeId `json:"nodeId"`
+
+	// New node's name.
+	Name string `json:"name"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetNodeNameParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Name == "" {
+		missing = append(missing, "name")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.setNodeName", Missing: missing, Invalid: invalid}
+}
+
 type SetNodeNameRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetNodeNameParams
 }
 
 // Sets node name for a node with given id.
 func (d *Client) SetNodeName() *SetNodeNameRequest {
-	return &SetNodeNameRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetNodeNameRequest{client: d.Client, params: &SetNodeNameParams{set: 1}}
+}
+
+// DoSetNodeName sends DOM.setNodeName with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetNodeName(ctx context.Context, params *SetNodeNameParams) (*SetNodeNameResult, error) {
+	if params == nil {
+		params = new(SetNodeNameParams)
+	}
+	return (&SetNodeNameRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to set name for.
 func (r *SetNodeNameRequest) NodeId(v NodeId) *SetNodeNameRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // New node's name.
 func (r *SetNodeNameRequest) Name(v string) *SetNodeNameRequest {
-	r.opts["name"] = v
+	r.params.Name = v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -449,160 +934,458 @@ type SetNodeNameResult struct {
 }
 
 func (r *SetNodeNameRequest) Do() (*SetNodeNameResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetNodeNameRequest) DoContext(ctx context.Context) (*SetNodeNameResult, error) {
 	var result SetNodeNameResult
-	err := r.client.Call("DOM.setNodeName", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.setNodeName", r.params, &result)
 	return &result, err
 }
 
+type SetNodeNameFuture struct {
+	*rpc.Call
+	result SetNodeNameResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetNodeNameFuture) Get() (*SetNodeNameResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetNodeNameRequest) DoAsync(ctx context.Context) *SetNodeNameFuture {
+	f := new(SetNodeNameFuture)
+	f.Call = r.client.Go(ctx, "DOM.setNodeName", r.params, &f.result)
+	return f
+}
+
+// SetNodeValueParams are the parameters of DOM.setNodeValue.
+type SetNodeValueParams struct {
+	// Id of the node to set value for.
+	NodeId NodeId `json:"nodeId"`
+
+	// New node's value.
+	Value string `json:"value"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetNodeValueParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Value == "" {
+		missing = append(missing, "value")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.setNodeValue", Missing: missing, Invalid: invalid}
+}
+
 type SetNodeValueRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetNodeValueParams
 }
 
 // Sets node value for a node with given id.
 func (d *Client) SetNodeValue() *SetNodeValueRequest {
-	return &SetNodeValueRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetNodeValueRequest{client: d.Client, params: &SetNodeValueParams{set: 1}}
+}
+
+// DoSetNodeValue sends DOM.setNodeValue with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetNodeValue(ctx context.Context, params *SetNodeValueParams) error {
+	if params == nil {
+		params = new(SetNodeValueParams)
+	}
+	return (&SetNodeValueRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to set value for.
 func (r *SetNodeValueRequest) NodeId(v NodeId) *SetNodeValueRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // New node's value.
 func (r *SetNodeValueRequest) Value(v string) *SetNodeValueRequest {
-	r.opts["value"] = v
+	r.params.Value = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetNodeValueRequest) Do() error {
-	return r.client.Call("DOM.setNodeValue", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetNodeValueRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.setNodeValue", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetNodeValueRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.setNodeValue", r.params, nil)
+}
+
+// RemoveNodeParams are the parameters of DOM.removeNode.
+type RemoveNodeParams struct {
+	// Id of the node to remove.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.removeNode", Missing: missing, Invalid: invalid}
 }
 
 type RemoveNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveNodeParams
 }
 
 // Removes node with given id.
 func (d *Client) RemoveNode() *RemoveNodeRequest {
-	return &RemoveNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveNodeRequest{client: d.Client, params: &RemoveNodeParams{set: 1}}
+}
+
+// DoRemoveNode sends DOM.removeNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveNode(ctx context.Context, params *RemoveNodeParams) error {
+	if params == nil {
+		params = new(RemoveNodeParams)
+	}
+	return (&RemoveNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to remove.
 func (r *RemoveNodeRequest) NodeId(v NodeId) *RemoveNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *RemoveNodeRequest) Do() error {
-	return r.client.Call("DOM.removeNode", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveNodeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.removeNode", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveNodeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.removeNode", r.params, nil)
+}
+
+// SetAttributeValueParams are the parameters of DOM.setAttributeValue.
+type SetAttributeValueParams struct {
+	// Id of the element to set attribute for.
+	NodeId NodeId `json:"nodeId"`
+
+	// Attribute name.
+	Name string `json:"name"`
+
+	// Attribute value.
+	Value string `json:"value"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetAttributeValueParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Name == "" {
+		missing = append(missing, "name")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Value == "" {
+		missing = append(missing, "value")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.setAttributeValue", Missing: missing, Invalid: invalid}
 }
 
 type SetAttributeValueRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetAttributeValueParams
 }
 
 // Sets attribute for an element with given id.
 func (d *Client) SetAttributeValue() *SetAttributeValueRequest {
-	return &SetAttributeValueRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetAttributeValueRequest{client: d.Client, params: &SetAttributeValueParams{set: 1}}
+}
+
+// DoSetAttributeValue sends DOM.setAttributeValue with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetAttributeValue(ctx context.Context, params *SetAttributeValueParams) error {
+	if params == nil {
+		params = new(SetAttributeValueParams)
+	}
+	return (&SetAttributeValueRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the element to set attribute for.
 func (r *SetAttributeValueRequest) NodeId(v NodeId) *SetAttributeValueRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Attribute name.
 func (r *SetAttributeValueRequest) Name(v string) *SetAttributeValueRequest {
-	r.opts["name"] = v
+	r.params.Name = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Attribute value.
 func (r *SetAttributeValueRequest) Value(v string) *SetAttributeValueRequest {
-	r.opts["value"] = v
+	r.params.Value = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetAttributeValueRequest) Do() error {
-	return r.client.Call("DOM.setAttributeValue", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetAttributeValueRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.setAttributeValue", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetAttributeValueRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.setAttributeValue", r.params, nil)
+}
+
+// SetAttributesAsTextParams are the parameters of DOM.setAttributesAsText.
+type SetAttributesAsTextParams struct {
+	// Id of the element to set attributes for.
+	NodeId NodeId `json:"nodeId"`
+
+	// Text with a number of attributes. Will parse this text using HTML parser.
+	Text string `json:"text"`
+
+	// Attribute name to replace with new attributes derived from text in case text parsed successfully. (optional)
+	Name *string `json:"name,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetAttributesAsTextParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Text == "" {
+		missing = append(missing, "text")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.setAttributesAsText", Missing: missing, Invalid: invalid}
 }
 
 type SetAttributesAsTextRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetAttributesAsTextParams
 }
 
 // Sets attributes on element with given id. This method is useful when user edits some existing attribute value and types in several attribute name/value pairs.
 func (d *Client) SetAttributesAsText() *SetAttributesAsTextRequest {
-	return &SetAttributesAsTextRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetAttributesAsTextRequest{client: d.Client, params: &SetAttributesAsTextParams{set: 1}}
+}
+
+// DoSetAttributesAsText sends DOM.setAttributesAsText with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetAttributesAsText(ctx context.Context, params *SetAttributesAsTextParams) error {
+	if params == nil {
+		params = new(SetAttributesAsTextParams)
+	}
+	return (&SetAttributesAsTextRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the element to set attributes for.
 func (r *SetAttributesAsTextRequest) NodeId(v NodeId) *SetAttributesAsTextRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Text with a number of attributes. Will parse this text using HTML parser.
 func (r *SetAttributesAsTextRequest) Text(v string) *SetAttributesAsTextRequest {
-	r.opts["text"] = v
+	r.params.Text = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Attribute name to replace with new attributes derived from text in case text parsed successfully. (optional)
 func (r *SetAttributesAsTextRequest) Name(v string) *SetAttributesAsTextRequest {
-	r.opts["name"] = v
+	r.params.Name = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetAttributesAsTextRequest) Do() error {
-	return r.client.Call("DOM.setAttributesAsText", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetAttributesAsTextRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.setAttributesAsText", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetAttributesAsTextRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.setAttributesAsText", r.params, nil)
+}
+
+// RemoveAttributeParams are the parameters of DOM.removeAttribute.
+type RemoveAttributeParams struct {
+	// Id of the element to remove attribute from.
+	NodeId NodeId `json:"nodeId"`
+
+	// Name of the attribute to remove.
+	Name string `json:"name"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveAttributeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Name == "" {
+		missing = append(missing, "name")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.removeAttribute", Missing: missing, Invalid: invalid}
 }
 
 type RemoveAttributeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveAttributeParams
 }
 
 // Removes attribute with given name from an element with given id.
 func (d *Client) RemoveAttribute() *RemoveAttributeRequest {
-	return &RemoveAttributeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveAttributeRequest{client: d.Client, params: &RemoveAttributeParams{set: 1}}
+}
+
+// DoRemoveAttribute sends DOM.removeAttribute with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveAttribute(ctx context.Context, params *RemoveAttributeParams) error {
+	if params == nil {
+		params = new(RemoveAttributeParams)
+	}
+	return (&RemoveAttributeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the element to remove attribute from.
 func (r *RemoveAttributeRequest) NodeId(v NodeId) *RemoveAttributeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Name of the attribute to remove.
 func (r *RemoveAttributeRequest) Name(v string) *RemoveAttributeRequest {
-	r.opts["name"] = v
+	r.params.Name = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *RemoveAttributeRequest) Do() error {
-	return r.client.Call("DOM.removeAttribute", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveAttributeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.removeAttribute", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveAttributeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.removeAttribute", r.params, nil)
+}
+
+// GetOuterHTMLParams are the parameters of DOM.getOuterHTML.
+type GetOuterHTMLParams struct {
+	// Id of the node to get markup for.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetOuterHTMLParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getOuterHTML", Missing: missing, Invalid: invalid}
 }
 
 type GetOuterHTMLRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetOuterHTMLParams
 }
 
 // Returns node's HTML markup.
 func (d *Client) GetOuterHTML() *GetOuterHTMLRequest {
-	return &GetOuterHTMLRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetOuterHTMLRequest{client: d.Client, params: &GetOuterHTMLParams{set: 1}}
+}
+
+// DoGetOuterHTML sends DOM.getOuterHTML with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetOuterHTML(ctx context.Context, params *GetOuterHTMLParams) (*GetOuterHTMLResult, error) {
+	if params == nil {
+		params = new(GetOuterHTMLParams)
+	}
+	return (&GetOuterHTMLRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to get markup for.
 func (r *GetOuterHTMLRequest) NodeId(v NodeId) *GetOuterHTMLRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -612,56 +1395,162 @@ type GetOuterHTMLResult struct {
 }
 
 func (r *GetOuterHTMLRequest) Do() (*GetOuterHTMLResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetOuterHTMLRequest) DoContext(ctx context.Context) (*GetOuterHTMLResult, error) {
 	var result GetOuterHTMLResult
-	err := r.client.Call("DOM.getOuterHTML", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getOuterHTML", r.params, &result)
 	return &result, err
 }
 
+type GetOuterHTMLFuture struct {
+	*rpc.Call
+	result GetOuterHTMLResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetOuterHTMLFuture) Get() (*GetOuterHTMLResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetOuterHTMLRequest) DoAsync(ctx context.Context) *GetOuterHTMLFuture {
+	f := new(GetOuterHTMLFuture)
+	f.Call = r.client.Go(ctx, "DOM.getOuterHTML", r.params, &f.result)
+	return f
+}
+
+// SetOuterHTMLParams are the parameters of DOM.setOuterHTML.
+type SetOuterHTMLParams struct {
+	// Id of the node to set markup for.
+	NodeId NodeId `json:"nodeId"`
+
+	// Outer HTML markup to set.
+	OuterHTML string `json:"outerHTML"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetOuterHTMLParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.OuterHTML == "" {
+		missing = append(missing, "outerHTML")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.setOuterHTML", Missing: missing, Invalid: invalid}
+}
+
 type SetOuterHTMLRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetOuterHTMLParams
 }
 
 // Sets node HTML markup, returns new node id.
 func (d *Client) SetOuterHTML() *SetOuterHTMLRequest {
-	return &SetOuterHTMLRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetOuterHTMLRequest{client: d.Client, params: &SetOuterHTMLParams{set: 1}}
+}
+
+// DoSetOuterHTML sends DOM.setOuterHTML with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetOuterHTML(ctx context.Context, params *SetOuterHTMLParams) error {
+	if params == nil {
+		params = new(SetOuterHTMLParams)
+	}
+	return (&SetOuterHTMLRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to set markup for.
 func (r *SetOuterHTMLRequest) NodeId(v NodeId) *SetOuterHTMLRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Outer HTML markup to set.
 func (r *SetOuterHTMLRequest) OuterHTML(v string) *SetOuterHTMLRequest {
-	r.opts["outerHTML"] = v
+	r.params.OuterHTML = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetOuterHTMLRequest) Do() error {
-	return r.client.Call("DOM.setOuterHTML", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetOuterHTMLRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.setOuterHTML", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetOuterHTMLRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.setOuterHTML", r.params, nil)
+}
+
+// PerformSearchParams are the parameters of DOM.performSearch.
+type PerformSearchParams struct {
+	// Plain text or query selector or XPath search query.
+	Query string `json:"query"`
+
+	// True to search in user agent shadow DOM. (optional, experimental)
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *PerformSearchParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Query == "" {
+		missing = append(missing, "query")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.performSearch", Missing: missing, Invalid: invalid}
 }
 
 type PerformSearchRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *PerformSearchParams
 }
 
 // Searches for a given string in the DOM tree. Use <code>getSearchResults</code> to access search results or <code>cancelSearch</code> to end this search session. (experimental)
 func (d *Client) PerformSearch() *PerformSearchRequest {
-	return &PerformSearchRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &PerformSearchRequest{client: d.Client, params: &PerformSearchParams{set: 1}}
+}
+
+// DoPerformSearch sends DOM.performSearch with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoPerformSearch(ctx context.Context, params *PerformSearchParams) (*PerformSearchResult, error) {
+	if params == nil {
+		params = new(PerformSearchParams)
+	}
+	return (&PerformSearchRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Plain text or query selector or XPath search query.
 func (r *PerformSearchRequest) Query(v string) *PerformSearchRequest {
-	r.opts["query"] = v
+	r.params.Query = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // True to search in user agent shadow DOM. (optional, experimental)
 func (r *PerformSearchRequest) IncludeUserAgentShadowDOM(v bool) *PerformSearchRequest {
-	r.opts["includeUserAgentShadowDOM"] = v
+	r.params.IncludeUserAgentShadowDOM = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -674,36 +1563,104 @@ type PerformSearchResult struct {
 }
 
 func (r *PerformSearchRequest) Do() (*PerformSearchResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *PerformSearchRequest) DoContext(ctx context.Context) (*PerformSearchResult, error) {
 	var result PerformSearchResult
-	err := r.client.Call("DOM.performSearch", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.performSearch", r.params, &result)
 	return &result, err
 }
 
-type GetSearchResultsRequest struct {
-	client *rpc.Client
-	opts   map[string]interface{}
+type PerformSearchFuture struct {
+	*rpc.Call
+	result PerformSearchResult
 }
 
-// Returns search results from given <code>fromIndex</code> to given <code>toIndex</code> from the sarch with the given identifier. (experimental)
-func (d *Client) GetSearchResults() *GetSearchResultsRequest {
-	return &GetSearchResultsRequest{opts: make(map[string]interface{}), client: d.Client}
+// Get waits for the command to complete and returns its result.
+func (f *PerformSearchFuture) Get() (*PerformSearchResult, error) {
+	err := f.Wait()
+	return &f.result, err
 }
 
-// Unique search session identifier.
-func (r *GetSearchResultsRequest) SearchId(v string) *GetSearchResultsRequest {
-	r.opts["searchId"] = v
-	return r
+// DoAsync sends the command without waiting for its result.
+func (r *PerformSearchRequest) DoAsync(ctx context.Context) *PerformSearchFuture {
+	f := new(PerformSearchFuture)
+	f.Call = r.client.Go(ctx, "DOM.performSearch", r.params, &f.result)
+	return f
+}
+
+// GetSearchResultsParams are the parameters of DOM.getSearchResults.
+type GetSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchId string `json:"searchId"`
+
+	// Start index of the search result to be returned.
+	FromIndex int `json:"fromIndex"`
+
+	// End index of the search result to be returned.
+	ToIndex int `json:"toIndex"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetSearchResultsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SearchId == "" {
+		missing = append(missing, "searchId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "fromIndex")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "toIndex")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getSearchResults", Missing: missing, Invalid: invalid}
+}
+
+type GetSearchResultsRequest struct {
+	client *rpc.Client
+	params *GetSearchResultsParams
+}
+
+// Returns search results from given <code>fromIndex</code> to given <code>toIndex</code> from the sarch with the given identifier. (experimental)
+func (d *Client) GetSearchResults() *GetSearchResultsRequest {
+	return &GetSearchResultsRequest{client: d.Client, params: &GetSearchResultsParams{set: 1}}
+}
+
+// DoGetSearchResults sends DOM.getSearchResults with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetSearchResults(ctx context.Context, params *GetSearchResultsParams) (*GetSearchResultsResult, error) {
+	if params == nil {
+		params = new(GetSearchResultsParams)
+	}
+	return (&GetSearchResultsRequest{client: d.Client, params: params}).DoContext(ctx)
+}
+
+// Unique search session identifier.
+func (r *GetSearchResultsRequest) SearchId(v string) *GetSearchResultsRequest {
+	r.params.SearchId = v
+	r.params.set |= 1 << 1
+	return r
 }
 
 // Start index of the search result to be returned.
 func (r *GetSearchResultsRequest) FromIndex(v int) *GetSearchResultsRequest {
-	r.opts["fromIndex"] = v
+	r.params.FromIndex = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // End index of the search result to be returned.
 func (r *GetSearchResultsRequest) ToIndex(v int) *GetSearchResultsRequest {
-	r.opts["toIndex"] = v
+	r.params.ToIndex = v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -713,44 +1670,139 @@ type GetSearchResultsResult struct {
 }
 
 func (r *GetSearchResultsRequest) Do() (*GetSearchResultsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetSearchResultsRequest) DoContext(ctx context.Context) (*GetSearchResultsResult, error) {
 	var result GetSearchResultsResult
-	err := r.client.Call("DOM.getSearchResults", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getSearchResults", r.params, &result)
 	return &result, err
 }
 
+type GetSearchResultsFuture struct {
+	*rpc.Call
+	result GetSearchResultsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetSearchResultsFuture) Get() (*GetSearchResultsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetSearchResultsRequest) DoAsync(ctx context.Context) *GetSearchResultsFuture {
+	f := new(GetSearchResultsFuture)
+	f.Call = r.client.Go(ctx, "DOM.getSearchResults", r.params, &f.result)
+	return f
+}
+
+// DiscardSearchResultsParams are the parameters of DOM.discardSearchResults.
+type DiscardSearchResultsParams struct {
+	// Unique search session identifier.
+	SearchId string `json:"searchId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DiscardSearchResultsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SearchId == "" {
+		missing = append(missing, "searchId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.discardSearchResults", Missing: missing, Invalid: invalid}
+}
+
 type DiscardSearchResultsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DiscardSearchResultsParams
 }
 
 // Discards search results from the session with the given id. <code>getSearchResults</code> should no longer be called for that search. (experimental)
 func (d *Client) DiscardSearchResults() *DiscardSearchResultsRequest {
-	return &DiscardSearchResultsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DiscardSearchResultsRequest{client: d.Client, params: &DiscardSearchResultsParams{set: 1}}
+}
+
+// DoDiscardSearchResults sends DOM.discardSearchResults with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDiscardSearchResults(ctx context.Context, params *DiscardSearchResultsParams) error {
+	if params == nil {
+		params = new(DiscardSearchResultsParams)
+	}
+	return (&DiscardSearchResultsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Unique search session identifier.
 func (r *DiscardSearchResultsRequest) SearchId(v string) *DiscardSearchResultsRequest {
-	r.opts["searchId"] = v
+	r.params.SearchId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *DiscardSearchResultsRequest) Do() error {
-	return r.client.Call("DOM.discardSearchResults", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DiscardSearchResultsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.discardSearchResults", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DiscardSearchResultsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.discardSearchResults", r.params, nil)
+}
+
+// RequestNodeParams are the parameters of DOM.requestNode.
+type RequestNodeParams struct {
+	// JavaScript object id to convert into node.
+	ObjectId runtime.RemoteObjectId `json:"objectId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RequestNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectId == "" {
+		missing = append(missing, "objectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.requestNode", Missing: missing, Invalid: invalid}
 }
 
 type RequestNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestNodeParams
 }
 
 // Requests that the node is sent to the caller given the JavaScript node object reference. All nodes that form the path from the node to the root are also sent to the client as a series of <code>setChildNodes</code> notifications.
 func (d *Client) RequestNode() *RequestNodeRequest {
-	return &RequestNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestNodeRequest{client: d.Client, params: &RequestNodeParams{set: 1}}
+}
+
+// DoRequestNode sends DOM.requestNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestNode(ctx context.Context, params *RequestNodeParams) (*RequestNodeResult, error) {
+	if params == nil {
+		params = new(RequestNodeParams)
+	}
+	return (&RequestNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // JavaScript object id to convert into node.
 func (r *RequestNodeRequest) ObjectId(v runtime.RemoteObjectId) *RequestNodeRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -760,66 +1812,183 @@ type RequestNodeResult struct {
 }
 
 func (r *RequestNodeRequest) Do() (*RequestNodeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestNodeRequest) DoContext(ctx context.Context) (*RequestNodeResult, error) {
 	var result RequestNodeResult
-	err := r.client.Call("DOM.requestNode", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.requestNode", r.params, &result)
 	return &result, err
 }
 
+type RequestNodeFuture struct {
+	*rpc.Call
+	result RequestNodeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RequestNodeFuture) Get() (*RequestNodeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RequestNodeRequest) DoAsync(ctx context.Context) *RequestNodeFuture {
+	f := new(RequestNodeFuture)
+	f.Call = r.client.Go(ctx, "DOM.requestNode", r.params, &f.result)
+	return f
+}
+
+// HighlightRectParams are the parameters of DOM.highlightRect.
+type HighlightRectParams struct {
+}
+
 type HighlightRectRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HighlightRectParams
 }
 
 // Highlights given rectangle.
 func (d *Client) HighlightRect() *HighlightRectRequest {
-	return &HighlightRectRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HighlightRectRequest{client: d.Client, params: &HighlightRectParams{}}
+}
+
+// DoHighlightRect sends DOM.highlightRect with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHighlightRect(ctx context.Context, params *HighlightRectParams) error {
+	if params == nil {
+		params = new(HighlightRectParams)
+	}
+	return (&HighlightRectRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *HighlightRectRequest) Do() error {
-	return r.client.Call("DOM.highlightRect", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HighlightRectRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.highlightRect", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HighlightRectRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.highlightRect", r.params, nil)
+}
+
+// HighlightNodeParams are the parameters of DOM.highlightNode.
+type HighlightNodeParams struct {
 }
 
 type HighlightNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HighlightNodeParams
 }
 
 // Highlights DOM node.
 func (d *Client) HighlightNode() *HighlightNodeRequest {
-	return &HighlightNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HighlightNodeRequest{client: d.Client, params: &HighlightNodeParams{}}
+}
+
+// DoHighlightNode sends DOM.highlightNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHighlightNode(ctx context.Context, params *HighlightNodeParams) error {
+	if params == nil {
+		params = new(HighlightNodeParams)
+	}
+	return (&HighlightNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *HighlightNodeRequest) Do() error {
-	return r.client.Call("DOM.highlightNode", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HighlightNodeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.highlightNode", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HighlightNodeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.highlightNode", r.params, nil)
+}
+
+// HideHighlightParams are the parameters of DOM.hideHighlight.
+type HideHighlightParams struct {
 }
 
 type HideHighlightRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HideHighlightParams
 }
 
 // Hides any highlight.
 func (d *Client) HideHighlight() *HideHighlightRequest {
-	return &HideHighlightRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HideHighlightRequest{client: d.Client, params: &HideHighlightParams{}}
+}
+
+// DoHideHighlight sends DOM.hideHighlight with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHideHighlight(ctx context.Context, params *HideHighlightParams) error {
+	if params == nil {
+		params = new(HideHighlightParams)
+	}
+	return (&HideHighlightRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *HideHighlightRequest) Do() error {
-	return r.client.Call("DOM.hideHighlight", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HideHighlightRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.hideHighlight", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HideHighlightRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.hideHighlight", r.params, nil)
+}
+
+// PushNodeByPathToFrontendParams are the parameters of DOM.pushNodeByPathToFrontend.
+type PushNodeByPathToFrontendParams struct {
+	// Path to node in the proprietary format.
+	Path string `json:"path"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *PushNodeByPathToFrontendParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Path == "" {
+		missing = append(missing, "path")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.pushNodeByPathToFrontend", Missing: missing, Invalid: invalid}
 }
 
 type PushNodeByPathToFrontendRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *PushNodeByPathToFrontendParams
 }
 
 // Requests that the node is sent to the caller given its path. // FIXME, use XPath (experimental)
 func (d *Client) PushNodeByPathToFrontend() *PushNodeByPathToFrontendRequest {
-	return &PushNodeByPathToFrontendRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &PushNodeByPathToFrontendRequest{client: d.Client, params: &PushNodeByPathToFrontendParams{set: 1}}
+}
+
+// DoPushNodeByPathToFrontend sends DOM.pushNodeByPathToFrontend with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoPushNodeByPathToFrontend(ctx context.Context, params *PushNodeByPathToFrontendParams) (*PushNodeByPathToFrontendResult, error) {
+	if params == nil {
+		params = new(PushNodeByPathToFrontendParams)
+	}
+	return (&PushNodeByPathToFrontendRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Path to node in the proprietary format.
 func (r *PushNodeByPathToFrontendRequest) Path(v string) *PushNodeByPathToFrontendRequest {
-	r.opts["path"] = v
+	r.params.Path = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -829,24 +1998,78 @@ type PushNodeByPathToFrontendResult struct {
 }
 
 func (r *PushNodeByPathToFrontendRequest) Do() (*PushNodeByPathToFrontendResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *PushNodeByPathToFrontendRequest) DoContext(ctx context.Context) (*PushNodeByPathToFrontendResult, error) {
 	var result PushNodeByPathToFrontendResult
-	err := r.client.Call("DOM.pushNodeByPathToFrontend", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.pushNodeByPathToFrontend", r.params, &result)
 	return &result, err
 }
 
+type PushNodeByPathToFrontendFuture struct {
+	*rpc.Call
+	result PushNodeByPathToFrontendResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *PushNodeByPathToFrontendFuture) Get() (*PushNodeByPathToFrontendResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *PushNodeByPathToFrontendRequest) DoAsync(ctx context.Context) *PushNodeByPathToFrontendFuture {
+	f := new(PushNodeByPathToFrontendFuture)
+	f.Call = r.client.Go(ctx, "DOM.pushNodeByPathToFrontend", r.params, &f.result)
+	return f
+}
+
+// PushNodesByBackendIdsToFrontendParams are the parameters of DOM.pushNodesByBackendIdsToFrontend.
+type PushNodesByBackendIdsToFrontendParams struct {
+	// The array of backend node ids.
+	BackendNodeIds []BackendNodeId `json:"backendNodeIds"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *PushNodesByBackendIdsToFrontendParams) Validate() error {
+	var missing, invalid []string
+	if p.BackendNodeIds == nil {
+		missing = append(missing, "backendNodeIds")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.pushNodesByBackendIdsToFrontend", Missing: missing, Invalid: invalid}
+}
+
 type PushNodesByBackendIdsToFrontendRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *PushNodesByBackendIdsToFrontendParams
 }
 
 // Requests that a batch of nodes is sent to the caller given their backend node ids. (experimental)
 func (d *Client) PushNodesByBackendIdsToFrontend() *PushNodesByBackendIdsToFrontendRequest {
-	return &PushNodesByBackendIdsToFrontendRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &PushNodesByBackendIdsToFrontendRequest{client: d.Client, params: &PushNodesByBackendIdsToFrontendParams{set: 1}}
+}
+
+// DoPushNodesByBackendIdsToFrontend sends DOM.pushNodesByBackendIdsToFrontend with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoPushNodesByBackendIdsToFrontend(ctx context.Context, params *PushNodesByBackendIdsToFrontendParams) (*PushNodesByBackendIdsToFrontendResult, error) {
+	if params == nil {
+		params = new(PushNodesByBackendIdsToFrontendParams)
+	}
+	return (&PushNodesByBackendIdsToFrontendRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The array of backend node ids.
 func (r *PushNodesByBackendIdsToFrontendRequest) BackendNodeIds(v []BackendNodeId) *PushNodesByBackendIdsToFrontendRequest {
-	r.opts["backendNodeIds"] = v
+	r.params.BackendNodeIds = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -856,50 +2079,149 @@ type PushNodesByBackendIdsToFrontendResult struct {
 }
 
 func (r *PushNodesByBackendIdsToFrontendRequest) Do() (*PushNodesByBackendIdsToFrontendResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *PushNodesByBackendIdsToFrontendRequest) DoContext(ctx context.Context) (*PushNodesByBackendIdsToFrontendResult, error) {
 	var result PushNodesByBackendIdsToFrontendResult
-	err := r.client.Call("DOM.pushNodesByBackendIdsToFrontend", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.pushNodesByBackendIdsToFrontend", r.params, &result)
 	return &result, err
 }
 
+type PushNodesByBackendIdsToFrontendFuture struct {
+	*rpc.Call
+	result PushNodesByBackendIdsToFrontendResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *PushNodesByBackendIdsToFrontendFuture) Get() (*PushNodesByBackendIdsToFrontendResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *PushNodesByBackendIdsToFrontendRequest) DoAsync(ctx context.Context) *PushNodesByBackendIdsToFrontendFuture {
+	f := new(PushNodesByBackendIdsToFrontendFuture)
+	f.Call = r.client.Go(ctx, "DOM.pushNodesByBackendIdsToFrontend", r.params, &f.result)
+	return f
+}
+
+// SetInspectedNodeParams are the parameters of DOM.setInspectedNode.
+type SetInspectedNodeParams struct {
+	// DOM node id to be accessible by means of $x command line API.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetInspectedNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.setInspectedNode", Missing: missing, Invalid: invalid}
+}
+
 type SetInspectedNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetInspectedNodeParams
 }
 
 // Enables console to refer to the node with given id via $x (see Command Line API for more details $x functions). (experimental)
 func (d *Client) SetInspectedNode() *SetInspectedNodeRequest {
-	return &SetInspectedNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetInspectedNodeRequest{client: d.Client, params: &SetInspectedNodeParams{set: 1}}
+}
+
+// DoSetInspectedNode sends DOM.setInspectedNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetInspectedNode(ctx context.Context, params *SetInspectedNodeParams) error {
+	if params == nil {
+		params = new(SetInspectedNodeParams)
+	}
+	return (&SetInspectedNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // DOM node id to be accessible by means of $x command line API.
 func (r *SetInspectedNodeRequest) NodeId(v NodeId) *SetInspectedNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetInspectedNodeRequest) Do() error {
-	return r.client.Call("DOM.setInspectedNode", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetInspectedNodeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.setInspectedNode", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetInspectedNodeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.setInspectedNode", r.params, nil)
+}
+
+// ResolveNodeParams are the parameters of DOM.resolveNode.
+type ResolveNodeParams struct {
+	// Id of the node to resolve.
+	NodeId NodeId `json:"nodeId"`
+
+	// Symbolic group name that can be used to release multiple objects. (optional)
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ResolveNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.resolveNode", Missing: missing, Invalid: invalid}
 }
 
 type ResolveNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ResolveNodeParams
 }
 
 // Resolves JavaScript node object for given node id.
 func (d *Client) ResolveNode() *ResolveNodeRequest {
-	return &ResolveNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ResolveNodeRequest{client: d.Client, params: &ResolveNodeParams{set: 1}}
+}
+
+// DoResolveNode sends DOM.resolveNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoResolveNode(ctx context.Context, params *ResolveNodeParams) (*ResolveNodeResult, error) {
+	if params == nil {
+		params = new(ResolveNodeParams)
+	}
+	return (&ResolveNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to resolve.
 func (r *ResolveNodeRequest) NodeId(v NodeId) *ResolveNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Symbolic group name that can be used to release multiple objects. (optional)
 func (r *ResolveNodeRequest) ObjectGroup(v string) *ResolveNodeRequest {
-	r.opts["objectGroup"] = v
+	r.params.ObjectGroup = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -909,24 +2231,78 @@ type ResolveNodeResult struct {
 }
 
 func (r *ResolveNodeRequest) Do() (*ResolveNodeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *ResolveNodeRequest) DoContext(ctx context.Context) (*ResolveNodeResult, error) {
 	var result ResolveNodeResult
-	err := r.client.Call("DOM.resolveNode", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.resolveNode", r.params, &result)
 	return &result, err
 }
 
+type ResolveNodeFuture struct {
+	*rpc.Call
+	result ResolveNodeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *ResolveNodeFuture) Get() (*ResolveNodeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *ResolveNodeRequest) DoAsync(ctx context.Context) *ResolveNodeFuture {
+	f := new(ResolveNodeFuture)
+	f.Call = r.client.Go(ctx, "DOM.resolveNode", r.params, &f.result)
+	return f
+}
+
+// GetAttributesParams are the parameters of DOM.getAttributes.
+type GetAttributesParams struct {
+	// Id of the node to retrieve attibutes for.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetAttributesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getAttributes", Missing: missing, Invalid: invalid}
+}
+
 type GetAttributesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetAttributesParams
 }
 
 // Returns attributes for the specified node.
 func (d *Client) GetAttributes() *GetAttributesRequest {
-	return &GetAttributesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetAttributesRequest{client: d.Client, params: &GetAttributesParams{set: 1}}
+}
+
+// DoGetAttributes sends DOM.getAttributes with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetAttributes(ctx context.Context, params *GetAttributesParams) (*GetAttributesResult, error) {
+	if params == nil {
+		params = new(GetAttributesParams)
+	}
+	return (&GetAttributesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to retrieve attibutes for.
 func (r *GetAttributesRequest) NodeId(v NodeId) *GetAttributesRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -936,36 +2312,101 @@ type GetAttributesResult struct {
 }
 
 func (r *GetAttributesRequest) Do() (*GetAttributesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetAttributesRequest) DoContext(ctx context.Context) (*GetAttributesResult, error) {
 	var result GetAttributesResult
-	err := r.client.Call("DOM.getAttributes", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getAttributes", r.params, &result)
 	return &result, err
 }
 
+type GetAttributesFuture struct {
+	*rpc.Call
+	result GetAttributesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetAttributesFuture) Get() (*GetAttributesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetAttributesRequest) DoAsync(ctx context.Context) *GetAttributesFuture {
+	f := new(GetAttributesFuture)
+	f.Call = r.client.Go(ctx, "DOM.getAttributes", r.params, &f.result)
+	return f
+}
+
+// CopyToParams are the parameters of DOM.copyTo.
+type CopyToParams struct {
+	// Id of the node to copy.
+	NodeId NodeId `json:"nodeId"`
+
+	// Id of the element to drop the copy into.
+	TargetNodeId NodeId `json:"targetNodeId"`
+
+	// Drop the copy before this node (if absent, the copy becomes the last child of <code>targetNodeId</code>). (optional)
+	InsertBeforeNodeId *NodeId `json:"insertBeforeNodeId,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CopyToParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "targetNodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.copyTo", Missing: missing, Invalid: invalid}
+}
+
 type CopyToRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CopyToParams
 }
 
 // Creates a deep copy of the specified node and places it into the target container before the given anchor. (experimental)
 func (d *Client) CopyTo() *CopyToRequest {
-	return &CopyToRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CopyToRequest{client: d.Client, params: &CopyToParams{set: 1}}
+}
+
+// DoCopyTo sends DOM.copyTo with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCopyTo(ctx context.Context, params *CopyToParams) (*CopyToResult, error) {
+	if params == nil {
+		params = new(CopyToParams)
+	}
+	return (&CopyToRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to copy.
 func (r *CopyToRequest) NodeId(v NodeId) *CopyToRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Id of the element to drop the copy into.
 func (r *CopyToRequest) TargetNodeId(v NodeId) *CopyToRequest {
-	r.opts["targetNodeId"] = v
+	r.params.TargetNodeId = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Drop the copy before this node (if absent, the copy becomes the last child of <code>targetNodeId</code>). (optional)
 func (r *CopyToRequest) InsertBeforeNodeId(v NodeId) *CopyToRequest {
-	r.opts["insertBeforeNodeId"] = v
+	r.params.InsertBeforeNodeId = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -975,36 +2416,101 @@ type CopyToResult struct {
 }
 
 func (r *CopyToRequest) Do() (*CopyToResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CopyToRequest) DoContext(ctx context.Context) (*CopyToResult, error) {
 	var result CopyToResult
-	err := r.client.Call("DOM.copyTo", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.copyTo", r.params, &result)
 	return &result, err
 }
 
+type CopyToFuture struct {
+	*rpc.Call
+	result CopyToResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CopyToFuture) Get() (*CopyToResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CopyToRequest) DoAsync(ctx context.Context) *CopyToFuture {
+	f := new(CopyToFuture)
+	f.Call = r.client.Go(ctx, "DOM.copyTo", r.params, &f.result)
+	return f
+}
+
+// MoveToParams are the parameters of DOM.moveTo.
+type MoveToParams struct {
+	// Id of the node to move.
+	NodeId NodeId `json:"nodeId"`
+
+	// Id of the element to drop the moved node into.
+	TargetNodeId NodeId `json:"targetNodeId"`
+
+	// Drop node before this one (if absent, the moved node becomes the last child of <code>targetNodeId</code>). (optional)
+	InsertBeforeNodeId *NodeId `json:"insertBeforeNodeId,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *MoveToParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "targetNodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.moveTo", Missing: missing, Invalid: invalid}
+}
+
 type MoveToRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *MoveToParams
 }
 
 // Moves node into the new container, places it before the given anchor.
 func (d *Client) MoveTo() *MoveToRequest {
-	return &MoveToRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &MoveToRequest{client: d.Client, params: &MoveToParams{set: 1}}
+}
+
+// DoMoveTo sends DOM.moveTo with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoMoveTo(ctx context.Context, params *MoveToParams) (*MoveToResult, error) {
+	if params == nil {
+		params = new(MoveToParams)
+	}
+	return (&MoveToRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to move.
 func (r *MoveToRequest) NodeId(v NodeId) *MoveToRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Id of the element to drop the moved node into.
 func (r *MoveToRequest) TargetNodeId(v NodeId) *MoveToRequest {
-	r.opts["targetNodeId"] = v
+	r.params.TargetNodeId = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Drop node before this one (if absent, the moved node becomes the last child of <code>targetNodeId</code>). (optional)
 func (r *MoveToRequest) InsertBeforeNodeId(v NodeId) *MoveToRequest {
-	r.opts["insertBeforeNodeId"] = v
+	r.params.InsertBeforeNodeId = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -1014,112 +2520,318 @@ type MoveToResult struct {
 }
 
 func (r *MoveToRequest) Do() (*MoveToResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *MoveToRequest) DoContext(ctx context.Context) (*MoveToResult, error) {
 	var result MoveToResult
-	err := r.client.Call("DOM.moveTo", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.moveTo", r.params, &result)
 	return &result, err
 }
 
+type MoveToFuture struct {
+	*rpc.Call
+	result MoveToResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *MoveToFuture) Get() (*MoveToResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *MoveToRequest) DoAsync(ctx context.Context) *MoveToFuture {
+	f := new(MoveToFuture)
+	f.Call = r.client.Go(ctx, "DOM.moveTo", r.params, &f.result)
+	return f
+}
+
+// UndoParams are the parameters of DOM.undo.
+type UndoParams struct {
+}
+
 type UndoRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *UndoParams
 }
 
 // Undoes the last performed action. (experimental)
 func (d *Client) Undo() *UndoRequest {
-	return &UndoRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &UndoRequest{client: d.Client, params: &UndoParams{}}
+}
+
+// DoUndo sends DOM.undo with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoUndo(ctx context.Context, params *UndoParams) error {
+	if params == nil {
+		params = new(UndoParams)
+	}
+	return (&UndoRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *UndoRequest) Do() error {
-	return r.client.Call("DOM.undo", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *UndoRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.undo", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *UndoRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.undo", r.params, nil)
+}
+
+// RedoParams are the parameters of DOM.redo.
+type RedoParams struct {
 }
 
 type RedoRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RedoParams
 }
 
 // Re-does the last undone action. (experimental)
 func (d *Client) Redo() *RedoRequest {
-	return &RedoRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RedoRequest{client: d.Client, params: &RedoParams{}}
+}
+
+// DoRedo sends DOM.redo with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRedo(ctx context.Context, params *RedoParams) error {
+	if params == nil {
+		params = new(RedoParams)
+	}
+	return (&RedoRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *RedoRequest) Do() error {
-	return r.client.Call("DOM.redo", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RedoRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.redo", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RedoRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.redo", r.params, nil)
+}
+
+// MarkUndoableStateParams are the parameters of DOM.markUndoableState.
+type MarkUndoableStateParams struct {
 }
 
 type MarkUndoableStateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *MarkUndoableStateParams
 }
 
 // Marks last undoable state. (experimental)
 func (d *Client) MarkUndoableState() *MarkUndoableStateRequest {
-	return &MarkUndoableStateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &MarkUndoableStateRequest{client: d.Client, params: &MarkUndoableStateParams{}}
+}
+
+// DoMarkUndoableState sends DOM.markUndoableState with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoMarkUndoableState(ctx context.Context, params *MarkUndoableStateParams) error {
+	if params == nil {
+		params = new(MarkUndoableStateParams)
+	}
+	return (&MarkUndoableStateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *MarkUndoableStateRequest) Do() error {
-	return r.client.Call("DOM.markUndoableState", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *MarkUndoableStateRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.markUndoableState", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *MarkUndoableStateRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.markUndoableState", r.params, nil)
+}
+
+// FocusParams are the parameters of DOM.focus.
+type FocusParams struct {
+	// Id of the node to focus.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *FocusParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.focus", Missing: missing, Invalid: invalid}
 }
 
 type FocusRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *FocusParams
 }
 
 // Focuses the given element. (experimental)
 func (d *Client) Focus() *FocusRequest {
-	return &FocusRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &FocusRequest{client: d.Client, params: &FocusParams{set: 1}}
+}
+
+// DoFocus sends DOM.focus with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoFocus(ctx context.Context, params *FocusParams) error {
+	if params == nil {
+		params = new(FocusParams)
+	}
+	return (&FocusRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to focus.
 func (r *FocusRequest) NodeId(v NodeId) *FocusRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *FocusRequest) Do() error {
-	return r.client.Call("DOM.focus", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *FocusRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.focus", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *FocusRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.focus", r.params, nil)
+}
+
+// SetFileInputFilesParams are the parameters of DOM.setFileInputFiles.
+type SetFileInputFilesParams struct {
+	// Id of the file input node to set files for.
+	NodeId NodeId `json:"nodeId"`
+
+	// Array of file paths to set.
+	Files []string `json:"files"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetFileInputFilesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.Files == nil {
+		missing = append(missing, "files")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.setFileInputFiles", Missing: missing, Invalid: invalid}
 }
 
 type SetFileInputFilesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetFileInputFilesParams
 }
 
 // Sets files for the given file input element. (experimental)
 func (d *Client) SetFileInputFiles() *SetFileInputFilesRequest {
-	return &SetFileInputFilesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetFileInputFilesRequest{client: d.Client, params: &SetFileInputFilesParams{set: 1}}
+}
+
+// DoSetFileInputFiles sends DOM.setFileInputFiles with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetFileInputFiles(ctx context.Context, params *SetFileInputFilesParams) error {
+	if params == nil {
+		params = new(SetFileInputFilesParams)
+	}
+	return (&SetFileInputFilesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the file input node to set files for.
 func (r *SetFileInputFilesRequest) NodeId(v NodeId) *SetFileInputFilesRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Array of file paths to set.
 func (r *SetFileInputFilesRequest) Files(v []string) *SetFileInputFilesRequest {
-	r.opts["files"] = v
+	r.params.Files = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetFileInputFilesRequest) Do() error {
-	return r.client.Call("DOM.setFileInputFiles", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetFileInputFilesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOM.setFileInputFiles", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetFileInputFilesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOM.setFileInputFiles", r.params, nil)
+}
+
+// GetBoxModelParams are the parameters of DOM.getBoxModel.
+type GetBoxModelParams struct {
+	// Id of the node to get box model for.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetBoxModelParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getBoxModel", Missing: missing, Invalid: invalid}
 }
 
 type GetBoxModelRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetBoxModelParams
 }
 
 // Returns boxes for the currently selected nodes. (experimental)
 func (d *Client) GetBoxModel() *GetBoxModelRequest {
-	return &GetBoxModelRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetBoxModelRequest{client: d.Client, params: &GetBoxModelParams{set: 1}}
+}
+
+// DoGetBoxModel sends DOM.getBoxModel with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetBoxModel(ctx context.Context, params *GetBoxModelParams) (*GetBoxModelResult, error) {
+	if params == nil {
+		params = new(GetBoxModelParams)
+	}
+	return (&GetBoxModelRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to get box model for.
 func (r *GetBoxModelRequest) NodeId(v NodeId) *GetBoxModelRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -1129,36 +2841,101 @@ type GetBoxModelResult struct {
 }
 
 func (r *GetBoxModelRequest) Do() (*GetBoxModelResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetBoxModelRequest) DoContext(ctx context.Context) (*GetBoxModelResult, error) {
 	var result GetBoxModelResult
-	err := r.client.Call("DOM.getBoxModel", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getBoxModel", r.params, &result)
 	return &result, err
 }
 
+type GetBoxModelFuture struct {
+	*rpc.Call
+	result GetBoxModelResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetBoxModelFuture) Get() (*GetBoxModelResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetBoxModelRequest) DoAsync(ctx context.Context) *GetBoxModelFuture {
+	f := new(GetBoxModelFuture)
+	f.Call = r.client.Go(ctx, "DOM.getBoxModel", r.params, &f.result)
+	return f
+}
+
+// GetNodeForLocationParams are the parameters of DOM.getNodeForLocation.
+type GetNodeForLocationParams struct {
+	// X coordinate.
+	X int `json:"x"`
+
+	// Y coordinate.
+	Y int `json:"y"`
+
+	// False to skip to the nearest non-UA shadow root ancestor (default: false). (optional)
+	IncludeUserAgentShadowDOM *bool `json:"includeUserAgentShadowDOM,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetNodeForLocationParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "y")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getNodeForLocation", Missing: missing, Invalid: invalid}
+}
+
 type GetNodeForLocationRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetNodeForLocationParams
 }
 
 // Returns node id at given location. (experimental)
 func (d *Client) GetNodeForLocation() *GetNodeForLocationRequest {
-	return &GetNodeForLocationRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetNodeForLocationRequest{client: d.Client, params: &GetNodeForLocationParams{set: 1}}
+}
+
+// DoGetNodeForLocation sends DOM.getNodeForLocation with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetNodeForLocation(ctx context.Context, params *GetNodeForLocationParams) (*GetNodeForLocationResult, error) {
+	if params == nil {
+		params = new(GetNodeForLocationParams)
+	}
+	return (&GetNodeForLocationRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // X coordinate.
 func (r *GetNodeForLocationRequest) X(v int) *GetNodeForLocationRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Y coordinate.
 func (r *GetNodeForLocationRequest) Y(v int) *GetNodeForLocationRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // False to skip to the nearest non-UA shadow root ancestor (default: false). (optional)
 func (r *GetNodeForLocationRequest) IncludeUserAgentShadowDOM(v bool) *GetNodeForLocationRequest {
-	r.opts["includeUserAgentShadowDOM"] = v
+	r.params.IncludeUserAgentShadowDOM = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -1168,24 +2945,78 @@ type GetNodeForLocationResult struct {
 }
 
 func (r *GetNodeForLocationRequest) Do() (*GetNodeForLocationResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetNodeForLocationRequest) DoContext(ctx context.Context) (*GetNodeForLocationResult, error) {
 	var result GetNodeForLocationResult
-	err := r.client.Call("DOM.getNodeForLocation", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getNodeForLocation", r.params, &result)
 	return &result, err
 }
 
+type GetNodeForLocationFuture struct {
+	*rpc.Call
+	result GetNodeForLocationResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetNodeForLocationFuture) Get() (*GetNodeForLocationResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetNodeForLocationRequest) DoAsync(ctx context.Context) *GetNodeForLocationFuture {
+	f := new(GetNodeForLocationFuture)
+	f.Call = r.client.Go(ctx, "DOM.getNodeForLocation", r.params, &f.result)
+	return f
+}
+
+// GetRelayoutBoundaryParams are the parameters of DOM.getRelayoutBoundary.
+type GetRelayoutBoundaryParams struct {
+	// Id of the node.
+	NodeId NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetRelayoutBoundaryParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOM.getRelayoutBoundary", Missing: missing, Invalid: invalid}
+}
+
 type GetRelayoutBoundaryRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetRelayoutBoundaryParams
 }
 
 // Returns the id of the nearest ancestor that is a relayout boundary. (experimental)
 func (d *Client) GetRelayoutBoundary() *GetRelayoutBoundaryRequest {
-	return &GetRelayoutBoundaryRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetRelayoutBoundaryRequest{client: d.Client, params: &GetRelayoutBoundaryParams{set: 1}}
+}
+
+// DoGetRelayoutBoundary sends DOM.getRelayoutBoundary with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetRelayoutBoundary(ctx context.Context, params *GetRelayoutBoundaryParams) (*GetRelayoutBoundaryResult, error) {
+	if params == nil {
+		params = new(GetRelayoutBoundaryParams)
+	}
+	return (&GetRelayoutBoundaryRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node.
 func (r *GetRelayoutBoundaryRequest) NodeId(v NodeId) *GetRelayoutBoundaryRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -1195,11 +3026,33 @@ type GetRelayoutBoundaryResult struct {
 }
 
 func (r *GetRelayoutBoundaryRequest) Do() (*GetRelayoutBoundaryResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetRelayoutBoundaryRequest) DoContext(ctx context.Context) (*GetRelayoutBoundaryResult, error) {
 	var result GetRelayoutBoundaryResult
-	err := r.client.Call("DOM.getRelayoutBoundary", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOM.getRelayoutBoundary", r.params, &result)
 	return &result, err
 }
 
+type GetRelayoutBoundaryFuture struct {
+	*rpc.Call
+	result GetRelayoutBoundaryResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetRelayoutBoundaryFuture) Get() (*GetRelayoutBoundaryResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetRelayoutBoundaryRequest) DoAsync(ctx context.Context) *GetRelayoutBoundaryFuture {
+	f := new(GetRelayoutBoundaryFuture)
+	f.Call = r.client.Go(ctx, "DOM.getRelayoutBoundary", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["DOM.documentUpdated"] = func() interface{} { return new(DocumentUpdatedEvent) }
 	rpc.EventTypes["DOM.setChildNodes"] = func() interface{} { return new(SetChildNodesEvent) }
@@ -1221,6 +3074,45 @@ func init() {
 type DocumentUpdatedEvent struct {
 }
 
+// Fired when <code>Document</code> has been totally updated. Node ids are no longer valid.
+func (d *Client) OnDocumentUpdated(fn func(*DocumentUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.documentUpdated", func(e interface{}) {
+		fn(e.(*DocumentUpdatedEvent))
+	}, opts...)
+}
+
+// FilterDocumentUpdated is rpc.WithFilter for OnDocumentUpdated.
+func FilterDocumentUpdated(fn func(*DocumentUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DocumentUpdatedEvent))
+	})
+}
+
+// DocumentUpdatedWaiter waits for a DOM.documentUpdated event. It is created by WaitForDocumentUpdated.
+type DocumentUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DocumentUpdatedWaiter) Wait() (*DocumentUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DocumentUpdatedEvent), nil
+}
+
+// WaitForDocumentUpdated arms a waiter for the first DOM.documentUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDocumentUpdated(ctx context.Context, predicate func(*DocumentUpdatedEvent) bool) *DocumentUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DocumentUpdatedEvent))
+		}
+	}
+	return &DocumentUpdatedWaiter{d.Client.WaitFor(ctx, "DOM.documentUpdated", p)}
+}
+
 // Fired when backend wants to provide client with the missing DOM structure. This happens upon most of the calls requesting node ids.
 type SetChildNodesEvent struct {
 	// Parent node id to populate with children.
@@ -1230,6 +3122,45 @@ type SetChildNodesEvent struct {
 	Nodes []*Node `json:"nodes"`
 }
 
+// Fired when backend wants to provide client with the missing DOM structure. This happens upon most of the calls requesting node ids.
+func (d *Client) OnSetChildNodes(fn func(*SetChildNodesEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.setChildNodes", func(e interface{}) {
+		fn(e.(*SetChildNodesEvent))
+	}, opts...)
+}
+
+// FilterSetChildNodes is rpc.WithFilter for OnSetChildNodes.
+func FilterSetChildNodes(fn func(*SetChildNodesEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*SetChildNodesEvent))
+	})
+}
+
+// SetChildNodesWaiter waits for a DOM.setChildNodes event. It is created by WaitForSetChildNodes.
+type SetChildNodesWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *SetChildNodesWaiter) Wait() (*SetChildNodesEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*SetChildNodesEvent), nil
+}
+
+// WaitForSetChildNodes arms a waiter for the first DOM.setChildNodes event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForSetChildNodes(ctx context.Context, predicate func(*SetChildNodesEvent) bool) *SetChildNodesWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*SetChildNodesEvent))
+		}
+	}
+	return &SetChildNodesWaiter{d.Client.WaitFor(ctx, "DOM.setChildNodes", p)}
+}
+
 // Fired when <code>Element</code>'s attribute is modified.
 type AttributeModifiedEvent struct {
 	// Id of the node that has changed.
@@ -1242,6 +3173,45 @@ type AttributeModifiedEvent struct {
 	Value string `json:"value"`
 }
 
+// Fired when <code>Element</code>'s attribute is modified.
+func (d *Client) OnAttributeModified(fn func(*AttributeModifiedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.attributeModified", func(e interface{}) {
+		fn(e.(*AttributeModifiedEvent))
+	}, opts...)
+}
+
+// FilterAttributeModified is rpc.WithFilter for OnAttributeModified.
+func FilterAttributeModified(fn func(*AttributeModifiedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AttributeModifiedEvent))
+	})
+}
+
+// AttributeModifiedWaiter waits for a DOM.attributeModified event. It is created by WaitForAttributeModified.
+type AttributeModifiedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AttributeModifiedWaiter) Wait() (*AttributeModifiedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AttributeModifiedEvent), nil
+}
+
+// WaitForAttributeModified arms a waiter for the first DOM.attributeModified event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAttributeModified(ctx context.Context, predicate func(*AttributeModifiedEvent) bool) *AttributeModifiedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AttributeModifiedEvent))
+		}
+	}
+	return &AttributeModifiedWaiter{d.Client.WaitFor(ctx, "DOM.attributeModified", p)}
+}
+
 // Fired when <code>Element</code>'s attribute is removed.
 type AttributeRemovedEvent struct {
 	// Id of the node that has changed.
@@ -1251,12 +3221,90 @@ type AttributeRemovedEvent struct {
 	Name string `json:"name"`
 }
 
+// Fired when <code>Element</code>'s attribute is removed.
+func (d *Client) OnAttributeRemoved(fn func(*AttributeRemovedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.attributeRemoved", func(e interface{}) {
+		fn(e.(*AttributeRemovedEvent))
+	}, opts...)
+}
+
+// FilterAttributeRemoved is rpc.WithFilter for OnAttributeRemoved.
+func FilterAttributeRemoved(fn func(*AttributeRemovedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AttributeRemovedEvent))
+	})
+}
+
+// AttributeRemovedWaiter waits for a DOM.attributeRemoved event. It is created by WaitForAttributeRemoved.
+type AttributeRemovedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AttributeRemovedWaiter) Wait() (*AttributeRemovedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AttributeRemovedEvent), nil
+}
+
+// WaitForAttributeRemoved arms a waiter for the first DOM.attributeRemoved event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAttributeRemoved(ctx context.Context, predicate func(*AttributeRemovedEvent) bool) *AttributeRemovedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AttributeRemovedEvent))
+		}
+	}
+	return &AttributeRemovedWaiter{d.Client.WaitFor(ctx, "DOM.attributeRemoved", p)}
+}
+
 // Fired when <code>Element</code>'s inline style is modified via a CSS property modification. (experimental)
 type InlineStyleInvalidatedEvent struct {
 	// Ids of the nodes for which the inline styles have been invalidated.
 	NodeIds []NodeId `json:"nodeIds"`
 }
 
+// Fired when <code>Element</code>'s inline style is modified via a CSS property modification. (experimental)
+func (d *Client) OnInlineStyleInvalidated(fn func(*InlineStyleInvalidatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.inlineStyleInvalidated", func(e interface{}) {
+		fn(e.(*InlineStyleInvalidatedEvent))
+	}, opts...)
+}
+
+// FilterInlineStyleInvalidated is rpc.WithFilter for OnInlineStyleInvalidated.
+func FilterInlineStyleInvalidated(fn func(*InlineStyleInvalidatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*InlineStyleInvalidatedEvent))
+	})
+}
+
+// InlineStyleInvalidatedWaiter waits for a DOM.inlineStyleInvalidated event. It is created by WaitForInlineStyleInvalidated.
+type InlineStyleInvalidatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *InlineStyleInvalidatedWaiter) Wait() (*InlineStyleInvalidatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*InlineStyleInvalidatedEvent), nil
+}
+
+// WaitForInlineStyleInvalidated arms a waiter for the first DOM.inlineStyleInvalidated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForInlineStyleInvalidated(ctx context.Context, predicate func(*InlineStyleInvalidatedEvent) bool) *InlineStyleInvalidatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*InlineStyleInvalidatedEvent))
+		}
+	}
+	return &InlineStyleInvalidatedWaiter{d.Client.WaitFor(ctx, "DOM.inlineStyleInvalidated", p)}
+}
+
 // Mirrors <code>DOMCharacterDataModified</code> event.
 type CharacterDataModifiedEvent struct {
 	// Id of the node that has changed.
@@ -1266,6 +3314,45 @@ type CharacterDataModifiedEvent struct {
 	CharacterData string `json:"characterData"`
 }
 
+// Mirrors <code>DOMCharacterDataModified</code> event.
+func (d *Client) OnCharacterDataModified(fn func(*CharacterDataModifiedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.characterDataModified", func(e interface{}) {
+		fn(e.(*CharacterDataModifiedEvent))
+	}, opts...)
+}
+
+// FilterCharacterDataModified is rpc.WithFilter for OnCharacterDataModified.
+func FilterCharacterDataModified(fn func(*CharacterDataModifiedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*CharacterDataModifiedEvent))
+	})
+}
+
+// CharacterDataModifiedWaiter waits for a DOM.characterDataModified event. It is created by WaitForCharacterDataModified.
+type CharacterDataModifiedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *CharacterDataModifiedWaiter) Wait() (*CharacterDataModifiedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*CharacterDataModifiedEvent), nil
+}
+
+// WaitForCharacterDataModified arms a waiter for the first DOM.characterDataModified event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForCharacterDataModified(ctx context.Context, predicate func(*CharacterDataModifiedEvent) bool) *CharacterDataModifiedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*CharacterDataModifiedEvent))
+		}
+	}
+	return &CharacterDataModifiedWaiter{d.Client.WaitFor(ctx, "DOM.characterDataModified", p)}
+}
+
 // Fired when <code>Container</code>'s child node count has changed.
 type ChildNodeCountUpdatedEvent struct {
 	// Id of the node that has changed.
@@ -1275,6 +3362,45 @@ type ChildNodeCountUpdatedEvent struct {
 	ChildNodeCount int `json:"childNodeCount"`
 }
 
+// Fired when <code>Container</code>'s child node count has changed.
+func (d *Client) OnChildNodeCountUpdated(fn func(*ChildNodeCountUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.childNodeCountUpdated", func(e interface{}) {
+		fn(e.(*ChildNodeCountUpdatedEvent))
+	}, opts...)
+}
+
+// FilterChildNodeCountUpdated is rpc.WithFilter for OnChildNodeCountUpdated.
+func FilterChildNodeCountUpdated(fn func(*ChildNodeCountUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ChildNodeCountUpdatedEvent))
+	})
+}
+
+// ChildNodeCountUpdatedWaiter waits for a DOM.childNodeCountUpdated event. It is created by WaitForChildNodeCountUpdated.
+type ChildNodeCountUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ChildNodeCountUpdatedWaiter) Wait() (*ChildNodeCountUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ChildNodeCountUpdatedEvent), nil
+}
+
+// WaitForChildNodeCountUpdated arms a waiter for the first DOM.childNodeCountUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForChildNodeCountUpdated(ctx context.Context, predicate func(*ChildNodeCountUpdatedEvent) bool) *ChildNodeCountUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ChildNodeCountUpdatedEvent))
+		}
+	}
+	return &ChildNodeCountUpdatedWaiter{d.Client.WaitFor(ctx, "DOM.childNodeCountUpdated", p)}
+}
+
 // Mirrors <code>DOMNodeInserted</code> event.
 type ChildNodeInsertedEvent struct {
 	// Id of the node that has changed.
@@ -1287,6 +3413,45 @@ type ChildNodeInsertedEvent struct {
 	Node *Node `json:"node"`
 }
 
+// Mirrors <code>DOMNodeInserted</code> event.
+func (d *Client) OnChildNodeInserted(fn func(*ChildNodeInsertedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.childNodeInserted", func(e interface{}) {
+		fn(e.(*ChildNodeInsertedEvent))
+	}, opts...)
+}
+
+// FilterChildNodeInserted is rpc.WithFilter for OnChildNodeInserted.
+func FilterChildNodeInserted(fn func(*ChildNodeInsertedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ChildNodeInsertedEvent))
+	})
+}
+
+// ChildNodeInsertedWaiter waits for a DOM.childNodeInserted event. It is created by WaitForChildNodeInserted.
+type ChildNodeInsertedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ChildNodeInsertedWaiter) Wait() (*ChildNodeInsertedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ChildNodeInsertedEvent), nil
+}
+
+// WaitForChildNodeInserted arms a waiter for the first DOM.childNodeInserted event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForChildNodeInserted(ctx context.Context, predicate func(*ChildNodeInsertedEvent) bool) *ChildNodeInsertedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ChildNodeInsertedEvent))
+		}
+	}
+	return &ChildNodeInsertedWaiter{d.Client.WaitFor(ctx, "DOM.childNodeInserted", p)}
+}
+
 // Mirrors <code>DOMNodeRemoved</code> event.
 type ChildNodeRemovedEvent struct {
 	// Parent id.
@@ -1296,6 +3461,45 @@ type ChildNodeRemovedEvent struct {
 	NodeId NodeId `json:"nodeId"`
 }
 
+// Mirrors <code>DOMNodeRemoved</code> event.
+func (d *Client) OnChildNodeRemoved(fn func(*ChildNodeRemovedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.childNodeRemoved", func(e interface{}) {
+		fn(e.(*ChildNodeRemovedEvent))
+	}, opts...)
+}
+
+// FilterChildNodeRemoved is rpc.WithFilter for OnChildNodeRemoved.
+func FilterChildNodeRemoved(fn func(*ChildNodeRemovedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ChildNodeRemovedEvent))
+	})
+}
+
+// ChildNodeRemovedWaiter waits for a DOM.childNodeRemoved event. It is created by WaitForChildNodeRemoved.
+type ChildNodeRemovedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ChildNodeRemovedWaiter) Wait() (*ChildNodeRemovedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ChildNodeRemovedEvent), nil
+}
+
+// WaitForChildNodeRemoved arms a waiter for the first DOM.childNodeRemoved event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForChildNodeRemoved(ctx context.Context, predicate func(*ChildNodeRemovedEvent) bool) *ChildNodeRemovedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ChildNodeRemovedEvent))
+		}
+	}
+	return &ChildNodeRemovedWaiter{d.Client.WaitFor(ctx, "DOM.childNodeRemoved", p)}
+}
+
 // Called when shadow root is pushed into the element. (experimental)
 type ShadowRootPushedEvent struct {
 	// Host element id.
@@ -1305,6 +3509,45 @@ type ShadowRootPushedEvent struct {
 	Root *Node `json:"root"`
 }
 
+// Called when shadow root is pushed into the element. (experimental)
+func (d *Client) OnShadowRootPushed(fn func(*ShadowRootPushedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.shadowRootPushed", func(e interface{}) {
+		fn(e.(*ShadowRootPushedEvent))
+	}, opts...)
+}
+
+// FilterShadowRootPushed is rpc.WithFilter for OnShadowRootPushed.
+func FilterShadowRootPushed(fn func(*ShadowRootPushedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ShadowRootPushedEvent))
+	})
+}
+
+// ShadowRootPushedWaiter waits for a DOM.shadowRootPushed event. It is created by WaitForShadowRootPushed.
+type ShadowRootPushedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ShadowRootPushedWaiter) Wait() (*ShadowRootPushedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ShadowRootPushedEvent), nil
+}
+
+// WaitForShadowRootPushed arms a waiter for the first DOM.shadowRootPushed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForShadowRootPushed(ctx context.Context, predicate func(*ShadowRootPushedEvent) bool) *ShadowRootPushedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ShadowRootPushedEvent))
+		}
+	}
+	return &ShadowRootPushedWaiter{d.Client.WaitFor(ctx, "DOM.shadowRootPushed", p)}
+}
+
 // Called when shadow root is popped from the element. (experimental)
 type ShadowRootPoppedEvent struct {
 	// Host element id.
@@ -1314,6 +3557,45 @@ type ShadowRootPoppedEvent struct {
 	RootId NodeId `json:"rootId"`
 }
 
+// Called when shadow root is popped from the element. (experimental)
+func (d *Client) OnShadowRootPopped(fn func(*ShadowRootPoppedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.shadowRootPopped", func(e interface{}) {
+		fn(e.(*ShadowRootPoppedEvent))
+	}, opts...)
+}
+
+// FilterShadowRootPopped is rpc.WithFilter for OnShadowRootPopped.
+func FilterShadowRootPopped(fn func(*ShadowRootPoppedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ShadowRootPoppedEvent))
+	})
+}
+
+// ShadowRootPoppedWaiter waits for a DOM.shadowRootPopped event. It is created by WaitForShadowRootPopped.
+type ShadowRootPoppedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ShadowRootPoppedWaiter) Wait() (*ShadowRootPoppedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ShadowRootPoppedEvent), nil
+}
+
+// WaitForShadowRootPopped arms a waiter for the first DOM.shadowRootPopped event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForShadowRootPopped(ctx context.Context, predicate func(*ShadowRootPoppedEvent) bool) *ShadowRootPoppedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ShadowRootPoppedEvent))
+		}
+	}
+	return &ShadowRootPoppedWaiter{d.Client.WaitFor(ctx, "DOM.shadowRootPopped", p)}
+}
+
 // Called when a pseudo element is added to an element. (experimental)
 type PseudoElementAddedEvent struct {
 	// Pseudo element's parent element id.
@@ -1323,6 +3605,45 @@ type PseudoElementAddedEvent struct {
 	PseudoElement *Node `json:"pseudoElement"`
 }
 
+// Called when a pseudo element is added to an element. (experimental)
+func (d *Client) OnPseudoElementAdded(fn func(*PseudoElementAddedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.pseudoElementAdded", func(e interface{}) {
+		fn(e.(*PseudoElementAddedEvent))
+	}, opts...)
+}
+
+// FilterPseudoElementAdded is rpc.WithFilter for OnPseudoElementAdded.
+func FilterPseudoElementAdded(fn func(*PseudoElementAddedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*PseudoElementAddedEvent))
+	})
+}
+
+// PseudoElementAddedWaiter waits for a DOM.pseudoElementAdded event. It is created by WaitForPseudoElementAdded.
+type PseudoElementAddedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *PseudoElementAddedWaiter) Wait() (*PseudoElementAddedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*PseudoElementAddedEvent), nil
+}
+
+// WaitForPseudoElementAdded arms a waiter for the first DOM.pseudoElementAdded event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForPseudoElementAdded(ctx context.Context, predicate func(*PseudoElementAddedEvent) bool) *PseudoElementAddedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*PseudoElementAddedEvent))
+		}
+	}
+	return &PseudoElementAddedWaiter{d.Client.WaitFor(ctx, "DOM.pseudoElementAdded", p)}
+}
+
 // Called when a pseudo element is removed from an element. (experimental)
 type PseudoElementRemovedEvent struct {
 	// Pseudo element's parent element id.
@@ -1332,6 +3653,45 @@ type PseudoElementRemovedEvent struct {
 	PseudoElementId NodeId `json:"pseudoElementId"`
 }
 
+// Called when a pseudo element is removed from an element. (experimental)
+func (d *Client) OnPseudoElementRemoved(fn func(*PseudoElementRemovedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.pseudoElementRemoved", func(e interface{}) {
+		fn(e.(*PseudoElementRemovedEvent))
+	}, opts...)
+}
+
+// FilterPseudoElementRemoved is rpc.WithFilter for OnPseudoElementRemoved.
+func FilterPseudoElementRemoved(fn func(*PseudoElementRemovedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*PseudoElementRemovedEvent))
+	})
+}
+
+// PseudoElementRemovedWaiter waits for a DOM.pseudoElementRemoved event. It is created by WaitForPseudoElementRemoved.
+type PseudoElementRemovedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *PseudoElementRemovedWaiter) Wait() (*PseudoElementRemovedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*PseudoElementRemovedEvent), nil
+}
+
+// WaitForPseudoElementRemoved arms a waiter for the first DOM.pseudoElementRemoved event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForPseudoElementRemoved(ctx context.Context, predicate func(*PseudoElementRemovedEvent) bool) *PseudoElementRemovedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*PseudoElementRemovedEvent))
+		}
+	}
+	return &PseudoElementRemovedWaiter{d.Client.WaitFor(ctx, "DOM.pseudoElementRemoved", p)}
+}
+
 // Called when distrubution is changed. (experimental)
 type DistributedNodesUpdatedEvent struct {
 	// Insertion point where distrubuted nodes were updated.
@@ -1340,3 +3700,42 @@ type DistributedNodesUpdatedEvent struct {
 	// Distributed nodes for given insertion point.
 	DistributedNodes []*BackendNode `json:"distributedNodes"`
 }
+
+// Called when distrubution is changed. (experimental)
+func (d *Client) OnDistributedNodesUpdated(fn func(*DistributedNodesUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOM.distributedNodesUpdated", func(e interface{}) {
+		fn(e.(*DistributedNodesUpdatedEvent))
+	}, opts...)
+}
+
+// FilterDistributedNodesUpdated is rpc.WithFilter for OnDistributedNodesUpdated.
+func FilterDistributedNodesUpdated(fn func(*DistributedNodesUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DistributedNodesUpdatedEvent))
+	})
+}
+
+// DistributedNodesUpdatedWaiter waits for a DOM.distributedNodesUpdated event. It is created by WaitForDistributedNodesUpdated.
+type DistributedNodesUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DistributedNodesUpdatedWaiter) Wait() (*DistributedNodesUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DistributedNodesUpdatedEvent), nil
+}
+
+// WaitForDistributedNodesUpdated arms a waiter for the first DOM.distributedNodesUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDistributedNodesUpdated(ctx context.Context, predicate func(*DistributedNodesUpdatedEvent) bool) *DistributedNodesUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DistributedNodesUpdatedEvent))
+		}
+	}
+	return &DistributedNodesUpdatedWaiter{d.Client.WaitFor(ctx, "DOM.distributedNodesUpdated", p)}
+}
@@ -2,6 +2,8 @@
 package domdebugger
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/dom"
@@ -17,6 +19,21 @@ type Client struct {
 
 type DOMBreakpointType string
 
+const (
+	DOMBreakpointTypeSubtreeModified   DOMBreakpointType = "subtree-modified"
+	DOMBreakpointTypeAttributeModified DOMBreakpointType = "attribute-modified"
+	DOMBreakpointTypeNodeRemoved       DOMBreakpointType = "node-removed"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v DOMBreakpointType) IsValid() bool {
+	switch v {
+	case DOMBreakpointTypeSubtreeModified, DOMBreakpointTypeAttributeModified, DOMBreakpointTypeNodeRemoved:
+		return true
+	}
+	return false
+}
+
 // Object event listener. (experimental)
 
 type EventListener struct {
@@ -48,218 +65,612 @@ type EventListener struct {
 	OriginalHandler *runtime.RemoteObject `json:"originalHandler,omitempty"`
 
 	// Node the listener is added to (if any). (optional)
-	BackendNodeId dom.BackendNodeId `json:"backendNodeId,omitempty"`
+	BackendNodeId *dom.BackendNodeId `json:"backendNodeId,omitempty"`
+}
+
+// SetDOMBreakpointParams are the parameters of DOMDebugger.setDOMBreakpoint.
+type SetDOMBreakpointParams struct {
+	// Identifier of the node to set breakpoint on.
+	NodeId dom.NodeId `json:"nodeId"`
+
+	// Type of the operation to stop upon.
+	Type DOMBreakpointType `json:"type"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDOMBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Type == "" {
+		missing = append(missing, "type")
+	} else if !p.Type.IsValid() {
+		invalid = append(invalid, "type")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.setDOMBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type SetDOMBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDOMBreakpointParams
 }
 
 // Sets breakpoint on particular operation with DOM.
 func (d *Client) SetDOMBreakpoint() *SetDOMBreakpointRequest {
-	return &SetDOMBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDOMBreakpointRequest{client: d.Client, params: &SetDOMBreakpointParams{set: 1}}
+}
+
+// DoSetDOMBreakpoint sends DOMDebugger.setDOMBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDOMBreakpoint(ctx context.Context, params *SetDOMBreakpointParams) error {
+	if params == nil {
+		params = new(SetDOMBreakpointParams)
+	}
+	return (&SetDOMBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the node to set breakpoint on.
 func (r *SetDOMBreakpointRequest) NodeId(v dom.NodeId) *SetDOMBreakpointRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Type of the operation to stop upon.
 func (r *SetDOMBreakpointRequest) Type(v DOMBreakpointType) *SetDOMBreakpointRequest {
-	r.opts["type"] = v
+	r.params.Type = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetDOMBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.setDOMBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDOMBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.setDOMBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDOMBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.setDOMBreakpoint", r.params, nil)
+}
+
+// RemoveDOMBreakpointParams are the parameters of DOMDebugger.removeDOMBreakpoint.
+type RemoveDOMBreakpointParams struct {
+	// Identifier of the node to remove breakpoint from.
+	NodeId dom.NodeId `json:"nodeId"`
+
+	// Type of the breakpoint to remove.
+	Type DOMBreakpointType `json:"type"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveDOMBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Type == "" {
+		missing = append(missing, "type")
+	} else if !p.Type.IsValid() {
+		invalid = append(invalid, "type")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.removeDOMBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type RemoveDOMBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveDOMBreakpointParams
 }
 
 // Removes DOM breakpoint that was set using <code>setDOMBreakpoint</code>.
 func (d *Client) RemoveDOMBreakpoint() *RemoveDOMBreakpointRequest {
-	return &RemoveDOMBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveDOMBreakpointRequest{client: d.Client, params: &RemoveDOMBreakpointParams{set: 1}}
+}
+
+// DoRemoveDOMBreakpoint sends DOMDebugger.removeDOMBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveDOMBreakpoint(ctx context.Context, params *RemoveDOMBreakpointParams) error {
+	if params == nil {
+		params = new(RemoveDOMBreakpointParams)
+	}
+	return (&RemoveDOMBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the node to remove breakpoint from.
 func (r *RemoveDOMBreakpointRequest) NodeId(v dom.NodeId) *RemoveDOMBreakpointRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Type of the breakpoint to remove.
 func (r *RemoveDOMBreakpointRequest) Type(v DOMBreakpointType) *RemoveDOMBreakpointRequest {
-	r.opts["type"] = v
+	r.params.Type = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *RemoveDOMBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.removeDOMBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveDOMBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.removeDOMBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveDOMBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.removeDOMBreakpoint", r.params, nil)
+}
+
+// SetEventListenerBreakpointParams are the parameters of DOMDebugger.setEventListenerBreakpoint.
+type SetEventListenerBreakpointParams struct {
+	// DOM Event name to stop on (any DOM event will do).
+	EventName string `json:"eventName"`
+
+	// EventTarget interface name to stop on. If equal to <code>"*"</code> or not provided, will stop on any EventTarget. (optional, experimental)
+	TargetName *string `json:"targetName,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetEventListenerBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.EventName == "" {
+		missing = append(missing, "eventName")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.setEventListenerBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type SetEventListenerBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetEventListenerBreakpointParams
 }
 
 // Sets breakpoint on particular DOM event.
 func (d *Client) SetEventListenerBreakpoint() *SetEventListenerBreakpointRequest {
-	return &SetEventListenerBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetEventListenerBreakpointRequest{client: d.Client, params: &SetEventListenerBreakpointParams{set: 1}}
+}
+
+// DoSetEventListenerBreakpoint sends DOMDebugger.setEventListenerBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetEventListenerBreakpoint(ctx context.Context, params *SetEventListenerBreakpointParams) error {
+	if params == nil {
+		params = new(SetEventListenerBreakpointParams)
+	}
+	return (&SetEventListenerBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // DOM Event name to stop on (any DOM event will do).
 func (r *SetEventListenerBreakpointRequest) EventName(v string) *SetEventListenerBreakpointRequest {
-	r.opts["eventName"] = v
+	r.params.EventName = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // EventTarget interface name to stop on. If equal to <code>"*"</code> or not provided, will stop on any EventTarget. (optional, experimental)
 func (r *SetEventListenerBreakpointRequest) TargetName(v string) *SetEventListenerBreakpointRequest {
-	r.opts["targetName"] = v
+	r.params.TargetName = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetEventListenerBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.setEventListenerBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetEventListenerBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.setEventListenerBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetEventListenerBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.setEventListenerBreakpoint", r.params, nil)
+}
+
+// RemoveEventListenerBreakpointParams are the parameters of DOMDebugger.removeEventListenerBreakpoint.
+type RemoveEventListenerBreakpointParams struct {
+	// Event name.
+	EventName string `json:"eventName"`
+
+	// EventTarget interface name. (optional, experimental)
+	TargetName *string `json:"targetName,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveEventListenerBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.EventName == "" {
+		missing = append(missing, "eventName")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.removeEventListenerBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type RemoveEventListenerBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveEventListenerBreakpointParams
 }
 
 // Removes breakpoint on particular DOM event.
 func (d *Client) RemoveEventListenerBreakpoint() *RemoveEventListenerBreakpointRequest {
-	return &RemoveEventListenerBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveEventListenerBreakpointRequest{client: d.Client, params: &RemoveEventListenerBreakpointParams{set: 1}}
+}
+
+// DoRemoveEventListenerBreakpoint sends DOMDebugger.removeEventListenerBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveEventListenerBreakpoint(ctx context.Context, params *RemoveEventListenerBreakpointParams) error {
+	if params == nil {
+		params = new(RemoveEventListenerBreakpointParams)
+	}
+	return (&RemoveEventListenerBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Event name.
 func (r *RemoveEventListenerBreakpointRequest) EventName(v string) *RemoveEventListenerBreakpointRequest {
-	r.opts["eventName"] = v
+	r.params.EventName = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // EventTarget interface name. (optional, experimental)
 func (r *RemoveEventListenerBreakpointRequest) TargetName(v string) *RemoveEventListenerBreakpointRequest {
-	r.opts["targetName"] = v
+	r.params.TargetName = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *RemoveEventListenerBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.removeEventListenerBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveEventListenerBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.removeEventListenerBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveEventListenerBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.removeEventListenerBreakpoint", r.params, nil)
+}
+
+// SetInstrumentationBreakpointParams are the parameters of DOMDebugger.setInstrumentationBreakpoint.
+type SetInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetInstrumentationBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.EventName == "" {
+		missing = append(missing, "eventName")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.setInstrumentationBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type SetInstrumentationBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetInstrumentationBreakpointParams
 }
 
 // Sets breakpoint on particular native event. (experimental)
 func (d *Client) SetInstrumentationBreakpoint() *SetInstrumentationBreakpointRequest {
-	return &SetInstrumentationBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetInstrumentationBreakpointRequest{client: d.Client, params: &SetInstrumentationBreakpointParams{set: 1}}
+}
+
+// DoSetInstrumentationBreakpoint sends DOMDebugger.setInstrumentationBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetInstrumentationBreakpoint(ctx context.Context, params *SetInstrumentationBreakpointParams) error {
+	if params == nil {
+		params = new(SetInstrumentationBreakpointParams)
+	}
+	return (&SetInstrumentationBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Instrumentation name to stop on.
 func (r *SetInstrumentationBreakpointRequest) EventName(v string) *SetInstrumentationBreakpointRequest {
-	r.opts["eventName"] = v
+	r.params.EventName = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetInstrumentationBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.setInstrumentationBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetInstrumentationBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.setInstrumentationBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetInstrumentationBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.setInstrumentationBreakpoint", r.params, nil)
+}
+
+// RemoveInstrumentationBreakpointParams are the parameters of DOMDebugger.removeInstrumentationBreakpoint.
+type RemoveInstrumentationBreakpointParams struct {
+	// Instrumentation name to stop on.
+	EventName string `json:"eventName"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveInstrumentationBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.EventName == "" {
+		missing = append(missing, "eventName")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.removeInstrumentationBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type RemoveInstrumentationBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveInstrumentationBreakpointParams
 }
 
 // Removes breakpoint on particular native event. (experimental)
 func (d *Client) RemoveInstrumentationBreakpoint() *RemoveInstrumentationBreakpointRequest {
-	return &RemoveInstrumentationBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveInstrumentationBreakpointRequest{client: d.Client, params: &RemoveInstrumentationBreakpointParams{set: 1}}
+}
+
+// DoRemoveInstrumentationBreakpoint sends DOMDebugger.removeInstrumentationBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveInstrumentationBreakpoint(ctx context.Context, params *RemoveInstrumentationBreakpointParams) error {
+	if params == nil {
+		params = new(RemoveInstrumentationBreakpointParams)
+	}
+	return (&RemoveInstrumentationBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Instrumentation name to stop on.
 func (r *RemoveInstrumentationBreakpointRequest) EventName(v string) *RemoveInstrumentationBreakpointRequest {
-	r.opts["eventName"] = v
+	r.params.EventName = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *RemoveInstrumentationBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.removeInstrumentationBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveInstrumentationBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.removeInstrumentationBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveInstrumentationBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.removeInstrumentationBreakpoint", r.params, nil)
+}
+
+// SetXHRBreakpointParams are the parameters of DOMDebugger.setXHRBreakpoint.
+type SetXHRBreakpointParams struct {
+	// Resource URL substring. All XHRs having this substring in the URL will get stopped upon.
+	URL string `json:"url"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetXHRBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.setXHRBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type SetXHRBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetXHRBreakpointParams
 }
 
 // Sets breakpoint on XMLHttpRequest.
 func (d *Client) SetXHRBreakpoint() *SetXHRBreakpointRequest {
-	return &SetXHRBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetXHRBreakpointRequest{client: d.Client, params: &SetXHRBreakpointParams{set: 1}}
+}
+
+// DoSetXHRBreakpoint sends DOMDebugger.setXHRBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetXHRBreakpoint(ctx context.Context, params *SetXHRBreakpointParams) error {
+	if params == nil {
+		params = new(SetXHRBreakpointParams)
+	}
+	return (&SetXHRBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Resource URL substring. All XHRs having this substring in the URL will get stopped upon.
 func (r *SetXHRBreakpointRequest) URL(v string) *SetXHRBreakpointRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetXHRBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.setXHRBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetXHRBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.setXHRBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetXHRBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.setXHRBreakpoint", r.params, nil)
+}
+
+// RemoveXHRBreakpointParams are the parameters of DOMDebugger.removeXHRBreakpoint.
+type RemoveXHRBreakpointParams struct {
+	// Resource URL substring.
+	URL string `json:"url"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveXHRBreakpointParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.removeXHRBreakpoint", Missing: missing, Invalid: invalid}
 }
 
 type RemoveXHRBreakpointRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveXHRBreakpointParams
 }
 
 // Removes breakpoint from XMLHttpRequest.
 func (d *Client) RemoveXHRBreakpoint() *RemoveXHRBreakpointRequest {
-	return &RemoveXHRBreakpointRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveXHRBreakpointRequest{client: d.Client, params: &RemoveXHRBreakpointParams{set: 1}}
+}
+
+// DoRemoveXHRBreakpoint sends DOMDebugger.removeXHRBreakpoint with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveXHRBreakpoint(ctx context.Context, params *RemoveXHRBreakpointParams) error {
+	if params == nil {
+		params = new(RemoveXHRBreakpointParams)
+	}
+	return (&RemoveXHRBreakpointRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Resource URL substring.
 func (r *RemoveXHRBreakpointRequest) URL(v string) *RemoveXHRBreakpointRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *RemoveXHRBreakpointRequest) Do() error {
-	return r.client.Call("DOMDebugger.removeXHRBreakpoint", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveXHRBreakpointRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMDebugger.removeXHRBreakpoint", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveXHRBreakpointRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMDebugger.removeXHRBreakpoint", r.params, nil)
+}
+
+// GetEventListenersParams are the parameters of DOMDebugger.getEventListeners.
+type GetEventListenersParams struct {
+	// Identifier of the object to return listeners for.
+	ObjectId runtime.RemoteObjectId `json:"objectId"`
+
+	// The maximum depth at which Node children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
+	Depth *int `json:"depth,omitempty"`
+
+	// Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false). Reports listeners for all contexts if pierce is enabled. (optional, experimental)
+	Pierce *bool `json:"pierce,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetEventListenersParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectId == "" {
+		missing = append(missing, "objectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMDebugger.getEventListeners", Missing: missing, Invalid: invalid}
 }
 
 type GetEventListenersRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetEventListenersParams
 }
 
 // Returns event listeners of the given object. (experimental)
 func (d *Client) GetEventListeners() *GetEventListenersRequest {
-	return &GetEventListenersRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetEventListenersRequest{client: d.Client, params: &GetEventListenersParams{set: 1}}
+}
+
+// DoGetEventListeners sends DOMDebugger.getEventListeners with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetEventListeners(ctx context.Context, params *GetEventListenersParams) (*GetEventListenersResult, error) {
+	if params == nil {
+		params = new(GetEventListenersParams)
+	}
+	return (&GetEventListenersRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the object to return listeners for.
 func (r *GetEventListenersRequest) ObjectId(v runtime.RemoteObjectId) *GetEventListenersRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The maximum depth at which Node children should be retrieved, defaults to 1. Use -1 for the entire subtree or provide an integer larger than 0. (optional, experimental)
 func (r *GetEventListenersRequest) Depth(v int) *GetEventListenersRequest {
-	r.opts["depth"] = v
+	r.params.Depth = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Whether or not iframes and shadow roots should be traversed when returning the subtree (default is false). Reports listeners for all contexts if pierce is enabled. (optional, experimental)
 func (r *GetEventListenersRequest) Pierce(v bool) *GetEventListenersRequest {
-	r.opts["pierce"] = v
+	r.params.Pierce = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -269,10 +680,32 @@ type GetEventListenersResult struct {
 }
 
 func (r *GetEventListenersRequest) Do() (*GetEventListenersResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetEventListenersRequest) DoContext(ctx context.Context) (*GetEventListenersResult, error) {
 	var result GetEventListenersResult
-	err := r.client.Call("DOMDebugger.getEventListeners", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOMDebugger.getEventListeners", r.params, &result)
 	return &result, err
 }
 
+type GetEventListenersFuture struct {
+	*rpc.Call
+	result GetEventListenersResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetEventListenersFuture) Get() (*GetEventListenersResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetEventListenersRequest) DoAsync(ctx context.Context) *GetEventListenersFuture {
+	f := new(GetEventListenersFuture)
+	f.Call = r.client.Go(ctx, "DOMDebugger.getEventListeners", r.params, &f.result)
+	return f
+}
+
 func init() {
 }
@@ -2,6 +2,8 @@
 package domsnapshot
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/css"
@@ -38,34 +40,34 @@ type DOMNode struct {
 	PseudoElementIndexes []int `json:"pseudoElementIndexes,omitempty"`
 
 	// The index of the node's related layout tree node in the <code>layoutTreeNodes</code> array returned by <code>getSnapshot</code>, if any. (optional)
-	LayoutNodeIndex int `json:"layoutNodeIndex,omitempty"`
+	LayoutNodeIndex *int `json:"layoutNodeIndex,omitempty"`
 
 	// Document URL that <code>Document</code> or <code>FrameOwner</code> node points to. (optional)
-	DocumentURL string `json:"documentURL,omitempty"`
+	DocumentURL *string `json:"documentURL,omitempty"`
 
 	// Base URL that <code>Document</code> or <code>FrameOwner</code> node uses for URL completion. (optional)
-	BaseURL string `json:"baseURL,omitempty"`
+	BaseURL *string `json:"baseURL,omitempty"`
 
 	// <code>DocumentType</code> node's publicId. (optional)
-	PublicId string `json:"publicId,omitempty"`
+	PublicId *string `json:"publicId,omitempty"`
 
 	// <code>DocumentType</code> node's systemId. (optional)
-	SystemId string `json:"systemId,omitempty"`
+	SystemId *string `json:"systemId,omitempty"`
 
 	// Frame ID for frame owner elements. (optional)
-	FrameId string `json:"frameId,omitempty"`
+	FrameId *string `json:"frameId,omitempty"`
 
 	// The index of a frame owner element's content document in the <code>domNodes</code> array returned by <code>getSnapshot</code>, if any. (optional)
-	ContentDocumentIndex int `json:"contentDocumentIndex,omitempty"`
+	ContentDocumentIndex *int `json:"contentDocumentIndex,omitempty"`
 
 	// Index of the imported document's node of a link element in the <code>domNodes</code> array returned by <code>getSnapshot</code>, if any. (optional)
-	ImportedDocumentIndex int `json:"importedDocumentIndex,omitempty"`
+	ImportedDocumentIndex *int `json:"importedDocumentIndex,omitempty"`
 
 	// Index of the content node of a template element in the <code>domNodes</code> array returned by <code>getSnapshot</code>. (optional)
-	TemplateContentIndex int `json:"templateContentIndex,omitempty"`
+	TemplateContentIndex *int `json:"templateContentIndex,omitempty"`
 
 	// Type of a pseudo element node. (optional)
-	PseudoType dom.PseudoType `json:"pseudoType,omitempty"`
+	PseudoType *dom.PseudoType `json:"pseudoType,omitempty"`
 }
 
 // Details of an element in the DOM tree with a LayoutObject.
@@ -78,13 +80,13 @@ type LayoutTreeNode struct {
 	BoundingBox *dom.Rect `json:"boundingBox"`
 
 	// Contents of the LayoutText, if any. (optional)
-	LayoutText string `json:"layoutText,omitempty"`
+	LayoutText *string `json:"layoutText,omitempty"`
 
 	// The post-layout inline text nodes, if any. (optional)
 	InlineTextNodes []*css.InlineTextBox `json:"inlineTextNodes,omitempty"`
 
 	// Index into the <code>computedStyles</code> array returned by <code>getSnapshot</code>. (optional)
-	StyleIndex int `json:"styleIndex,omitempty"`
+	StyleIndex *int `json:"styleIndex,omitempty"`
 }
 
 // A subset of the full ComputedStyle as defined by the request whitelist.
@@ -104,19 +106,51 @@ type NameValue struct {
 	Value string `json:"value"`
 }
 
+// GetSnapshotParams are the parameters of DOMSnapshot.getSnapshot.
+type GetSnapshotParams struct {
+	// Whitelist of computed styles to return.
+	ComputedStyleWhitelist []string `json:"computedStyleWhitelist"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetSnapshotParams) Validate() error {
+	var missing, invalid []string
+	if p.ComputedStyleWhitelist == nil {
+		missing = append(missing, "computedStyleWhitelist")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMSnapshot.getSnapshot", Missing: missing, Invalid: invalid}
+}
+
 type GetSnapshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetSnapshotParams
 }
 
 // Returns a document snapshot, including the full DOM tree of the root node (including iframes, template contents, and imported documents) in a flattened array, as well as layout and white-listed computed style information for the nodes. Shadow DOM in the returned DOM tree is flattened.
 func (d *Client) GetSnapshot() *GetSnapshotRequest {
-	return &GetSnapshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetSnapshotRequest{client: d.Client, params: &GetSnapshotParams{set: 1}}
+}
+
+// DoGetSnapshot sends DOMSnapshot.getSnapshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetSnapshot(ctx context.Context, params *GetSnapshotParams) (*GetSnapshotResult, error) {
+	if params == nil {
+		params = new(GetSnapshotParams)
+	}
+	return (&GetSnapshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whitelist of computed styles to return.
 func (r *GetSnapshotRequest) ComputedStyleWhitelist(v []string) *GetSnapshotRequest {
-	r.opts["computedStyleWhitelist"] = v
+	r.params.ComputedStyleWhitelist = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -132,10 +166,32 @@ type GetSnapshotResult struct {
 }
 
 func (r *GetSnapshotRequest) Do() (*GetSnapshotResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetSnapshotRequest) DoContext(ctx context.Context) (*GetSnapshotResult, error) {
 	var result GetSnapshotResult
-	err := r.client.Call("DOMSnapshot.getSnapshot", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOMSnapshot.getSnapshot", r.params, &result)
 	return &result, err
 }
 
+type GetSnapshotFuture struct {
+	*rpc.Call
+	result GetSnapshotResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetSnapshotFuture) Get() (*GetSnapshotResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetSnapshotRequest) DoAsync(ctx context.Context) *GetSnapshotFuture {
+	f := new(GetSnapshotFuture)
+	f.Call = r.client.Go(ctx, "DOMSnapshot.getSnapshot", r.params, &f.result)
+	return f
+}
+
 func init() {
 }
@@ -2,6 +2,8 @@
 package domstorage
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -24,63 +26,178 @@ type StorageId struct {
 
 type Item []string
 
+// EnableParams are the parameters of DOMStorage.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables storage tracking, storage events will now be delivered to the client.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends DOMStorage.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("DOMStorage.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "DOMStorage", r.params)
+}
+
+// DisableParams are the parameters of DOMStorage.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables storage tracking, prevents storage events from being sent to the client.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends DOMStorage.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("DOMStorage.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "DOMStorage", r.params)
+}
+
+// ClearParams are the parameters of DOMStorage.clear.
+type ClearParams struct {
+	StorageId *StorageId `json:"storageId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ClearParams) Validate() error {
+	var missing, invalid []string
+	if p.StorageId == nil {
+		missing = append(missing, "storageId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMStorage.clear", Missing: missing, Invalid: invalid}
 }
 
 type ClearRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearParams
 }
 
 func (d *Client) Clear() *ClearRequest {
-	return &ClearRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearRequest{client: d.Client, params: &ClearParams{set: 1}}
+}
+
+// DoClear sends DOMStorage.clear with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClear(ctx context.Context, params *ClearParams) error {
+	if params == nil {
+		params = new(ClearParams)
+	}
+	return (&ClearRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearRequest) StorageId(v *StorageId) *ClearRequest {
-	r.opts["storageId"] = v
+	r.params.StorageId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ClearRequest) Do() error {
-	return r.client.Call("DOMStorage.clear", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMStorage.clear", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMStorage.clear", r.params, nil)
+}
+
+// GetDOMStorageItemsParams are the parameters of DOMStorage.getDOMStorageItems.
+type GetDOMStorageItemsParams struct {
+	StorageId *StorageId `json:"storageId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetDOMStorageItemsParams) Validate() error {
+	var missing, invalid []string
+	if p.StorageId == nil {
+		missing = append(missing, "storageId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMStorage.getDOMStorageItems", Missing: missing, Invalid: invalid}
 }
 
 type GetDOMStorageItemsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetDOMStorageItemsParams
 }
 
 func (d *Client) GetDOMStorageItems() *GetDOMStorageItemsRequest {
-	return &GetDOMStorageItemsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetDOMStorageItemsRequest{client: d.Client, params: &GetDOMStorageItemsParams{set: 1}}
+}
+
+// DoGetDOMStorageItems sends DOMStorage.getDOMStorageItems with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetDOMStorageItems(ctx context.Context, params *GetDOMStorageItemsParams) (*GetDOMStorageItemsResult, error) {
+	if params == nil {
+		params = new(GetDOMStorageItemsParams)
+	}
+	return (&GetDOMStorageItemsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetDOMStorageItemsRequest) StorageId(v *StorageId) *GetDOMStorageItemsRequest {
-	r.opts["storageId"] = v
+	r.params.StorageId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -89,60 +206,180 @@ type GetDOMStorageItemsResult struct {
 }
 
 func (r *GetDOMStorageItemsRequest) Do() (*GetDOMStorageItemsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetDOMStorageItemsRequest) DoContext(ctx context.Context) (*GetDOMStorageItemsResult, error) {
 	var result GetDOMStorageItemsResult
-	err := r.client.Call("DOMStorage.getDOMStorageItems", r.opts, &result)
+	err := r.client.CallContext(ctx, "DOMStorage.getDOMStorageItems", r.params, &result)
 	return &result, err
 }
 
+type GetDOMStorageItemsFuture struct {
+	*rpc.Call
+	result GetDOMStorageItemsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetDOMStorageItemsFuture) Get() (*GetDOMStorageItemsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetDOMStorageItemsRequest) DoAsync(ctx context.Context) *GetDOMStorageItemsFuture {
+	f := new(GetDOMStorageItemsFuture)
+	f.Call = r.client.Go(ctx, "DOMStorage.getDOMStorageItems", r.params, &f.result)
+	return f
+}
+
+// SetDOMStorageItemParams are the parameters of DOMStorage.setDOMStorageItem.
+type SetDOMStorageItemParams struct {
+	StorageId *StorageId `json:"storageId"`
+
+	Key string `json:"key"`
+
+	Value string `json:"value"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDOMStorageItemParams) Validate() error {
+	var missing, invalid []string
+	if p.StorageId == nil {
+		missing = append(missing, "storageId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Key == "" {
+		missing = append(missing, "key")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Value == "" {
+		missing = append(missing, "value")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMStorage.setDOMStorageItem", Missing: missing, Invalid: invalid}
+}
+
 type SetDOMStorageItemRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDOMStorageItemParams
 }
 
 func (d *Client) SetDOMStorageItem() *SetDOMStorageItemRequest {
-	return &SetDOMStorageItemRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDOMStorageItemRequest{client: d.Client, params: &SetDOMStorageItemParams{set: 1}}
+}
+
+// DoSetDOMStorageItem sends DOMStorage.setDOMStorageItem with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDOMStorageItem(ctx context.Context, params *SetDOMStorageItemParams) error {
+	if params == nil {
+		params = new(SetDOMStorageItemParams)
+	}
+	return (&SetDOMStorageItemRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetDOMStorageItemRequest) StorageId(v *StorageId) *SetDOMStorageItemRequest {
-	r.opts["storageId"] = v
+	r.params.StorageId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetDOMStorageItemRequest) Key(v string) *SetDOMStorageItemRequest {
-	r.opts["key"] = v
+	r.params.Key = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetDOMStorageItemRequest) Value(v string) *SetDOMStorageItemRequest {
-	r.opts["value"] = v
+	r.params.Value = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetDOMStorageItemRequest) Do() error {
-	return r.client.Call("DOMStorage.setDOMStorageItem", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDOMStorageItemRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMStorage.setDOMStorageItem", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDOMStorageItemRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMStorage.setDOMStorageItem", r.params, nil)
+}
+
+// RemoveDOMStorageItemParams are the parameters of DOMStorage.removeDOMStorageItem.
+type RemoveDOMStorageItemParams struct {
+	StorageId *StorageId `json:"storageId"`
+
+	Key string `json:"key"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveDOMStorageItemParams) Validate() error {
+	var missing, invalid []string
+	if p.StorageId == nil {
+		missing = append(missing, "storageId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Key == "" {
+		missing = append(missing, "key")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "DOMStorage.removeDOMStorageItem", Missing: missing, Invalid: invalid}
 }
 
 type RemoveDOMStorageItemRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveDOMStorageItemParams
 }
 
 func (d *Client) RemoveDOMStorageItem() *RemoveDOMStorageItemRequest {
-	return &RemoveDOMStorageItemRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveDOMStorageItemRequest{client: d.Client, params: &RemoveDOMStorageItemParams{set: 1}}
+}
+
+// DoRemoveDOMStorageItem sends DOMStorage.removeDOMStorageItem with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveDOMStorageItem(ctx context.Context, params *RemoveDOMStorageItemParams) error {
+	if params == nil {
+		params = new(RemoveDOMStorageItemParams)
+	}
+	return (&RemoveDOMStorageItemRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *RemoveDOMStorageItemRequest) StorageId(v *StorageId) *RemoveDOMStorageItemRequest {
-	r.opts["storageId"] = v
+	r.params.StorageId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *RemoveDOMStorageItemRequest) Key(v string) *RemoveDOMStorageItemRequest {
-	r.opts["key"] = v
+	r.params.Key = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *RemoveDOMStorageItemRequest) Do() error {
-	return r.client.Call("DOMStorage.removeDOMStorageItem", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveDOMStorageItemRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "DOMStorage.removeDOMStorageItem", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveDOMStorageItemRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "DOMStorage.removeDOMStorageItem", r.params, nil)
 }
 
 func init() {
@@ -156,12 +393,88 @@ type DomStorageItemsClearedEvent struct {
 	StorageId *StorageId `json:"storageId"`
 }
 
+func (d *Client) OnDomStorageItemsCleared(fn func(*DomStorageItemsClearedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOMStorage.domStorageItemsCleared", func(e interface{}) {
+		fn(e.(*DomStorageItemsClearedEvent))
+	}, opts...)
+}
+
+// FilterDomStorageItemsCleared is rpc.WithFilter for OnDomStorageItemsCleared.
+func FilterDomStorageItemsCleared(fn func(*DomStorageItemsClearedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DomStorageItemsClearedEvent))
+	})
+}
+
+// DomStorageItemsClearedWaiter waits for a DOMStorage.domStorageItemsCleared event. It is created by WaitForDomStorageItemsCleared.
+type DomStorageItemsClearedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DomStorageItemsClearedWaiter) Wait() (*DomStorageItemsClearedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DomStorageItemsClearedEvent), nil
+}
+
+// WaitForDomStorageItemsCleared arms a waiter for the first DOMStorage.domStorageItemsCleared event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDomStorageItemsCleared(ctx context.Context, predicate func(*DomStorageItemsClearedEvent) bool) *DomStorageItemsClearedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DomStorageItemsClearedEvent))
+		}
+	}
+	return &DomStorageItemsClearedWaiter{d.Client.WaitFor(ctx, "DOMStorage.domStorageItemsCleared", p)}
+}
+
 type DomStorageItemRemovedEvent struct {
 	StorageId *StorageId `json:"storageId"`
 
 	Key string `json:"key"`
 }
 
+func (d *Client) OnDomStorageItemRemoved(fn func(*DomStorageItemRemovedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOMStorage.domStorageItemRemoved", func(e interface{}) {
+		fn(e.(*DomStorageItemRemovedEvent))
+	}, opts...)
+}
+
+// FilterDomStorageItemRemoved is rpc.WithFilter for OnDomStorageItemRemoved.
+func FilterDomStorageItemRemoved(fn func(*DomStorageItemRemovedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DomStorageItemRemovedEvent))
+	})
+}
+
+// DomStorageItemRemovedWaiter waits for a DOMStorage.domStorageItemRemoved event. It is created by WaitForDomStorageItemRemoved.
+type DomStorageItemRemovedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DomStorageItemRemovedWaiter) Wait() (*DomStorageItemRemovedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DomStorageItemRemovedEvent), nil
+}
+
+// WaitForDomStorageItemRemoved arms a waiter for the first DOMStorage.domStorageItemRemoved event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDomStorageItemRemoved(ctx context.Context, predicate func(*DomStorageItemRemovedEvent) bool) *DomStorageItemRemovedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DomStorageItemRemovedEvent))
+		}
+	}
+	return &DomStorageItemRemovedWaiter{d.Client.WaitFor(ctx, "DOMStorage.domStorageItemRemoved", p)}
+}
+
 type DomStorageItemAddedEvent struct {
 	StorageId *StorageId `json:"storageId"`
 
@@ -170,6 +483,44 @@ type DomStorageItemAddedEvent struct {
 	NewValue string `json:"newValue"`
 }
 
+func (d *Client) OnDomStorageItemAdded(fn func(*DomStorageItemAddedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOMStorage.domStorageItemAdded", func(e interface{}) {
+		fn(e.(*DomStorageItemAddedEvent))
+	}, opts...)
+}
+
+// FilterDomStorageItemAdded is rpc.WithFilter for OnDomStorageItemAdded.
+func FilterDomStorageItemAdded(fn func(*DomStorageItemAddedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DomStorageItemAddedEvent))
+	})
+}
+
+// DomStorageItemAddedWaiter waits for a DOMStorage.domStorageItemAdded event. It is created by WaitForDomStorageItemAdded.
+type DomStorageItemAddedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DomStorageItemAddedWaiter) Wait() (*DomStorageItemAddedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DomStorageItemAddedEvent), nil
+}
+
+// WaitForDomStorageItemAdded arms a waiter for the first DOMStorage.domStorageItemAdded event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDomStorageItemAdded(ctx context.Context, predicate func(*DomStorageItemAddedEvent) bool) *DomStorageItemAddedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DomStorageItemAddedEvent))
+		}
+	}
+	return &DomStorageItemAddedWaiter{d.Client.WaitFor(ctx, "DOMStorage.domStorageItemAdded", p)}
+}
+
 type DomStorageItemUpdatedEvent struct {
 	StorageId *StorageId `json:"storageId"`
 
@@ -179,3 +530,41 @@ type DomStorageItemUpdatedEvent struct {
 
 	NewValue string `json:"newValue"`
 }
+
+func (d *Client) OnDomStorageItemUpdated(fn func(*DomStorageItemUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("DOMStorage.domStorageItemUpdated", func(e interface{}) {
+		fn(e.(*DomStorageItemUpdatedEvent))
+	}, opts...)
+}
+
+// FilterDomStorageItemUpdated is rpc.WithFilter for OnDomStorageItemUpdated.
+func FilterDomStorageItemUpdated(fn func(*DomStorageItemUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DomStorageItemUpdatedEvent))
+	})
+}
+
+// DomStorageItemUpdatedWaiter waits for a DOMStorage.domStorageItemUpdated event. It is created by WaitForDomStorageItemUpdated.
+type DomStorageItemUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DomStorageItemUpdatedWaiter) Wait() (*DomStorageItemUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DomStorageItemUpdatedEvent), nil
+}
+
+// WaitForDomStorageItemUpdated arms a waiter for the first DOMStorage.domStorageItemUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDomStorageItemUpdated(ctx context.Context, predicate func(*DomStorageItemUpdatedEvent) bool) *DomStorageItemUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DomStorageItemUpdatedEvent))
+		}
+	}
+	return &DomStorageItemUpdatedWaiter{d.Client.WaitFor(ctx, "DOMStorage.domStorageItemUpdated", p)}
+}
@@ -2,6 +2,8 @@
 package emulation
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/dom"
@@ -16,7 +18,7 @@ type Client struct {
 
 type ScreenOrientation struct {
 	// Orientation type.
-	Type string `json:"type"`
+	Type ScreenOrientationType `json:"type"`
 
 	// Orientation angle.
 	Angle int `json:"angle"`
@@ -26,358 +28,948 @@ type ScreenOrientation struct {
 
 type VirtualTimePolicy string
 
+const (
+	VirtualTimePolicyAdvance                      VirtualTimePolicy = "advance"
+	VirtualTimePolicyPause                        VirtualTimePolicy = "pause"
+	VirtualTimePolicyPauseIfNetworkFetchesPending VirtualTimePolicy = "pauseIfNetworkFetchesPending"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v VirtualTimePolicy) IsValid() bool {
+	switch v {
+	case VirtualTimePolicyAdvance, VirtualTimePolicyPause, VirtualTimePolicyPauseIfNetworkFetchesPending:
+		return true
+	}
+	return false
+}
+
+// Values of the type property of ScreenOrientation.
+
+type ScreenOrientationType string
+
+const (
+	ScreenOrientationTypePortraitPrimary    ScreenOrientationType = "portraitPrimary"
+	ScreenOrientationTypePortraitSecondary  ScreenOrientationType = "portraitSecondary"
+	ScreenOrientationTypeLandscapePrimary   ScreenOrientationType = "landscapePrimary"
+	ScreenOrientationTypeLandscapeSecondary ScreenOrientationType = "landscapeSecondary"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ScreenOrientationType) IsValid() bool {
+	switch v {
+	case ScreenOrientationTypePortraitPrimary, ScreenOrientationTypePortraitSecondary, ScreenOrientationTypeLandscapePrimary, ScreenOrientationTypeLandscapeSecondary:
+		return true
+	}
+	return false
+}
+
+// SetDeviceMetricsOverrideParams are the parameters of Emulation.setDeviceMetricsOverride.
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Width int `json:"width"`
+
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height int `json:"height"`
+
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
+
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
+	Mobile bool `json:"mobile"`
+
+	// Whether a view that exceeds the available browser window area should be scaled down to fit.
+	FitWindow bool `json:"fitWindow"`
+
+	// Scale to apply to resulting view image. Ignored in |fitWindow| mode. (optional, experimental)
+	Scale *float64 `json:"scale,omitempty"`
+
+	// Not used. (optional, experimental)
+	OffsetX *float64 `json:"offsetX,omitempty"`
+
+	// Not used. (optional, experimental)
+	OffsetY *float64 `json:"offsetY,omitempty"`
+
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
+	ScreenWidth *int `json:"screenWidth,omitempty"`
+
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
+	ScreenHeight *int `json:"screenHeight,omitempty"`
+
+	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
+	PositionX *int `json:"positionX,omitempty"`
+
+	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
+	PositionY *int `json:"positionY,omitempty"`
+
+	// Screen orientation override. (optional)
+	ScreenOrientation *ScreenOrientation `json:"screenOrientation,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDeviceMetricsOverrideParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "width")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "height")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "deviceScaleFactor")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 {
+		missing = append(missing, "mobile")
+	}
+	if p.set&1 != 0 && p.set&(1<<5) == 0 {
+		missing = append(missing, "fitWindow")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setDeviceMetricsOverride", Missing: missing, Invalid: invalid}
+}
+
 type SetDeviceMetricsOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDeviceMetricsOverrideParams
 }
 
 // Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media query results).
 func (d *Client) SetDeviceMetricsOverride() *SetDeviceMetricsOverrideRequest {
-	return &SetDeviceMetricsOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDeviceMetricsOverrideRequest{client: d.Client, params: &SetDeviceMetricsOverrideParams{set: 1}}
+}
+
+// DoSetDeviceMetricsOverride sends Emulation.setDeviceMetricsOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDeviceMetricsOverride(ctx context.Context, params *SetDeviceMetricsOverrideParams) error {
+	if params == nil {
+		params = new(SetDeviceMetricsOverrideParams)
+	}
+	return (&SetDeviceMetricsOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
 func (r *SetDeviceMetricsOverrideRequest) Width(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["width"] = v
+	r.params.Width = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
 func (r *SetDeviceMetricsOverrideRequest) Height(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["height"] = v
+	r.params.Height = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Overriding device scale factor value. 0 disables the override.
 func (r *SetDeviceMetricsOverrideRequest) DeviceScaleFactor(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["deviceScaleFactor"] = v
+	r.params.DeviceScaleFactor = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
 func (r *SetDeviceMetricsOverrideRequest) Mobile(v bool) *SetDeviceMetricsOverrideRequest {
-	r.opts["mobile"] = v
+	r.params.Mobile = v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Whether a view that exceeds the available browser window area should be scaled down to fit.
 func (r *SetDeviceMetricsOverrideRequest) FitWindow(v bool) *SetDeviceMetricsOverrideRequest {
-	r.opts["fitWindow"] = v
+	r.params.FitWindow = v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Scale to apply to resulting view image. Ignored in |fitWindow| mode. (optional, experimental)
 func (r *SetDeviceMetricsOverrideRequest) Scale(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["scale"] = v
+	r.params.Scale = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Not used. (optional, experimental)
 func (r *SetDeviceMetricsOverrideRequest) OffsetX(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["offsetX"] = v
+	r.params.OffsetX = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Not used. (optional, experimental)
 func (r *SetDeviceMetricsOverrideRequest) OffsetY(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["offsetY"] = v
+	r.params.OffsetY = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // Overriding screen width value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
 func (r *SetDeviceMetricsOverrideRequest) ScreenWidth(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["screenWidth"] = v
+	r.params.ScreenWidth = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
 // Overriding screen height value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
 func (r *SetDeviceMetricsOverrideRequest) ScreenHeight(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["screenHeight"] = v
+	r.params.ScreenHeight = &v
+	r.params.set |= 1 << 10
 	return r
 }
 
 // Overriding view X position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
 func (r *SetDeviceMetricsOverrideRequest) PositionX(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["positionX"] = v
+	r.params.PositionX = &v
+	r.params.set |= 1 << 11
 	return r
 }
 
 // Overriding view Y position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional, experimental)
 func (r *SetDeviceMetricsOverrideRequest) PositionY(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["positionY"] = v
+	r.params.PositionY = &v
+	r.params.set |= 1 << 12
 	return r
 }
 
 // Screen orientation override. (optional)
 func (r *SetDeviceMetricsOverrideRequest) ScreenOrientation(v *ScreenOrientation) *SetDeviceMetricsOverrideRequest {
-	r.opts["screenOrientation"] = v
+	r.params.ScreenOrientation = v
+	r.params.set |= 1 << 13
 	return r
 }
 
 func (r *SetDeviceMetricsOverrideRequest) Do() error {
-	return r.client.Call("Emulation.setDeviceMetricsOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDeviceMetricsOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setDeviceMetricsOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDeviceMetricsOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setDeviceMetricsOverride", r.params, nil)
+}
+
+// ClearDeviceMetricsOverrideParams are the parameters of Emulation.clearDeviceMetricsOverride.
+type ClearDeviceMetricsOverrideParams struct {
 }
 
 type ClearDeviceMetricsOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearDeviceMetricsOverrideParams
 }
 
 // Clears the overriden device metrics.
 func (d *Client) ClearDeviceMetricsOverride() *ClearDeviceMetricsOverrideRequest {
-	return &ClearDeviceMetricsOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearDeviceMetricsOverrideRequest{client: d.Client, params: &ClearDeviceMetricsOverrideParams{}}
+}
+
+// DoClearDeviceMetricsOverride sends Emulation.clearDeviceMetricsOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearDeviceMetricsOverride(ctx context.Context, params *ClearDeviceMetricsOverrideParams) error {
+	if params == nil {
+		params = new(ClearDeviceMetricsOverrideParams)
+	}
+	return (&ClearDeviceMetricsOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearDeviceMetricsOverrideRequest) Do() error {
-	return r.client.Call("Emulation.clearDeviceMetricsOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearDeviceMetricsOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.clearDeviceMetricsOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearDeviceMetricsOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.clearDeviceMetricsOverride", r.params, nil)
+}
+
+// ForceViewportParams are the parameters of Emulation.forceViewport.
+type ForceViewportParams struct {
+	// X coordinate of top-left corner of the area (CSS pixels).
+	X float64 `json:"x"`
+
+	// Y coordinate of top-left corner of the area (CSS pixels).
+	Y float64 `json:"y"`
+
+	// Scale to apply to the area (relative to a page scale of 1.0).
+	Scale float64 `json:"scale"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ForceViewportParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "y")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "scale")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.forceViewport", Missing: missing, Invalid: invalid}
 }
 
 type ForceViewportRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ForceViewportParams
 }
 
 // Overrides the visible area of the page. The change is hidden from the page, i.e. the observable scroll position and page scale does not change. In effect, the command moves the specified area of the page into the top-left corner of the frame. (experimental)
 func (d *Client) ForceViewport() *ForceViewportRequest {
-	return &ForceViewportRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ForceViewportRequest{client: d.Client, params: &ForceViewportParams{set: 1}}
+}
+
+// DoForceViewport sends Emulation.forceViewport with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoForceViewport(ctx context.Context, params *ForceViewportParams) error {
+	if params == nil {
+		params = new(ForceViewportParams)
+	}
+	return (&ForceViewportRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // X coordinate of top-left corner of the area (CSS pixels).
 func (r *ForceViewportRequest) X(v float64) *ForceViewportRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Y coordinate of top-left corner of the area (CSS pixels).
 func (r *ForceViewportRequest) Y(v float64) *ForceViewportRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Scale to apply to the area (relative to a page scale of 1.0).
 func (r *ForceViewportRequest) Scale(v float64) *ForceViewportRequest {
-	r.opts["scale"] = v
+	r.params.Scale = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *ForceViewportRequest) Do() error {
-	return r.client.Call("Emulation.forceViewport", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ForceViewportRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.forceViewport", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ForceViewportRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.forceViewport", r.params, nil)
+}
+
+// ResetViewportParams are the parameters of Emulation.resetViewport.
+type ResetViewportParams struct {
 }
 
 type ResetViewportRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ResetViewportParams
 }
 
 // Resets the visible area of the page to the original viewport, undoing any effects of the <code>forceViewport</code> command. (experimental)
 func (d *Client) ResetViewport() *ResetViewportRequest {
-	return &ResetViewportRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ResetViewportRequest{client: d.Client, params: &ResetViewportParams{}}
+}
+
+// DoResetViewport sends Emulation.resetViewport with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoResetViewport(ctx context.Context, params *ResetViewportParams) error {
+	if params == nil {
+		params = new(ResetViewportParams)
+	}
+	return (&ResetViewportRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ResetViewportRequest) Do() error {
-	return r.client.Call("Emulation.resetViewport", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ResetViewportRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.resetViewport", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ResetViewportRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.resetViewport", r.params, nil)
+}
+
+// ResetPageScaleFactorParams are the parameters of Emulation.resetPageScaleFactor.
+type ResetPageScaleFactorParams struct {
 }
 
 type ResetPageScaleFactorRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ResetPageScaleFactorParams
 }
 
 // Requests that page scale factor is reset to initial values. (experimental)
 func (d *Client) ResetPageScaleFactor() *ResetPageScaleFactorRequest {
-	return &ResetPageScaleFactorRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ResetPageScaleFactorRequest{client: d.Client, params: &ResetPageScaleFactorParams{}}
+}
+
+// DoResetPageScaleFactor sends Emulation.resetPageScaleFactor with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoResetPageScaleFactor(ctx context.Context, params *ResetPageScaleFactorParams) error {
+	if params == nil {
+		params = new(ResetPageScaleFactorParams)
+	}
+	return (&ResetPageScaleFactorRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ResetPageScaleFactorRequest) Do() error {
-	return r.client.Call("Emulation.resetPageScaleFactor", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ResetPageScaleFactorRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.resetPageScaleFactor", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ResetPageScaleFactorRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.resetPageScaleFactor", r.params, nil)
+}
+
+// SetPageScaleFactorParams are the parameters of Emulation.setPageScaleFactor.
+type SetPageScaleFactorParams struct {
+	// Page scale factor.
+	PageScaleFactor float64 `json:"pageScaleFactor"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetPageScaleFactorParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "pageScaleFactor")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setPageScaleFactor", Missing: missing, Invalid: invalid}
 }
 
 type SetPageScaleFactorRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetPageScaleFactorParams
 }
 
 // Sets a specified page scale factor. (experimental)
 func (d *Client) SetPageScaleFactor() *SetPageScaleFactorRequest {
-	return &SetPageScaleFactorRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetPageScaleFactorRequest{client: d.Client, params: &SetPageScaleFactorParams{set: 1}}
+}
+
+// DoSetPageScaleFactor sends Emulation.setPageScaleFactor with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetPageScaleFactor(ctx context.Context, params *SetPageScaleFactorParams) error {
+	if params == nil {
+		params = new(SetPageScaleFactorParams)
+	}
+	return (&SetPageScaleFactorRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Page scale factor.
 func (r *SetPageScaleFactorRequest) PageScaleFactor(v float64) *SetPageScaleFactorRequest {
-	r.opts["pageScaleFactor"] = v
+	r.params.PageScaleFactor = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetPageScaleFactorRequest) Do() error {
-	return r.client.Call("Emulation.setPageScaleFactor", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetPageScaleFactorRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setPageScaleFactor", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetPageScaleFactorRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setPageScaleFactor", r.params, nil)
+}
+
+// SetVisibleSizeParams are the parameters of Emulation.setVisibleSize.
+type SetVisibleSizeParams struct {
+	// Frame width (DIP).
+	Width int `json:"width"`
+
+	// Frame height (DIP).
+	Height int `json:"height"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetVisibleSizeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "width")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "height")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setVisibleSize", Missing: missing, Invalid: invalid}
 }
 
 type SetVisibleSizeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetVisibleSizeParams
 }
 
 // Resizes the frame/viewport of the page. Note that this does not affect the frame's container (e.g. browser window). Can be used to produce screenshots of the specified size. Not supported on Android. (experimental)
 func (d *Client) SetVisibleSize() *SetVisibleSizeRequest {
-	return &SetVisibleSizeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetVisibleSizeRequest{client: d.Client, params: &SetVisibleSizeParams{set: 1}}
+}
+
+// DoSetVisibleSize sends Emulation.setVisibleSize with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetVisibleSize(ctx context.Context, params *SetVisibleSizeParams) error {
+	if params == nil {
+		params = new(SetVisibleSizeParams)
+	}
+	return (&SetVisibleSizeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Frame width (DIP).
 func (r *SetVisibleSizeRequest) Width(v int) *SetVisibleSizeRequest {
-	r.opts["width"] = v
+	r.params.Width = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Frame height (DIP).
 func (r *SetVisibleSizeRequest) Height(v int) *SetVisibleSizeRequest {
-	r.opts["height"] = v
+	r.params.Height = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetVisibleSizeRequest) Do() error {
-	return r.client.Call("Emulation.setVisibleSize", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetVisibleSizeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setVisibleSize", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetVisibleSizeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setVisibleSize", r.params, nil)
+}
+
+// SetScriptExecutionDisabledParams are the parameters of Emulation.setScriptExecutionDisabled.
+type SetScriptExecutionDisabledParams struct {
+	// Whether script execution should be disabled in the page.
+	Value bool `json:"value"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetScriptExecutionDisabledParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "value")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setScriptExecutionDisabled", Missing: missing, Invalid: invalid}
 }
 
 type SetScriptExecutionDisabledRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetScriptExecutionDisabledParams
 }
 
 // Switches script execution in the page. (experimental)
 func (d *Client) SetScriptExecutionDisabled() *SetScriptExecutionDisabledRequest {
-	return &SetScriptExecutionDisabledRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetScriptExecutionDisabledRequest{client: d.Client, params: &SetScriptExecutionDisabledParams{set: 1}}
+}
+
+// DoSetScriptExecutionDisabled sends Emulation.setScriptExecutionDisabled with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetScriptExecutionDisabled(ctx context.Context, params *SetScriptExecutionDisabledParams) error {
+	if params == nil {
+		params = new(SetScriptExecutionDisabledParams)
+	}
+	return (&SetScriptExecutionDisabledRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether script execution should be disabled in the page.
 func (r *SetScriptExecutionDisabledRequest) Value(v bool) *SetScriptExecutionDisabledRequest {
-	r.opts["value"] = v
+	r.params.Value = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetScriptExecutionDisabledRequest) Do() error {
-	return r.client.Call("Emulation.setScriptExecutionDisabled", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetScriptExecutionDisabledRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setScriptExecutionDisabled", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetScriptExecutionDisabledRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setScriptExecutionDisabled", r.params, nil)
+}
+
+// SetGeolocationOverrideParams are the parameters of Emulation.setGeolocationOverride.
+type SetGeolocationOverrideParams struct {
+	// Mock latitude (optional)
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Mock longitude (optional)
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Mock accuracy (optional)
+	Accuracy *float64 `json:"accuracy,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetGeolocationOverrideParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setGeolocationOverride", Missing: missing, Invalid: invalid}
 }
 
 type SetGeolocationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetGeolocationOverrideParams
 }
 
 // Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position unavailable. (experimental)
 func (d *Client) SetGeolocationOverride() *SetGeolocationOverrideRequest {
-	return &SetGeolocationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetGeolocationOverrideRequest{client: d.Client, params: &SetGeolocationOverrideParams{set: 1}}
+}
+
+// DoSetGeolocationOverride sends Emulation.setGeolocationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetGeolocationOverride(ctx context.Context, params *SetGeolocationOverrideParams) error {
+	if params == nil {
+		params = new(SetGeolocationOverrideParams)
+	}
+	return (&SetGeolocationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Mock latitude (optional)
 func (r *SetGeolocationOverrideRequest) Latitude(v float64) *SetGeolocationOverrideRequest {
-	r.opts["latitude"] = v
+	r.params.Latitude = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Mock longitude (optional)
 func (r *SetGeolocationOverrideRequest) Longitude(v float64) *SetGeolocationOverrideRequest {
-	r.opts["longitude"] = v
+	r.params.Longitude = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Mock accuracy (optional)
 func (r *SetGeolocationOverrideRequest) Accuracy(v float64) *SetGeolocationOverrideRequest {
-	r.opts["accuracy"] = v
+	r.params.Accuracy = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetGeolocationOverrideRequest) Do() error {
-	return r.client.Call("Emulation.setGeolocationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetGeolocationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setGeolocationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetGeolocationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setGeolocationOverride", r.params, nil)
+}
+
+// ClearGeolocationOverrideParams are the parameters of Emulation.clearGeolocationOverride.
+type ClearGeolocationOverrideParams struct {
 }
 
 type ClearGeolocationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearGeolocationOverrideParams
 }
 
 // Clears the overriden Geolocation Position and Error. (experimental)
 func (d *Client) ClearGeolocationOverride() *ClearGeolocationOverrideRequest {
-	return &ClearGeolocationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearGeolocationOverrideRequest{client: d.Client, params: &ClearGeolocationOverrideParams{}}
+}
+
+// DoClearGeolocationOverride sends Emulation.clearGeolocationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearGeolocationOverride(ctx context.Context, params *ClearGeolocationOverrideParams) error {
+	if params == nil {
+		params = new(ClearGeolocationOverrideParams)
+	}
+	return (&ClearGeolocationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearGeolocationOverrideRequest) Do() error {
-	return r.client.Call("Emulation.clearGeolocationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearGeolocationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.clearGeolocationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearGeolocationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.clearGeolocationOverride", r.params, nil)
+}
+
+// SetTouchEmulationEnabledParams are the parameters of Emulation.setTouchEmulationEnabled.
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+
+	// Touch/gesture events configuration. Default: current platform. (optional)
+	Configuration *string `json:"configuration,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetTouchEmulationEnabledParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "enabled")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setTouchEmulationEnabled", Missing: missing, Invalid: invalid}
 }
 
 type SetTouchEmulationEnabledRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetTouchEmulationEnabledParams
 }
 
 // Toggles mouse event-based touch event emulation.
 func (d *Client) SetTouchEmulationEnabled() *SetTouchEmulationEnabledRequest {
-	return &SetTouchEmulationEnabledRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetTouchEmulationEnabledRequest{client: d.Client, params: &SetTouchEmulationEnabledParams{set: 1}}
+}
+
+// DoSetTouchEmulationEnabled sends Emulation.setTouchEmulationEnabled with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetTouchEmulationEnabled(ctx context.Context, params *SetTouchEmulationEnabledParams) error {
+	if params == nil {
+		params = new(SetTouchEmulationEnabledParams)
+	}
+	return (&SetTouchEmulationEnabledRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether the touch event emulation should be enabled.
 func (r *SetTouchEmulationEnabledRequest) Enabled(v bool) *SetTouchEmulationEnabledRequest {
-	r.opts["enabled"] = v
+	r.params.Enabled = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Touch/gesture events configuration. Default: current platform. (optional)
 func (r *SetTouchEmulationEnabledRequest) Configuration(v string) *SetTouchEmulationEnabledRequest {
-	r.opts["configuration"] = v
+	r.params.Configuration = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetTouchEmulationEnabledRequest) Do() error {
-	return r.client.Call("Emulation.setTouchEmulationEnabled", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetTouchEmulationEnabledRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setTouchEmulationEnabled", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetTouchEmulationEnabledRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setTouchEmulationEnabled", r.params, nil)
+}
+
+// SetEmulatedMediaParams are the parameters of Emulation.setEmulatedMedia.
+type SetEmulatedMediaParams struct {
+	// Media type to emulate. Empty string disables the override.
+	Media string `json:"media"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetEmulatedMediaParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Media == "" {
+		missing = append(missing, "media")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setEmulatedMedia", Missing: missing, Invalid: invalid}
 }
 
 type SetEmulatedMediaRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetEmulatedMediaParams
 }
 
 // Emulates the given media for CSS media queries.
 func (d *Client) SetEmulatedMedia() *SetEmulatedMediaRequest {
-	return &SetEmulatedMediaRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetEmulatedMediaRequest{client: d.Client, params: &SetEmulatedMediaParams{set: 1}}
+}
+
+// DoSetEmulatedMedia sends Emulation.setEmulatedMedia with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetEmulatedMedia(ctx context.Context, params *SetEmulatedMediaParams) error {
+	if params == nil {
+		params = new(SetEmulatedMediaParams)
+	}
+	return (&SetEmulatedMediaRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Media type to emulate. Empty string disables the override.
 func (r *SetEmulatedMediaRequest) Media(v string) *SetEmulatedMediaRequest {
-	r.opts["media"] = v
+	r.params.Media = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetEmulatedMediaRequest) Do() error {
-	return r.client.Call("Emulation.setEmulatedMedia", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetEmulatedMediaRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setEmulatedMedia", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetEmulatedMediaRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setEmulatedMedia", r.params, nil)
+}
+
+// SetCPUThrottlingRateParams are the parameters of Emulation.setCPUThrottlingRate.
+type SetCPUThrottlingRateParams struct {
+	// Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
+	Rate float64 `json:"rate"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetCPUThrottlingRateParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "rate")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setCPUThrottlingRate", Missing: missing, Invalid: invalid}
 }
 
 type SetCPUThrottlingRateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetCPUThrottlingRateParams
 }
 
 // Enables CPU throttling to emulate slow CPUs. (experimental)
 func (d *Client) SetCPUThrottlingRate() *SetCPUThrottlingRateRequest {
-	return &SetCPUThrottlingRateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetCPUThrottlingRateRequest{client: d.Client, params: &SetCPUThrottlingRateParams{set: 1}}
+}
+
+// DoSetCPUThrottlingRate sends Emulation.setCPUThrottlingRate with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetCPUThrottlingRate(ctx context.Context, params *SetCPUThrottlingRateParams) error {
+	if params == nil {
+		params = new(SetCPUThrottlingRateParams)
+	}
+	return (&SetCPUThrottlingRateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Throttling rate as a slowdown factor (1 is no throttle, 2 is 2x slowdown, etc).
 func (r *SetCPUThrottlingRateRequest) Rate(v float64) *SetCPUThrottlingRateRequest {
-	r.opts["rate"] = v
+	r.params.Rate = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetCPUThrottlingRateRequest) Do() error {
-	return r.client.Call("Emulation.setCPUThrottlingRate", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetCPUThrottlingRateRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setCPUThrottlingRate", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetCPUThrottlingRateRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setCPUThrottlingRate", r.params, nil)
+}
+
+// CanEmulateParams are the parameters of Emulation.canEmulate.
+type CanEmulateParams struct {
 }
 
 type CanEmulateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CanEmulateParams
 }
 
 // Tells whether emulation is supported. (experimental)
 func (d *Client) CanEmulate() *CanEmulateRequest {
-	return &CanEmulateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CanEmulateRequest{client: d.Client, params: &CanEmulateParams{}}
+}
+
+// DoCanEmulate sends Emulation.canEmulate with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCanEmulate(ctx context.Context, params *CanEmulateParams) (*CanEmulateResult, error) {
+	if params == nil {
+		params = new(CanEmulateParams)
+	}
+	return (&CanEmulateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type CanEmulateResult struct {
@@ -386,54 +978,160 @@ type CanEmulateResult struct {
 }
 
 func (r *CanEmulateRequest) Do() (*CanEmulateResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CanEmulateRequest) DoContext(ctx context.Context) (*CanEmulateResult, error) {
 	var result CanEmulateResult
-	err := r.client.Call("Emulation.canEmulate", r.opts, &result)
+	err := r.client.CallContext(ctx, "Emulation.canEmulate", r.params, &result)
 	return &result, err
 }
 
+type CanEmulateFuture struct {
+	*rpc.Call
+	result CanEmulateResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CanEmulateFuture) Get() (*CanEmulateResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CanEmulateRequest) DoAsync(ctx context.Context) *CanEmulateFuture {
+	f := new(CanEmulateFuture)
+	f.Call = r.client.Go(ctx, "Emulation.canEmulate", r.params, &f.result)
+	return f
+}
+
+// SetVirtualTimePolicyParams are the parameters of Emulation.setVirtualTimePolicy.
+type SetVirtualTimePolicyParams struct {
+	Policy VirtualTimePolicy `json:"policy"`
+
+	// If set, after this many virtual milliseconds have elapsed virtual time will be paused and a virtualTimeBudgetExpired event is sent. (optional)
+	Budget *int `json:"budget,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetVirtualTimePolicyParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Policy == "" {
+		missing = append(missing, "policy")
+	} else if !p.Policy.IsValid() {
+		invalid = append(invalid, "policy")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setVirtualTimePolicy", Missing: missing, Invalid: invalid}
+}
+
 type SetVirtualTimePolicyRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetVirtualTimePolicyParams
 }
 
 // Turns on virtual time for all frames (replacing real-time with a synthetic time source) and sets the current virtual time policy.  Note this supersedes any previous time budget. (experimental)
 func (d *Client) SetVirtualTimePolicy() *SetVirtualTimePolicyRequest {
-	return &SetVirtualTimePolicyRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetVirtualTimePolicyRequest{client: d.Client, params: &SetVirtualTimePolicyParams{set: 1}}
+}
+
+// DoSetVirtualTimePolicy sends Emulation.setVirtualTimePolicy with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetVirtualTimePolicy(ctx context.Context, params *SetVirtualTimePolicyParams) error {
+	if params == nil {
+		params = new(SetVirtualTimePolicyParams)
+	}
+	return (&SetVirtualTimePolicyRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetVirtualTimePolicyRequest) Policy(v VirtualTimePolicy) *SetVirtualTimePolicyRequest {
-	r.opts["policy"] = v
+	r.params.Policy = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // If set, after this many virtual milliseconds have elapsed virtual time will be paused and a virtualTimeBudgetExpired event is sent. (optional)
 func (r *SetVirtualTimePolicyRequest) Budget(v int) *SetVirtualTimePolicyRequest {
-	r.opts["budget"] = v
+	r.params.Budget = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetVirtualTimePolicyRequest) Do() error {
-	return r.client.Call("Emulation.setVirtualTimePolicy", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetVirtualTimePolicyRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setVirtualTimePolicy", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetVirtualTimePolicyRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setVirtualTimePolicy", r.params, nil)
+}
+
+// SetDefaultBackgroundColorOverrideParams are the parameters of Emulation.setDefaultBackgroundColorOverride.
+type SetDefaultBackgroundColorOverrideParams struct {
+	// RGBA of the default background color. If not specified, any existing override will be cleared. (optional)
+	Color *dom.RGBA `json:"color,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDefaultBackgroundColorOverrideParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Emulation.setDefaultBackgroundColorOverride", Missing: missing, Invalid: invalid}
 }
 
 type SetDefaultBackgroundColorOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDefaultBackgroundColorOverrideParams
 }
 
 // Sets or clears an override of the default background color of the frame. This override is used if the content does not specify one. (experimental)
 func (d *Client) SetDefaultBackgroundColorOverride() *SetDefaultBackgroundColorOverrideRequest {
-	return &SetDefaultBackgroundColorOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDefaultBackgroundColorOverrideRequest{client: d.Client, params: &SetDefaultBackgroundColorOverrideParams{set: 1}}
+}
+
+// DoSetDefaultBackgroundColorOverride sends Emulation.setDefaultBackgroundColorOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDefaultBackgroundColorOverride(ctx context.Context, params *SetDefaultBackgroundColorOverrideParams) error {
+	if params == nil {
+		params = new(SetDefaultBackgroundColorOverrideParams)
+	}
+	return (&SetDefaultBackgroundColorOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // RGBA of the default background color. If not specified, any existing override will be cleared. (optional)
 func (r *SetDefaultBackgroundColorOverrideRequest) Color(v *dom.RGBA) *SetDefaultBackgroundColorOverrideRequest {
-	r.opts["color"] = v
+	r.params.Color = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetDefaultBackgroundColorOverrideRequest) Do() error {
-	return r.client.Call("Emulation.setDefaultBackgroundColorOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDefaultBackgroundColorOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Emulation.setDefaultBackgroundColorOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDefaultBackgroundColorOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Emulation.setDefaultBackgroundColorOverride", r.params, nil)
 }
 
 func init() {
@@ -443,3 +1141,42 @@ func init() {
 // Notification sent after the virual time budget for the current VirtualTimePolicy has run out. (experimental)
 type VirtualTimeBudgetExpiredEvent struct {
 }
+
+// Notification sent after the virual time budget for the current VirtualTimePolicy has run out. (experimental)
+func (d *Client) OnVirtualTimeBudgetExpired(fn func(*VirtualTimeBudgetExpiredEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Emulation.virtualTimeBudgetExpired", func(e interface{}) {
+		fn(e.(*VirtualTimeBudgetExpiredEvent))
+	}, opts...)
+}
+
+// FilterVirtualTimeBudgetExpired is rpc.WithFilter for OnVirtualTimeBudgetExpired.
+func FilterVirtualTimeBudgetExpired(fn func(*VirtualTimeBudgetExpiredEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*VirtualTimeBudgetExpiredEvent))
+	})
+}
+
+// VirtualTimeBudgetExpiredWaiter waits for a Emulation.virtualTimeBudgetExpired event. It is created by WaitForVirtualTimeBudgetExpired.
+type VirtualTimeBudgetExpiredWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *VirtualTimeBudgetExpiredWaiter) Wait() (*VirtualTimeBudgetExpiredEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*VirtualTimeBudgetExpiredEvent), nil
+}
+
+// WaitForVirtualTimeBudgetExpired arms a waiter for the first Emulation.virtualTimeBudgetExpired event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForVirtualTimeBudgetExpired(ctx context.Context, predicate func(*VirtualTimeBudgetExpiredEvent) bool) *VirtualTimeBudgetExpiredWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*VirtualTimeBudgetExpiredEvent))
+		}
+	}
+	return &VirtualTimeBudgetExpiredWaiter{d.Client.WaitFor(ctx, "Emulation.virtualTimeBudgetExpired", p)}
+}
@@ -2,6 +2,8 @@
 package heapprofiler
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/runtime"
@@ -35,119 +37,333 @@ type SamplingHeapProfile struct {
 	Head *SamplingHeapProfileNode `json:"head"`
 }
 
+// EnableParams are the parameters of HeapProfiler.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends HeapProfiler.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("HeapProfiler.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "HeapProfiler", r.params)
+}
+
+// DisableParams are the parameters of HeapProfiler.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends HeapProfiler.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("HeapProfiler.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "HeapProfiler", r.params)
+}
+
+// StartTrackingHeapObjectsParams are the parameters of HeapProfiler.startTrackingHeapObjects.
+type StartTrackingHeapObjectsParams struct {
+	// (optional)
+	TrackAllocations *bool `json:"trackAllocations,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StartTrackingHeapObjectsParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "HeapProfiler.startTrackingHeapObjects", Missing: missing, Invalid: invalid}
 }
 
 type StartTrackingHeapObjectsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartTrackingHeapObjectsParams
 }
 
 func (d *Client) StartTrackingHeapObjects() *StartTrackingHeapObjectsRequest {
-	return &StartTrackingHeapObjectsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartTrackingHeapObjectsRequest{client: d.Client, params: &StartTrackingHeapObjectsParams{set: 1}}
+}
+
+// DoStartTrackingHeapObjects sends HeapProfiler.startTrackingHeapObjects with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStartTrackingHeapObjects(ctx context.Context, params *StartTrackingHeapObjectsParams) error {
+	if params == nil {
+		params = new(StartTrackingHeapObjectsParams)
+	}
+	return (&StartTrackingHeapObjectsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // (optional)
 func (r *StartTrackingHeapObjectsRequest) TrackAllocations(v bool) *StartTrackingHeapObjectsRequest {
-	r.opts["trackAllocations"] = v
+	r.params.TrackAllocations = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *StartTrackingHeapObjectsRequest) Do() error {
-	return r.client.Call("HeapProfiler.startTrackingHeapObjects", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartTrackingHeapObjectsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "HeapProfiler.startTrackingHeapObjects", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartTrackingHeapObjectsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "HeapProfiler.startTrackingHeapObjects", r.params, nil)
+}
+
+// StopTrackingHeapObjectsParams are the parameters of HeapProfiler.stopTrackingHeapObjects.
+type StopTrackingHeapObjectsParams struct {
+	// If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken when the tracking is stopped. (optional)
+	ReportProgress *bool `json:"reportProgress,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StopTrackingHeapObjectsParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "HeapProfiler.stopTrackingHeapObjects", Missing: missing, Invalid: invalid}
 }
 
 type StopTrackingHeapObjectsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopTrackingHeapObjectsParams
 }
 
 func (d *Client) StopTrackingHeapObjects() *StopTrackingHeapObjectsRequest {
-	return &StopTrackingHeapObjectsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopTrackingHeapObjectsRequest{client: d.Client, params: &StopTrackingHeapObjectsParams{set: 1}}
+}
+
+// DoStopTrackingHeapObjects sends HeapProfiler.stopTrackingHeapObjects with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopTrackingHeapObjects(ctx context.Context, params *StopTrackingHeapObjectsParams) error {
+	if params == nil {
+		params = new(StopTrackingHeapObjectsParams)
+	}
+	return (&StopTrackingHeapObjectsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken when the tracking is stopped. (optional)
 func (r *StopTrackingHeapObjectsRequest) ReportProgress(v bool) *StopTrackingHeapObjectsRequest {
-	r.opts["reportProgress"] = v
+	r.params.ReportProgress = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *StopTrackingHeapObjectsRequest) Do() error {
-	return r.client.Call("HeapProfiler.stopTrackingHeapObjects", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StopTrackingHeapObjectsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "HeapProfiler.stopTrackingHeapObjects", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StopTrackingHeapObjectsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "HeapProfiler.stopTrackingHeapObjects", r.params, nil)
+}
+
+// TakeHeapSnapshotParams are the parameters of HeapProfiler.takeHeapSnapshot.
+type TakeHeapSnapshotParams struct {
+	// If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken. (optional)
+	ReportProgress *bool `json:"reportProgress,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *TakeHeapSnapshotParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "HeapProfiler.takeHeapSnapshot", Missing: missing, Invalid: invalid}
 }
 
 type TakeHeapSnapshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *TakeHeapSnapshotParams
 }
 
 func (d *Client) TakeHeapSnapshot() *TakeHeapSnapshotRequest {
-	return &TakeHeapSnapshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &TakeHeapSnapshotRequest{client: d.Client, params: &TakeHeapSnapshotParams{set: 1}}
+}
+
+// DoTakeHeapSnapshot sends HeapProfiler.takeHeapSnapshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoTakeHeapSnapshot(ctx context.Context, params *TakeHeapSnapshotParams) error {
+	if params == nil {
+		params = new(TakeHeapSnapshotParams)
+	}
+	return (&TakeHeapSnapshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // If true 'reportHeapSnapshotProgress' events will be generated while snapshot is being taken. (optional)
 func (r *TakeHeapSnapshotRequest) ReportProgress(v bool) *TakeHeapSnapshotRequest {
-	r.opts["reportProgress"] = v
+	r.params.ReportProgress = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *TakeHeapSnapshotRequest) Do() error {
-	return r.client.Call("HeapProfiler.takeHeapSnapshot", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *TakeHeapSnapshotRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "HeapProfiler.takeHeapSnapshot", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *TakeHeapSnapshotRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "HeapProfiler.takeHeapSnapshot", r.params, nil)
+}
+
+// CollectGarbageParams are the parameters of HeapProfiler.collectGarbage.
+type CollectGarbageParams struct {
 }
 
 type CollectGarbageRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CollectGarbageParams
 }
 
 func (d *Client) CollectGarbage() *CollectGarbageRequest {
-	return &CollectGarbageRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CollectGarbageRequest{client: d.Client, params: &CollectGarbageParams{}}
+}
+
+// DoCollectGarbage sends HeapProfiler.collectGarbage with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCollectGarbage(ctx context.Context, params *CollectGarbageParams) error {
+	if params == nil {
+		params = new(CollectGarbageParams)
+	}
+	return (&CollectGarbageRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *CollectGarbageRequest) Do() error {
-	return r.client.Call("HeapProfiler.collectGarbage", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *CollectGarbageRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "HeapProfiler.collectGarbage", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *CollectGarbageRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "HeapProfiler.collectGarbage", r.params, nil)
+}
+
+// GetObjectByHeapObjectIdParams are the parameters of HeapProfiler.getObjectByHeapObjectId.
+type GetObjectByHeapObjectIdParams struct {
+	ObjectId HeapSnapshotObjectId `json:"objectId"`
+
+	// Symbolic group name that can be used to release multiple objects. (optional)
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetObjectByHeapObjectIdParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectId == "" {
+		missing = append(missing, "objectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "HeapProfiler.getObjectByHeapObjectId", Missing: missing, Invalid: invalid}
 }
 
 type GetObjectByHeapObjectIdRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetObjectByHeapObjectIdParams
 }
 
 func (d *Client) GetObjectByHeapObjectId() *GetObjectByHeapObjectIdRequest {
-	return &GetObjectByHeapObjectIdRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetObjectByHeapObjectIdRequest{client: d.Client, params: &GetObjectByHeapObjectIdParams{set: 1}}
+}
+
+// DoGetObjectByHeapObjectId sends HeapProfiler.getObjectByHeapObjectId with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetObjectByHeapObjectId(ctx context.Context, params *GetObjectByHeapObjectIdParams) (*GetObjectByHeapObjectIdResult, error) {
+	if params == nil {
+		params = new(GetObjectByHeapObjectIdParams)
+	}
+	return (&GetObjectByHeapObjectIdRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetObjectByHeapObjectIdRequest) ObjectId(v HeapSnapshotObjectId) *GetObjectByHeapObjectIdRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Symbolic group name that can be used to release multiple objects. (optional)
 func (r *GetObjectByHeapObjectIdRequest) ObjectGroup(v string) *GetObjectByHeapObjectIdRequest {
-	r.opts["objectGroup"] = v
+	r.params.ObjectGroup = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -157,43 +373,138 @@ type GetObjectByHeapObjectIdResult struct {
 }
 
 func (r *GetObjectByHeapObjectIdRequest) Do() (*GetObjectByHeapObjectIdResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetObjectByHeapObjectIdRequest) DoContext(ctx context.Context) (*GetObjectByHeapObjectIdResult, error) {
 	var result GetObjectByHeapObjectIdResult
-	err := r.client.Call("HeapProfiler.getObjectByHeapObjectId", r.opts, &result)
+	err := r.client.CallContext(ctx, "HeapProfiler.getObjectByHeapObjectId", r.params, &result)
 	return &result, err
 }
 
+type GetObjectByHeapObjectIdFuture struct {
+	*rpc.Call
+	result GetObjectByHeapObjectIdResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetObjectByHeapObjectIdFuture) Get() (*GetObjectByHeapObjectIdResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetObjectByHeapObjectIdRequest) DoAsync(ctx context.Context) *GetObjectByHeapObjectIdFuture {
+	f := new(GetObjectByHeapObjectIdFuture)
+	f.Call = r.client.Go(ctx, "HeapProfiler.getObjectByHeapObjectId", r.params, &f.result)
+	return f
+}
+
+// AddInspectedHeapObjectParams are the parameters of HeapProfiler.addInspectedHeapObject.
+type AddInspectedHeapObjectParams struct {
+	// Heap snapshot object id to be accessible by means of $x command line API.
+	HeapObjectId HeapSnapshotObjectId `json:"heapObjectId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *AddInspectedHeapObjectParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.HeapObjectId == "" {
+		missing = append(missing, "heapObjectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "HeapProfiler.addInspectedHeapObject", Missing: missing, Invalid: invalid}
+}
+
 type AddInspectedHeapObjectRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *AddInspectedHeapObjectParams
 }
 
 // Enables console to refer to the node with given id via $x (see Command Line API for more details $x functions).
 func (d *Client) AddInspectedHeapObject() *AddInspectedHeapObjectRequest {
-	return &AddInspectedHeapObjectRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &AddInspectedHeapObjectRequest{client: d.Client, params: &AddInspectedHeapObjectParams{set: 1}}
+}
+
+// DoAddInspectedHeapObject sends HeapProfiler.addInspectedHeapObject with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoAddInspectedHeapObject(ctx context.Context, params *AddInspectedHeapObjectParams) error {
+	if params == nil {
+		params = new(AddInspectedHeapObjectParams)
+	}
+	return (&AddInspectedHeapObjectRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Heap snapshot object id to be accessible by means of $x command line API.
 func (r *AddInspectedHeapObjectRequest) HeapObjectId(v HeapSnapshotObjectId) *AddInspectedHeapObjectRequest {
-	r.opts["heapObjectId"] = v
+	r.params.HeapObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *AddInspectedHeapObjectRequest) Do() error {
-	return r.client.Call("HeapProfiler.addInspectedHeapObject", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *AddInspectedHeapObjectRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "HeapProfiler.addInspectedHeapObject", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *AddInspectedHeapObjectRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "HeapProfiler.addInspectedHeapObject", r.params, nil)
+}
+
+// GetHeapObjectIdParams are the parameters of HeapProfiler.getHeapObjectId.
+type GetHeapObjectIdParams struct {
+	// Identifier of the object to get heap object id for.
+	ObjectId runtime.RemoteObjectId `json:"objectId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetHeapObjectIdParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectId == "" {
+		missing = append(missing, "objectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "HeapProfiler.getHeapObjectId", Missing: missing, Invalid: invalid}
 }
 
 type GetHeapObjectIdRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetHeapObjectIdParams
 }
 
 func (d *Client) GetHeapObjectId() *GetHeapObjectIdRequest {
-	return &GetHeapObjectIdRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetHeapObjectIdRequest{client: d.Client, params: &GetHeapObjectIdParams{set: 1}}
+}
+
+// DoGetHeapObjectId sends HeapProfiler.getHeapObjectId with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetHeapObjectId(ctx context.Context, params *GetHeapObjectIdParams) (*GetHeapObjectIdResult, error) {
+	if params == nil {
+		params = new(GetHeapObjectIdParams)
+	}
+	return (&GetHeapObjectIdRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the object to get heap object id for.
 func (r *GetHeapObjectIdRequest) ObjectId(v runtime.RemoteObjectId) *GetHeapObjectIdRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -203,37 +514,109 @@ type GetHeapObjectIdResult struct {
 }
 
 func (r *GetHeapObjectIdRequest) Do() (*GetHeapObjectIdResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetHeapObjectIdRequest) DoContext(ctx context.Context) (*GetHeapObjectIdResult, error) {
 	var result GetHeapObjectIdResult
-	err := r.client.Call("HeapProfiler.getHeapObjectId", r.opts, &result)
+	err := r.client.CallContext(ctx, "HeapProfiler.getHeapObjectId", r.params, &result)
 	return &result, err
 }
 
+type GetHeapObjectIdFuture struct {
+	*rpc.Call
+	result GetHeapObjectIdResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetHeapObjectIdFuture) Get() (*GetHeapObjectIdResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetHeapObjectIdRequest) DoAsync(ctx context.Context) *GetHeapObjectIdFuture {
+	f := new(GetHeapObjectIdFuture)
+	f.Call = r.client.Go(ctx, "HeapProfiler.getHeapObjectId", r.params, &f.result)
+	return f
+}
+
+// StartSamplingParams are the parameters of HeapProfiler.startSampling.
+type StartSamplingParams struct {
+	// Average sample interval in bytes. Poisson distribution is used for the intervals. The default value is 32768 bytes. (optional)
+	SamplingInterval *float64 `json:"samplingInterval,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StartSamplingParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "HeapProfiler.startSampling", Missing: missing, Invalid: invalid}
+}
+
 type StartSamplingRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartSamplingParams
 }
 
 func (d *Client) StartSampling() *StartSamplingRequest {
-	return &StartSamplingRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartSamplingRequest{client: d.Client, params: &StartSamplingParams{set: 1}}
+}
+
+// DoStartSampling sends HeapProfiler.startSampling with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStartSampling(ctx context.Context, params *StartSamplingParams) error {
+	if params == nil {
+		params = new(StartSamplingParams)
+	}
+	return (&StartSamplingRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Average sample interval in bytes. Poisson distribution is used for the intervals. The default value is 32768 bytes. (optional)
 func (r *StartSamplingRequest) SamplingInterval(v float64) *StartSamplingRequest {
-	r.opts["samplingInterval"] = v
+	r.params.SamplingInterval = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *StartSamplingRequest) Do() error {
-	return r.client.Call("HeapProfiler.startSampling", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartSamplingRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "HeapProfiler.startSampling", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartSamplingRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "HeapProfiler.startSampling", r.params, nil)
+}
+
+// StopSamplingParams are the parameters of HeapProfiler.stopSampling.
+type StopSamplingParams struct {
 }
 
 type StopSamplingRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopSamplingParams
 }
 
 func (d *Client) StopSampling() *StopSamplingRequest {
-	return &StopSamplingRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopSamplingRequest{client: d.Client, params: &StopSamplingParams{}}
+}
+
+// DoStopSampling sends HeapProfiler.stopSampling with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopSampling(ctx context.Context, params *StopSamplingParams) (*StopSamplingResult, error) {
+	if params == nil {
+		params = new(StopSamplingParams)
+	}
+	return (&StopSamplingRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type StopSamplingResult struct {
@@ -242,11 +625,33 @@ type StopSamplingResult struct {
 }
 
 func (r *StopSamplingRequest) Do() (*StopSamplingResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *StopSamplingRequest) DoContext(ctx context.Context) (*StopSamplingResult, error) {
 	var result StopSamplingResult
-	err := r.client.Call("HeapProfiler.stopSampling", r.opts, &result)
+	err := r.client.CallContext(ctx, "HeapProfiler.stopSampling", r.params, &result)
 	return &result, err
 }
 
+type StopSamplingFuture struct {
+	*rpc.Call
+	result StopSamplingResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *StopSamplingFuture) Get() (*StopSamplingResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *StopSamplingRequest) DoAsync(ctx context.Context) *StopSamplingFuture {
+	f := new(StopSamplingFuture)
+	f.Call = r.client.Go(ctx, "HeapProfiler.stopSampling", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["HeapProfiler.addHeapSnapshotChunk"] = func() interface{} { return new(AddHeapSnapshotChunkEvent) }
 	rpc.EventTypes["HeapProfiler.resetProfiles"] = func() interface{} { return new(ResetProfilesEvent) }
@@ -259,16 +664,130 @@ type AddHeapSnapshotChunkEvent struct {
 	Chunk string `json:"chunk"`
 }
 
+func (d *Client) OnAddHeapSnapshotChunk(fn func(*AddHeapSnapshotChunkEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("HeapProfiler.addHeapSnapshotChunk", func(e interface{}) {
+		fn(e.(*AddHeapSnapshotChunkEvent))
+	}, opts...)
+}
+
+// FilterAddHeapSnapshotChunk is rpc.WithFilter for OnAddHeapSnapshotChunk.
+func FilterAddHeapSnapshotChunk(fn func(*AddHeapSnapshotChunkEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AddHeapSnapshotChunkEvent))
+	})
+}
+
+// AddHeapSnapshotChunkWaiter waits for a HeapProfiler.addHeapSnapshotChunk event. It is created by WaitForAddHeapSnapshotChunk.
+type AddHeapSnapshotChunkWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AddHeapSnapshotChunkWaiter) Wait() (*AddHeapSnapshotChunkEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AddHeapSnapshotChunkEvent), nil
+}
+
+// WaitForAddHeapSnapshotChunk arms a waiter for the first HeapProfiler.addHeapSnapshotChunk event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAddHeapSnapshotChunk(ctx context.Context, predicate func(*AddHeapSnapshotChunkEvent) bool) *AddHeapSnapshotChunkWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AddHeapSnapshotChunkEvent))
+		}
+	}
+	return &AddHeapSnapshotChunkWaiter{d.Client.WaitFor(ctx, "HeapProfiler.addHeapSnapshotChunk", p)}
+}
+
 type ResetProfilesEvent struct {
 }
 
+func (d *Client) OnResetProfiles(fn func(*ResetProfilesEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("HeapProfiler.resetProfiles", func(e interface{}) {
+		fn(e.(*ResetProfilesEvent))
+	}, opts...)
+}
+
+// FilterResetProfiles is rpc.WithFilter for OnResetProfiles.
+func FilterResetProfiles(fn func(*ResetProfilesEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ResetProfilesEvent))
+	})
+}
+
+// ResetProfilesWaiter waits for a HeapProfiler.resetProfiles event. It is created by WaitForResetProfiles.
+type ResetProfilesWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ResetProfilesWaiter) Wait() (*ResetProfilesEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ResetProfilesEvent), nil
+}
+
+// WaitForResetProfiles arms a waiter for the first HeapProfiler.resetProfiles event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForResetProfiles(ctx context.Context, predicate func(*ResetProfilesEvent) bool) *ResetProfilesWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ResetProfilesEvent))
+		}
+	}
+	return &ResetProfilesWaiter{d.Client.WaitFor(ctx, "HeapProfiler.resetProfiles", p)}
+}
+
 type ReportHeapSnapshotProgressEvent struct {
 	Done int `json:"done"`
 
 	Total int `json:"total"`
 
 	// (optional)
-	Finished bool `json:"finished"`
+	Finished *bool `json:"finished,omitempty"`
+}
+
+func (d *Client) OnReportHeapSnapshotProgress(fn func(*ReportHeapSnapshotProgressEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("HeapProfiler.reportHeapSnapshotProgress", func(e interface{}) {
+		fn(e.(*ReportHeapSnapshotProgressEvent))
+	}, opts...)
+}
+
+// FilterReportHeapSnapshotProgress is rpc.WithFilter for OnReportHeapSnapshotProgress.
+func FilterReportHeapSnapshotProgress(fn func(*ReportHeapSnapshotProgressEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ReportHeapSnapshotProgressEvent))
+	})
+}
+
+// ReportHeapSnapshotProgressWaiter waits for a HeapProfiler.reportHeapSnapshotProgress event. It is created by WaitForReportHeapSnapshotProgress.
+type ReportHeapSnapshotProgressWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ReportHeapSnapshotProgressWaiter) Wait() (*ReportHeapSnapshotProgressEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ReportHeapSnapshotProgressEvent), nil
+}
+
+// WaitForReportHeapSnapshotProgress arms a waiter for the first HeapProfiler.reportHeapSnapshotProgress event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForReportHeapSnapshotProgress(ctx context.Context, predicate func(*ReportHeapSnapshotProgressEvent) bool) *ReportHeapSnapshotProgressWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ReportHeapSnapshotProgressEvent))
+		}
+	}
+	return &ReportHeapSnapshotProgressWaiter{d.Client.WaitFor(ctx, "HeapProfiler.reportHeapSnapshotProgress", p)}
 }
 
 // If heap objects tracking has been started then backend regularly sends a current value for last seen object id and corresponding timestamp. If the were changes in the heap since last event then one or more heapStatsUpdate events will be sent before a new lastSeenObjectId event.
@@ -278,8 +797,86 @@ type LastSeenObjectIdEvent struct {
 	Timestamp float64 `json:"timestamp"`
 }
 
+// If heap objects tracking has been started then backend regularly sends a current value for last seen object id and corresponding timestamp. If the were changes in the heap since last event then one or more heapStatsUpdate events will be sent before a new lastSeenObjectId event.
+func (d *Client) OnLastSeenObjectId(fn func(*LastSeenObjectIdEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("HeapProfiler.lastSeenObjectId", func(e interface{}) {
+		fn(e.(*LastSeenObjectIdEvent))
+	}, opts...)
+}
+
+// FilterLastSeenObjectId is rpc.WithFilter for OnLastSeenObjectId.
+func FilterLastSeenObjectId(fn func(*LastSeenObjectIdEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*LastSeenObjectIdEvent))
+	})
+}
+
+// LastSeenObjectIdWaiter waits for a HeapProfiler.lastSeenObjectId event. It is created by WaitForLastSeenObjectId.
+type LastSeenObjectIdWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *LastSeenObjectIdWaiter) Wait() (*LastSeenObjectIdEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*LastSeenObjectIdEvent), nil
+}
+
+// WaitForLastSeenObjectId arms a waiter for the first HeapProfiler.lastSeenObjectId event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForLastSeenObjectId(ctx context.Context, predicate func(*LastSeenObjectIdEvent) bool) *LastSeenObjectIdWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*LastSeenObjectIdEvent))
+		}
+	}
+	return &LastSeenObjectIdWaiter{d.Client.WaitFor(ctx, "HeapProfiler.lastSeenObjectId", p)}
+}
+
 // If heap objects tracking has been started then backend may send update for one or more fragments
 type HeapStatsUpdateEvent struct {
 	// An array of triplets. Each triplet describes a fragment. The first integer is the fragment index, the second integer is a total count of objects for the fragment, the third integer is a total size of the objects for the fragment.
 	StatsUpdate []int `json:"statsUpdate"`
 }
+
+// If heap objects tracking has been started then backend may send update for one or more fragments
+func (d *Client) OnHeapStatsUpdate(fn func(*HeapStatsUpdateEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("HeapProfiler.heapStatsUpdate", func(e interface{}) {
+		fn(e.(*HeapStatsUpdateEvent))
+	}, opts...)
+}
+
+// FilterHeapStatsUpdate is rpc.WithFilter for OnHeapStatsUpdate.
+func FilterHeapStatsUpdate(fn func(*HeapStatsUpdateEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*HeapStatsUpdateEvent))
+	})
+}
+
+// HeapStatsUpdateWaiter waits for a HeapProfiler.heapStatsUpdate event. It is created by WaitForHeapStatsUpdate.
+type HeapStatsUpdateWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *HeapStatsUpdateWaiter) Wait() (*HeapStatsUpdateEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*HeapStatsUpdateEvent), nil
+}
+
+// WaitForHeapStatsUpdate arms a waiter for the first HeapProfiler.heapStatsUpdate event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForHeapStatsUpdate(ctx context.Context, predicate func(*HeapStatsUpdateEvent) bool) *HeapStatsUpdateWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*HeapStatsUpdateEvent))
+		}
+	}
+	return &HeapStatsUpdateWaiter{d.Client.WaitFor(ctx, "HeapProfiler.heapStatsUpdate", p)}
+}
@@ -2,6 +2,8 @@
 package indexeddb
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/runtime"
@@ -61,16 +63,16 @@ type ObjectStoreIndex struct {
 
 type Key struct {
 	// Key type.
-	Type string `json:"type"`
+	Type KeyType `json:"type"`
 
 	// Number value. (optional)
-	Number float64 `json:"number,omitempty"`
+	Number *float64 `json:"number,omitempty"`
 
 	// String value. (optional)
-	String string `json:"string,omitempty"`
+	String *string `json:"string,omitempty"`
 
 	// Date value. (optional)
-	Date float64 `json:"date,omitempty"`
+	Date *float64 `json:"date,omitempty"`
 
 	// Array value. (optional)
 	Array []*Key `json:"array,omitempty"`
@@ -109,56 +111,171 @@ type DataEntry struct {
 
 type KeyPath struct {
 	// Key path type.
-	Type string `json:"type"`
+	Type KeyPathType `json:"type"`
 
 	// String value. (optional)
-	String string `json:"string,omitempty"`
+	String *string `json:"string,omitempty"`
 
 	// Array value. (optional)
 	Array []string `json:"array,omitempty"`
 }
 
+// Values of the type property of Key.
+
+type KeyType string
+
+const (
+	KeyTypeNumber KeyType = "number"
+	KeyTypeString KeyType = "string"
+	KeyTypeDate   KeyType = "date"
+	KeyTypeArray  KeyType = "array"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v KeyType) IsValid() bool {
+	switch v {
+	case KeyTypeNumber, KeyTypeString, KeyTypeDate, KeyTypeArray:
+		return true
+	}
+	return false
+}
+
+// Values of the type property of KeyPath.
+
+type KeyPathType string
+
+const (
+	KeyPathTypeNull   KeyPathType = "null"
+	KeyPathTypeString KeyPathType = "string"
+	KeyPathTypeArray  KeyPathType = "array"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v KeyPathType) IsValid() bool {
+	switch v {
+	case KeyPathTypeNull, KeyPathTypeString, KeyPathTypeArray:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of IndexedDB.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables events from backend.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends IndexedDB.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("IndexedDB.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "IndexedDB", r.params)
+}
+
+// DisableParams are the parameters of IndexedDB.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables events from backend.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends IndexedDB.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("IndexedDB.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "IndexedDB", r.params)
+}
+
+// RequestDatabaseNamesParams are the parameters of IndexedDB.requestDatabaseNames.
+type RequestDatabaseNamesParams struct {
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RequestDatabaseNamesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SecurityOrigin == "" {
+		missing = append(missing, "securityOrigin")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "IndexedDB.requestDatabaseNames", Missing: missing, Invalid: invalid}
 }
 
 type RequestDatabaseNamesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestDatabaseNamesParams
 }
 
 // Requests database names for given security origin.
 func (d *Client) RequestDatabaseNames() *RequestDatabaseNamesRequest {
-	return &RequestDatabaseNamesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestDatabaseNamesRequest{client: d.Client, params: &RequestDatabaseNamesParams{set: 1}}
+}
+
+// DoRequestDatabaseNames sends IndexedDB.requestDatabaseNames with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestDatabaseNames(ctx context.Context, params *RequestDatabaseNamesParams) (*RequestDatabaseNamesResult, error) {
+	if params == nil {
+		params = new(RequestDatabaseNamesParams)
+	}
+	return (&RequestDatabaseNamesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Security origin.
 func (r *RequestDatabaseNamesRequest) SecurityOrigin(v string) *RequestDatabaseNamesRequest {
-	r.opts["securityOrigin"] = v
+	r.params.SecurityOrigin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -168,30 +285,91 @@ type RequestDatabaseNamesResult struct {
 }
 
 func (r *RequestDatabaseNamesRequest) Do() (*RequestDatabaseNamesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestDatabaseNamesRequest) DoContext(ctx context.Context) (*RequestDatabaseNamesResult, error) {
 	var result RequestDatabaseNamesResult
-	err := r.client.Call("IndexedDB.requestDatabaseNames", r.opts, &result)
+	err := r.client.CallContext(ctx, "IndexedDB.requestDatabaseNames", r.params, &result)
 	return &result, err
 }
 
+type RequestDatabaseNamesFuture struct {
+	*rpc.Call
+	result RequestDatabaseNamesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RequestDatabaseNamesFuture) Get() (*RequestDatabaseNamesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RequestDatabaseNamesRequest) DoAsync(ctx context.Context) *RequestDatabaseNamesFuture {
+	f := new(RequestDatabaseNamesFuture)
+	f.Call = r.client.Go(ctx, "IndexedDB.requestDatabaseNames", r.params, &f.result)
+	return f
+}
+
+// RequestDatabaseParams are the parameters of IndexedDB.requestDatabase.
+type RequestDatabaseParams struct {
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RequestDatabaseParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SecurityOrigin == "" {
+		missing = append(missing, "securityOrigin")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.DatabaseName == "" {
+		missing = append(missing, "databaseName")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "IndexedDB.requestDatabase", Missing: missing, Invalid: invalid}
+}
+
 type RequestDatabaseRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestDatabaseParams
 }
 
 // Requests database with given name in given frame.
 func (d *Client) RequestDatabase() *RequestDatabaseRequest {
-	return &RequestDatabaseRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestDatabaseRequest{client: d.Client, params: &RequestDatabaseParams{set: 1}}
+}
+
+// DoRequestDatabase sends IndexedDB.requestDatabase with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestDatabase(ctx context.Context, params *RequestDatabaseParams) (*RequestDatabaseResult, error) {
+	if params == nil {
+		params = new(RequestDatabaseParams)
+	}
+	return (&RequestDatabaseRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Security origin.
 func (r *RequestDatabaseRequest) SecurityOrigin(v string) *RequestDatabaseRequest {
-	r.opts["securityOrigin"] = v
+	r.params.SecurityOrigin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Database name.
 func (r *RequestDatabaseRequest) DatabaseName(v string) *RequestDatabaseRequest {
-	r.opts["databaseName"] = v
+	r.params.DatabaseName = v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -201,60 +379,153 @@ type RequestDatabaseResult struct {
 }
 
 func (r *RequestDatabaseRequest) Do() (*RequestDatabaseResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestDatabaseRequest) DoContext(ctx context.Context) (*RequestDatabaseResult, error) {
 	var result RequestDatabaseResult
-	err := r.client.Call("IndexedDB.requestDatabase", r.opts, &result)
+	err := r.client.CallContext(ctx, "IndexedDB.requestDatabase", r.params, &result)
 	return &result, err
 }
 
+type RequestDatabaseFuture struct {
+	*rpc.Call
+	result RequestDatabaseResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RequestDatabaseFuture) Get() (*RequestDatabaseResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RequestDatabaseRequest) DoAsync(ctx context.Context) *RequestDatabaseFuture {
+	f := new(RequestDatabaseFuture)
+	f.Call = r.client.Go(ctx, "IndexedDB.requestDatabase", r.params, &f.result)
+	return f
+}
+
+// RequestDataParams are the parameters of IndexedDB.requestData.
+type RequestDataParams struct {
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+
+	// Index name, empty string for object store data requests.
+	IndexName string `json:"indexName"`
+
+	// Number of records to skip.
+	SkipCount int `json:"skipCount"`
+
+	// Number of records to fetch.
+	PageSize int `json:"pageSize"`
+
+	// Key range. (optional)
+	KeyRange *KeyRange `json:"keyRange,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RequestDataParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SecurityOrigin == "" {
+		missing = append(missing, "securityOrigin")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.DatabaseName == "" {
+		missing = append(missing, "databaseName")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.ObjectStoreName == "" {
+		missing = append(missing, "objectStoreName")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 || p.set&1 == 0 && p.IndexName == "" {
+		missing = append(missing, "indexName")
+	}
+	if p.set&1 != 0 && p.set&(1<<5) == 0 {
+		missing = append(missing, "skipCount")
+	}
+	if p.set&1 != 0 && p.set&(1<<6) == 0 {
+		missing = append(missing, "pageSize")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "IndexedDB.requestData", Missing: missing, Invalid: invalid}
+}
+
 type RequestDataRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestDataParams
 }
 
 // Requests data from object store or index.
 func (d *Client) RequestData() *RequestDataRequest {
-	return &RequestDataRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestDataRequest{client: d.Client, params: &RequestDataParams{set: 1}}
+}
+
+// DoRequestData sends IndexedDB.requestData with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestData(ctx context.Context, params *RequestDataParams) (*RequestDataResult, error) {
+	if params == nil {
+		params = new(RequestDataParams)
+	}
+	return (&RequestDataRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Security origin.
 func (r *RequestDataRequest) SecurityOrigin(v string) *RequestDataRequest {
-	r.opts["securityOrigin"] = v
+	r.params.SecurityOrigin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Database name.
 func (r *RequestDataRequest) DatabaseName(v string) *RequestDataRequest {
-	r.opts["databaseName"] = v
+	r.params.DatabaseName = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Object store name.
 func (r *RequestDataRequest) ObjectStoreName(v string) *RequestDataRequest {
-	r.opts["objectStoreName"] = v
+	r.params.ObjectStoreName = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Index name, empty string for object store data requests.
 func (r *RequestDataRequest) IndexName(v string) *RequestDataRequest {
-	r.opts["indexName"] = v
+	r.params.IndexName = v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Number of records to skip.
 func (r *RequestDataRequest) SkipCount(v int) *RequestDataRequest {
-	r.opts["skipCount"] = v
+	r.params.SkipCount = v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Number of records to fetch.
 func (r *RequestDataRequest) PageSize(v int) *RequestDataRequest {
-	r.opts["pageSize"] = v
+	r.params.PageSize = v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Key range. (optional)
 func (r *RequestDataRequest) KeyRange(v *KeyRange) *RequestDataRequest {
-	r.opts["keyRange"] = v
+	r.params.KeyRange = v
+	r.params.set |= 1 << 7
 	return r
 }
 
@@ -267,67 +538,192 @@ type RequestDataResult struct {
 }
 
 func (r *RequestDataRequest) Do() (*RequestDataResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestDataRequest) DoContext(ctx context.Context) (*RequestDataResult, error) {
 	var result RequestDataResult
-	err := r.client.Call("IndexedDB.requestData", r.opts, &result)
+	err := r.client.CallContext(ctx, "IndexedDB.requestData", r.params, &result)
 	return &result, err
 }
 
+type RequestDataFuture struct {
+	*rpc.Call
+	result RequestDataResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RequestDataFuture) Get() (*RequestDataResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RequestDataRequest) DoAsync(ctx context.Context) *RequestDataFuture {
+	f := new(RequestDataFuture)
+	f.Call = r.client.Go(ctx, "IndexedDB.requestData", r.params, &f.result)
+	return f
+}
+
+// ClearObjectStoreParams are the parameters of IndexedDB.clearObjectStore.
+type ClearObjectStoreParams struct {
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+
+	// Object store name.
+	ObjectStoreName string `json:"objectStoreName"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ClearObjectStoreParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SecurityOrigin == "" {
+		missing = append(missing, "securityOrigin")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.DatabaseName == "" {
+		missing = append(missing, "databaseName")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.ObjectStoreName == "" {
+		missing = append(missing, "objectStoreName")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "IndexedDB.clearObjectStore", Missing: missing, Invalid: invalid}
+}
+
 type ClearObjectStoreRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearObjectStoreParams
 }
 
 // Clears all entries from an object store.
 func (d *Client) ClearObjectStore() *ClearObjectStoreRequest {
-	return &ClearObjectStoreRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearObjectStoreRequest{client: d.Client, params: &ClearObjectStoreParams{set: 1}}
+}
+
+// DoClearObjectStore sends IndexedDB.clearObjectStore with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearObjectStore(ctx context.Context, params *ClearObjectStoreParams) error {
+	if params == nil {
+		params = new(ClearObjectStoreParams)
+	}
+	return (&ClearObjectStoreRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Security origin.
 func (r *ClearObjectStoreRequest) SecurityOrigin(v string) *ClearObjectStoreRequest {
-	r.opts["securityOrigin"] = v
+	r.params.SecurityOrigin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Database name.
 func (r *ClearObjectStoreRequest) DatabaseName(v string) *ClearObjectStoreRequest {
-	r.opts["databaseName"] = v
+	r.params.DatabaseName = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Object store name.
 func (r *ClearObjectStoreRequest) ObjectStoreName(v string) *ClearObjectStoreRequest {
-	r.opts["objectStoreName"] = v
+	r.params.ObjectStoreName = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *ClearObjectStoreRequest) Do() error {
-	return r.client.Call("IndexedDB.clearObjectStore", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearObjectStoreRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "IndexedDB.clearObjectStore", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearObjectStoreRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "IndexedDB.clearObjectStore", r.params, nil)
+}
+
+// DeleteDatabaseParams are the parameters of IndexedDB.deleteDatabase.
+type DeleteDatabaseParams struct {
+	// Security origin.
+	SecurityOrigin string `json:"securityOrigin"`
+
+	// Database name.
+	DatabaseName string `json:"databaseName"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DeleteDatabaseParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SecurityOrigin == "" {
+		missing = append(missing, "securityOrigin")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.DatabaseName == "" {
+		missing = append(missing, "databaseName")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "IndexedDB.deleteDatabase", Missing: missing, Invalid: invalid}
 }
 
 type DeleteDatabaseRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DeleteDatabaseParams
 }
 
 // Deletes a database.
 func (d *Client) DeleteDatabase() *DeleteDatabaseRequest {
-	return &DeleteDatabaseRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DeleteDatabaseRequest{client: d.Client, params: &DeleteDatabaseParams{set: 1}}
+}
+
+// DoDeleteDatabase sends IndexedDB.deleteDatabase with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDeleteDatabase(ctx context.Context, params *DeleteDatabaseParams) error {
+	if params == nil {
+		params = new(DeleteDatabaseParams)
+	}
+	return (&DeleteDatabaseRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Security origin.
 func (r *DeleteDatabaseRequest) SecurityOrigin(v string) *DeleteDatabaseRequest {
-	r.opts["securityOrigin"] = v
+	r.params.SecurityOrigin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Database name.
 func (r *DeleteDatabaseRequest) DatabaseName(v string) *DeleteDatabaseRequest {
-	r.opts["databaseName"] = v
+	r.params.DatabaseName = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *DeleteDatabaseRequest) Do() error {
-	return r.client.Call("IndexedDB.deleteDatabase", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DeleteDatabaseRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "IndexedDB.deleteDatabase", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DeleteDatabaseRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "IndexedDB.deleteDatabase", r.params, nil)
 }
 
 func init() {
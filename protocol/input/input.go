@@ -1,6 +1,8 @@
 package input
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -21,471 +23,1136 @@ type TouchPoint struct {
 	Y int `json:"y"`
 
 	// X radius of the touch area (default: 1). (optional)
-	RadiusX int `json:"radiusX,omitempty"`
+	RadiusX *int `json:"radiusX,omitempty"`
 
 	// Y radius of the touch area (default: 1). (optional)
-	RadiusY int `json:"radiusY,omitempty"`
+	RadiusY *int `json:"radiusY,omitempty"`
 
 	// Rotation angle (default: 0.0). (optional)
-	RotationAngle float64 `json:"rotationAngle,omitempty"`
+	RotationAngle *float64 `json:"rotationAngle,omitempty"`
 
 	// Force (default: 1.0). (optional)
-	Force float64 `json:"force,omitempty"`
+	Force *float64 `json:"force,omitempty"`
 
 	// Identifier used to track touch sources between events, must be unique within an event. (optional)
-	Id float64 `json:"id,omitempty"`
+	Id *float64 `json:"id,omitempty"`
 }
 
 // (experimental)
 
 type GestureSourceType string
 
+const (
+	GestureSourceTypeDefault GestureSourceType = "default"
+	GestureSourceTypeTouch   GestureSourceType = "touch"
+	GestureSourceTypeMouse   GestureSourceType = "mouse"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v GestureSourceType) IsValid() bool {
+	switch v {
+	case GestureSourceTypeDefault, GestureSourceTypeTouch, GestureSourceTypeMouse:
+		return true
+	}
+	return false
+}
+
+// Values of the type parameter of Input.dispatchKeyEvent.
+
+type DispatchKeyEventType string
+
+const (
+	DispatchKeyEventTypeKeyDown    DispatchKeyEventType = "keyDown"
+	DispatchKeyEventTypeKeyUp      DispatchKeyEventType = "keyUp"
+	DispatchKeyEventTypeRawKeyDown DispatchKeyEventType = "rawKeyDown"
+	DispatchKeyEventTypeChar       DispatchKeyEventType = "char"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v DispatchKeyEventType) IsValid() bool {
+	switch v {
+	case DispatchKeyEventTypeKeyDown, DispatchKeyEventTypeKeyUp, DispatchKeyEventTypeRawKeyDown, DispatchKeyEventTypeChar:
+		return true
+	}
+	return false
+}
+
+// Values of the type parameter of Input.dispatchMouseEvent.
+
+type DispatchMouseEventType string
+
+const (
+	DispatchMouseEventTypeMousePressed  DispatchMouseEventType = "mousePressed"
+	DispatchMouseEventTypeMouseReleased DispatchMouseEventType = "mouseReleased"
+	DispatchMouseEventTypeMouseMoved    DispatchMouseEventType = "mouseMoved"
+	DispatchMouseEventTypeMouseWheel    DispatchMouseEventType = "mouseWheel"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v DispatchMouseEventType) IsValid() bool {
+	switch v {
+	case DispatchMouseEventTypeMousePressed, DispatchMouseEventTypeMouseReleased, DispatchMouseEventTypeMouseMoved, DispatchMouseEventTypeMouseWheel:
+		return true
+	}
+	return false
+}
+
+// Values of the type parameter of Input.dispatchTouchEvent.
+
+type DispatchTouchEventType string
+
+const (
+	DispatchTouchEventTypeTouchStart  DispatchTouchEventType = "touchStart"
+	DispatchTouchEventTypeTouchEnd    DispatchTouchEventType = "touchEnd"
+	DispatchTouchEventTypeTouchMove   DispatchTouchEventType = "touchMove"
+	DispatchTouchEventTypeTouchCancel DispatchTouchEventType = "touchCancel"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v DispatchTouchEventType) IsValid() bool {
+	switch v {
+	case DispatchTouchEventTypeTouchStart, DispatchTouchEventTypeTouchEnd, DispatchTouchEventTypeTouchMove, DispatchTouchEventTypeTouchCancel:
+		return true
+	}
+	return false
+}
+
+// Values of the type parameter of Input.emulateTouchFromMouseEvent.
+
+type EmulateTouchFromMouseEventType string
+
+const (
+	EmulateTouchFromMouseEventTypeMousePressed  EmulateTouchFromMouseEventType = "mousePressed"
+	EmulateTouchFromMouseEventTypeMouseReleased EmulateTouchFromMouseEventType = "mouseReleased"
+	EmulateTouchFromMouseEventTypeMouseMoved    EmulateTouchFromMouseEventType = "mouseMoved"
+	EmulateTouchFromMouseEventTypeMouseWheel    EmulateTouchFromMouseEventType = "mouseWheel"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v EmulateTouchFromMouseEventType) IsValid() bool {
+	switch v {
+	case EmulateTouchFromMouseEventTypeMousePressed, EmulateTouchFromMouseEventTypeMouseReleased, EmulateTouchFromMouseEventTypeMouseMoved, EmulateTouchFromMouseEventTypeMouseWheel:
+		return true
+	}
+	return false
+}
+
+// SetIgnoreInputEventsParams are the parameters of Input.setIgnoreInputEvents.
+type SetIgnoreInputEventsParams struct {
+	// Ignores input events processing when set to true.
+	Ignore bool `json:"ignore"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetIgnoreInputEventsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "ignore")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.setIgnoreInputEvents", Missing: missing, Invalid: invalid}
+}
+
 type SetIgnoreInputEventsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetIgnoreInputEventsParams
 }
 
 // Ignores input events (useful while auditing page).
 func (d *Client) SetIgnoreInputEvents() *SetIgnoreInputEventsRequest {
-	return &SetIgnoreInputEventsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetIgnoreInputEventsRequest{client: d.Client, params: &SetIgnoreInputEventsParams{set: 1}}
+}
+
+// DoSetIgnoreInputEvents sends Input.setIgnoreInputEvents with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetIgnoreInputEvents(ctx context.Context, params *SetIgnoreInputEventsParams) error {
+	if params == nil {
+		params = new(SetIgnoreInputEventsParams)
+	}
+	return (&SetIgnoreInputEventsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Ignores input events processing when set to true.
 func (r *SetIgnoreInputEventsRequest) Ignore(v bool) *SetIgnoreInputEventsRequest {
-	r.opts["ignore"] = v
+	r.params.Ignore = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetIgnoreInputEventsRequest) Do() error {
-	return r.client.Call("Input.setIgnoreInputEvents", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetIgnoreInputEventsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.setIgnoreInputEvents", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetIgnoreInputEventsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.setIgnoreInputEvents", r.params, nil)
+}
+
+// DispatchKeyEventParams are the parameters of Input.dispatchKeyEvent.
+type DispatchKeyEventParams struct {
+	// Type of the key event.
+	Type DispatchKeyEventType `json:"type"`
+
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
+	Modifiers *int `json:"modifiers,omitempty"`
+
+	// Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970 (default: current time). (optional)
+	Timestamp *float64 `json:"timestamp,omitempty"`
+
+	// Text as generated by processing a virtual key code with a keyboard layout. Not needed for for <code>keyUp</code> and <code>rawKeyDown</code> events (default: "") (optional)
+	Text *string `json:"text,omitempty"`
+
+	// Text that would have been generated by the keyboard if no modifiers were pressed (except for shift). Useful for shortcut (accelerator) key handling (default: ""). (optional)
+	UnmodifiedText *string `json:"unmodifiedText,omitempty"`
+
+	// Unique key identifier (e.g., 'U+0041') (default: ""). (optional)
+	KeyIdentifier *string `json:"keyIdentifier,omitempty"`
+
+	// Unique DOM defined string value for each physical key (e.g., 'KeyA') (default: ""). (optional)
+	Code *string `json:"code,omitempty"`
+
+	// Unique DOM defined string value describing the meaning of the key in the context of active modifiers, keyboard layout, etc (e.g., 'AltGr') (default: ""). (optional)
+	Key *string `json:"key,omitempty"`
+
+	// Windows virtual key code (default: 0). (optional)
+	WindowsVirtualKeyCode *int `json:"windowsVirtualKeyCode,omitempty"`
+
+	// Native virtual key code (default: 0). (optional)
+	NativeVirtualKeyCode *int `json:"nativeVirtualKeyCode,omitempty"`
+
+	// Whether the event was generated from auto repeat (default: false). (optional)
+	AutoRepeat *bool `json:"autoRepeat,omitempty"`
+
+	// Whether the event was generated from the keypad (default: false). (optional)
+	IsKeypad *bool `json:"isKeypad,omitempty"`
+
+	// Whether the event was a system key event (default: false). (optional)
+	IsSystemKey *bool `json:"isSystemKey,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DispatchKeyEventParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Type == "" {
+		missing = append(missing, "type")
+	} else if !p.Type.IsValid() {
+		invalid = append(invalid, "type")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.dispatchKeyEvent", Missing: missing, Invalid: invalid}
 }
 
 type DispatchKeyEventRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DispatchKeyEventParams
 }
 
 // Dispatches a key event to the page.
 func (d *Client) DispatchKeyEvent() *DispatchKeyEventRequest {
-	return &DispatchKeyEventRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DispatchKeyEventRequest{client: d.Client, params: &DispatchKeyEventParams{set: 1}}
+}
+
+// DoDispatchKeyEvent sends Input.dispatchKeyEvent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDispatchKeyEvent(ctx context.Context, params *DispatchKeyEventParams) error {
+	if params == nil {
+		params = new(DispatchKeyEventParams)
+	}
+	return (&DispatchKeyEventRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Type of the key event.
-func (r *DispatchKeyEventRequest) Type(v string) *DispatchKeyEventRequest {
-	r.opts["type"] = v
+func (r *DispatchKeyEventRequest) Type(v DispatchKeyEventType) *DispatchKeyEventRequest {
+	r.params.Type = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
 func (r *DispatchKeyEventRequest) Modifiers(v int) *DispatchKeyEventRequest {
-	r.opts["modifiers"] = v
+	r.params.Modifiers = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970 (default: current time). (optional)
 func (r *DispatchKeyEventRequest) Timestamp(v float64) *DispatchKeyEventRequest {
-	r.opts["timestamp"] = v
+	r.params.Timestamp = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Text as generated by processing a virtual key code with a keyboard layout. Not needed for for <code>keyUp</code> and <code>rawKeyDown</code> events (default: "") (optional)
 func (r *DispatchKeyEventRequest) Text(v string) *DispatchKeyEventRequest {
-	r.opts["text"] = v
+	r.params.Text = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Text that would have been generated by the keyboard if no modifiers were pressed (except for shift). Useful for shortcut (accelerator) key handling (default: ""). (optional)
 func (r *DispatchKeyEventRequest) UnmodifiedText(v string) *DispatchKeyEventRequest {
-	r.opts["unmodifiedText"] = v
+	r.params.UnmodifiedText = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Unique key identifier (e.g., 'U+0041') (default: ""). (optional)
 func (r *DispatchKeyEventRequest) KeyIdentifier(v string) *DispatchKeyEventRequest {
-	r.opts["keyIdentifier"] = v
+	r.params.KeyIdentifier = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Unique DOM defined string value for each physical key (e.g., 'KeyA') (default: ""). (optional)
 func (r *DispatchKeyEventRequest) Code(v string) *DispatchKeyEventRequest {
-	r.opts["code"] = v
+	r.params.Code = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Unique DOM defined string value describing the meaning of the key in the context of active modifiers, keyboard layout, etc (e.g., 'AltGr') (default: ""). (optional)
 func (r *DispatchKeyEventRequest) Key(v string) *DispatchKeyEventRequest {
-	r.opts["key"] = v
+	r.params.Key = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // Windows virtual key code (default: 0). (optional)
 func (r *DispatchKeyEventRequest) WindowsVirtualKeyCode(v int) *DispatchKeyEventRequest {
-	r.opts["windowsVirtualKeyCode"] = v
+	r.params.WindowsVirtualKeyCode = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
 // Native virtual key code (default: 0). (optional)
 func (r *DispatchKeyEventRequest) NativeVirtualKeyCode(v int) *DispatchKeyEventRequest {
-	r.opts["nativeVirtualKeyCode"] = v
+	r.params.NativeVirtualKeyCode = &v
+	r.params.set |= 1 << 10
 	return r
 }
 
 // Whether the event was generated from auto repeat (default: false). (optional)
 func (r *DispatchKeyEventRequest) AutoRepeat(v bool) *DispatchKeyEventRequest {
-	r.opts["autoRepeat"] = v
+	r.params.AutoRepeat = &v
+	r.params.set |= 1 << 11
 	return r
 }
 
 // Whether the event was generated from the keypad (default: false). (optional)
 func (r *DispatchKeyEventRequest) IsKeypad(v bool) *DispatchKeyEventRequest {
-	r.opts["isKeypad"] = v
+	r.params.IsKeypad = &v
+	r.params.set |= 1 << 12
 	return r
 }
 
 // Whether the event was a system key event (default: false). (optional)
 func (r *DispatchKeyEventRequest) IsSystemKey(v bool) *DispatchKeyEventRequest {
-	r.opts["isSystemKey"] = v
+	r.params.IsSystemKey = &v
+	r.params.set |= 1 << 13
 	return r
 }
 
 func (r *DispatchKeyEventRequest) Do() error {
-	return r.client.Call("Input.dispatchKeyEvent", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DispatchKeyEventRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.dispatchKeyEvent", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DispatchKeyEventRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.dispatchKeyEvent", r.params, nil)
+}
+
+// DispatchMouseEventParams are the parameters of Input.dispatchMouseEvent.
+type DispatchMouseEventParams struct {
+	// Type of the mouse event.
+	Type DispatchMouseEventType `json:"type"`
+
+	// X coordinate of the event relative to the main frame's viewport.
+	X int `json:"x"`
+
+	// Y coordinate of the event relative to the main frame's viewport. 0 refers to the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
+	Y int `json:"y"`
+
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
+	Modifiers *int `json:"modifiers,omitempty"`
+
+	// Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970 (default: current time). (optional)
+	Timestamp *float64 `json:"timestamp,omitempty"`
+
+	// Mouse button (default: "none"). (optional)
+	Button *string `json:"button,omitempty"`
+
+	// Number of times the mouse button was clicked (default: 0). (optional)
+	ClickCount *int `json:"clickCount,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DispatchMouseEventParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Type == "" {
+		missing = append(missing, "type")
+	} else if !p.Type.IsValid() {
+		invalid = append(invalid, "type")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "y")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.dispatchMouseEvent", Missing: missing, Invalid: invalid}
 }
 
 type DispatchMouseEventRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DispatchMouseEventParams
 }
 
 // Dispatches a mouse event to the page.
 func (d *Client) DispatchMouseEvent() *DispatchMouseEventRequest {
-	return &DispatchMouseEventRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DispatchMouseEventRequest{client: d.Client, params: &DispatchMouseEventParams{set: 1}}
+}
+
+// DoDispatchMouseEvent sends Input.dispatchMouseEvent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDispatchMouseEvent(ctx context.Context, params *DispatchMouseEventParams) error {
+	if params == nil {
+		params = new(DispatchMouseEventParams)
+	}
+	return (&DispatchMouseEventRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Type of the mouse event.
-func (r *DispatchMouseEventRequest) Type(v string) *DispatchMouseEventRequest {
-	r.opts["type"] = v
+func (r *DispatchMouseEventRequest) Type(v DispatchMouseEventType) *DispatchMouseEventRequest {
+	r.params.Type = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // X coordinate of the event relative to the main frame's viewport.
 func (r *DispatchMouseEventRequest) X(v int) *DispatchMouseEventRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Y coordinate of the event relative to the main frame's viewport. 0 refers to the top of the viewport and Y increases as it proceeds towards the bottom of the viewport.
 func (r *DispatchMouseEventRequest) Y(v int) *DispatchMouseEventRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
 func (r *DispatchMouseEventRequest) Modifiers(v int) *DispatchMouseEventRequest {
-	r.opts["modifiers"] = v
+	r.params.Modifiers = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970 (default: current time). (optional)
 func (r *DispatchMouseEventRequest) Timestamp(v float64) *DispatchMouseEventRequest {
-	r.opts["timestamp"] = v
+	r.params.Timestamp = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Mouse button (default: "none"). (optional)
 func (r *DispatchMouseEventRequest) Button(v string) *DispatchMouseEventRequest {
-	r.opts["button"] = v
+	r.params.Button = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Number of times the mouse button was clicked (default: 0). (optional)
 func (r *DispatchMouseEventRequest) ClickCount(v int) *DispatchMouseEventRequest {
-	r.opts["clickCount"] = v
+	r.params.ClickCount = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 func (r *DispatchMouseEventRequest) Do() error {
-	return r.client.Call("Input.dispatchMouseEvent", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DispatchMouseEventRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.dispatchMouseEvent", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DispatchMouseEventRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.dispatchMouseEvent", r.params, nil)
+}
+
+// DispatchTouchEventParams are the parameters of Input.dispatchTouchEvent.
+type DispatchTouchEventParams struct {
+	// Type of the touch event.
+	Type DispatchTouchEventType `json:"type"`
+
+	// Touch points.
+	TouchPoints []*TouchPoint `json:"touchPoints"`
+
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
+	Modifiers *int `json:"modifiers,omitempty"`
+
+	// Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970 (default: current time). (optional)
+	Timestamp *float64 `json:"timestamp,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DispatchTouchEventParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Type == "" {
+		missing = append(missing, "type")
+	} else if !p.Type.IsValid() {
+		invalid = append(invalid, "type")
+	}
+	if p.TouchPoints == nil {
+		missing = append(missing, "touchPoints")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.dispatchTouchEvent", Missing: missing, Invalid: invalid}
 }
 
 type DispatchTouchEventRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DispatchTouchEventParams
 }
 
 // Dispatches a touch event to the page. (experimental)
 func (d *Client) DispatchTouchEvent() *DispatchTouchEventRequest {
-	return &DispatchTouchEventRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DispatchTouchEventRequest{client: d.Client, params: &DispatchTouchEventParams{set: 1}}
+}
+
+// DoDispatchTouchEvent sends Input.dispatchTouchEvent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDispatchTouchEvent(ctx context.Context, params *DispatchTouchEventParams) error {
+	if params == nil {
+		params = new(DispatchTouchEventParams)
+	}
+	return (&DispatchTouchEventRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Type of the touch event.
-func (r *DispatchTouchEventRequest) Type(v string) *DispatchTouchEventRequest {
-	r.opts["type"] = v
+func (r *DispatchTouchEventRequest) Type(v DispatchTouchEventType) *DispatchTouchEventRequest {
+	r.params.Type = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Touch points.
 func (r *DispatchTouchEventRequest) TouchPoints(v []*TouchPoint) *DispatchTouchEventRequest {
-	r.opts["touchPoints"] = v
+	r.params.TouchPoints = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
 func (r *DispatchTouchEventRequest) Modifiers(v int) *DispatchTouchEventRequest {
-	r.opts["modifiers"] = v
+	r.params.Modifiers = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970 (default: current time). (optional)
 func (r *DispatchTouchEventRequest) Timestamp(v float64) *DispatchTouchEventRequest {
-	r.opts["timestamp"] = v
+	r.params.Timestamp = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 func (r *DispatchTouchEventRequest) Do() error {
-	return r.client.Call("Input.dispatchTouchEvent", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DispatchTouchEventRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.dispatchTouchEvent", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DispatchTouchEventRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.dispatchTouchEvent", r.params, nil)
+}
+
+// EmulateTouchFromMouseEventParams are the parameters of Input.emulateTouchFromMouseEvent.
+type EmulateTouchFromMouseEventParams struct {
+	// Type of the mouse event.
+	Type EmulateTouchFromMouseEventType `json:"type"`
+
+	// X coordinate of the mouse pointer in DIP.
+	X int `json:"x"`
+
+	// Y coordinate of the mouse pointer in DIP.
+	Y int `json:"y"`
+
+	// Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970.
+	Timestamp float64 `json:"timestamp"`
+
+	// Mouse button.
+	Button string `json:"button"`
+
+	// X delta in DIP for mouse wheel event (default: 0). (optional)
+	DeltaX *float64 `json:"deltaX,omitempty"`
+
+	// Y delta in DIP for mouse wheel event (default: 0). (optional)
+	DeltaY *float64 `json:"deltaY,omitempty"`
+
+	// Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
+	Modifiers *int `json:"modifiers,omitempty"`
+
+	// Number of times the mouse button was clicked (default: 0). (optional)
+	ClickCount *int `json:"clickCount,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *EmulateTouchFromMouseEventParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Type == "" {
+		missing = append(missing, "type")
+	} else if !p.Type.IsValid() {
+		invalid = append(invalid, "type")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "y")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 {
+		missing = append(missing, "timestamp")
+	}
+	if p.set&1 != 0 && p.set&(1<<5) == 0 || p.set&1 == 0 && p.Button == "" {
+		missing = append(missing, "button")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.emulateTouchFromMouseEvent", Missing: missing, Invalid: invalid}
 }
 
 type EmulateTouchFromMouseEventRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EmulateTouchFromMouseEventParams
 }
 
 // Emulates touch event from the mouse event parameters. (experimental)
 func (d *Client) EmulateTouchFromMouseEvent() *EmulateTouchFromMouseEventRequest {
-	return &EmulateTouchFromMouseEventRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EmulateTouchFromMouseEventRequest{client: d.Client, params: &EmulateTouchFromMouseEventParams{set: 1}}
+}
+
+// DoEmulateTouchFromMouseEvent sends Input.emulateTouchFromMouseEvent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEmulateTouchFromMouseEvent(ctx context.Context, params *EmulateTouchFromMouseEventParams) error {
+	if params == nil {
+		params = new(EmulateTouchFromMouseEventParams)
+	}
+	return (&EmulateTouchFromMouseEventRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Type of the mouse event.
-func (r *EmulateTouchFromMouseEventRequest) Type(v string) *EmulateTouchFromMouseEventRequest {
-	r.opts["type"] = v
+func (r *EmulateTouchFromMouseEventRequest) Type(v EmulateTouchFromMouseEventType) *EmulateTouchFromMouseEventRequest {
+	r.params.Type = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // X coordinate of the mouse pointer in DIP.
 func (r *EmulateTouchFromMouseEventRequest) X(v int) *EmulateTouchFromMouseEventRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Y coordinate of the mouse pointer in DIP.
 func (r *EmulateTouchFromMouseEventRequest) Y(v int) *EmulateTouchFromMouseEventRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Time at which the event occurred. Measured in UTC time in seconds since January 1, 1970.
 func (r *EmulateTouchFromMouseEventRequest) Timestamp(v float64) *EmulateTouchFromMouseEventRequest {
-	r.opts["timestamp"] = v
+	r.params.Timestamp = v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Mouse button.
 func (r *EmulateTouchFromMouseEventRequest) Button(v string) *EmulateTouchFromMouseEventRequest {
-	r.opts["button"] = v
+	r.params.Button = v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // X delta in DIP for mouse wheel event (default: 0). (optional)
 func (r *EmulateTouchFromMouseEventRequest) DeltaX(v float64) *EmulateTouchFromMouseEventRequest {
-	r.opts["deltaX"] = v
+	r.params.DeltaX = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Y delta in DIP for mouse wheel event (default: 0). (optional)
 func (r *EmulateTouchFromMouseEventRequest) DeltaY(v float64) *EmulateTouchFromMouseEventRequest {
-	r.opts["deltaY"] = v
+	r.params.DeltaY = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Bit field representing pressed modifier keys. Alt=1, Ctrl=2, Meta/Command=4, Shift=8 (default: 0). (optional)
 func (r *EmulateTouchFromMouseEventRequest) Modifiers(v int) *EmulateTouchFromMouseEventRequest {
-	r.opts["modifiers"] = v
+	r.params.Modifiers = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // Number of times the mouse button was clicked (default: 0). (optional)
 func (r *EmulateTouchFromMouseEventRequest) ClickCount(v int) *EmulateTouchFromMouseEventRequest {
-	r.opts["clickCount"] = v
+	r.params.ClickCount = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
 func (r *EmulateTouchFromMouseEventRequest) Do() error {
-	return r.client.Call("Input.emulateTouchFromMouseEvent", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *EmulateTouchFromMouseEventRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.emulateTouchFromMouseEvent", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *EmulateTouchFromMouseEventRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.emulateTouchFromMouseEvent", r.params, nil)
+}
+
+// SynthesizePinchGestureParams are the parameters of Input.synthesizePinchGesture.
+type SynthesizePinchGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X int `json:"x"`
+
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y int `json:"y"`
+
+	// Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).
+	ScaleFactor float64 `json:"scaleFactor"`
+
+	// Relative pointer speed in pixels per second (default: 800). (optional)
+	RelativeSpeed *int `json:"relativeSpeed,omitempty"`
+
+	// Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). (optional)
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SynthesizePinchGestureParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "y")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "scaleFactor")
+	}
+	if p.GestureSourceType != nil && !p.GestureSourceType.IsValid() {
+		invalid = append(invalid, "gestureSourceType")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.synthesizePinchGesture", Missing: missing, Invalid: invalid}
 }
 
 type SynthesizePinchGestureRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SynthesizePinchGestureParams
 }
 
 // Synthesizes a pinch gesture over a time period by issuing appropriate touch events. (experimental)
 func (d *Client) SynthesizePinchGesture() *SynthesizePinchGestureRequest {
-	return &SynthesizePinchGestureRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SynthesizePinchGestureRequest{client: d.Client, params: &SynthesizePinchGestureParams{set: 1}}
+}
+
+// DoSynthesizePinchGesture sends Input.synthesizePinchGesture with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSynthesizePinchGesture(ctx context.Context, params *SynthesizePinchGestureParams) error {
+	if params == nil {
+		params = new(SynthesizePinchGestureParams)
+	}
+	return (&SynthesizePinchGestureRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // X coordinate of the start of the gesture in CSS pixels.
 func (r *SynthesizePinchGestureRequest) X(v int) *SynthesizePinchGestureRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Y coordinate of the start of the gesture in CSS pixels.
 func (r *SynthesizePinchGestureRequest) Y(v int) *SynthesizePinchGestureRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Relative scale factor after zooming (>1.0 zooms in, <1.0 zooms out).
 func (r *SynthesizePinchGestureRequest) ScaleFactor(v float64) *SynthesizePinchGestureRequest {
-	r.opts["scaleFactor"] = v
+	r.params.ScaleFactor = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Relative pointer speed in pixels per second (default: 800). (optional)
 func (r *SynthesizePinchGestureRequest) RelativeSpeed(v int) *SynthesizePinchGestureRequest {
-	r.opts["relativeSpeed"] = v
+	r.params.RelativeSpeed = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). (optional)
 func (r *SynthesizePinchGestureRequest) GestureSourceType(v GestureSourceType) *SynthesizePinchGestureRequest {
-	r.opts["gestureSourceType"] = v
+	r.params.GestureSourceType = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 func (r *SynthesizePinchGestureRequest) Do() error {
-	return r.client.Call("Input.synthesizePinchGesture", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SynthesizePinchGestureRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.synthesizePinchGesture", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SynthesizePinchGestureRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.synthesizePinchGesture", r.params, nil)
+}
+
+// SynthesizeScrollGestureParams are the parameters of Input.synthesizeScrollGesture.
+type SynthesizeScrollGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X int `json:"x"`
+
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y int `json:"y"`
+
+	// The distance to scroll along the X axis (positive to scroll left). (optional)
+	XDistance *int `json:"xDistance,omitempty"`
+
+	// The distance to scroll along the Y axis (positive to scroll up). (optional)
+	YDistance *int `json:"yDistance,omitempty"`
+
+	// The number of additional pixels to scroll back along the X axis, in addition to the given distance. (optional)
+	XOverscroll *int `json:"xOverscroll,omitempty"`
+
+	// The number of additional pixels to scroll back along the Y axis, in addition to the given distance. (optional)
+	YOverscroll *int `json:"yOverscroll,omitempty"`
+
+	// Prevent fling (default: true). (optional)
+	PreventFling *bool `json:"preventFling,omitempty"`
+
+	// Swipe speed in pixels per second (default: 800). (optional)
+	Speed *int `json:"speed,omitempty"`
+
+	// Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). (optional)
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
+
+	// The number of times to repeat the gesture (default: 0). (optional)
+	RepeatCount *int `json:"repeatCount,omitempty"`
+
+	// The number of milliseconds delay between each repeat. (default: 250). (optional)
+	RepeatDelayMs *int `json:"repeatDelayMs,omitempty"`
+
+	// The name of the interaction markers to generate, if not empty (default: ""). (optional)
+	InteractionMarkerName *string `json:"interactionMarkerName,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SynthesizeScrollGestureParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "y")
+	}
+	if p.GestureSourceType != nil && !p.GestureSourceType.IsValid() {
+		invalid = append(invalid, "gestureSourceType")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.synthesizeScrollGesture", Missing: missing, Invalid: invalid}
 }
 
 type SynthesizeScrollGestureRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SynthesizeScrollGestureParams
 }
 
 // Synthesizes a scroll gesture over a time period by issuing appropriate touch events. (experimental)
 func (d *Client) SynthesizeScrollGesture() *SynthesizeScrollGestureRequest {
-	return &SynthesizeScrollGestureRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SynthesizeScrollGestureRequest{client: d.Client, params: &SynthesizeScrollGestureParams{set: 1}}
+}
+
+// DoSynthesizeScrollGesture sends Input.synthesizeScrollGesture with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSynthesizeScrollGesture(ctx context.Context, params *SynthesizeScrollGestureParams) error {
+	if params == nil {
+		params = new(SynthesizeScrollGestureParams)
+	}
+	return (&SynthesizeScrollGestureRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // X coordinate of the start of the gesture in CSS pixels.
 func (r *SynthesizeScrollGestureRequest) X(v int) *SynthesizeScrollGestureRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Y coordinate of the start of the gesture in CSS pixels.
 func (r *SynthesizeScrollGestureRequest) Y(v int) *SynthesizeScrollGestureRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // The distance to scroll along the X axis (positive to scroll left). (optional)
 func (r *SynthesizeScrollGestureRequest) XDistance(v int) *SynthesizeScrollGestureRequest {
-	r.opts["xDistance"] = v
+	r.params.XDistance = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // The distance to scroll along the Y axis (positive to scroll up). (optional)
 func (r *SynthesizeScrollGestureRequest) YDistance(v int) *SynthesizeScrollGestureRequest {
-	r.opts["yDistance"] = v
+	r.params.YDistance = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // The number of additional pixels to scroll back along the X axis, in addition to the given distance. (optional)
 func (r *SynthesizeScrollGestureRequest) XOverscroll(v int) *SynthesizeScrollGestureRequest {
-	r.opts["xOverscroll"] = v
+	r.params.XOverscroll = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // The number of additional pixels to scroll back along the Y axis, in addition to the given distance. (optional)
 func (r *SynthesizeScrollGestureRequest) YOverscroll(v int) *SynthesizeScrollGestureRequest {
-	r.opts["yOverscroll"] = v
+	r.params.YOverscroll = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Prevent fling (default: true). (optional)
 func (r *SynthesizeScrollGestureRequest) PreventFling(v bool) *SynthesizeScrollGestureRequest {
-	r.opts["preventFling"] = v
+	r.params.PreventFling = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Swipe speed in pixels per second (default: 800). (optional)
 func (r *SynthesizeScrollGestureRequest) Speed(v int) *SynthesizeScrollGestureRequest {
-	r.opts["speed"] = v
+	r.params.Speed = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). (optional)
 func (r *SynthesizeScrollGestureRequest) GestureSourceType(v GestureSourceType) *SynthesizeScrollGestureRequest {
-	r.opts["gestureSourceType"] = v
+	r.params.GestureSourceType = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
 // The number of times to repeat the gesture (default: 0). (optional)
 func (r *SynthesizeScrollGestureRequest) RepeatCount(v int) *SynthesizeScrollGestureRequest {
-	r.opts["repeatCount"] = v
+	r.params.RepeatCount = &v
+	r.params.set |= 1 << 10
 	return r
 }
 
 // The number of milliseconds delay between each repeat. (default: 250). (optional)
 func (r *SynthesizeScrollGestureRequest) RepeatDelayMs(v int) *SynthesizeScrollGestureRequest {
-	r.opts["repeatDelayMs"] = v
+	r.params.RepeatDelayMs = &v
+	r.params.set |= 1 << 11
 	return r
 }
 
 // The name of the interaction markers to generate, if not empty (default: ""). (optional)
 func (r *SynthesizeScrollGestureRequest) InteractionMarkerName(v string) *SynthesizeScrollGestureRequest {
-	r.opts["interactionMarkerName"] = v
+	r.params.InteractionMarkerName = &v
+	r.params.set |= 1 << 12
 	return r
 }
 
 func (r *SynthesizeScrollGestureRequest) Do() error {
-	return r.client.Call("Input.synthesizeScrollGesture", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SynthesizeScrollGestureRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.synthesizeScrollGesture", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SynthesizeScrollGestureRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.synthesizeScrollGesture", r.params, nil)
+}
+
+// SynthesizeTapGestureParams are the parameters of Input.synthesizeTapGesture.
+type SynthesizeTapGestureParams struct {
+	// X coordinate of the start of the gesture in CSS pixels.
+	X int `json:"x"`
+
+	// Y coordinate of the start of the gesture in CSS pixels.
+	Y int `json:"y"`
+
+	// Duration between touchdown and touchup events in ms (default: 50). (optional)
+	Duration *int `json:"duration,omitempty"`
+
+	// Number of times to perform the tap (e.g. 2 for double tap, default: 1). (optional)
+	TapCount *int `json:"tapCount,omitempty"`
+
+	// Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). (optional)
+	GestureSourceType *GestureSourceType `json:"gestureSourceType,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SynthesizeTapGestureParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "y")
+	}
+	if p.GestureSourceType != nil && !p.GestureSourceType.IsValid() {
+		invalid = append(invalid, "gestureSourceType")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Input.synthesizeTapGesture", Missing: missing, Invalid: invalid}
 }
 
 type SynthesizeTapGestureRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SynthesizeTapGestureParams
 }
 
 // Synthesizes a tap gesture over a time period by issuing appropriate touch events. (experimental)
 func (d *Client) SynthesizeTapGesture() *SynthesizeTapGestureRequest {
-	return &SynthesizeTapGestureRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SynthesizeTapGestureRequest{client: d.Client, params: &SynthesizeTapGestureParams{set: 1}}
+}
+
+// DoSynthesizeTapGesture sends Input.synthesizeTapGesture with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSynthesizeTapGesture(ctx context.Context, params *SynthesizeTapGestureParams) error {
+	if params == nil {
+		params = new(SynthesizeTapGestureParams)
+	}
+	return (&SynthesizeTapGestureRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // X coordinate of the start of the gesture in CSS pixels.
 func (r *SynthesizeTapGestureRequest) X(v int) *SynthesizeTapGestureRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Y coordinate of the start of the gesture in CSS pixels.
 func (r *SynthesizeTapGestureRequest) Y(v int) *SynthesizeTapGestureRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Duration between touchdown and touchup events in ms (default: 50). (optional)
 func (r *SynthesizeTapGestureRequest) Duration(v int) *SynthesizeTapGestureRequest {
-	r.opts["duration"] = v
+	r.params.Duration = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Number of times to perform the tap (e.g. 2 for double tap, default: 1). (optional)
 func (r *SynthesizeTapGestureRequest) TapCount(v int) *SynthesizeTapGestureRequest {
-	r.opts["tapCount"] = v
+	r.params.TapCount = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Which type of input events to be generated (default: 'default', which queries the platform for the preferred input type). (optional)
 func (r *SynthesizeTapGestureRequest) GestureSourceType(v GestureSourceType) *SynthesizeTapGestureRequest {
-	r.opts["gestureSourceType"] = v
+	r.params.GestureSourceType = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 func (r *SynthesizeTapGestureRequest) Do() error {
-	return r.client.Call("Input.synthesizeTapGesture", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SynthesizeTapGestureRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Input.synthesizeTapGesture", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SynthesizeTapGestureRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Input.synthesizeTapGesture", r.params, nil)
 }
 
 func init() {
@@ -2,6 +2,8 @@
 package inspector
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -10,32 +12,76 @@ type Client struct {
 	*rpc.Client
 }
 
+// EnableParams are the parameters of Inspector.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables inspector domain notifications.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Inspector.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Inspector.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Inspector", r.params)
+}
+
+// DisableParams are the parameters of Inspector.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables inspector domain notifications.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Inspector.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Inspector.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Inspector", r.params)
 }
 
 func init() {
@@ -49,6 +95,84 @@ type DetachedEvent struct {
 	Reason string `json:"reason"`
 }
 
+// Fired when remote debugging connection is about to be terminated. Contains detach reason.
+func (d *Client) OnDetached(fn func(*DetachedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Inspector.detached", func(e interface{}) {
+		fn(e.(*DetachedEvent))
+	}, opts...)
+}
+
+// FilterDetached is rpc.WithFilter for OnDetached.
+func FilterDetached(fn func(*DetachedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DetachedEvent))
+	})
+}
+
+// DetachedWaiter waits for a Inspector.detached event. It is created by WaitForDetached.
+type DetachedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DetachedWaiter) Wait() (*DetachedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DetachedEvent), nil
+}
+
+// WaitForDetached arms a waiter for the first Inspector.detached event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDetached(ctx context.Context, predicate func(*DetachedEvent) bool) *DetachedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DetachedEvent))
+		}
+	}
+	return &DetachedWaiter{d.Client.WaitFor(ctx, "Inspector.detached", p)}
+}
+
 // Fired when debugging target has crashed
 type TargetCrashedEvent struct {
 }
+
+// Fired when debugging target has crashed
+func (d *Client) OnTargetCrashed(fn func(*TargetCrashedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Inspector.targetCrashed", func(e interface{}) {
+		fn(e.(*TargetCrashedEvent))
+	}, opts...)
+}
+
+// FilterTargetCrashed is rpc.WithFilter for OnTargetCrashed.
+func FilterTargetCrashed(fn func(*TargetCrashedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*TargetCrashedEvent))
+	})
+}
+
+// TargetCrashedWaiter waits for a Inspector.targetCrashed event. It is created by WaitForTargetCrashed.
+type TargetCrashedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *TargetCrashedWaiter) Wait() (*TargetCrashedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*TargetCrashedEvent), nil
+}
+
+// WaitForTargetCrashed arms a waiter for the first Inspector.targetCrashed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForTargetCrashed(ctx context.Context, predicate func(*TargetCrashedEvent) bool) *TargetCrashedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*TargetCrashedEvent))
+		}
+	}
+	return &TargetCrashedWaiter{d.Client.WaitFor(ctx, "Inspector.targetCrashed", p)}
+}
@@ -2,6 +2,8 @@
 package io
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -12,31 +14,71 @@ type Client struct {
 
 type StreamHandle string
 
+// ReadParams are the parameters of IO.read.
+type ReadParams struct {
+	// Handle of the stream to read.
+	Handle StreamHandle `json:"handle"`
+
+	// Seek to the specified offset before reading (if not specificed, proceed with offset following the last read). (optional)
+	Offset *int `json:"offset,omitempty"`
+
+	// Maximum number of bytes to read (left upon the agent discretion if not specified). (optional)
+	Size *int `json:"size,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReadParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Handle == "" {
+		missing = append(missing, "handle")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "IO.read", Missing: missing, Invalid: invalid}
+}
+
 type ReadRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReadParams
 }
 
 // Read a chunk of the stream
 func (d *Client) Read() *ReadRequest {
-	return &ReadRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReadRequest{client: d.Client, params: &ReadParams{set: 1}}
+}
+
+// DoRead sends IO.read with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRead(ctx context.Context, params *ReadParams) (*ReadResult, error) {
+	if params == nil {
+		params = new(ReadParams)
+	}
+	return (&ReadRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Handle of the stream to read.
 func (r *ReadRequest) Handle(v StreamHandle) *ReadRequest {
-	r.opts["handle"] = v
+	r.params.Handle = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Seek to the specified offset before reading (if not specificed, proceed with offset following the last read). (optional)
 func (r *ReadRequest) Offset(v int) *ReadRequest {
-	r.opts["offset"] = v
+	r.params.Offset = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Maximum number of bytes to read (left upon the agent discretion if not specified). (optional)
 func (r *ReadRequest) Size(v int) *ReadRequest {
-	r.opts["size"] = v
+	r.params.Size = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -49,29 +91,92 @@ type ReadResult struct {
 }
 
 func (r *ReadRequest) Do() (*ReadResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *ReadRequest) DoContext(ctx context.Context) (*ReadResult, error) {
 	var result ReadResult
-	err := r.client.Call("IO.read", r.opts, &result)
+	err := r.client.CallContext(ctx, "IO.read", r.params, &result)
 	return &result, err
 }
 
+type ReadFuture struct {
+	*rpc.Call
+	result ReadResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *ReadFuture) Get() (*ReadResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *ReadRequest) DoAsync(ctx context.Context) *ReadFuture {
+	f := new(ReadFuture)
+	f.Call = r.client.Go(ctx, "IO.read", r.params, &f.result)
+	return f
+}
+
+// CloseParams are the parameters of IO.close.
+type CloseParams struct {
+	// Handle of the stream to close.
+	Handle StreamHandle `json:"handle"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CloseParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Handle == "" {
+		missing = append(missing, "handle")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "IO.close", Missing: missing, Invalid: invalid}
+}
+
 type CloseRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CloseParams
 }
 
 // Close the stream, discard any temporary backing storage.
 func (d *Client) Close() *CloseRequest {
-	return &CloseRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CloseRequest{client: d.Client, params: &CloseParams{set: 1}}
+}
+
+// DoClose sends IO.close with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClose(ctx context.Context, params *CloseParams) error {
+	if params == nil {
+		params = new(CloseParams)
+	}
+	return (&CloseRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Handle of the stream to close.
 func (r *CloseRequest) Handle(v StreamHandle) *CloseRequest {
-	r.opts["handle"] = v
+	r.params.Handle = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *CloseRequest) Do() error {
-	return r.client.Call("IO.close", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *CloseRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "IO.close", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *CloseRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "IO.close", r.params, nil)
 }
 
 func init() {
@@ -2,6 +2,8 @@
 package layertree
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/dom"
@@ -27,7 +29,7 @@ type ScrollRect struct {
 	Rect *dom.Rect `json:"rect"`
 
 	// Reason for rectangle to force scrolling on the main thread
-	Type string `json:"type"`
+	Type ScrollRectType `json:"type"`
 }
 
 // Serialized fragment of layer picture along with its offset within the layer.
@@ -50,10 +52,10 @@ type Layer struct {
 	LayerId LayerId `json:"layerId"`
 
 	// The id of parent (not present for root). (optional)
-	ParentLayerId LayerId `json:"parentLayerId,omitempty"`
+	ParentLayerId *LayerId `json:"parentLayerId,omitempty"`
 
 	// The backend id for the node associated with this layer. (optional)
-	BackendNodeId dom.BackendNodeId `json:"backendNodeId,omitempty"`
+	BackendNodeId *dom.BackendNodeId `json:"backendNodeId,omitempty"`
 
 	// Offset from parent layer, X coordinate.
 	OffsetX float64 `json:"offsetX"`
@@ -71,13 +73,13 @@ type Layer struct {
 	Transform []float64 `json:"transform,omitempty"`
 
 	// Transform anchor point X, absent if no transform specified (optional)
-	AnchorX float64 `json:"anchorX,omitempty"`
+	AnchorX *float64 `json:"anchorX,omitempty"`
 
 	// Transform anchor point Y, absent if no transform specified (optional)
-	AnchorY float64 `json:"anchorY,omitempty"`
+	AnchorY *float64 `json:"anchorY,omitempty"`
 
 	// Transform anchor point Z, absent if no transform specified (optional)
-	AnchorZ float64 `json:"anchorZ,omitempty"`
+	AnchorZ *float64 `json:"anchorZ,omitempty"`
 
 	// Indicates how many time this layer has painted.
 	PaintCount int `json:"paintCount"`
@@ -86,7 +88,7 @@ type Layer struct {
 	DrawsContent bool `json:"drawsContent"`
 
 	// Set if layer is not visible. (optional)
-	Invisible bool `json:"invisible,omitempty"`
+	Invisible *bool `json:"invisible,omitempty"`
 
 	// Rectangles scrolling on main thread only. (optional)
 	ScrollRects []*ScrollRect `json:"scrollRects,omitempty"`
@@ -96,47 +98,142 @@ type Layer struct {
 
 type PaintProfile []float64
 
+// Values of the type property of ScrollRect.
+
+type ScrollRectType string
+
+const (
+	ScrollRectTypeRepaintsOnScroll  ScrollRectType = "RepaintsOnScroll"
+	ScrollRectTypeTouchEventHandler ScrollRectType = "TouchEventHandler"
+	ScrollRectTypeWheelEventHandler ScrollRectType = "WheelEventHandler"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ScrollRectType) IsValid() bool {
+	switch v {
+	case ScrollRectTypeRepaintsOnScroll, ScrollRectTypeTouchEventHandler, ScrollRectTypeWheelEventHandler:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of LayerTree.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables compositing tree inspection.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends LayerTree.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("LayerTree.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "LayerTree", r.params)
+}
+
+// DisableParams are the parameters of LayerTree.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables compositing tree inspection.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends LayerTree.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("LayerTree.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "LayerTree", r.params)
+}
+
+// CompositingReasonsParams are the parameters of LayerTree.compositingReasons.
+type CompositingReasonsParams struct {
+	// The id of the layer for which we want to get the reasons it was composited.
+	LayerId LayerId `json:"layerId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CompositingReasonsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.LayerId == "" {
+		missing = append(missing, "layerId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "LayerTree.compositingReasons", Missing: missing, Invalid: invalid}
 }
 
 type CompositingReasonsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CompositingReasonsParams
 }
 
 // Provides the reasons why the given layer was composited.
 func (d *Client) CompositingReasons() *CompositingReasonsRequest {
-	return &CompositingReasonsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CompositingReasonsRequest{client: d.Client, params: &CompositingReasonsParams{set: 1}}
+}
+
+// DoCompositingReasons sends LayerTree.compositingReasons with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCompositingReasons(ctx context.Context, params *CompositingReasonsParams) (*CompositingReasonsResult, error) {
+	if params == nil {
+		params = new(CompositingReasonsParams)
+	}
+	return (&CompositingReasonsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The id of the layer for which we want to get the reasons it was composited.
 func (r *CompositingReasonsRequest) LayerId(v LayerId) *CompositingReasonsRequest {
-	r.opts["layerId"] = v
+	r.params.LayerId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -146,24 +243,78 @@ type CompositingReasonsResult struct {
 }
 
 func (r *CompositingReasonsRequest) Do() (*CompositingReasonsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CompositingReasonsRequest) DoContext(ctx context.Context) (*CompositingReasonsResult, error) {
 	var result CompositingReasonsResult
-	err := r.client.Call("LayerTree.compositingReasons", r.opts, &result)
+	err := r.client.CallContext(ctx, "LayerTree.compositingReasons", r.params, &result)
 	return &result, err
 }
 
+type CompositingReasonsFuture struct {
+	*rpc.Call
+	result CompositingReasonsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CompositingReasonsFuture) Get() (*CompositingReasonsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CompositingReasonsRequest) DoAsync(ctx context.Context) *CompositingReasonsFuture {
+	f := new(CompositingReasonsFuture)
+	f.Call = r.client.Go(ctx, "LayerTree.compositingReasons", r.params, &f.result)
+	return f
+}
+
+// MakeSnapshotParams are the parameters of LayerTree.makeSnapshot.
+type MakeSnapshotParams struct {
+	// The id of the layer.
+	LayerId LayerId `json:"layerId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *MakeSnapshotParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.LayerId == "" {
+		missing = append(missing, "layerId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "LayerTree.makeSnapshot", Missing: missing, Invalid: invalid}
+}
+
 type MakeSnapshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *MakeSnapshotParams
 }
 
 // Returns the layer snapshot identifier.
 func (d *Client) MakeSnapshot() *MakeSnapshotRequest {
-	return &MakeSnapshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &MakeSnapshotRequest{client: d.Client, params: &MakeSnapshotParams{set: 1}}
+}
+
+// DoMakeSnapshot sends LayerTree.makeSnapshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoMakeSnapshot(ctx context.Context, params *MakeSnapshotParams) (*MakeSnapshotResult, error) {
+	if params == nil {
+		params = new(MakeSnapshotParams)
+	}
+	return (&MakeSnapshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The id of the layer.
 func (r *MakeSnapshotRequest) LayerId(v LayerId) *MakeSnapshotRequest {
-	r.opts["layerId"] = v
+	r.params.LayerId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -173,24 +324,78 @@ type MakeSnapshotResult struct {
 }
 
 func (r *MakeSnapshotRequest) Do() (*MakeSnapshotResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *MakeSnapshotRequest) DoContext(ctx context.Context) (*MakeSnapshotResult, error) {
 	var result MakeSnapshotResult
-	err := r.client.Call("LayerTree.makeSnapshot", r.opts, &result)
+	err := r.client.CallContext(ctx, "LayerTree.makeSnapshot", r.params, &result)
 	return &result, err
 }
 
+type MakeSnapshotFuture struct {
+	*rpc.Call
+	result MakeSnapshotResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *MakeSnapshotFuture) Get() (*MakeSnapshotResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *MakeSnapshotRequest) DoAsync(ctx context.Context) *MakeSnapshotFuture {
+	f := new(MakeSnapshotFuture)
+	f.Call = r.client.Go(ctx, "LayerTree.makeSnapshot", r.params, &f.result)
+	return f
+}
+
+// LoadSnapshotParams are the parameters of LayerTree.loadSnapshot.
+type LoadSnapshotParams struct {
+	// An array of tiles composing the snapshot.
+	Tiles []*PictureTile `json:"tiles"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *LoadSnapshotParams) Validate() error {
+	var missing, invalid []string
+	if p.Tiles == nil {
+		missing = append(missing, "tiles")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "LayerTree.loadSnapshot", Missing: missing, Invalid: invalid}
+}
+
 type LoadSnapshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *LoadSnapshotParams
 }
 
 // Returns the snapshot identifier.
 func (d *Client) LoadSnapshot() *LoadSnapshotRequest {
-	return &LoadSnapshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &LoadSnapshotRequest{client: d.Client, params: &LoadSnapshotParams{set: 1}}
+}
+
+// DoLoadSnapshot sends LayerTree.loadSnapshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoLoadSnapshot(ctx context.Context, params *LoadSnapshotParams) (*LoadSnapshotResult, error) {
+	if params == nil {
+		params = new(LoadSnapshotParams)
+	}
+	return (&LoadSnapshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // An array of tiles composing the snapshot.
 func (r *LoadSnapshotRequest) Tiles(v []*PictureTile) *LoadSnapshotRequest {
-	r.opts["tiles"] = v
+	r.params.Tiles = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -200,61 +405,168 @@ type LoadSnapshotResult struct {
 }
 
 func (r *LoadSnapshotRequest) Do() (*LoadSnapshotResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *LoadSnapshotRequest) DoContext(ctx context.Context) (*LoadSnapshotResult, error) {
 	var result LoadSnapshotResult
-	err := r.client.Call("LayerTree.loadSnapshot", r.opts, &result)
+	err := r.client.CallContext(ctx, "LayerTree.loadSnapshot", r.params, &result)
 	return &result, err
 }
 
+type LoadSnapshotFuture struct {
+	*rpc.Call
+	result LoadSnapshotResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *LoadSnapshotFuture) Get() (*LoadSnapshotResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *LoadSnapshotRequest) DoAsync(ctx context.Context) *LoadSnapshotFuture {
+	f := new(LoadSnapshotFuture)
+	f.Call = r.client.Go(ctx, "LayerTree.loadSnapshot", r.params, &f.result)
+	return f
+}
+
+// ReleaseSnapshotParams are the parameters of LayerTree.releaseSnapshot.
+type ReleaseSnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotId SnapshotId `json:"snapshotId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReleaseSnapshotParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SnapshotId == "" {
+		missing = append(missing, "snapshotId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "LayerTree.releaseSnapshot", Missing: missing, Invalid: invalid}
+}
+
 type ReleaseSnapshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReleaseSnapshotParams
 }
 
 // Releases layer snapshot captured by the back-end.
 func (d *Client) ReleaseSnapshot() *ReleaseSnapshotRequest {
-	return &ReleaseSnapshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReleaseSnapshotRequest{client: d.Client, params: &ReleaseSnapshotParams{set: 1}}
+}
+
+// DoReleaseSnapshot sends LayerTree.releaseSnapshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoReleaseSnapshot(ctx context.Context, params *ReleaseSnapshotParams) error {
+	if params == nil {
+		params = new(ReleaseSnapshotParams)
+	}
+	return (&ReleaseSnapshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The id of the layer snapshot.
 func (r *ReleaseSnapshotRequest) SnapshotId(v SnapshotId) *ReleaseSnapshotRequest {
-	r.opts["snapshotId"] = v
+	r.params.SnapshotId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ReleaseSnapshotRequest) Do() error {
-	return r.client.Call("LayerTree.releaseSnapshot", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ReleaseSnapshotRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "LayerTree.releaseSnapshot", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ReleaseSnapshotRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "LayerTree.releaseSnapshot", r.params, nil)
+}
+
+// ProfileSnapshotParams are the parameters of LayerTree.profileSnapshot.
+type ProfileSnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotId SnapshotId `json:"snapshotId"`
+
+	// The maximum number of times to replay the snapshot (1, if not specified). (optional)
+	MinRepeatCount *int `json:"minRepeatCount,omitempty"`
+
+	// The minimum duration (in seconds) to replay the snapshot. (optional)
+	MinDuration *float64 `json:"minDuration,omitempty"`
+
+	// The clip rectangle to apply when replaying the snapshot. (optional)
+	ClipRect *dom.Rect `json:"clipRect,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ProfileSnapshotParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SnapshotId == "" {
+		missing = append(missing, "snapshotId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "LayerTree.profileSnapshot", Missing: missing, Invalid: invalid}
 }
 
 type ProfileSnapshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ProfileSnapshotParams
 }
 
 func (d *Client) ProfileSnapshot() *ProfileSnapshotRequest {
-	return &ProfileSnapshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ProfileSnapshotRequest{client: d.Client, params: &ProfileSnapshotParams{set: 1}}
+}
+
+// DoProfileSnapshot sends LayerTree.profileSnapshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoProfileSnapshot(ctx context.Context, params *ProfileSnapshotParams) (*ProfileSnapshotResult, error) {
+	if params == nil {
+		params = new(ProfileSnapshotParams)
+	}
+	return (&ProfileSnapshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The id of the layer snapshot.
 func (r *ProfileSnapshotRequest) SnapshotId(v SnapshotId) *ProfileSnapshotRequest {
-	r.opts["snapshotId"] = v
+	r.params.SnapshotId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The maximum number of times to replay the snapshot (1, if not specified). (optional)
 func (r *ProfileSnapshotRequest) MinRepeatCount(v int) *ProfileSnapshotRequest {
-	r.opts["minRepeatCount"] = v
+	r.params.MinRepeatCount = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // The minimum duration (in seconds) to replay the snapshot. (optional)
 func (r *ProfileSnapshotRequest) MinDuration(v float64) *ProfileSnapshotRequest {
-	r.opts["minDuration"] = v
+	r.params.MinDuration = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // The clip rectangle to apply when replaying the snapshot. (optional)
 func (r *ProfileSnapshotRequest) ClipRect(v *dom.Rect) *ProfileSnapshotRequest {
-	r.opts["clipRect"] = v
+	r.params.ClipRect = v
+	r.params.set |= 1 << 4
 	return r
 }
 
@@ -264,42 +576,108 @@ type ProfileSnapshotResult struct {
 }
 
 func (r *ProfileSnapshotRequest) Do() (*ProfileSnapshotResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *ProfileSnapshotRequest) DoContext(ctx context.Context) (*ProfileSnapshotResult, error) {
 	var result ProfileSnapshotResult
-	err := r.client.Call("LayerTree.profileSnapshot", r.opts, &result)
+	err := r.client.CallContext(ctx, "LayerTree.profileSnapshot", r.params, &result)
 	return &result, err
 }
 
+type ProfileSnapshotFuture struct {
+	*rpc.Call
+	result ProfileSnapshotResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *ProfileSnapshotFuture) Get() (*ProfileSnapshotResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *ProfileSnapshotRequest) DoAsync(ctx context.Context) *ProfileSnapshotFuture {
+	f := new(ProfileSnapshotFuture)
+	f.Call = r.client.Go(ctx, "LayerTree.profileSnapshot", r.params, &f.result)
+	return f
+}
+
+// ReplaySnapshotParams are the parameters of LayerTree.replaySnapshot.
+type ReplaySnapshotParams struct {
+	// The id of the layer snapshot.
+	SnapshotId SnapshotId `json:"snapshotId"`
+
+	// The first step to replay from (replay from the very start if not specified). (optional)
+	FromStep *int `json:"fromStep,omitempty"`
+
+	// The last step to replay to (replay till the end if not specified). (optional)
+	ToStep *int `json:"toStep,omitempty"`
+
+	// The scale to apply while replaying (defaults to 1). (optional)
+	Scale *float64 `json:"scale,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReplaySnapshotParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SnapshotId == "" {
+		missing = append(missing, "snapshotId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "LayerTree.replaySnapshot", Missing: missing, Invalid: invalid}
+}
+
 type ReplaySnapshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReplaySnapshotParams
 }
 
 // Replays the layer snapshot and returns the resulting bitmap.
 func (d *Client) ReplaySnapshot() *ReplaySnapshotRequest {
-	return &ReplaySnapshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReplaySnapshotRequest{client: d.Client, params: &ReplaySnapshotParams{set: 1}}
+}
+
+// DoReplaySnapshot sends LayerTree.replaySnapshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoReplaySnapshot(ctx context.Context, params *ReplaySnapshotParams) (*ReplaySnapshotResult, error) {
+	if params == nil {
+		params = new(ReplaySnapshotParams)
+	}
+	return (&ReplaySnapshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The id of the layer snapshot.
 func (r *ReplaySnapshotRequest) SnapshotId(v SnapshotId) *ReplaySnapshotRequest {
-	r.opts["snapshotId"] = v
+	r.params.SnapshotId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The first step to replay from (replay from the very start if not specified). (optional)
 func (r *ReplaySnapshotRequest) FromStep(v int) *ReplaySnapshotRequest {
-	r.opts["fromStep"] = v
+	r.params.FromStep = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // The last step to replay to (replay till the end if not specified). (optional)
 func (r *ReplaySnapshotRequest) ToStep(v int) *ReplaySnapshotRequest {
-	r.opts["toStep"] = v
+	r.params.ToStep = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // The scale to apply while replaying (defaults to 1). (optional)
 func (r *ReplaySnapshotRequest) Scale(v float64) *ReplaySnapshotRequest {
-	r.opts["scale"] = v
+	r.params.Scale = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
@@ -309,24 +687,78 @@ type ReplaySnapshotResult struct {
 }
 
 func (r *ReplaySnapshotRequest) Do() (*ReplaySnapshotResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *ReplaySnapshotRequest) DoContext(ctx context.Context) (*ReplaySnapshotResult, error) {
 	var result ReplaySnapshotResult
-	err := r.client.Call("LayerTree.replaySnapshot", r.opts, &result)
+	err := r.client.CallContext(ctx, "LayerTree.replaySnapshot", r.params, &result)
 	return &result, err
 }
 
+type ReplaySnapshotFuture struct {
+	*rpc.Call
+	result ReplaySnapshotResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *ReplaySnapshotFuture) Get() (*ReplaySnapshotResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *ReplaySnapshotRequest) DoAsync(ctx context.Context) *ReplaySnapshotFuture {
+	f := new(ReplaySnapshotFuture)
+	f.Call = r.client.Go(ctx, "LayerTree.replaySnapshot", r.params, &f.result)
+	return f
+}
+
+// SnapshotCommandLogParams are the parameters of LayerTree.snapshotCommandLog.
+type SnapshotCommandLogParams struct {
+	// The id of the layer snapshot.
+	SnapshotId SnapshotId `json:"snapshotId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SnapshotCommandLogParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SnapshotId == "" {
+		missing = append(missing, "snapshotId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "LayerTree.snapshotCommandLog", Missing: missing, Invalid: invalid}
+}
+
 type SnapshotCommandLogRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SnapshotCommandLogParams
 }
 
 // Replays the layer snapshot and returns canvas log.
 func (d *Client) SnapshotCommandLog() *SnapshotCommandLogRequest {
-	return &SnapshotCommandLogRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SnapshotCommandLogRequest{client: d.Client, params: &SnapshotCommandLogParams{set: 1}}
+}
+
+// DoSnapshotCommandLog sends LayerTree.snapshotCommandLog with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSnapshotCommandLog(ctx context.Context, params *SnapshotCommandLogParams) (*SnapshotCommandLogResult, error) {
+	if params == nil {
+		params = new(SnapshotCommandLogParams)
+	}
+	return (&SnapshotCommandLogRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The id of the layer snapshot.
 func (r *SnapshotCommandLogRequest) SnapshotId(v SnapshotId) *SnapshotCommandLogRequest {
-	r.opts["snapshotId"] = v
+	r.params.SnapshotId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -336,11 +768,33 @@ type SnapshotCommandLogResult struct {
 }
 
 func (r *SnapshotCommandLogRequest) Do() (*SnapshotCommandLogResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SnapshotCommandLogRequest) DoContext(ctx context.Context) (*SnapshotCommandLogResult, error) {
 	var result SnapshotCommandLogResult
-	err := r.client.Call("LayerTree.snapshotCommandLog", r.opts, &result)
+	err := r.client.CallContext(ctx, "LayerTree.snapshotCommandLog", r.params, &result)
 	return &result, err
 }
 
+type SnapshotCommandLogFuture struct {
+	*rpc.Call
+	result SnapshotCommandLogResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SnapshotCommandLogFuture) Get() (*SnapshotCommandLogResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SnapshotCommandLogRequest) DoAsync(ctx context.Context) *SnapshotCommandLogFuture {
+	f := new(SnapshotCommandLogFuture)
+	f.Call = r.client.Go(ctx, "LayerTree.snapshotCommandLog", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["LayerTree.layerTreeDidChange"] = func() interface{} { return new(LayerTreeDidChangeEvent) }
 	rpc.EventTypes["LayerTree.layerPainted"] = func() interface{} { return new(LayerPaintedEvent) }
@@ -348,7 +802,45 @@ func init() {
 
 type LayerTreeDidChangeEvent struct {
 	// Layer tree, absent if not in the comspositing mode. (optional)
-	Layers []*Layer `json:"layers"`
+	Layers []*Layer `json:"layers,omitempty"`
+}
+
+func (d *Client) OnLayerTreeDidChange(fn func(*LayerTreeDidChangeEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("LayerTree.layerTreeDidChange", func(e interface{}) {
+		fn(e.(*LayerTreeDidChangeEvent))
+	}, opts...)
+}
+
+// FilterLayerTreeDidChange is rpc.WithFilter for OnLayerTreeDidChange.
+func FilterLayerTreeDidChange(fn func(*LayerTreeDidChangeEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*LayerTreeDidChangeEvent))
+	})
+}
+
+// LayerTreeDidChangeWaiter waits for a LayerTree.layerTreeDidChange event. It is created by WaitForLayerTreeDidChange.
+type LayerTreeDidChangeWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *LayerTreeDidChangeWaiter) Wait() (*LayerTreeDidChangeEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*LayerTreeDidChangeEvent), nil
+}
+
+// WaitForLayerTreeDidChange arms a waiter for the first LayerTree.layerTreeDidChange event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForLayerTreeDidChange(ctx context.Context, predicate func(*LayerTreeDidChangeEvent) bool) *LayerTreeDidChangeWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*LayerTreeDidChangeEvent))
+		}
+	}
+	return &LayerTreeDidChangeWaiter{d.Client.WaitFor(ctx, "LayerTree.layerTreeDidChange", p)}
 }
 
 type LayerPaintedEvent struct {
@@ -358,3 +850,41 @@ type LayerPaintedEvent struct {
 	// Clip rectangle.
 	Clip *dom.Rect `json:"clip"`
 }
+
+func (d *Client) OnLayerPainted(fn func(*LayerPaintedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("LayerTree.layerPainted", func(e interface{}) {
+		fn(e.(*LayerPaintedEvent))
+	}, opts...)
+}
+
+// FilterLayerPainted is rpc.WithFilter for OnLayerPainted.
+func FilterLayerPainted(fn func(*LayerPaintedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*LayerPaintedEvent))
+	})
+}
+
+// LayerPaintedWaiter waits for a LayerTree.layerPainted event. It is created by WaitForLayerPainted.
+type LayerPaintedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *LayerPaintedWaiter) Wait() (*LayerPaintedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*LayerPaintedEvent), nil
+}
+
+// WaitForLayerPainted arms a waiter for the first LayerTree.layerPainted event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForLayerPainted(ctx context.Context, predicate func(*LayerPaintedEvent) bool) *LayerPaintedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*LayerPaintedEvent))
+		}
+	}
+	return &LayerPaintedWaiter{d.Client.WaitFor(ctx, "LayerTree.layerPainted", p)}
+}
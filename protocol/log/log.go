@@ -2,6 +2,8 @@
 package log
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/network"
@@ -17,10 +19,10 @@ type Client struct {
 
 type LogEntry struct {
 	// Log entry source.
-	Source string `json:"source"`
+	Source LogEntrySource `json:"source"`
 
 	// Log entry severity.
-	Level string `json:"level"`
+	Level LogEntryLevel `json:"level"`
 
 	// Logged text.
 	Text string `json:"text"`
@@ -29,105 +31,304 @@ type LogEntry struct {
 	Timestamp runtime.Timestamp `json:"timestamp"`
 
 	// URL of the resource if known. (optional)
-	URL string `json:"url,omitempty"`
+	URL *string `json:"url,omitempty"`
 
 	// Line number in the resource. (optional)
-	LineNumber int `json:"lineNumber,omitempty"`
+	LineNumber *int `json:"lineNumber,omitempty"`
 
 	// JavaScript stack trace. (optional)
 	StackTrace *runtime.StackTrace `json:"stackTrace,omitempty"`
 
 	// Identifier of the network request associated with this entry. (optional)
-	NetworkRequestId network.RequestId `json:"networkRequestId,omitempty"`
+	NetworkRequestId *network.RequestId `json:"networkRequestId,omitempty"`
 
 	// Identifier of the worker associated with this entry. (optional)
-	WorkerId string `json:"workerId,omitempty"`
+	WorkerId *string `json:"workerId,omitempty"`
 }
 
 // Violation configuration setting.
 
 type ViolationSetting struct {
 	// Violation type.
-	Name string `json:"name"`
+	Name ViolationSettingName `json:"name"`
 
 	// Time threshold to trigger upon.
 	Threshold float64 `json:"threshold"`
 }
 
+// Values of the source property of LogEntry.
+
+type LogEntrySource string
+
+const (
+	LogEntrySourceXml            LogEntrySource = "xml"
+	LogEntrySourceJavascript     LogEntrySource = "javascript"
+	LogEntrySourceNetwork        LogEntrySource = "network"
+	LogEntrySourceStorage        LogEntrySource = "storage"
+	LogEntrySourceAppcache       LogEntrySource = "appcache"
+	LogEntrySourceRendering      LogEntrySource = "rendering"
+	LogEntrySourceSecurity       LogEntrySource = "security"
+	LogEntrySourceDeprecation    LogEntrySource = "deprecation"
+	LogEntrySourceWorker         LogEntrySource = "worker"
+	LogEntrySourceViolation      LogEntrySource = "violation"
+	LogEntrySourceIntervention   LogEntrySource = "intervention"
+	LogEntrySourceRecommendation LogEntrySource = "recommendation"
+	LogEntrySourceOther          LogEntrySource = "other"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v LogEntrySource) IsValid() bool {
+	switch v {
+	case LogEntrySourceXml, LogEntrySourceJavascript, LogEntrySourceNetwork, LogEntrySourceStorage, LogEntrySourceAppcache, LogEntrySourceRendering, LogEntrySourceSecurity, LogEntrySourceDeprecation, LogEntrySourceWorker, LogEntrySourceViolation, LogEntrySourceIntervention, LogEntrySourceRecommendation, LogEntrySourceOther:
+		return true
+	}
+	return false
+}
+
+// Values of the level property of LogEntry.
+
+type LogEntryLevel string
+
+const (
+	LogEntryLevelVerbose LogEntryLevel = "verbose"
+	LogEntryLevelInfo    LogEntryLevel = "info"
+	LogEntryLevelWarning LogEntryLevel = "warning"
+	LogEntryLevelError   LogEntryLevel = "error"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v LogEntryLevel) IsValid() bool {
+	switch v {
+	case LogEntryLevelVerbose, LogEntryLevelInfo, LogEntryLevelWarning, LogEntryLevelError:
+		return true
+	}
+	return false
+}
+
+// Values of the name property of ViolationSetting.
+
+type ViolationSettingName string
+
+const (
+	ViolationSettingNameLongTask          ViolationSettingName = "longTask"
+	ViolationSettingNameLongLayout        ViolationSettingName = "longLayout"
+	ViolationSettingNameBlockedEvent      ViolationSettingName = "blockedEvent"
+	ViolationSettingNameBlockedParser     ViolationSettingName = "blockedParser"
+	ViolationSettingNameDiscouragedAPIUse ViolationSettingName = "discouragedAPIUse"
+	ViolationSettingNameHandler           ViolationSettingName = "handler"
+	ViolationSettingNameRecurringHandler  ViolationSettingName = "recurringHandler"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ViolationSettingName) IsValid() bool {
+	switch v {
+	case ViolationSettingNameLongTask, ViolationSettingNameLongLayout, ViolationSettingNameBlockedEvent, ViolationSettingNameBlockedParser, ViolationSettingNameDiscouragedAPIUse, ViolationSettingNameHandler, ViolationSettingNameRecurringHandler:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Log.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables log domain, sends the entries collected so far to the client by means of the <code>entryAdded</code> notification.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Log.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Log.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Log", r.params)
+}
+
+// DisableParams are the parameters of Log.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables log domain, prevents further log entries from being reported to the client.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Log.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Log.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Log", r.params)
+}
+
+// ClearParams are the parameters of Log.clear.
+type ClearParams struct {
 }
 
 type ClearRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearParams
 }
 
 // Clears the log.
 func (d *Client) Clear() *ClearRequest {
-	return &ClearRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearRequest{client: d.Client, params: &ClearParams{}}
+}
+
+// DoClear sends Log.clear with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClear(ctx context.Context, params *ClearParams) error {
+	if params == nil {
+		params = new(ClearParams)
+	}
+	return (&ClearRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearRequest) Do() error {
-	return r.client.Call("Log.clear", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Log.clear", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Log.clear", r.params, nil)
+}
+
+// StartViolationsReportParams are the parameters of Log.startViolationsReport.
+type StartViolationsReportParams struct {
+	// Configuration for violations.
+	Config []*ViolationSetting `json:"config"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StartViolationsReportParams) Validate() error {
+	var missing, invalid []string
+	if p.Config == nil {
+		missing = append(missing, "config")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Log.startViolationsReport", Missing: missing, Invalid: invalid}
 }
 
 type StartViolationsReportRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartViolationsReportParams
 }
 
 // start violation reporting.
 func (d *Client) StartViolationsReport() *StartViolationsReportRequest {
-	return &StartViolationsReportRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartViolationsReportRequest{client: d.Client, params: &StartViolationsReportParams{set: 1}}
+}
+
+// DoStartViolationsReport sends Log.startViolationsReport with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStartViolationsReport(ctx context.Context, params *StartViolationsReportParams) error {
+	if params == nil {
+		params = new(StartViolationsReportParams)
+	}
+	return (&StartViolationsReportRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Configuration for violations.
 func (r *StartViolationsReportRequest) Config(v []*ViolationSetting) *StartViolationsReportRequest {
-	r.opts["config"] = v
+	r.params.Config = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *StartViolationsReportRequest) Do() error {
-	return r.client.Call("Log.startViolationsReport", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartViolationsReportRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Log.startViolationsReport", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartViolationsReportRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Log.startViolationsReport", r.params, nil)
+}
+
+// StopViolationsReportParams are the parameters of Log.stopViolationsReport.
+type StopViolationsReportParams struct {
 }
 
 type StopViolationsReportRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopViolationsReportParams
 }
 
 // Stop violation reporting.
 func (d *Client) StopViolationsReport() *StopViolationsReportRequest {
-	return &StopViolationsReportRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopViolationsReportRequest{client: d.Client, params: &StopViolationsReportParams{}}
+}
+
+// DoStopViolationsReport sends Log.stopViolationsReport with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopViolationsReport(ctx context.Context, params *StopViolationsReportParams) error {
+	if params == nil {
+		params = new(StopViolationsReportParams)
+	}
+	return (&StopViolationsReportRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StopViolationsReportRequest) Do() error {
-	return r.client.Call("Log.stopViolationsReport", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StopViolationsReportRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Log.stopViolationsReport", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StopViolationsReportRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Log.stopViolationsReport", r.params, nil)
 }
 
 func init() {
@@ -139,3 +340,42 @@ type EntryAddedEvent struct {
 	// The entry.
 	Entry *LogEntry `json:"entry"`
 }
+
+// Issued when new message was logged.
+func (d *Client) OnEntryAdded(fn func(*EntryAddedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Log.entryAdded", func(e interface{}) {
+		fn(e.(*EntryAddedEvent))
+	}, opts...)
+}
+
+// FilterEntryAdded is rpc.WithFilter for OnEntryAdded.
+func FilterEntryAdded(fn func(*EntryAddedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*EntryAddedEvent))
+	})
+}
+
+// EntryAddedWaiter waits for a Log.entryAdded event. It is created by WaitForEntryAdded.
+type EntryAddedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *EntryAddedWaiter) Wait() (*EntryAddedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*EntryAddedEvent), nil
+}
+
+// WaitForEntryAdded arms a waiter for the first Log.entryAdded event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForEntryAdded(ctx context.Context, predicate func(*EntryAddedEvent) bool) *EntryAddedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*EntryAddedEvent))
+		}
+	}
+	return &EntryAddedWaiter{d.Client.WaitFor(ctx, "Log.entryAdded", p)}
+}
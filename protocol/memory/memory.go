@@ -2,6 +2,8 @@
 package memory
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -14,13 +16,39 @@ type Client struct {
 
 type PressureLevel string
 
+const (
+	PressureLevelModerate PressureLevel = "moderate"
+	PressureLevelCritical PressureLevel = "critical"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v PressureLevel) IsValid() bool {
+	switch v {
+	case PressureLevelModerate, PressureLevelCritical:
+		return true
+	}
+	return false
+}
+
+// GetDOMCountersParams are the parameters of Memory.getDOMCounters.
+type GetDOMCountersParams struct {
+}
+
 type GetDOMCountersRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetDOMCountersParams
 }
 
 func (d *Client) GetDOMCounters() *GetDOMCountersRequest {
-	return &GetDOMCountersRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetDOMCountersRequest{client: d.Client, params: &GetDOMCountersParams{}}
+}
+
+// DoGetDOMCounters sends Memory.getDOMCounters with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetDOMCounters(ctx context.Context, params *GetDOMCountersParams) (*GetDOMCountersResult, error) {
+	if params == nil {
+		params = new(GetDOMCountersParams)
+	}
+	return (&GetDOMCountersRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetDOMCountersResult struct {
@@ -32,49 +60,155 @@ type GetDOMCountersResult struct {
 }
 
 func (r *GetDOMCountersRequest) Do() (*GetDOMCountersResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetDOMCountersRequest) DoContext(ctx context.Context) (*GetDOMCountersResult, error) {
 	var result GetDOMCountersResult
-	err := r.client.Call("Memory.getDOMCounters", r.opts, &result)
+	err := r.client.CallContext(ctx, "Memory.getDOMCounters", r.params, &result)
 	return &result, err
 }
 
+type GetDOMCountersFuture struct {
+	*rpc.Call
+	result GetDOMCountersResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetDOMCountersFuture) Get() (*GetDOMCountersResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetDOMCountersRequest) DoAsync(ctx context.Context) *GetDOMCountersFuture {
+	f := new(GetDOMCountersFuture)
+	f.Call = r.client.Go(ctx, "Memory.getDOMCounters", r.params, &f.result)
+	return f
+}
+
+// SetPressureNotificationsSuppressedParams are the parameters of Memory.setPressureNotificationsSuppressed.
+type SetPressureNotificationsSuppressedParams struct {
+	// If true, memory pressure notifications will be suppressed.
+	Suppressed bool `json:"suppressed"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetPressureNotificationsSuppressedParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "suppressed")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Memory.setPressureNotificationsSuppressed", Missing: missing, Invalid: invalid}
+}
+
 type SetPressureNotificationsSuppressedRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetPressureNotificationsSuppressedParams
 }
 
 // Enable/disable suppressing memory pressure notifications in all processes.
 func (d *Client) SetPressureNotificationsSuppressed() *SetPressureNotificationsSuppressedRequest {
-	return &SetPressureNotificationsSuppressedRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetPressureNotificationsSuppressedRequest{client: d.Client, params: &SetPressureNotificationsSuppressedParams{set: 1}}
+}
+
+// DoSetPressureNotificationsSuppressed sends Memory.setPressureNotificationsSuppressed with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetPressureNotificationsSuppressed(ctx context.Context, params *SetPressureNotificationsSuppressedParams) error {
+	if params == nil {
+		params = new(SetPressureNotificationsSuppressedParams)
+	}
+	return (&SetPressureNotificationsSuppressedRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // If true, memory pressure notifications will be suppressed.
 func (r *SetPressureNotificationsSuppressedRequest) Suppressed(v bool) *SetPressureNotificationsSuppressedRequest {
-	r.opts["suppressed"] = v
+	r.params.Suppressed = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetPressureNotificationsSuppressedRequest) Do() error {
-	return r.client.Call("Memory.setPressureNotificationsSuppressed", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetPressureNotificationsSuppressedRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Memory.setPressureNotificationsSuppressed", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetPressureNotificationsSuppressedRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Memory.setPressureNotificationsSuppressed", r.params, nil)
+}
+
+// SimulatePressureNotificationParams are the parameters of Memory.simulatePressureNotification.
+type SimulatePressureNotificationParams struct {
+	// Memory pressure level of the notification.
+	Level PressureLevel `json:"level"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SimulatePressureNotificationParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Level == "" {
+		missing = append(missing, "level")
+	} else if !p.Level.IsValid() {
+		invalid = append(invalid, "level")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Memory.simulatePressureNotification", Missing: missing, Invalid: invalid}
 }
 
 type SimulatePressureNotificationRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SimulatePressureNotificationParams
 }
 
 // Simulate a memory pressure notification in all processes.
 func (d *Client) SimulatePressureNotification() *SimulatePressureNotificationRequest {
-	return &SimulatePressureNotificationRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SimulatePressureNotificationRequest{client: d.Client, params: &SimulatePressureNotificationParams{set: 1}}
+}
+
+// DoSimulatePressureNotification sends Memory.simulatePressureNotification with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSimulatePressureNotification(ctx context.Context, params *SimulatePressureNotificationParams) error {
+	if params == nil {
+		params = new(SimulatePressureNotificationParams)
+	}
+	return (&SimulatePressureNotificationRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Memory pressure level of the notification.
 func (r *SimulatePressureNotificationRequest) Level(v PressureLevel) *SimulatePressureNotificationRequest {
-	r.opts["level"] = v
+	r.params.Level = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SimulatePressureNotificationRequest) Do() error {
-	return r.client.Call("Memory.simulatePressureNotification", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SimulatePressureNotificationRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Memory.simulatePressureNotification", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SimulatePressureNotificationRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Memory.simulatePressureNotification", r.params, nil)
 }
 
 func init() {
@@ -2,6 +2,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/runtime"
@@ -29,23 +31,84 @@ type InterceptionId string
 
 type ErrorReason string
 
+const (
+	ErrorReasonFailed               ErrorReason = "Failed"
+	ErrorReasonAborted              ErrorReason = "Aborted"
+	ErrorReasonTimedOut             ErrorReason = "TimedOut"
+	ErrorReasonAccessDenied         ErrorReason = "AccessDenied"
+	ErrorReasonConnectionClosed     ErrorReason = "ConnectionClosed"
+	ErrorReasonConnectionReset      ErrorReason = "ConnectionReset"
+	ErrorReasonConnectionRefused    ErrorReason = "ConnectionRefused"
+	ErrorReasonConnectionAborted    ErrorReason = "ConnectionAborted"
+	ErrorReasonConnectionFailed     ErrorReason = "ConnectionFailed"
+	ErrorReasonNameNotResolved      ErrorReason = "NameNotResolved"
+	ErrorReasonInternetDisconnected ErrorReason = "InternetDisconnected"
+	ErrorReasonAddressUnreachable   ErrorReason = "AddressUnreachable"
+	ErrorReasonBlockedByClient      ErrorReason = "BlockedByClient"
+	ErrorReasonBlockedByResponse    ErrorReason = "BlockedByResponse"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ErrorReason) IsValid() bool {
+	switch v {
+	case ErrorReasonFailed, ErrorReasonAborted, ErrorReasonTimedOut, ErrorReasonAccessDenied, ErrorReasonConnectionClosed, ErrorReasonConnectionReset, ErrorReasonConnectionRefused, ErrorReasonConnectionAborted, ErrorReasonConnectionFailed, ErrorReasonNameNotResolved, ErrorReasonInternetDisconnected, ErrorReasonAddressUnreachable, ErrorReasonBlockedByClient, ErrorReasonBlockedByResponse:
+		return true
+	}
+	return false
+}
+
 // Number of seconds since epoch.
 
 type Timestamp float64
 
 // Request / response headers as keys / values of JSON object.
 
-type Headers struct {
-}
+type Headers map[string]string
 
 // Loading priority of a resource request.
 
 type ConnectionType string
 
+const (
+	ConnectionTypeNone       ConnectionType = "none"
+	ConnectionTypeCellular2g ConnectionType = "cellular2g"
+	ConnectionTypeCellular3g ConnectionType = "cellular3g"
+	ConnectionTypeCellular4g ConnectionType = "cellular4g"
+	ConnectionTypeBluetooth  ConnectionType = "bluetooth"
+	ConnectionTypeEthernet   ConnectionType = "ethernet"
+	ConnectionTypeWifi       ConnectionType = "wifi"
+	ConnectionTypeWimax      ConnectionType = "wimax"
+	ConnectionTypeOther      ConnectionType = "other"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ConnectionType) IsValid() bool {
+	switch v {
+	case ConnectionTypeNone, ConnectionTypeCellular2g, ConnectionTypeCellular3g, ConnectionTypeCellular4g, ConnectionTypeBluetooth, ConnectionTypeEthernet, ConnectionTypeWifi, ConnectionTypeWimax, ConnectionTypeOther:
+		return true
+	}
+	return false
+}
+
 // Represents the cookie's 'SameSite' status: https://tools.ietf.org/html/draft-west-first-party-cookies
 
 type CookieSameSite string
 
+const (
+	CookieSameSiteStrict CookieSameSite = "Strict"
+	CookieSameSiteLax    CookieSameSite = "Lax"
+	CookieSameSiteNone   CookieSameSite = "None"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v CookieSameSite) IsValid() bool {
+	switch v {
+	case CookieSameSiteStrict, CookieSameSiteLax, CookieSameSiteNone:
+		return true
+	}
+	return false
+}
+
 // Timing information for the request.
 
 type ResourceTiming struct {
@@ -102,6 +165,23 @@ type ResourceTiming struct {
 
 type ResourcePriority string
 
+const (
+	ResourcePriorityVeryLow  ResourcePriority = "VeryLow"
+	ResourcePriorityLow      ResourcePriority = "Low"
+	ResourcePriorityMedium   ResourcePriority = "Medium"
+	ResourcePriorityHigh     ResourcePriority = "High"
+	ResourcePriorityVeryHigh ResourcePriority = "VeryHigh"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ResourcePriority) IsValid() bool {
+	switch v {
+	case ResourcePriorityVeryLow, ResourcePriorityLow, ResourcePriorityMedium, ResourcePriorityHigh, ResourcePriorityVeryHigh:
+		return true
+	}
+	return false
+}
+
 // HTTP request data.
 
 type Request struct {
@@ -112,22 +192,22 @@ type Request struct {
 	Method string `json:"method"`
 
 	// HTTP request headers.
-	Headers *Headers `json:"headers"`
+	Headers Headers `json:"headers"`
 
 	// HTTP POST request data. (optional)
-	PostData string `json:"postData,omitempty"`
+	PostData *string `json:"postData,omitempty"`
 
 	// The mixed content status of the request, as defined in http://www.w3.org/TR/mixed-content/ (optional)
-	MixedContentType string `json:"mixedContentType,omitempty"`
+	MixedContentType *string `json:"mixedContentType,omitempty"`
 
 	// Priority of the resource request at the time request is sent.
 	InitialPriority ResourcePriority `json:"initialPriority"`
 
 	// The referrer policy of the request, as defined in https://www.w3.org/TR/referrer-policy/
-	ReferrerPolicy string `json:"referrerPolicy"`
+	ReferrerPolicy RequestReferrerPolicy `json:"referrerPolicy"`
 
 	// Whether is loaded via link preload. (optional)
-	IsLinkPreload bool `json:"isLinkPreload,omitempty"`
+	IsLinkPreload *bool `json:"isLinkPreload,omitempty"`
 }
 
 // Details of a signed certificate timestamp (SCT).
@@ -168,13 +248,13 @@ type SecurityDetails struct {
 	KeyExchange string `json:"keyExchange"`
 
 	// (EC)DH group used by the connection, if applicable. (optional)
-	KeyExchangeGroup string `json:"keyExchangeGroup,omitempty"`
+	KeyExchangeGroup *string `json:"keyExchangeGroup,omitempty"`
 
 	// Cipher name.
 	Cipher string `json:"cipher"`
 
 	// TLS MAC. Note that AEAD ciphers do not have separate MACs. (optional)
-	Mac string `json:"mac,omitempty"`
+	Mac *string `json:"mac,omitempty"`
 
 	// Certificate ID value.
 	CertificateId security.CertificateId `json:"certificateId"`
@@ -202,6 +282,34 @@ type SecurityDetails struct {
 
 type BlockedReason string
 
+const (
+	BlockedReasonOther                                                   BlockedReason = "other"
+	BlockedReasonCsp                                                     BlockedReason = "csp"
+	BlockedReasonMixedContent                                            BlockedReason = "mixed-content"
+	BlockedReasonOrigin                                                  BlockedReason = "origin"
+	BlockedReasonInspector                                               BlockedReason = "inspector"
+	BlockedReasonIntegrity                                               BlockedReason = "integrity"
+	BlockedReasonSubresourceFilter                                       BlockedReason = "subresource-filter"
+	BlockedReasonContentType                                             BlockedReason = "content-type"
+	BlockedReasonCoepFrameResourceNeedsCoepHeader                        BlockedReason = "coep-frame-resource-needs-coep-header"
+	BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage             BlockedReason = "coop-sandboxed-iframe-cannot-navigate-to-coop-page"
+	BlockedReasonCorpNotSameOrigin                                       BlockedReason = "corp-not-same-origin"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep       BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip        BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-dip"
+	BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip BlockedReason = "corp-not-same-origin-after-defaulted-to-same-origin-by-coep-and-dip"
+	BlockedReasonCorpNotSameSite                                         BlockedReason = "corp-not-same-site"
+	BlockedReasonSriMessageSignatureMismatch                             BlockedReason = "sri-message-signature-mismatch"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v BlockedReason) IsValid() bool {
+	switch v {
+	case BlockedReasonOther, BlockedReasonCsp, BlockedReasonMixedContent, BlockedReasonOrigin, BlockedReasonInspector, BlockedReasonIntegrity, BlockedReasonSubresourceFilter, BlockedReasonContentType, BlockedReasonCoepFrameResourceNeedsCoepHeader, BlockedReasonCoopSandboxedIframeCannotNavigateToCoopPage, BlockedReasonCorpNotSameOrigin, BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoep, BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByDip, BlockedReasonCorpNotSameOriginAfterDefaultedToSameOriginByCoepAndDip, BlockedReasonCorpNotSameSite, BlockedReasonSriMessageSignatureMismatch:
+		return true
+	}
+	return false
+}
+
 // HTTP response data.
 
 type Response struct {
@@ -215,19 +323,19 @@ type Response struct {
 	StatusText string `json:"statusText"`
 
 	// HTTP response headers.
-	Headers *Headers `json:"headers"`
+	Headers Headers `json:"headers"`
 
 	// HTTP response headers text. (optional)
-	HeadersText string `json:"headersText,omitempty"`
+	HeadersText *string `json:"headersText,omitempty"`
 
 	// Resource mimeType as determined by the browser.
 	MimeType string `json:"mimeType"`
 
 	// Refined HTTP request headers that were actually transmitted over the network. (optional)
-	RequestHeaders *Headers `json:"requestHeaders,omitempty"`
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
 
 	// HTTP request headers text. (optional)
-	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+	RequestHeadersText *string `json:"requestHeadersText,omitempty"`
 
 	// Specifies whether physical connection was actually reused for this request.
 	ConnectionReused bool `json:"connectionReused"`
@@ -236,16 +344,16 @@ type Response struct {
 	ConnectionId float64 `json:"connectionId"`
 
 	// Remote IP address. (optional, experimental)
-	RemoteIPAddress string `json:"remoteIPAddress,omitempty"`
+	RemoteIPAddress *string `json:"remoteIPAddress,omitempty"`
 
 	// Remote port. (optional, experimental)
-	RemotePort int `json:"remotePort,omitempty"`
+	RemotePort *int `json:"remotePort,omitempty"`
 
 	// Specifies that the request was served from the disk cache. (optional)
-	FromDiskCache bool `json:"fromDiskCache,omitempty"`
+	FromDiskCache *bool `json:"fromDiskCache,omitempty"`
 
 	// Specifies that the request was served from the ServiceWorker. (optional)
-	FromServiceWorker bool `json:"fromServiceWorker,omitempty"`
+	FromServiceWorker *bool `json:"fromServiceWorker,omitempty"`
 
 	// Total number of bytes received for this request so far.
 	EncodedDataLength float64 `json:"encodedDataLength"`
@@ -254,7 +362,7 @@ type Response struct {
 	Timing *ResourceTiming `json:"timing,omitempty"`
 
 	// Protocol used to fetch this request. (optional)
-	Protocol string `json:"protocol,omitempty"`
+	Protocol *string `json:"protocol,omitempty"`
 
 	// Security state of the request resource.
 	SecurityState security.SecurityState `json:"securityState"`
@@ -267,7 +375,7 @@ type Response struct {
 
 type WebSocketRequest struct {
 	// HTTP request headers.
-	Headers *Headers `json:"headers"`
+	Headers Headers `json:"headers"`
 }
 
 // WebSocket response data. (experimental)
@@ -280,16 +388,16 @@ type WebSocketResponse struct {
 	StatusText string `json:"statusText"`
 
 	// HTTP response headers.
-	Headers *Headers `json:"headers"`
+	Headers Headers `json:"headers"`
 
 	// HTTP response headers text. (optional)
-	HeadersText string `json:"headersText,omitempty"`
+	HeadersText *string `json:"headersText,omitempty"`
 
 	// HTTP request headers. (optional)
-	RequestHeaders *Headers `json:"requestHeaders,omitempty"`
+	RequestHeaders Headers `json:"requestHeaders,omitempty"`
 
 	// HTTP request headers text. (optional)
-	RequestHeadersText string `json:"requestHeadersText,omitempty"`
+	RequestHeadersText *string `json:"requestHeadersText,omitempty"`
 }
 
 // WebSocket frame data. (experimental)
@@ -325,16 +433,16 @@ type CachedResource struct {
 
 type Initiator struct {
 	// Type of this initiator.
-	Type string `json:"type"`
+	Type InitiatorType `json:"type"`
 
 	// Initiator JavaScript stack trace, set for Script only. (optional)
 	Stack *runtime.StackTrace `json:"stack,omitempty"`
 
 	// Initiator URL, set for Parser type only. (optional)
-	URL string `json:"url,omitempty"`
+	URL *string `json:"url,omitempty"`
 
 	// Initiator line number, set for Parser type only (0-based). (optional)
-	LineNumber float64 `json:"lineNumber,omitempty"`
+	LineNumber *float64 `json:"lineNumber,omitempty"`
 }
 
 // Cookie object (experimental)
@@ -368,102 +476,327 @@ type Cookie struct {
 	Session bool `json:"session"`
 
 	// Cookie SameSite type. (optional)
-	SameSite CookieSameSite `json:"sameSite,omitempty"`
+	SameSite *CookieSameSite `json:"sameSite,omitempty"`
+}
+
+// Values of the referrerPolicy property of Request.
+
+type RequestReferrerPolicy string
+
+const (
+	RequestReferrerPolicyUnsafeUrl                   RequestReferrerPolicy = "unsafe-url"
+	RequestReferrerPolicyNoReferrerWhenDowngrade     RequestReferrerPolicy = "no-referrer-when-downgrade"
+	RequestReferrerPolicyNoReferrer                  RequestReferrerPolicy = "no-referrer"
+	RequestReferrerPolicyOrigin                      RequestReferrerPolicy = "origin"
+	RequestReferrerPolicyOriginWhenCrossOrigin       RequestReferrerPolicy = "origin-when-cross-origin"
+	RequestReferrerPolicySameOrigin                  RequestReferrerPolicy = "same-origin"
+	RequestReferrerPolicyStrictOrigin                RequestReferrerPolicy = "strict-origin"
+	RequestReferrerPolicyStrictOriginWhenCrossOrigin RequestReferrerPolicy = "strict-origin-when-cross-origin"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v RequestReferrerPolicy) IsValid() bool {
+	switch v {
+	case RequestReferrerPolicyUnsafeUrl, RequestReferrerPolicyNoReferrerWhenDowngrade, RequestReferrerPolicyNoReferrer, RequestReferrerPolicyOrigin, RequestReferrerPolicyOriginWhenCrossOrigin, RequestReferrerPolicySameOrigin, RequestReferrerPolicyStrictOrigin, RequestReferrerPolicyStrictOriginWhenCrossOrigin:
+		return true
+	}
+	return false
+}
+
+// Values of the type property of Initiator.
+
+type InitiatorType string
+
+const (
+	InitiatorTypeParser         InitiatorType = "parser"
+	InitiatorTypeScript         InitiatorType = "script"
+	InitiatorTypePreload        InitiatorType = "preload"
+	InitiatorTypeSignedExchange InitiatorType = "SignedExchange"
+	InitiatorTypePreflight      InitiatorType = "preflight"
+	InitiatorTypeOther          InitiatorType = "other"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v InitiatorType) IsValid() bool {
+	switch v {
+	case InitiatorTypeParser, InitiatorTypeScript, InitiatorTypePreload, InitiatorTypeSignedExchange, InitiatorTypePreflight, InitiatorTypeOther:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Network.enable.
+type EnableParams struct {
+	// Buffer size in bytes to use when preserving network payloads (XHRs, etc). (optional, experimental)
+	MaxTotalBufferSize *int `json:"maxTotalBufferSize,omitempty"`
+
+	// Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc). (optional, experimental)
+	MaxResourceBufferSize *int `json:"maxResourceBufferSize,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *EnableParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.enable", Missing: missing, Invalid: invalid}
 }
 
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables network tracking, network events will now be delivered to the client.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{set: 1}}
+}
+
+// DoEnable sends Network.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Buffer size in bytes to use when preserving network payloads (XHRs, etc). (optional, experimental)
 func (r *EnableRequest) MaxTotalBufferSize(v int) *EnableRequest {
-	r.opts["maxTotalBufferSize"] = v
+	r.params.MaxTotalBufferSize = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Per-resource buffer size in bytes to use when preserving network payloads (XHRs, etc). (optional, experimental)
 func (r *EnableRequest) MaxResourceBufferSize(v int) *EnableRequest {
-	r.opts["maxResourceBufferSize"] = v
+	r.params.MaxResourceBufferSize = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Network.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Network", r.params)
+}
+
+// DisableParams are the parameters of Network.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables network tracking, prevents network events from being sent to the client.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Network.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Network.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Network", r.params)
+}
+
+// SetUserAgentOverrideParams are the parameters of Network.setUserAgentOverride.
+type SetUserAgentOverrideParams struct {
+	// User agent to use.
+	UserAgent string `json:"userAgent"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetUserAgentOverrideParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.UserAgent == "" {
+		missing = append(missing, "userAgent")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.setUserAgentOverride", Missing: missing, Invalid: invalid}
 }
 
 type SetUserAgentOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetUserAgentOverrideParams
 }
 
 // Allows overriding user agent with the given string.
 func (d *Client) SetUserAgentOverride() *SetUserAgentOverrideRequest {
-	return &SetUserAgentOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetUserAgentOverrideRequest{client: d.Client, params: &SetUserAgentOverrideParams{set: 1}}
+}
+
+// DoSetUserAgentOverride sends Network.setUserAgentOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetUserAgentOverride(ctx context.Context, params *SetUserAgentOverrideParams) error {
+	if params == nil {
+		params = new(SetUserAgentOverrideParams)
+	}
+	return (&SetUserAgentOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // User agent to use.
 func (r *SetUserAgentOverrideRequest) UserAgent(v string) *SetUserAgentOverrideRequest {
-	r.opts["userAgent"] = v
+	r.params.UserAgent = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetUserAgentOverrideRequest) Do() error {
-	return r.client.Call("Network.setUserAgentOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetUserAgentOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.setUserAgentOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetUserAgentOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.setUserAgentOverride", r.params, nil)
+}
+
+// SetExtraHTTPHeadersParams are the parameters of Network.setExtraHTTPHeaders.
+type SetExtraHTTPHeadersParams struct {
+	// Map with extra HTTP headers.
+	Headers Headers `json:"headers"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetExtraHTTPHeadersParams) Validate() error {
+	var missing, invalid []string
+	if p.Headers == nil {
+		missing = append(missing, "headers")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.setExtraHTTPHeaders", Missing: missing, Invalid: invalid}
 }
 
 type SetExtraHTTPHeadersRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetExtraHTTPHeadersParams
 }
 
 // Specifies whether to always send extra HTTP headers with the requests from this page.
 func (d *Client) SetExtraHTTPHeaders() *SetExtraHTTPHeadersRequest {
-	return &SetExtraHTTPHeadersRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetExtraHTTPHeadersRequest{client: d.Client, params: &SetExtraHTTPHeadersParams{set: 1}}
+}
+
+// DoSetExtraHTTPHeaders sends Network.setExtraHTTPHeaders with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetExtraHTTPHeaders(ctx context.Context, params *SetExtraHTTPHeadersParams) error {
+	if params == nil {
+		params = new(SetExtraHTTPHeadersParams)
+	}
+	return (&SetExtraHTTPHeadersRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Map with extra HTTP headers.
-func (r *SetExtraHTTPHeadersRequest) Headers(v *Headers) *SetExtraHTTPHeadersRequest {
-	r.opts["headers"] = v
+func (r *SetExtraHTTPHeadersRequest) Headers(v Headers) *SetExtraHTTPHeadersRequest {
+	r.params.Headers = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetExtraHTTPHeadersRequest) Do() error {
-	return r.client.Call("Network.setExtraHTTPHeaders", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetExtraHTTPHeadersRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.setExtraHTTPHeaders", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetExtraHTTPHeadersRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.setExtraHTTPHeaders", r.params, nil)
+}
+
+// GetResponseBodyParams are the parameters of Network.getResponseBody.
+type GetResponseBodyParams struct {
+	// Identifier of the network request to get content for.
+	RequestId RequestId `json:"requestId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetResponseBodyParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.RequestId == "" {
+		missing = append(missing, "requestId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.getResponseBody", Missing: missing, Invalid: invalid}
 }
 
 type GetResponseBodyRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetResponseBodyParams
 }
 
 // Returns content served for the given request.
 func (d *Client) GetResponseBody() *GetResponseBodyRequest {
-	return &GetResponseBodyRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetResponseBodyRequest{client: d.Client, params: &GetResponseBodyParams{set: 1}}
+}
+
+// DoGetResponseBody sends Network.getResponseBody with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetResponseBody(ctx context.Context, params *GetResponseBodyParams) (*GetResponseBodyResult, error) {
+	if params == nil {
+		params = new(GetResponseBodyParams)
+	}
+	return (&GetResponseBodyRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the network request to get content for.
 func (r *GetResponseBodyRequest) RequestId(v RequestId) *GetResponseBodyRequest {
-	r.opts["requestId"] = v
+	r.params.RequestId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -476,59 +809,175 @@ type GetResponseBodyResult struct {
 }
 
 func (r *GetResponseBodyRequest) Do() (*GetResponseBodyResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetResponseBodyRequest) DoContext(ctx context.Context) (*GetResponseBodyResult, error) {
 	var result GetResponseBodyResult
-	err := r.client.Call("Network.getResponseBody", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.getResponseBody", r.params, &result)
 	return &result, err
 }
 
+type GetResponseBodyFuture struct {
+	*rpc.Call
+	result GetResponseBodyResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetResponseBodyFuture) Get() (*GetResponseBodyResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetResponseBodyRequest) DoAsync(ctx context.Context) *GetResponseBodyFuture {
+	f := new(GetResponseBodyFuture)
+	f.Call = r.client.Go(ctx, "Network.getResponseBody", r.params, &f.result)
+	return f
+}
+
+// SetBlockedURLsParams are the parameters of Network.setBlockedURLs.
+type SetBlockedURLsParams struct {
+	// URL patterns to block. Wildcards ('*') are allowed.
+	Urls []string `json:"urls"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetBlockedURLsParams) Validate() error {
+	var missing, invalid []string
+	if p.Urls == nil {
+		missing = append(missing, "urls")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.setBlockedURLs", Missing: missing, Invalid: invalid}
+}
+
 type SetBlockedURLsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetBlockedURLsParams
 }
 
 // Blocks URLs from loading. (experimental)
 func (d *Client) SetBlockedURLs() *SetBlockedURLsRequest {
-	return &SetBlockedURLsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetBlockedURLsRequest{client: d.Client, params: &SetBlockedURLsParams{set: 1}}
+}
+
+// DoSetBlockedURLs sends Network.setBlockedURLs with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetBlockedURLs(ctx context.Context, params *SetBlockedURLsParams) error {
+	if params == nil {
+		params = new(SetBlockedURLsParams)
+	}
+	return (&SetBlockedURLsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // URL patterns to block. Wildcards ('*') are allowed.
 func (r *SetBlockedURLsRequest) Urls(v []string) *SetBlockedURLsRequest {
-	r.opts["urls"] = v
+	r.params.Urls = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetBlockedURLsRequest) Do() error {
-	return r.client.Call("Network.setBlockedURLs", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetBlockedURLsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.setBlockedURLs", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetBlockedURLsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.setBlockedURLs", r.params, nil)
+}
+
+// ReplayXHRParams are the parameters of Network.replayXHR.
+type ReplayXHRParams struct {
+	// Identifier of XHR to replay.
+	RequestId RequestId `json:"requestId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReplayXHRParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.RequestId == "" {
+		missing = append(missing, "requestId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.replayXHR", Missing: missing, Invalid: invalid}
 }
 
 type ReplayXHRRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReplayXHRParams
 }
 
 // This method sends a new XMLHttpRequest which is identical to the original one. The following parameters should be identical: method, url, async, request body, extra headers, withCredentials attribute, user, password. (experimental)
 func (d *Client) ReplayXHR() *ReplayXHRRequest {
-	return &ReplayXHRRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReplayXHRRequest{client: d.Client, params: &ReplayXHRParams{set: 1}}
+}
+
+// DoReplayXHR sends Network.replayXHR with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoReplayXHR(ctx context.Context, params *ReplayXHRParams) error {
+	if params == nil {
+		params = new(ReplayXHRParams)
+	}
+	return (&ReplayXHRRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of XHR to replay.
 func (r *ReplayXHRRequest) RequestId(v RequestId) *ReplayXHRRequest {
-	r.opts["requestId"] = v
+	r.params.RequestId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ReplayXHRRequest) Do() error {
-	return r.client.Call("Network.replayXHR", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ReplayXHRRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.replayXHR", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ReplayXHRRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.replayXHR", r.params, nil)
+}
+
+// CanClearBrowserCacheParams are the parameters of Network.canClearBrowserCache.
+type CanClearBrowserCacheParams struct {
 }
 
 type CanClearBrowserCacheRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CanClearBrowserCacheParams
 }
 
 // Tells whether clearing browser cache is supported.
 func (d *Client) CanClearBrowserCache() *CanClearBrowserCacheRequest {
-	return &CanClearBrowserCacheRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CanClearBrowserCacheRequest{client: d.Client, params: &CanClearBrowserCacheParams{}}
+}
+
+// DoCanClearBrowserCache sends Network.canClearBrowserCache with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCanClearBrowserCache(ctx context.Context, params *CanClearBrowserCacheParams) (*CanClearBrowserCacheResult, error) {
+	if params == nil {
+		params = new(CanClearBrowserCacheParams)
+	}
+	return (&CanClearBrowserCacheRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type CanClearBrowserCacheResult struct {
@@ -537,33 +986,88 @@ type CanClearBrowserCacheResult struct {
 }
 
 func (r *CanClearBrowserCacheRequest) Do() (*CanClearBrowserCacheResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CanClearBrowserCacheRequest) DoContext(ctx context.Context) (*CanClearBrowserCacheResult, error) {
 	var result CanClearBrowserCacheResult
-	err := r.client.Call("Network.canClearBrowserCache", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.canClearBrowserCache", r.params, &result)
 	return &result, err
 }
 
+type CanClearBrowserCacheFuture struct {
+	*rpc.Call
+	result CanClearBrowserCacheResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CanClearBrowserCacheFuture) Get() (*CanClearBrowserCacheResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CanClearBrowserCacheRequest) DoAsync(ctx context.Context) *CanClearBrowserCacheFuture {
+	f := new(CanClearBrowserCacheFuture)
+	f.Call = r.client.Go(ctx, "Network.canClearBrowserCache", r.params, &f.result)
+	return f
+}
+
+// ClearBrowserCacheParams are the parameters of Network.clearBrowserCache.
+type ClearBrowserCacheParams struct {
+}
+
 type ClearBrowserCacheRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearBrowserCacheParams
 }
 
 // Clears browser cache.
 func (d *Client) ClearBrowserCache() *ClearBrowserCacheRequest {
-	return &ClearBrowserCacheRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearBrowserCacheRequest{client: d.Client, params: &ClearBrowserCacheParams{}}
+}
+
+// DoClearBrowserCache sends Network.clearBrowserCache with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearBrowserCache(ctx context.Context, params *ClearBrowserCacheParams) error {
+	if params == nil {
+		params = new(ClearBrowserCacheParams)
+	}
+	return (&ClearBrowserCacheRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearBrowserCacheRequest) Do() error {
-	return r.client.Call("Network.clearBrowserCache", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearBrowserCacheRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.clearBrowserCache", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearBrowserCacheRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.clearBrowserCache", r.params, nil)
+}
+
+// CanClearBrowserCookiesParams are the parameters of Network.canClearBrowserCookies.
+type CanClearBrowserCookiesParams struct {
 }
 
 type CanClearBrowserCookiesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CanClearBrowserCookiesParams
 }
 
 // Tells whether clearing browser cookies is supported.
 func (d *Client) CanClearBrowserCookies() *CanClearBrowserCookiesRequest {
-	return &CanClearBrowserCookiesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CanClearBrowserCookiesRequest{client: d.Client, params: &CanClearBrowserCookiesParams{}}
+}
+
+// DoCanClearBrowserCookies sends Network.canClearBrowserCookies with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCanClearBrowserCookies(ctx context.Context, params *CanClearBrowserCookiesParams) (*CanClearBrowserCookiesResult, error) {
+	if params == nil {
+		params = new(CanClearBrowserCookiesParams)
+	}
+	return (&CanClearBrowserCookiesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type CanClearBrowserCookiesResult struct {
@@ -572,38 +1076,110 @@ type CanClearBrowserCookiesResult struct {
 }
 
 func (r *CanClearBrowserCookiesRequest) Do() (*CanClearBrowserCookiesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CanClearBrowserCookiesRequest) DoContext(ctx context.Context) (*CanClearBrowserCookiesResult, error) {
 	var result CanClearBrowserCookiesResult
-	err := r.client.Call("Network.canClearBrowserCookies", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.canClearBrowserCookies", r.params, &result)
 	return &result, err
 }
 
+type CanClearBrowserCookiesFuture struct {
+	*rpc.Call
+	result CanClearBrowserCookiesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CanClearBrowserCookiesFuture) Get() (*CanClearBrowserCookiesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CanClearBrowserCookiesRequest) DoAsync(ctx context.Context) *CanClearBrowserCookiesFuture {
+	f := new(CanClearBrowserCookiesFuture)
+	f.Call = r.client.Go(ctx, "Network.canClearBrowserCookies", r.params, &f.result)
+	return f
+}
+
+// ClearBrowserCookiesParams are the parameters of Network.clearBrowserCookies.
+type ClearBrowserCookiesParams struct {
+}
+
 type ClearBrowserCookiesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearBrowserCookiesParams
 }
 
 // Clears browser cookies.
 func (d *Client) ClearBrowserCookies() *ClearBrowserCookiesRequest {
-	return &ClearBrowserCookiesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearBrowserCookiesRequest{client: d.Client, params: &ClearBrowserCookiesParams{}}
+}
+
+// DoClearBrowserCookies sends Network.clearBrowserCookies with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearBrowserCookies(ctx context.Context, params *ClearBrowserCookiesParams) error {
+	if params == nil {
+		params = new(ClearBrowserCookiesParams)
+	}
+	return (&ClearBrowserCookiesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearBrowserCookiesRequest) Do() error {
-	return r.client.Call("Network.clearBrowserCookies", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearBrowserCookiesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.clearBrowserCookies", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearBrowserCookiesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.clearBrowserCookies", r.params, nil)
+}
+
+// GetCookiesParams are the parameters of Network.getCookies.
+type GetCookiesParams struct {
+	// The list of URLs for which applicable cookies will be fetched (optional)
+	Urls []string `json:"urls,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetCookiesParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.getCookies", Missing: missing, Invalid: invalid}
 }
 
 type GetCookiesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetCookiesParams
 }
 
 // Returns all browser cookies for the current URL. Depending on the backend support, will return detailed cookie information in the <code>cookies</code> field. (experimental)
 func (d *Client) GetCookies() *GetCookiesRequest {
-	return &GetCookiesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetCookiesRequest{client: d.Client, params: &GetCookiesParams{set: 1}}
+}
+
+// DoGetCookies sends Network.getCookies with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetCookies(ctx context.Context, params *GetCookiesParams) (*GetCookiesResult, error) {
+	if params == nil {
+		params = new(GetCookiesParams)
+	}
+	return (&GetCookiesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The list of URLs for which applicable cookies will be fetched (optional)
 func (r *GetCookiesRequest) Urls(v []string) *GetCookiesRequest {
-	r.opts["urls"] = v
+	r.params.Urls = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -613,19 +1189,53 @@ type GetCookiesResult struct {
 }
 
 func (r *GetCookiesRequest) Do() (*GetCookiesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetCookiesRequest) DoContext(ctx context.Context) (*GetCookiesResult, error) {
 	var result GetCookiesResult
-	err := r.client.Call("Network.getCookies", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.getCookies", r.params, &result)
 	return &result, err
 }
 
+type GetCookiesFuture struct {
+	*rpc.Call
+	result GetCookiesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetCookiesFuture) Get() (*GetCookiesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetCookiesRequest) DoAsync(ctx context.Context) *GetCookiesFuture {
+	f := new(GetCookiesFuture)
+	f.Call = r.client.Go(ctx, "Network.getCookies", r.params, &f.result)
+	return f
+}
+
+// GetAllCookiesParams are the parameters of Network.getAllCookies.
+type GetAllCookiesParams struct {
+}
+
 type GetAllCookiesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetAllCookiesParams
 }
 
 // Returns all browser cookies. Depending on the backend support, will return detailed cookie information in the <code>cookies</code> field. (experimental)
 func (d *Client) GetAllCookies() *GetAllCookiesRequest {
-	return &GetAllCookiesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetAllCookiesRequest{client: d.Client, params: &GetAllCookiesParams{}}
+}
+
+// DoGetAllCookies sends Network.getAllCookies with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetAllCookies(ctx context.Context, params *GetAllCookiesParams) (*GetAllCookiesResult, error) {
+	if params == nil {
+		params = new(GetAllCookiesParams)
+	}
+	return (&GetAllCookiesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetAllCookiesResult struct {
@@ -634,98 +1244,241 @@ type GetAllCookiesResult struct {
 }
 
 func (r *GetAllCookiesRequest) Do() (*GetAllCookiesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetAllCookiesRequest) DoContext(ctx context.Context) (*GetAllCookiesResult, error) {
 	var result GetAllCookiesResult
-	err := r.client.Call("Network.getAllCookies", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.getAllCookies", r.params, &result)
 	return &result, err
 }
 
+type GetAllCookiesFuture struct {
+	*rpc.Call
+	result GetAllCookiesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetAllCookiesFuture) Get() (*GetAllCookiesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetAllCookiesRequest) DoAsync(ctx context.Context) *GetAllCookiesFuture {
+	f := new(GetAllCookiesFuture)
+	f.Call = r.client.Go(ctx, "Network.getAllCookies", r.params, &f.result)
+	return f
+}
+
+// DeleteCookieParams are the parameters of Network.deleteCookie.
+type DeleteCookieParams struct {
+	// Name of the cookie to remove.
+	CookieName string `json:"cookieName"`
+
+	// URL to match cooke domain and path.
+	URL string `json:"url"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DeleteCookieParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.CookieName == "" {
+		missing = append(missing, "cookieName")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.deleteCookie", Missing: missing, Invalid: invalid}
+}
+
 type DeleteCookieRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DeleteCookieParams
 }
 
 // Deletes browser cookie with given name, domain and path. (experimental)
 func (d *Client) DeleteCookie() *DeleteCookieRequest {
-	return &DeleteCookieRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DeleteCookieRequest{client: d.Client, params: &DeleteCookieParams{set: 1}}
+}
+
+// DoDeleteCookie sends Network.deleteCookie with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDeleteCookie(ctx context.Context, params *DeleteCookieParams) error {
+	if params == nil {
+		params = new(DeleteCookieParams)
+	}
+	return (&DeleteCookieRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Name of the cookie to remove.
 func (r *DeleteCookieRequest) CookieName(v string) *DeleteCookieRequest {
-	r.opts["cookieName"] = v
+	r.params.CookieName = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // URL to match cooke domain and path.
 func (r *DeleteCookieRequest) URL(v string) *DeleteCookieRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *DeleteCookieRequest) Do() error {
-	return r.client.Call("Network.deleteCookie", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DeleteCookieRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.deleteCookie", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DeleteCookieRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.deleteCookie", r.params, nil)
+}
+
+// SetCookieParams are the parameters of Network.setCookie.
+type SetCookieParams struct {
+	// The request-URI to associate with the setting of the cookie. This value can affect the default domain and path values of the created cookie.
+	URL string `json:"url"`
+
+	// The name of the cookie.
+	Name string `json:"name"`
+
+	// The value of the cookie.
+	Value string `json:"value"`
+
+	// If omitted, the cookie becomes a host-only cookie. (optional)
+	Domain *string `json:"domain,omitempty"`
+
+	// Defaults to the path portion of the url parameter. (optional)
+	Path *string `json:"path,omitempty"`
+
+	// Defaults ot false. (optional)
+	Secure *bool `json:"secure,omitempty"`
+
+	// Defaults to false. (optional)
+	HttpOnly *bool `json:"httpOnly,omitempty"`
+
+	// Defaults to browser default behavior. (optional)
+	SameSite *CookieSameSite `json:"sameSite,omitempty"`
+
+	// If omitted, the cookie becomes a session cookie. (optional)
+	ExpirationDate *Timestamp `json:"expirationDate,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetCookieParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Name == "" {
+		missing = append(missing, "name")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Value == "" {
+		missing = append(missing, "value")
+	}
+	if p.SameSite != nil && !p.SameSite.IsValid() {
+		invalid = append(invalid, "sameSite")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.setCookie", Missing: missing, Invalid: invalid}
 }
 
 type SetCookieRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetCookieParams
 }
 
 // Sets a cookie with the given cookie data; may overwrite equivalent cookies if they exist. (experimental)
 func (d *Client) SetCookie() *SetCookieRequest {
-	return &SetCookieRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetCookieRequest{client: d.Client, params: &SetCookieParams{set: 1}}
+}
+
+// DoSetCookie sends Network.setCookie with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetCookie(ctx context.Context, params *SetCookieParams) (*SetCookieResult, error) {
+	if params == nil {
+		params = new(SetCookieParams)
+	}
+	return (&SetCookieRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The request-URI to associate with the setting of the cookie. This value can affect the default domain and path values of the created cookie.
 func (r *SetCookieRequest) URL(v string) *SetCookieRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The name of the cookie.
 func (r *SetCookieRequest) Name(v string) *SetCookieRequest {
-	r.opts["name"] = v
+	r.params.Name = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // The value of the cookie.
 func (r *SetCookieRequest) Value(v string) *SetCookieRequest {
-	r.opts["value"] = v
+	r.params.Value = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // If omitted, the cookie becomes a host-only cookie. (optional)
 func (r *SetCookieRequest) Domain(v string) *SetCookieRequest {
-	r.opts["domain"] = v
+	r.params.Domain = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Defaults to the path portion of the url parameter. (optional)
 func (r *SetCookieRequest) Path(v string) *SetCookieRequest {
-	r.opts["path"] = v
+	r.params.Path = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Defaults ot false. (optional)
 func (r *SetCookieRequest) Secure(v bool) *SetCookieRequest {
-	r.opts["secure"] = v
+	r.params.Secure = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Defaults to false. (optional)
 func (r *SetCookieRequest) HttpOnly(v bool) *SetCookieRequest {
-	r.opts["httpOnly"] = v
+	r.params.HttpOnly = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Defaults to browser default behavior. (optional)
 func (r *SetCookieRequest) SameSite(v CookieSameSite) *SetCookieRequest {
-	r.opts["sameSite"] = v
+	r.params.SameSite = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // If omitted, the cookie becomes a session cookie. (optional)
 func (r *SetCookieRequest) ExpirationDate(v Timestamp) *SetCookieRequest {
-	r.opts["expirationDate"] = v
+	r.params.ExpirationDate = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
@@ -735,19 +1488,53 @@ type SetCookieResult struct {
 }
 
 func (r *SetCookieRequest) Do() (*SetCookieResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SetCookieRequest) DoContext(ctx context.Context) (*SetCookieResult, error) {
 	var result SetCookieResult
-	err := r.client.Call("Network.setCookie", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.setCookie", r.params, &result)
 	return &result, err
 }
 
+type SetCookieFuture struct {
+	*rpc.Call
+	result SetCookieResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SetCookieFuture) Get() (*SetCookieResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SetCookieRequest) DoAsync(ctx context.Context) *SetCookieFuture {
+	f := new(SetCookieFuture)
+	f.Call = r.client.Go(ctx, "Network.setCookie", r.params, &f.result)
+	return f
+}
+
+// CanEmulateNetworkConditionsParams are the parameters of Network.canEmulateNetworkConditions.
+type CanEmulateNetworkConditionsParams struct {
+}
+
 type CanEmulateNetworkConditionsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CanEmulateNetworkConditionsParams
 }
 
 // Tells whether emulation of network conditions is supported. (experimental)
 func (d *Client) CanEmulateNetworkConditions() *CanEmulateNetworkConditionsRequest {
-	return &CanEmulateNetworkConditionsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CanEmulateNetworkConditionsRequest{client: d.Client, params: &CanEmulateNetworkConditionsParams{}}
+}
+
+// DoCanEmulateNetworkConditions sends Network.canEmulateNetworkConditions with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCanEmulateNetworkConditions(ctx context.Context, params *CanEmulateNetworkConditionsParams) (*CanEmulateNetworkConditionsResult, error) {
+	if params == nil {
+		params = new(CanEmulateNetworkConditionsParams)
+	}
+	return (&CanEmulateNetworkConditionsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type CanEmulateNetworkConditionsResult struct {
@@ -756,134 +1543,387 @@ type CanEmulateNetworkConditionsResult struct {
 }
 
 func (r *CanEmulateNetworkConditionsRequest) Do() (*CanEmulateNetworkConditionsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CanEmulateNetworkConditionsRequest) DoContext(ctx context.Context) (*CanEmulateNetworkConditionsResult, error) {
 	var result CanEmulateNetworkConditionsResult
-	err := r.client.Call("Network.canEmulateNetworkConditions", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.canEmulateNetworkConditions", r.params, &result)
 	return &result, err
 }
 
+type CanEmulateNetworkConditionsFuture struct {
+	*rpc.Call
+	result CanEmulateNetworkConditionsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CanEmulateNetworkConditionsFuture) Get() (*CanEmulateNetworkConditionsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CanEmulateNetworkConditionsRequest) DoAsync(ctx context.Context) *CanEmulateNetworkConditionsFuture {
+	f := new(CanEmulateNetworkConditionsFuture)
+	f.Call = r.client.Go(ctx, "Network.canEmulateNetworkConditions", r.params, &f.result)
+	return f
+}
+
+// EmulateNetworkConditionsParams are the parameters of Network.emulateNetworkConditions.
+type EmulateNetworkConditionsParams struct {
+	// True to emulate internet disconnection.
+	Offline bool `json:"offline"`
+
+	// Additional latency (ms).
+	Latency float64 `json:"latency"`
+
+	// Maximal aggregated download throughput.
+	DownloadThroughput float64 `json:"downloadThroughput"`
+
+	// Maximal aggregated upload throughput.
+	UploadThroughput float64 `json:"uploadThroughput"`
+
+	// Connection type if known. (optional)
+	ConnectionType *ConnectionType `json:"connectionType,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *EmulateNetworkConditionsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "offline")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "latency")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "downloadThroughput")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 {
+		missing = append(missing, "uploadThroughput")
+	}
+	if p.ConnectionType != nil && !p.ConnectionType.IsValid() {
+		invalid = append(invalid, "connectionType")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.emulateNetworkConditions", Missing: missing, Invalid: invalid}
+}
+
 type EmulateNetworkConditionsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EmulateNetworkConditionsParams
 }
 
 // Activates emulation of network conditions.
 func (d *Client) EmulateNetworkConditions() *EmulateNetworkConditionsRequest {
-	return &EmulateNetworkConditionsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EmulateNetworkConditionsRequest{client: d.Client, params: &EmulateNetworkConditionsParams{set: 1}}
+}
+
+// DoEmulateNetworkConditions sends Network.emulateNetworkConditions with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEmulateNetworkConditions(ctx context.Context, params *EmulateNetworkConditionsParams) error {
+	if params == nil {
+		params = new(EmulateNetworkConditionsParams)
+	}
+	return (&EmulateNetworkConditionsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // True to emulate internet disconnection.
 func (r *EmulateNetworkConditionsRequest) Offline(v bool) *EmulateNetworkConditionsRequest {
-	r.opts["offline"] = v
+	r.params.Offline = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Additional latency (ms).
 func (r *EmulateNetworkConditionsRequest) Latency(v float64) *EmulateNetworkConditionsRequest {
-	r.opts["latency"] = v
+	r.params.Latency = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Maximal aggregated download throughput.
 func (r *EmulateNetworkConditionsRequest) DownloadThroughput(v float64) *EmulateNetworkConditionsRequest {
-	r.opts["downloadThroughput"] = v
+	r.params.DownloadThroughput = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Maximal aggregated upload throughput.
 func (r *EmulateNetworkConditionsRequest) UploadThroughput(v float64) *EmulateNetworkConditionsRequest {
-	r.opts["uploadThroughput"] = v
+	r.params.UploadThroughput = v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Connection type if known. (optional)
 func (r *EmulateNetworkConditionsRequest) ConnectionType(v ConnectionType) *EmulateNetworkConditionsRequest {
-	r.opts["connectionType"] = v
+	r.params.ConnectionType = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 func (r *EmulateNetworkConditionsRequest) Do() error {
-	return r.client.Call("Network.emulateNetworkConditions", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *EmulateNetworkConditionsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.emulateNetworkConditions", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *EmulateNetworkConditionsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.emulateNetworkConditions", r.params, nil)
+}
+
+// SetCacheDisabledParams are the parameters of Network.setCacheDisabled.
+type SetCacheDisabledParams struct {
+	// Cache disabled state.
+	CacheDisabled bool `json:"cacheDisabled"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetCacheDisabledParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "cacheDisabled")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.setCacheDisabled", Missing: missing, Invalid: invalid}
 }
 
 type SetCacheDisabledRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetCacheDisabledParams
 }
 
 // Toggles ignoring cache for each request. If <code>true</code>, cache will not be used.
 func (d *Client) SetCacheDisabled() *SetCacheDisabledRequest {
-	return &SetCacheDisabledRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetCacheDisabledRequest{client: d.Client, params: &SetCacheDisabledParams{set: 1}}
+}
+
+// DoSetCacheDisabled sends Network.setCacheDisabled with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetCacheDisabled(ctx context.Context, params *SetCacheDisabledParams) error {
+	if params == nil {
+		params = new(SetCacheDisabledParams)
+	}
+	return (&SetCacheDisabledRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Cache disabled state.
 func (r *SetCacheDisabledRequest) CacheDisabled(v bool) *SetCacheDisabledRequest {
-	r.opts["cacheDisabled"] = v
+	r.params.CacheDisabled = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetCacheDisabledRequest) Do() error {
-	return r.client.Call("Network.setCacheDisabled", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetCacheDisabledRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.setCacheDisabled", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetCacheDisabledRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.setCacheDisabled", r.params, nil)
+}
+
+// SetBypassServiceWorkerParams are the parameters of Network.setBypassServiceWorker.
+type SetBypassServiceWorkerParams struct {
+	// Bypass service worker and load from network.
+	Bypass bool `json:"bypass"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetBypassServiceWorkerParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "bypass")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.setBypassServiceWorker", Missing: missing, Invalid: invalid}
 }
 
 type SetBypassServiceWorkerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetBypassServiceWorkerParams
 }
 
 // Toggles ignoring of service worker for each request. (experimental)
 func (d *Client) SetBypassServiceWorker() *SetBypassServiceWorkerRequest {
-	return &SetBypassServiceWorkerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetBypassServiceWorkerRequest{client: d.Client, params: &SetBypassServiceWorkerParams{set: 1}}
+}
+
+// DoSetBypassServiceWorker sends Network.setBypassServiceWorker with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetBypassServiceWorker(ctx context.Context, params *SetBypassServiceWorkerParams) error {
+	if params == nil {
+		params = new(SetBypassServiceWorkerParams)
+	}
+	return (&SetBypassServiceWorkerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Bypass service worker and load from network.
 func (r *SetBypassServiceWorkerRequest) Bypass(v bool) *SetBypassServiceWorkerRequest {
-	r.opts["bypass"] = v
+	r.params.Bypass = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetBypassServiceWorkerRequest) Do() error {
-	return r.client.Call("Network.setBypassServiceWorker", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetBypassServiceWorkerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.setBypassServiceWorker", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetBypassServiceWorkerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.setBypassServiceWorker", r.params, nil)
+}
+
+// SetDataSizeLimitsForTestParams are the parameters of Network.setDataSizeLimitsForTest.
+type SetDataSizeLimitsForTestParams struct {
+	// Maximum total buffer size.
+	MaxTotalSize int `json:"maxTotalSize"`
+
+	// Maximum per-resource size.
+	MaxResourceSize int `json:"maxResourceSize"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDataSizeLimitsForTestParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "maxTotalSize")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "maxResourceSize")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.setDataSizeLimitsForTest", Missing: missing, Invalid: invalid}
 }
 
 type SetDataSizeLimitsForTestRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDataSizeLimitsForTestParams
 }
 
 // For testing. (experimental)
 func (d *Client) SetDataSizeLimitsForTest() *SetDataSizeLimitsForTestRequest {
-	return &SetDataSizeLimitsForTestRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDataSizeLimitsForTestRequest{client: d.Client, params: &SetDataSizeLimitsForTestParams{set: 1}}
+}
+
+// DoSetDataSizeLimitsForTest sends Network.setDataSizeLimitsForTest with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDataSizeLimitsForTest(ctx context.Context, params *SetDataSizeLimitsForTestParams) error {
+	if params == nil {
+		params = new(SetDataSizeLimitsForTestParams)
+	}
+	return (&SetDataSizeLimitsForTestRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Maximum total buffer size.
 func (r *SetDataSizeLimitsForTestRequest) MaxTotalSize(v int) *SetDataSizeLimitsForTestRequest {
-	r.opts["maxTotalSize"] = v
+	r.params.MaxTotalSize = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Maximum per-resource size.
 func (r *SetDataSizeLimitsForTestRequest) MaxResourceSize(v int) *SetDataSizeLimitsForTestRequest {
-	r.opts["maxResourceSize"] = v
+	r.params.MaxResourceSize = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetDataSizeLimitsForTestRequest) Do() error {
-	return r.client.Call("Network.setDataSizeLimitsForTest", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDataSizeLimitsForTestRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.setDataSizeLimitsForTest", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDataSizeLimitsForTestRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.setDataSizeLimitsForTest", r.params, nil)
+}
+
+// GetCertificateParams are the parameters of Network.getCertificate.
+type GetCertificateParams struct {
+	// Origin to get certificate for.
+	Origin string `json:"origin"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetCertificateParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Origin == "" {
+		missing = append(missing, "origin")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.getCertificate", Missing: missing, Invalid: invalid}
 }
 
 type GetCertificateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetCertificateParams
 }
 
 // Returns the DER-encoded certificate. (experimental)
 func (d *Client) GetCertificate() *GetCertificateRequest {
-	return &GetCertificateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetCertificateRequest{client: d.Client, params: &GetCertificateParams{set: 1}}
+}
+
+// DoGetCertificate sends Network.getCertificate with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetCertificate(ctx context.Context, params *GetCertificateParams) (*GetCertificateResult, error) {
+	if params == nil {
+		params = new(GetCertificateParams)
+	}
+	return (&GetCertificateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Origin to get certificate for.
 func (r *GetCertificateRequest) Origin(v string) *GetCertificateRequest {
-	r.opts["origin"] = v
+	r.params.Origin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -892,84 +1932,214 @@ type GetCertificateResult struct {
 }
 
 func (r *GetCertificateRequest) Do() (*GetCertificateResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetCertificateRequest) DoContext(ctx context.Context) (*GetCertificateResult, error) {
 	var result GetCertificateResult
-	err := r.client.Call("Network.getCertificate", r.opts, &result)
+	err := r.client.CallContext(ctx, "Network.getCertificate", r.params, &result)
 	return &result, err
 }
 
+type GetCertificateFuture struct {
+	*rpc.Call
+	result GetCertificateResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetCertificateFuture) Get() (*GetCertificateResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetCertificateRequest) DoAsync(ctx context.Context) *GetCertificateFuture {
+	f := new(GetCertificateFuture)
+	f.Call = r.client.Go(ctx, "Network.getCertificate", r.params, &f.result)
+	return f
+}
+
+// EnableRequestInterceptionParams are the parameters of Network.enableRequestInterception.
+type EnableRequestInterceptionParams struct {
+	// Whether or not HTTP requests should be intercepted and Network.requestIntercepted events sent.
+	Enabled bool `json:"enabled"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *EnableRequestInterceptionParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "enabled")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.enableRequestInterception", Missing: missing, Invalid: invalid}
+}
+
 type EnableRequestInterceptionRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableRequestInterceptionParams
 }
 
 // (experimental)
 func (d *Client) EnableRequestInterception() *EnableRequestInterceptionRequest {
-	return &EnableRequestInterceptionRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequestInterceptionRequest{client: d.Client, params: &EnableRequestInterceptionParams{set: 1}}
+}
+
+// DoEnableRequestInterception sends Network.enableRequestInterception with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnableRequestInterception(ctx context.Context, params *EnableRequestInterceptionParams) error {
+	if params == nil {
+		params = new(EnableRequestInterceptionParams)
+	}
+	return (&EnableRequestInterceptionRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether or not HTTP requests should be intercepted and Network.requestIntercepted events sent.
 func (r *EnableRequestInterceptionRequest) Enabled(v bool) *EnableRequestInterceptionRequest {
-	r.opts["enabled"] = v
+	r.params.Enabled = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *EnableRequestInterceptionRequest) Do() error {
-	return r.client.Call("Network.enableRequestInterception", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *EnableRequestInterceptionRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.enableRequestInterception", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *EnableRequestInterceptionRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.enableRequestInterception", r.params, nil)
+}
+
+// ContinueInterceptedRequestParams are the parameters of Network.continueInterceptedRequest.
+type ContinueInterceptedRequestParams struct {
+	InterceptionId InterceptionId `json:"interceptionId"`
+
+	// If set this causes the request to fail with the given reason. (optional)
+	ErrorReason *ErrorReason `json:"errorReason,omitempty"`
+
+	// If set the requests completes using with the provided base64 encoded raw response, including HTTP status line and headers etc... (optional)
+	RawResponse *string `json:"rawResponse,omitempty"`
+
+	// If set the request url will be modified in a way that's not observable by page. (optional)
+	URL *string `json:"url,omitempty"`
+
+	// If set this allows the request method to be overridden. (optional)
+	Method *string `json:"method,omitempty"`
+
+	// If set this allows postData to be set. (optional)
+	PostData *string `json:"postData,omitempty"`
+
+	// If set this allows the request headers to be changed. (optional)
+	Headers Headers `json:"headers,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ContinueInterceptedRequestParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.InterceptionId == "" {
+		missing = append(missing, "interceptionId")
+	}
+	if p.ErrorReason != nil && !p.ErrorReason.IsValid() {
+		invalid = append(invalid, "errorReason")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Network.continueInterceptedRequest", Missing: missing, Invalid: invalid}
 }
 
 type ContinueInterceptedRequestRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ContinueInterceptedRequestParams
 }
 
 // Response to Network.requestIntercepted which either modifies the request to continue with any modifications, or blocks it, or completes it with the provided response bytes. If a network fetch occurs as a result which encounters a redirect an additional Network.requestIntercepted event will be sent with the same InterceptionId. (experimental)
 func (d *Client) ContinueInterceptedRequest() *ContinueInterceptedRequestRequest {
-	return &ContinueInterceptedRequestRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ContinueInterceptedRequestRequest{client: d.Client, params: &ContinueInterceptedRequestParams{set: 1}}
+}
+
+// DoContinueInterceptedRequest sends Network.continueInterceptedRequest with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoContinueInterceptedRequest(ctx context.Context, params *ContinueInterceptedRequestParams) error {
+	if params == nil {
+		params = new(ContinueInterceptedRequestParams)
+	}
+	return (&ContinueInterceptedRequestRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ContinueInterceptedRequestRequest) InterceptionId(v InterceptionId) *ContinueInterceptedRequestRequest {
-	r.opts["interceptionId"] = v
+	r.params.InterceptionId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // If set this causes the request to fail with the given reason. (optional)
 func (r *ContinueInterceptedRequestRequest) ErrorReason(v ErrorReason) *ContinueInterceptedRequestRequest {
-	r.opts["errorReason"] = v
+	r.params.ErrorReason = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // If set the requests completes using with the provided base64 encoded raw response, including HTTP status line and headers etc... (optional)
 func (r *ContinueInterceptedRequestRequest) RawResponse(v string) *ContinueInterceptedRequestRequest {
-	r.opts["rawResponse"] = v
+	r.params.RawResponse = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // If set the request url will be modified in a way that's not observable by page. (optional)
 func (r *ContinueInterceptedRequestRequest) URL(v string) *ContinueInterceptedRequestRequest {
-	r.opts["url"] = v
+	r.params.URL = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // If set this allows the request method to be overridden. (optional)
 func (r *ContinueInterceptedRequestRequest) Method(v string) *ContinueInterceptedRequestRequest {
-	r.opts["method"] = v
+	r.params.Method = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // If set this allows postData to be set. (optional)
 func (r *ContinueInterceptedRequestRequest) PostData(v string) *ContinueInterceptedRequestRequest {
-	r.opts["postData"] = v
+	r.params.PostData = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // If set this allows the request headers to be changed. (optional)
-func (r *ContinueInterceptedRequestRequest) Headers(v *Headers) *ContinueInterceptedRequestRequest {
-	r.opts["headers"] = v
+func (r *ContinueInterceptedRequestRequest) Headers(v Headers) *ContinueInterceptedRequestRequest {
+	r.params.Headers = v
+	r.params.set |= 1 << 7
 	return r
 }
 
 func (r *ContinueInterceptedRequestRequest) Do() error {
-	return r.client.Call("Network.continueInterceptedRequest", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ContinueInterceptedRequestRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Network.continueInterceptedRequest", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ContinueInterceptedRequestRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Network.continueInterceptedRequest", r.params, nil)
 }
 
 func init() {
@@ -1003,6 +2173,45 @@ type ResourceChangedPriorityEvent struct {
 	Timestamp Timestamp `json:"timestamp"`
 }
 
+// Fired when resource loading priority is changed (experimental)
+func (d *Client) OnResourceChangedPriority(fn func(*ResourceChangedPriorityEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.resourceChangedPriority", func(e interface{}) {
+		fn(e.(*ResourceChangedPriorityEvent))
+	}, opts...)
+}
+
+// FilterResourceChangedPriority is rpc.WithFilter for OnResourceChangedPriority.
+func FilterResourceChangedPriority(fn func(*ResourceChangedPriorityEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ResourceChangedPriorityEvent))
+	})
+}
+
+// ResourceChangedPriorityWaiter waits for a Network.resourceChangedPriority event. It is created by WaitForResourceChangedPriority.
+type ResourceChangedPriorityWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ResourceChangedPriorityWaiter) Wait() (*ResourceChangedPriorityEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ResourceChangedPriorityEvent), nil
+}
+
+// WaitForResourceChangedPriority arms a waiter for the first Network.resourceChangedPriority event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForResourceChangedPriority(ctx context.Context, predicate func(*ResourceChangedPriorityEvent) bool) *ResourceChangedPriorityWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ResourceChangedPriorityEvent))
+		}
+	}
+	return &ResourceChangedPriorityWaiter{d.Client.WaitFor(ctx, "Network.resourceChangedPriority", p)}
+}
+
 // Fired when page is about to send HTTP request.
 type RequestWillBeSentEvent struct {
 	// Request identifier.
@@ -1030,10 +2239,49 @@ type RequestWillBeSentEvent struct {
 	Initiator *Initiator `json:"initiator"`
 
 	// Redirect response data. (optional)
-	RedirectResponse *Response `json:"redirectResponse"`
+	RedirectResponse *Response `json:"redirectResponse,omitempty"`
 
 	// Type of this resource. (optional, experimental)
-	Type string `json:"type"`
+	Type *string `json:"type,omitempty"`
+}
+
+// Fired when page is about to send HTTP request.
+func (d *Client) OnRequestWillBeSent(fn func(*RequestWillBeSentEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.requestWillBeSent", func(e interface{}) {
+		fn(e.(*RequestWillBeSentEvent))
+	}, opts...)
+}
+
+// FilterRequestWillBeSent is rpc.WithFilter for OnRequestWillBeSent.
+func FilterRequestWillBeSent(fn func(*RequestWillBeSentEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*RequestWillBeSentEvent))
+	})
+}
+
+// RequestWillBeSentWaiter waits for a Network.requestWillBeSent event. It is created by WaitForRequestWillBeSent.
+type RequestWillBeSentWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *RequestWillBeSentWaiter) Wait() (*RequestWillBeSentEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*RequestWillBeSentEvent), nil
+}
+
+// WaitForRequestWillBeSent arms a waiter for the first Network.requestWillBeSent event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForRequestWillBeSent(ctx context.Context, predicate func(*RequestWillBeSentEvent) bool) *RequestWillBeSentWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*RequestWillBeSentEvent))
+		}
+	}
+	return &RequestWillBeSentWaiter{d.Client.WaitFor(ctx, "Network.requestWillBeSent", p)}
 }
 
 // Fired if request ended up loading from cache.
@@ -1042,6 +2290,45 @@ type RequestServedFromCacheEvent struct {
 	RequestId RequestId `json:"requestId"`
 }
 
+// Fired if request ended up loading from cache.
+func (d *Client) OnRequestServedFromCache(fn func(*RequestServedFromCacheEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.requestServedFromCache", func(e interface{}) {
+		fn(e.(*RequestServedFromCacheEvent))
+	}, opts...)
+}
+
+// FilterRequestServedFromCache is rpc.WithFilter for OnRequestServedFromCache.
+func FilterRequestServedFromCache(fn func(*RequestServedFromCacheEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*RequestServedFromCacheEvent))
+	})
+}
+
+// RequestServedFromCacheWaiter waits for a Network.requestServedFromCache event. It is created by WaitForRequestServedFromCache.
+type RequestServedFromCacheWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *RequestServedFromCacheWaiter) Wait() (*RequestServedFromCacheEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*RequestServedFromCacheEvent), nil
+}
+
+// WaitForRequestServedFromCache arms a waiter for the first Network.requestServedFromCache event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForRequestServedFromCache(ctx context.Context, predicate func(*RequestServedFromCacheEvent) bool) *RequestServedFromCacheWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*RequestServedFromCacheEvent))
+		}
+	}
+	return &RequestServedFromCacheWaiter{d.Client.WaitFor(ctx, "Network.requestServedFromCache", p)}
+}
+
 // Fired when HTTP response is available.
 type ResponseReceivedEvent struct {
 	// Request identifier.
@@ -1063,6 +2350,45 @@ type ResponseReceivedEvent struct {
 	Response *Response `json:"response"`
 }
 
+// Fired when HTTP response is available.
+func (d *Client) OnResponseReceived(fn func(*ResponseReceivedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.responseReceived", func(e interface{}) {
+		fn(e.(*ResponseReceivedEvent))
+	}, opts...)
+}
+
+// FilterResponseReceived is rpc.WithFilter for OnResponseReceived.
+func FilterResponseReceived(fn func(*ResponseReceivedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ResponseReceivedEvent))
+	})
+}
+
+// ResponseReceivedWaiter waits for a Network.responseReceived event. It is created by WaitForResponseReceived.
+type ResponseReceivedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ResponseReceivedWaiter) Wait() (*ResponseReceivedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ResponseReceivedEvent), nil
+}
+
+// WaitForResponseReceived arms a waiter for the first Network.responseReceived event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForResponseReceived(ctx context.Context, predicate func(*ResponseReceivedEvent) bool) *ResponseReceivedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ResponseReceivedEvent))
+		}
+	}
+	return &ResponseReceivedWaiter{d.Client.WaitFor(ctx, "Network.responseReceived", p)}
+}
+
 // Fired when data chunk was received over the network.
 type DataReceivedEvent struct {
 	// Request identifier.
@@ -1078,6 +2404,45 @@ type DataReceivedEvent struct {
 	EncodedDataLength int `json:"encodedDataLength"`
 }
 
+// Fired when data chunk was received over the network.
+func (d *Client) OnDataReceived(fn func(*DataReceivedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.dataReceived", func(e interface{}) {
+		fn(e.(*DataReceivedEvent))
+	}, opts...)
+}
+
+// FilterDataReceived is rpc.WithFilter for OnDataReceived.
+func FilterDataReceived(fn func(*DataReceivedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DataReceivedEvent))
+	})
+}
+
+// DataReceivedWaiter waits for a Network.dataReceived event. It is created by WaitForDataReceived.
+type DataReceivedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DataReceivedWaiter) Wait() (*DataReceivedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DataReceivedEvent), nil
+}
+
+// WaitForDataReceived arms a waiter for the first Network.dataReceived event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDataReceived(ctx context.Context, predicate func(*DataReceivedEvent) bool) *DataReceivedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DataReceivedEvent))
+		}
+	}
+	return &DataReceivedWaiter{d.Client.WaitFor(ctx, "Network.dataReceived", p)}
+}
+
 // Fired when HTTP request has finished loading.
 type LoadingFinishedEvent struct {
 	// Request identifier.
@@ -1090,6 +2455,45 @@ type LoadingFinishedEvent struct {
 	EncodedDataLength float64 `json:"encodedDataLength"`
 }
 
+// Fired when HTTP request has finished loading.
+func (d *Client) OnLoadingFinished(fn func(*LoadingFinishedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.loadingFinished", func(e interface{}) {
+		fn(e.(*LoadingFinishedEvent))
+	}, opts...)
+}
+
+// FilterLoadingFinished is rpc.WithFilter for OnLoadingFinished.
+func FilterLoadingFinished(fn func(*LoadingFinishedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*LoadingFinishedEvent))
+	})
+}
+
+// LoadingFinishedWaiter waits for a Network.loadingFinished event. It is created by WaitForLoadingFinished.
+type LoadingFinishedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *LoadingFinishedWaiter) Wait() (*LoadingFinishedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*LoadingFinishedEvent), nil
+}
+
+// WaitForLoadingFinished arms a waiter for the first Network.loadingFinished event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForLoadingFinished(ctx context.Context, predicate func(*LoadingFinishedEvent) bool) *LoadingFinishedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*LoadingFinishedEvent))
+		}
+	}
+	return &LoadingFinishedWaiter{d.Client.WaitFor(ctx, "Network.loadingFinished", p)}
+}
+
 // Fired when HTTP request has failed to load.
 type LoadingFailedEvent struct {
 	// Request identifier.
@@ -1105,10 +2509,49 @@ type LoadingFailedEvent struct {
 	ErrorText string `json:"errorText"`
 
 	// True if loading was canceled. (optional)
-	Canceled bool `json:"canceled"`
+	Canceled *bool `json:"canceled,omitempty"`
 
 	// The reason why loading was blocked, if any. (optional, experimental)
-	BlockedReason BlockedReason `json:"blockedReason"`
+	BlockedReason *BlockedReason `json:"blockedReason,omitempty"`
+}
+
+// Fired when HTTP request has failed to load.
+func (d *Client) OnLoadingFailed(fn func(*LoadingFailedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.loadingFailed", func(e interface{}) {
+		fn(e.(*LoadingFailedEvent))
+	}, opts...)
+}
+
+// FilterLoadingFailed is rpc.WithFilter for OnLoadingFailed.
+func FilterLoadingFailed(fn func(*LoadingFailedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*LoadingFailedEvent))
+	})
+}
+
+// LoadingFailedWaiter waits for a Network.loadingFailed event. It is created by WaitForLoadingFailed.
+type LoadingFailedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *LoadingFailedWaiter) Wait() (*LoadingFailedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*LoadingFailedEvent), nil
+}
+
+// WaitForLoadingFailed arms a waiter for the first Network.loadingFailed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForLoadingFailed(ctx context.Context, predicate func(*LoadingFailedEvent) bool) *LoadingFailedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*LoadingFailedEvent))
+		}
+	}
+	return &LoadingFailedWaiter{d.Client.WaitFor(ctx, "Network.loadingFailed", p)}
 }
 
 // Fired when WebSocket is about to initiate handshake. (experimental)
@@ -1126,6 +2569,45 @@ type WebSocketWillSendHandshakeRequestEvent struct {
 	Request *WebSocketRequest `json:"request"`
 }
 
+// Fired when WebSocket is about to initiate handshake. (experimental)
+func (d *Client) OnWebSocketWillSendHandshakeRequest(fn func(*WebSocketWillSendHandshakeRequestEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.webSocketWillSendHandshakeRequest", func(e interface{}) {
+		fn(e.(*WebSocketWillSendHandshakeRequestEvent))
+	}, opts...)
+}
+
+// FilterWebSocketWillSendHandshakeRequest is rpc.WithFilter for OnWebSocketWillSendHandshakeRequest.
+func FilterWebSocketWillSendHandshakeRequest(fn func(*WebSocketWillSendHandshakeRequestEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WebSocketWillSendHandshakeRequestEvent))
+	})
+}
+
+// WebSocketWillSendHandshakeRequestWaiter waits for a Network.webSocketWillSendHandshakeRequest event. It is created by WaitForWebSocketWillSendHandshakeRequest.
+type WebSocketWillSendHandshakeRequestWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WebSocketWillSendHandshakeRequestWaiter) Wait() (*WebSocketWillSendHandshakeRequestEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WebSocketWillSendHandshakeRequestEvent), nil
+}
+
+// WaitForWebSocketWillSendHandshakeRequest arms a waiter for the first Network.webSocketWillSendHandshakeRequest event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWebSocketWillSendHandshakeRequest(ctx context.Context, predicate func(*WebSocketWillSendHandshakeRequestEvent) bool) *WebSocketWillSendHandshakeRequestWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WebSocketWillSendHandshakeRequestEvent))
+		}
+	}
+	return &WebSocketWillSendHandshakeRequestWaiter{d.Client.WaitFor(ctx, "Network.webSocketWillSendHandshakeRequest", p)}
+}
+
 // Fired when WebSocket handshake response becomes available. (experimental)
 type WebSocketHandshakeResponseReceivedEvent struct {
 	// Request identifier.
@@ -1138,6 +2620,45 @@ type WebSocketHandshakeResponseReceivedEvent struct {
 	Response *WebSocketResponse `json:"response"`
 }
 
+// Fired when WebSocket handshake response becomes available. (experimental)
+func (d *Client) OnWebSocketHandshakeResponseReceived(fn func(*WebSocketHandshakeResponseReceivedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.webSocketHandshakeResponseReceived", func(e interface{}) {
+		fn(e.(*WebSocketHandshakeResponseReceivedEvent))
+	}, opts...)
+}
+
+// FilterWebSocketHandshakeResponseReceived is rpc.WithFilter for OnWebSocketHandshakeResponseReceived.
+func FilterWebSocketHandshakeResponseReceived(fn func(*WebSocketHandshakeResponseReceivedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WebSocketHandshakeResponseReceivedEvent))
+	})
+}
+
+// WebSocketHandshakeResponseReceivedWaiter waits for a Network.webSocketHandshakeResponseReceived event. It is created by WaitForWebSocketHandshakeResponseReceived.
+type WebSocketHandshakeResponseReceivedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WebSocketHandshakeResponseReceivedWaiter) Wait() (*WebSocketHandshakeResponseReceivedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WebSocketHandshakeResponseReceivedEvent), nil
+}
+
+// WaitForWebSocketHandshakeResponseReceived arms a waiter for the first Network.webSocketHandshakeResponseReceived event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWebSocketHandshakeResponseReceived(ctx context.Context, predicate func(*WebSocketHandshakeResponseReceivedEvent) bool) *WebSocketHandshakeResponseReceivedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WebSocketHandshakeResponseReceivedEvent))
+		}
+	}
+	return &WebSocketHandshakeResponseReceivedWaiter{d.Client.WaitFor(ctx, "Network.webSocketHandshakeResponseReceived", p)}
+}
+
 // Fired upon WebSocket creation. (experimental)
 type WebSocketCreatedEvent struct {
 	// Request identifier.
@@ -1147,7 +2668,46 @@ type WebSocketCreatedEvent struct {
 	URL string `json:"url"`
 
 	// Request initiator. (optional)
-	Initiator *Initiator `json:"initiator"`
+	Initiator *Initiator `json:"initiator,omitempty"`
+}
+
+// Fired upon WebSocket creation. (experimental)
+func (d *Client) OnWebSocketCreated(fn func(*WebSocketCreatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.webSocketCreated", func(e interface{}) {
+		fn(e.(*WebSocketCreatedEvent))
+	}, opts...)
+}
+
+// FilterWebSocketCreated is rpc.WithFilter for OnWebSocketCreated.
+func FilterWebSocketCreated(fn func(*WebSocketCreatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WebSocketCreatedEvent))
+	})
+}
+
+// WebSocketCreatedWaiter waits for a Network.webSocketCreated event. It is created by WaitForWebSocketCreated.
+type WebSocketCreatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WebSocketCreatedWaiter) Wait() (*WebSocketCreatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WebSocketCreatedEvent), nil
+}
+
+// WaitForWebSocketCreated arms a waiter for the first Network.webSocketCreated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWebSocketCreated(ctx context.Context, predicate func(*WebSocketCreatedEvent) bool) *WebSocketCreatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WebSocketCreatedEvent))
+		}
+	}
+	return &WebSocketCreatedWaiter{d.Client.WaitFor(ctx, "Network.webSocketCreated", p)}
 }
 
 // Fired when WebSocket is closed. (experimental)
@@ -1159,6 +2719,45 @@ type WebSocketClosedEvent struct {
 	Timestamp Timestamp `json:"timestamp"`
 }
 
+// Fired when WebSocket is closed. (experimental)
+func (d *Client) OnWebSocketClosed(fn func(*WebSocketClosedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.webSocketClosed", func(e interface{}) {
+		fn(e.(*WebSocketClosedEvent))
+	}, opts...)
+}
+
+// FilterWebSocketClosed is rpc.WithFilter for OnWebSocketClosed.
+func FilterWebSocketClosed(fn func(*WebSocketClosedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WebSocketClosedEvent))
+	})
+}
+
+// WebSocketClosedWaiter waits for a Network.webSocketClosed event. It is created by WaitForWebSocketClosed.
+type WebSocketClosedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WebSocketClosedWaiter) Wait() (*WebSocketClosedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WebSocketClosedEvent), nil
+}
+
+// WaitForWebSocketClosed arms a waiter for the first Network.webSocketClosed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWebSocketClosed(ctx context.Context, predicate func(*WebSocketClosedEvent) bool) *WebSocketClosedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WebSocketClosedEvent))
+		}
+	}
+	return &WebSocketClosedWaiter{d.Client.WaitFor(ctx, "Network.webSocketClosed", p)}
+}
+
 // Fired when WebSocket frame is received. (experimental)
 type WebSocketFrameReceivedEvent struct {
 	// Request identifier.
@@ -1171,6 +2770,45 @@ type WebSocketFrameReceivedEvent struct {
 	Response *WebSocketFrame `json:"response"`
 }
 
+// Fired when WebSocket frame is received. (experimental)
+func (d *Client) OnWebSocketFrameReceived(fn func(*WebSocketFrameReceivedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.webSocketFrameReceived", func(e interface{}) {
+		fn(e.(*WebSocketFrameReceivedEvent))
+	}, opts...)
+}
+
+// FilterWebSocketFrameReceived is rpc.WithFilter for OnWebSocketFrameReceived.
+func FilterWebSocketFrameReceived(fn func(*WebSocketFrameReceivedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WebSocketFrameReceivedEvent))
+	})
+}
+
+// WebSocketFrameReceivedWaiter waits for a Network.webSocketFrameReceived event. It is created by WaitForWebSocketFrameReceived.
+type WebSocketFrameReceivedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WebSocketFrameReceivedWaiter) Wait() (*WebSocketFrameReceivedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WebSocketFrameReceivedEvent), nil
+}
+
+// WaitForWebSocketFrameReceived arms a waiter for the first Network.webSocketFrameReceived event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWebSocketFrameReceived(ctx context.Context, predicate func(*WebSocketFrameReceivedEvent) bool) *WebSocketFrameReceivedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WebSocketFrameReceivedEvent))
+		}
+	}
+	return &WebSocketFrameReceivedWaiter{d.Client.WaitFor(ctx, "Network.webSocketFrameReceived", p)}
+}
+
 // Fired when WebSocket frame error occurs. (experimental)
 type WebSocketFrameErrorEvent struct {
 	// Request identifier.
@@ -1183,6 +2821,45 @@ type WebSocketFrameErrorEvent struct {
 	ErrorMessage string `json:"errorMessage"`
 }
 
+// Fired when WebSocket frame error occurs. (experimental)
+func (d *Client) OnWebSocketFrameError(fn func(*WebSocketFrameErrorEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.webSocketFrameError", func(e interface{}) {
+		fn(e.(*WebSocketFrameErrorEvent))
+	}, opts...)
+}
+
+// FilterWebSocketFrameError is rpc.WithFilter for OnWebSocketFrameError.
+func FilterWebSocketFrameError(fn func(*WebSocketFrameErrorEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WebSocketFrameErrorEvent))
+	})
+}
+
+// WebSocketFrameErrorWaiter waits for a Network.webSocketFrameError event. It is created by WaitForWebSocketFrameError.
+type WebSocketFrameErrorWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WebSocketFrameErrorWaiter) Wait() (*WebSocketFrameErrorEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WebSocketFrameErrorEvent), nil
+}
+
+// WaitForWebSocketFrameError arms a waiter for the first Network.webSocketFrameError event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWebSocketFrameError(ctx context.Context, predicate func(*WebSocketFrameErrorEvent) bool) *WebSocketFrameErrorWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WebSocketFrameErrorEvent))
+		}
+	}
+	return &WebSocketFrameErrorWaiter{d.Client.WaitFor(ctx, "Network.webSocketFrameError", p)}
+}
+
 // Fired when WebSocket frame is sent. (experimental)
 type WebSocketFrameSentEvent struct {
 	// Request identifier.
@@ -1195,6 +2872,45 @@ type WebSocketFrameSentEvent struct {
 	Response *WebSocketFrame `json:"response"`
 }
 
+// Fired when WebSocket frame is sent. (experimental)
+func (d *Client) OnWebSocketFrameSent(fn func(*WebSocketFrameSentEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.webSocketFrameSent", func(e interface{}) {
+		fn(e.(*WebSocketFrameSentEvent))
+	}, opts...)
+}
+
+// FilterWebSocketFrameSent is rpc.WithFilter for OnWebSocketFrameSent.
+func FilterWebSocketFrameSent(fn func(*WebSocketFrameSentEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WebSocketFrameSentEvent))
+	})
+}
+
+// WebSocketFrameSentWaiter waits for a Network.webSocketFrameSent event. It is created by WaitForWebSocketFrameSent.
+type WebSocketFrameSentWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WebSocketFrameSentWaiter) Wait() (*WebSocketFrameSentEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WebSocketFrameSentEvent), nil
+}
+
+// WaitForWebSocketFrameSent arms a waiter for the first Network.webSocketFrameSent event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWebSocketFrameSent(ctx context.Context, predicate func(*WebSocketFrameSentEvent) bool) *WebSocketFrameSentWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WebSocketFrameSentEvent))
+		}
+	}
+	return &WebSocketFrameSentWaiter{d.Client.WaitFor(ctx, "Network.webSocketFrameSent", p)}
+}
+
 // Fired when EventSource message is received. (experimental)
 type EventSourceMessageReceivedEvent struct {
 	// Request identifier.
@@ -1213,6 +2929,45 @@ type EventSourceMessageReceivedEvent struct {
 	Data string `json:"data"`
 }
 
+// Fired when EventSource message is received. (experimental)
+func (d *Client) OnEventSourceMessageReceived(fn func(*EventSourceMessageReceivedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.eventSourceMessageReceived", func(e interface{}) {
+		fn(e.(*EventSourceMessageReceivedEvent))
+	}, opts...)
+}
+
+// FilterEventSourceMessageReceived is rpc.WithFilter for OnEventSourceMessageReceived.
+func FilterEventSourceMessageReceived(fn func(*EventSourceMessageReceivedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*EventSourceMessageReceivedEvent))
+	})
+}
+
+// EventSourceMessageReceivedWaiter waits for a Network.eventSourceMessageReceived event. It is created by WaitForEventSourceMessageReceived.
+type EventSourceMessageReceivedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *EventSourceMessageReceivedWaiter) Wait() (*EventSourceMessageReceivedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*EventSourceMessageReceivedEvent), nil
+}
+
+// WaitForEventSourceMessageReceived arms a waiter for the first Network.eventSourceMessageReceived event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForEventSourceMessageReceived(ctx context.Context, predicate func(*EventSourceMessageReceivedEvent) bool) *EventSourceMessageReceivedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*EventSourceMessageReceivedEvent))
+		}
+	}
+	return &EventSourceMessageReceivedWaiter{d.Client.WaitFor(ctx, "Network.eventSourceMessageReceived", p)}
+}
+
 // Details of an intercepted HTTP request, which must be either allowed, blocked, modified or mocked. (experimental)
 type RequestInterceptedEvent struct {
 	// Each request the page makes will have a unique id, however if any redirects are encountered while processing that fetch, they will be reported with the same id as the original fetch.
@@ -1224,11 +2979,50 @@ type RequestInterceptedEvent struct {
 	ResourceType string `json:"resourceType"`
 
 	// HTTP response headers, only sent if a redirect was intercepted. (optional)
-	RedirectHeaders *Headers `json:"redirectHeaders"`
+	RedirectHeaders Headers `json:"redirectHeaders,omitempty"`
 
 	// HTTP response code, only sent if a redirect was intercepted. (optional)
-	RedirectStatusCode int `json:"redirectStatusCode"`
+	RedirectStatusCode *int `json:"redirectStatusCode,omitempty"`
 
 	// Redirect location, only sent if a redirect was intercepted. (optional)
-	RedirectUrl string `json:"redirectUrl"`
+	RedirectUrl *string `json:"redirectUrl,omitempty"`
+}
+
+// Details of an intercepted HTTP request, which must be either allowed, blocked, modified or mocked. (experimental)
+func (d *Client) OnRequestIntercepted(fn func(*RequestInterceptedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Network.requestIntercepted", func(e interface{}) {
+		fn(e.(*RequestInterceptedEvent))
+	}, opts...)
+}
+
+// FilterRequestIntercepted is rpc.WithFilter for OnRequestIntercepted.
+func FilterRequestIntercepted(fn func(*RequestInterceptedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*RequestInterceptedEvent))
+	})
+}
+
+// RequestInterceptedWaiter waits for a Network.requestIntercepted event. It is created by WaitForRequestIntercepted.
+type RequestInterceptedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *RequestInterceptedWaiter) Wait() (*RequestInterceptedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*RequestInterceptedEvent), nil
+}
+
+// WaitForRequestIntercepted arms a waiter for the first Network.requestIntercepted event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForRequestIntercepted(ctx context.Context, predicate func(*RequestInterceptedEvent) bool) *RequestInterceptedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*RequestInterceptedEvent))
+		}
+	}
+	return &RequestInterceptedWaiter{d.Client.WaitFor(ctx, "Network.requestIntercepted", p)}
 }
@@ -2,6 +2,8 @@
 package overlay
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/dom"
@@ -17,16 +19,16 @@ type Client struct {
 
 type HighlightConfig struct {
 	// Whether the node info tooltip should be shown (default: false). (optional)
-	ShowInfo bool `json:"showInfo,omitempty"`
+	ShowInfo *bool `json:"showInfo,omitempty"`
 
 	// Whether the rulers should be shown (default: false). (optional)
-	ShowRulers bool `json:"showRulers,omitempty"`
+	ShowRulers *bool `json:"showRulers,omitempty"`
 
 	// Whether the extension lines from node to the rulers should be shown (default: false). (optional)
-	ShowExtensionLines bool `json:"showExtensionLines,omitempty"`
+	ShowExtensionLines *bool `json:"showExtensionLines,omitempty"`
 
 	// (optional)
-	DisplayAsMaterial bool `json:"displayAsMaterial,omitempty"`
+	DisplayAsMaterial *bool `json:"displayAsMaterial,omitempty"`
 
 	// The content box highlight fill color (default: transparent). (optional)
 	ContentColor *dom.RGBA `json:"contentColor,omitempty"`
@@ -50,382 +52,1047 @@ type HighlightConfig struct {
 	ShapeMarginColor *dom.RGBA `json:"shapeMarginColor,omitempty"`
 
 	// Selectors to highlight relevant nodes. (optional)
-	SelectorList string `json:"selectorList,omitempty"`
+	SelectorList *string `json:"selectorList,omitempty"`
 }
 
 type InspectMode string
 
+const (
+	InspectModeSearchForNode         InspectMode = "searchForNode"
+	InspectModeSearchForUAShadowDOM  InspectMode = "searchForUAShadowDOM"
+	InspectModeCaptureAreaScreenshot InspectMode = "captureAreaScreenshot"
+	InspectModeNone                  InspectMode = "none"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v InspectMode) IsValid() bool {
+	switch v {
+	case InspectModeSearchForNode, InspectModeSearchForUAShadowDOM, InspectModeCaptureAreaScreenshot, InspectModeNone:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Overlay.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables domain notifications.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Overlay.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Overlay.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Overlay", r.params)
+}
+
+// DisableParams are the parameters of Overlay.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables domain notifications.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Overlay.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Overlay.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Overlay", r.params)
+}
+
+// SetShowPaintRectsParams are the parameters of Overlay.setShowPaintRects.
+type SetShowPaintRectsParams struct {
+	// True for showing paint rectangles
+	Result bool `json:"result"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetShowPaintRectsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "result")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setShowPaintRects", Missing: missing, Invalid: invalid}
 }
 
 type SetShowPaintRectsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetShowPaintRectsParams
 }
 
 // Requests that backend shows paint rectangles
 func (d *Client) SetShowPaintRects() *SetShowPaintRectsRequest {
-	return &SetShowPaintRectsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetShowPaintRectsRequest{client: d.Client, params: &SetShowPaintRectsParams{set: 1}}
+}
+
+// DoSetShowPaintRects sends Overlay.setShowPaintRects with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetShowPaintRects(ctx context.Context, params *SetShowPaintRectsParams) error {
+	if params == nil {
+		params = new(SetShowPaintRectsParams)
+	}
+	return (&SetShowPaintRectsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // True for showing paint rectangles
 func (r *SetShowPaintRectsRequest) Result(v bool) *SetShowPaintRectsRequest {
-	r.opts["result"] = v
+	r.params.Result = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetShowPaintRectsRequest) Do() error {
-	return r.client.Call("Overlay.setShowPaintRects", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetShowPaintRectsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setShowPaintRects", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetShowPaintRectsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setShowPaintRects", r.params, nil)
+}
+
+// SetShowDebugBordersParams are the parameters of Overlay.setShowDebugBorders.
+type SetShowDebugBordersParams struct {
+	// True for showing debug borders
+	Show bool `json:"show"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetShowDebugBordersParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "show")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setShowDebugBorders", Missing: missing, Invalid: invalid}
 }
 
 type SetShowDebugBordersRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetShowDebugBordersParams
 }
 
 // Requests that backend shows debug borders on layers
 func (d *Client) SetShowDebugBorders() *SetShowDebugBordersRequest {
-	return &SetShowDebugBordersRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetShowDebugBordersRequest{client: d.Client, params: &SetShowDebugBordersParams{set: 1}}
+}
+
+// DoSetShowDebugBorders sends Overlay.setShowDebugBorders with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetShowDebugBorders(ctx context.Context, params *SetShowDebugBordersParams) error {
+	if params == nil {
+		params = new(SetShowDebugBordersParams)
+	}
+	return (&SetShowDebugBordersRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // True for showing debug borders
 func (r *SetShowDebugBordersRequest) Show(v bool) *SetShowDebugBordersRequest {
-	r.opts["show"] = v
+	r.params.Show = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetShowDebugBordersRequest) Do() error {
-	return r.client.Call("Overlay.setShowDebugBorders", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetShowDebugBordersRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setShowDebugBorders", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetShowDebugBordersRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setShowDebugBorders", r.params, nil)
+}
+
+// SetShowFPSCounterParams are the parameters of Overlay.setShowFPSCounter.
+type SetShowFPSCounterParams struct {
+	// True for showing the FPS counter
+	Show bool `json:"show"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetShowFPSCounterParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "show")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setShowFPSCounter", Missing: missing, Invalid: invalid}
 }
 
 type SetShowFPSCounterRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetShowFPSCounterParams
 }
 
 // Requests that backend shows the FPS counter
 func (d *Client) SetShowFPSCounter() *SetShowFPSCounterRequest {
-	return &SetShowFPSCounterRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetShowFPSCounterRequest{client: d.Client, params: &SetShowFPSCounterParams{set: 1}}
+}
+
+// DoSetShowFPSCounter sends Overlay.setShowFPSCounter with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetShowFPSCounter(ctx context.Context, params *SetShowFPSCounterParams) error {
+	if params == nil {
+		params = new(SetShowFPSCounterParams)
+	}
+	return (&SetShowFPSCounterRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // True for showing the FPS counter
 func (r *SetShowFPSCounterRequest) Show(v bool) *SetShowFPSCounterRequest {
-	r.opts["show"] = v
+	r.params.Show = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetShowFPSCounterRequest) Do() error {
-	return r.client.Call("Overlay.setShowFPSCounter", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetShowFPSCounterRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setShowFPSCounter", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetShowFPSCounterRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setShowFPSCounter", r.params, nil)
+}
+
+// SetShowScrollBottleneckRectsParams are the parameters of Overlay.setShowScrollBottleneckRects.
+type SetShowScrollBottleneckRectsParams struct {
+	// True for showing scroll bottleneck rects
+	Show bool `json:"show"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetShowScrollBottleneckRectsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "show")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setShowScrollBottleneckRects", Missing: missing, Invalid: invalid}
 }
 
 type SetShowScrollBottleneckRectsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetShowScrollBottleneckRectsParams
 }
 
 // Requests that backend shows scroll bottleneck rects
 func (d *Client) SetShowScrollBottleneckRects() *SetShowScrollBottleneckRectsRequest {
-	return &SetShowScrollBottleneckRectsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetShowScrollBottleneckRectsRequest{client: d.Client, params: &SetShowScrollBottleneckRectsParams{set: 1}}
+}
+
+// DoSetShowScrollBottleneckRects sends Overlay.setShowScrollBottleneckRects with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetShowScrollBottleneckRects(ctx context.Context, params *SetShowScrollBottleneckRectsParams) error {
+	if params == nil {
+		params = new(SetShowScrollBottleneckRectsParams)
+	}
+	return (&SetShowScrollBottleneckRectsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // True for showing scroll bottleneck rects
 func (r *SetShowScrollBottleneckRectsRequest) Show(v bool) *SetShowScrollBottleneckRectsRequest {
-	r.opts["show"] = v
+	r.params.Show = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetShowScrollBottleneckRectsRequest) Do() error {
-	return r.client.Call("Overlay.setShowScrollBottleneckRects", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetShowScrollBottleneckRectsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setShowScrollBottleneckRects", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetShowScrollBottleneckRectsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setShowScrollBottleneckRects", r.params, nil)
+}
+
+// SetShowViewportSizeOnResizeParams are the parameters of Overlay.setShowViewportSizeOnResize.
+type SetShowViewportSizeOnResizeParams struct {
+	// Whether to paint size or not.
+	Show bool `json:"show"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetShowViewportSizeOnResizeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "show")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setShowViewportSizeOnResize", Missing: missing, Invalid: invalid}
 }
 
 type SetShowViewportSizeOnResizeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetShowViewportSizeOnResizeParams
 }
 
 // Paints viewport size upon main frame resize.
 func (d *Client) SetShowViewportSizeOnResize() *SetShowViewportSizeOnResizeRequest {
-	return &SetShowViewportSizeOnResizeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetShowViewportSizeOnResizeRequest{client: d.Client, params: &SetShowViewportSizeOnResizeParams{set: 1}}
+}
+
+// DoSetShowViewportSizeOnResize sends Overlay.setShowViewportSizeOnResize with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetShowViewportSizeOnResize(ctx context.Context, params *SetShowViewportSizeOnResizeParams) error {
+	if params == nil {
+		params = new(SetShowViewportSizeOnResizeParams)
+	}
+	return (&SetShowViewportSizeOnResizeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether to paint size or not.
 func (r *SetShowViewportSizeOnResizeRequest) Show(v bool) *SetShowViewportSizeOnResizeRequest {
-	r.opts["show"] = v
+	r.params.Show = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetShowViewportSizeOnResizeRequest) Do() error {
-	return r.client.Call("Overlay.setShowViewportSizeOnResize", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetShowViewportSizeOnResizeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setShowViewportSizeOnResize", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetShowViewportSizeOnResizeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setShowViewportSizeOnResize", r.params, nil)
+}
+
+// SetPausedInDebuggerMessageParams are the parameters of Overlay.setPausedInDebuggerMessage.
+type SetPausedInDebuggerMessageParams struct {
+	// The message to display, also triggers resume and step over controls. (optional)
+	Message *string `json:"message,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetPausedInDebuggerMessageParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setPausedInDebuggerMessage", Missing: missing, Invalid: invalid}
 }
 
 type SetPausedInDebuggerMessageRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetPausedInDebuggerMessageParams
 }
 
 func (d *Client) SetPausedInDebuggerMessage() *SetPausedInDebuggerMessageRequest {
-	return &SetPausedInDebuggerMessageRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetPausedInDebuggerMessageRequest{client: d.Client, params: &SetPausedInDebuggerMessageParams{set: 1}}
+}
+
+// DoSetPausedInDebuggerMessage sends Overlay.setPausedInDebuggerMessage with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetPausedInDebuggerMessage(ctx context.Context, params *SetPausedInDebuggerMessageParams) error {
+	if params == nil {
+		params = new(SetPausedInDebuggerMessageParams)
+	}
+	return (&SetPausedInDebuggerMessageRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The message to display, also triggers resume and step over controls. (optional)
 func (r *SetPausedInDebuggerMessageRequest) Message(v string) *SetPausedInDebuggerMessageRequest {
-	r.opts["message"] = v
+	r.params.Message = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetPausedInDebuggerMessageRequest) Do() error {
-	return r.client.Call("Overlay.setPausedInDebuggerMessage", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetPausedInDebuggerMessageRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setPausedInDebuggerMessage", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetPausedInDebuggerMessageRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setPausedInDebuggerMessage", r.params, nil)
+}
+
+// SetSuspendedParams are the parameters of Overlay.setSuspended.
+type SetSuspendedParams struct {
+	// Whether overlay should be suspended and not consume any resources until resumed.
+	Suspended bool `json:"suspended"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetSuspendedParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "suspended")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setSuspended", Missing: missing, Invalid: invalid}
 }
 
 type SetSuspendedRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetSuspendedParams
 }
 
 func (d *Client) SetSuspended() *SetSuspendedRequest {
-	return &SetSuspendedRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetSuspendedRequest{client: d.Client, params: &SetSuspendedParams{set: 1}}
+}
+
+// DoSetSuspended sends Overlay.setSuspended with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetSuspended(ctx context.Context, params *SetSuspendedParams) error {
+	if params == nil {
+		params = new(SetSuspendedParams)
+	}
+	return (&SetSuspendedRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether overlay should be suspended and not consume any resources until resumed.
 func (r *SetSuspendedRequest) Suspended(v bool) *SetSuspendedRequest {
-	r.opts["suspended"] = v
+	r.params.Suspended = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetSuspendedRequest) Do() error {
-	return r.client.Call("Overlay.setSuspended", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetSuspendedRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setSuspended", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetSuspendedRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setSuspended", r.params, nil)
+}
+
+// SetInspectModeParams are the parameters of Overlay.setInspectMode.
+type SetInspectModeParams struct {
+	// Set an inspection mode.
+	Mode InspectMode `json:"mode"`
+
+	// A descriptor for the highlight appearance of hovered-over nodes. May be omitted if <code>enabled == false</code>. (optional)
+	HighlightConfig *HighlightConfig `json:"highlightConfig,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetInspectModeParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Mode == "" {
+		missing = append(missing, "mode")
+	} else if !p.Mode.IsValid() {
+		invalid = append(invalid, "mode")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.setInspectMode", Missing: missing, Invalid: invalid}
 }
 
 type SetInspectModeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetInspectModeParams
 }
 
 // Enters the 'inspect' mode. In this mode, elements that user is hovering over are highlighted. Backend then generates 'inspectNodeRequested' event upon element selection.
 func (d *Client) SetInspectMode() *SetInspectModeRequest {
-	return &SetInspectModeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetInspectModeRequest{client: d.Client, params: &SetInspectModeParams{set: 1}}
+}
+
+// DoSetInspectMode sends Overlay.setInspectMode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetInspectMode(ctx context.Context, params *SetInspectModeParams) error {
+	if params == nil {
+		params = new(SetInspectModeParams)
+	}
+	return (&SetInspectModeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Set an inspection mode.
 func (r *SetInspectModeRequest) Mode(v InspectMode) *SetInspectModeRequest {
-	r.opts["mode"] = v
+	r.params.Mode = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // A descriptor for the highlight appearance of hovered-over nodes. May be omitted if <code>enabled == false</code>. (optional)
 func (r *SetInspectModeRequest) HighlightConfig(v *HighlightConfig) *SetInspectModeRequest {
-	r.opts["highlightConfig"] = v
+	r.params.HighlightConfig = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetInspectModeRequest) Do() error {
-	return r.client.Call("Overlay.setInspectMode", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetInspectModeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.setInspectMode", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetInspectModeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.setInspectMode", r.params, nil)
+}
+
+// HighlightRectParams are the parameters of Overlay.highlightRect.
+type HighlightRectParams struct {
+	// X coordinate
+	X int `json:"x"`
+
+	// Y coordinate
+	Y int `json:"y"`
+
+	// Rectangle width
+	Width int `json:"width"`
+
+	// Rectangle height
+	Height int `json:"height"`
+
+	// The highlight fill color (default: transparent). (optional)
+	Color *dom.RGBA `json:"color,omitempty"`
+
+	// The highlight outline color (default: transparent). (optional)
+	OutlineColor *dom.RGBA `json:"outlineColor,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *HighlightRectParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "x")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "y")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "width")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 {
+		missing = append(missing, "height")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.highlightRect", Missing: missing, Invalid: invalid}
 }
 
 type HighlightRectRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HighlightRectParams
 }
 
 // Highlights given rectangle. Coordinates are absolute with respect to the main frame viewport.
 func (d *Client) HighlightRect() *HighlightRectRequest {
-	return &HighlightRectRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HighlightRectRequest{client: d.Client, params: &HighlightRectParams{set: 1}}
+}
+
+// DoHighlightRect sends Overlay.highlightRect with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHighlightRect(ctx context.Context, params *HighlightRectParams) error {
+	if params == nil {
+		params = new(HighlightRectParams)
+	}
+	return (&HighlightRectRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // X coordinate
 func (r *HighlightRectRequest) X(v int) *HighlightRectRequest {
-	r.opts["x"] = v
+	r.params.X = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Y coordinate
 func (r *HighlightRectRequest) Y(v int) *HighlightRectRequest {
-	r.opts["y"] = v
+	r.params.Y = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Rectangle width
 func (r *HighlightRectRequest) Width(v int) *HighlightRectRequest {
-	r.opts["width"] = v
+	r.params.Width = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Rectangle height
 func (r *HighlightRectRequest) Height(v int) *HighlightRectRequest {
-	r.opts["height"] = v
+	r.params.Height = v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // The highlight fill color (default: transparent). (optional)
 func (r *HighlightRectRequest) Color(v *dom.RGBA) *HighlightRectRequest {
-	r.opts["color"] = v
+	r.params.Color = v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // The highlight outline color (default: transparent). (optional)
 func (r *HighlightRectRequest) OutlineColor(v *dom.RGBA) *HighlightRectRequest {
-	r.opts["outlineColor"] = v
+	r.params.OutlineColor = v
+	r.params.set |= 1 << 6
 	return r
 }
 
 func (r *HighlightRectRequest) Do() error {
-	return r.client.Call("Overlay.highlightRect", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HighlightRectRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.highlightRect", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HighlightRectRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.highlightRect", r.params, nil)
+}
+
+// HighlightQuadParams are the parameters of Overlay.highlightQuad.
+type HighlightQuadParams struct {
+	// Quad to highlight
+	Quad dom.Quad `json:"quad"`
+
+	// The highlight fill color (default: transparent). (optional)
+	Color *dom.RGBA `json:"color,omitempty"`
+
+	// The highlight outline color (default: transparent). (optional)
+	OutlineColor *dom.RGBA `json:"outlineColor,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *HighlightQuadParams) Validate() error {
+	var missing, invalid []string
+	if p.Quad == nil {
+		missing = append(missing, "quad")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.highlightQuad", Missing: missing, Invalid: invalid}
 }
 
 type HighlightQuadRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HighlightQuadParams
 }
 
 // Highlights given quad. Coordinates are absolute with respect to the main frame viewport.
 func (d *Client) HighlightQuad() *HighlightQuadRequest {
-	return &HighlightQuadRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HighlightQuadRequest{client: d.Client, params: &HighlightQuadParams{set: 1}}
+}
+
+// DoHighlightQuad sends Overlay.highlightQuad with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHighlightQuad(ctx context.Context, params *HighlightQuadParams) error {
+	if params == nil {
+		params = new(HighlightQuadParams)
+	}
+	return (&HighlightQuadRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Quad to highlight
 func (r *HighlightQuadRequest) Quad(v dom.Quad) *HighlightQuadRequest {
-	r.opts["quad"] = v
+	r.params.Quad = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The highlight fill color (default: transparent). (optional)
 func (r *HighlightQuadRequest) Color(v *dom.RGBA) *HighlightQuadRequest {
-	r.opts["color"] = v
+	r.params.Color = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // The highlight outline color (default: transparent). (optional)
 func (r *HighlightQuadRequest) OutlineColor(v *dom.RGBA) *HighlightQuadRequest {
-	r.opts["outlineColor"] = v
+	r.params.OutlineColor = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *HighlightQuadRequest) Do() error {
-	return r.client.Call("Overlay.highlightQuad", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HighlightQuadRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.highlightQuad", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HighlightQuadRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.highlightQuad", r.params, nil)
+}
+
+// HighlightNodeParams are the parameters of Overlay.highlightNode.
+type HighlightNodeParams struct {
+	// A descriptor for the highlight appearance.
+	HighlightConfig *HighlightConfig `json:"highlightConfig"`
+
+	// Identifier of the node to highlight. (optional)
+	NodeId *dom.NodeId `json:"nodeId,omitempty"`
+
+	// Identifier of the backend node to highlight. (optional)
+	BackendNodeId *dom.BackendNodeId `json:"backendNodeId,omitempty"`
+
+	// JavaScript object id of the node to be highlighted. (optional)
+	ObjectId *runtime.RemoteObjectId `json:"objectId,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *HighlightNodeParams) Validate() error {
+	var missing, invalid []string
+	if p.HighlightConfig == nil {
+		missing = append(missing, "highlightConfig")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.highlightNode", Missing: missing, Invalid: invalid}
 }
 
 type HighlightNodeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HighlightNodeParams
 }
 
 // Highlights DOM node with given id or with the given JavaScript object wrapper. Either nodeId or objectId must be specified.
 func (d *Client) HighlightNode() *HighlightNodeRequest {
-	return &HighlightNodeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HighlightNodeRequest{client: d.Client, params: &HighlightNodeParams{set: 1}}
+}
+
+// DoHighlightNode sends Overlay.highlightNode with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHighlightNode(ctx context.Context, params *HighlightNodeParams) error {
+	if params == nil {
+		params = new(HighlightNodeParams)
+	}
+	return (&HighlightNodeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // A descriptor for the highlight appearance.
 func (r *HighlightNodeRequest) HighlightConfig(v *HighlightConfig) *HighlightNodeRequest {
-	r.opts["highlightConfig"] = v
+	r.params.HighlightConfig = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Identifier of the node to highlight. (optional)
 func (r *HighlightNodeRequest) NodeId(v dom.NodeId) *HighlightNodeRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Identifier of the backend node to highlight. (optional)
 func (r *HighlightNodeRequest) BackendNodeId(v dom.BackendNodeId) *HighlightNodeRequest {
-	r.opts["backendNodeId"] = v
+	r.params.BackendNodeId = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // JavaScript object id of the node to be highlighted. (optional)
 func (r *HighlightNodeRequest) ObjectId(v runtime.RemoteObjectId) *HighlightNodeRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 func (r *HighlightNodeRequest) Do() error {
-	return r.client.Call("Overlay.highlightNode", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HighlightNodeRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.highlightNode", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HighlightNodeRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.highlightNode", r.params, nil)
+}
+
+// HighlightFrameParams are the parameters of Overlay.highlightFrame.
+type HighlightFrameParams struct {
+	// Identifier of the frame to highlight.
+	FrameId string `json:"frameId"`
+
+	// The content box highlight fill color (default: transparent). (optional)
+	ContentColor *dom.RGBA `json:"contentColor,omitempty"`
+
+	// The content box highlight outline color (default: transparent). (optional)
+	ContentOutlineColor *dom.RGBA `json:"contentOutlineColor,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *HighlightFrameParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.highlightFrame", Missing: missing, Invalid: invalid}
 }
 
 type HighlightFrameRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HighlightFrameParams
 }
 
 // Highlights owner element of the frame with given id.
 func (d *Client) HighlightFrame() *HighlightFrameRequest {
-	return &HighlightFrameRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HighlightFrameRequest{client: d.Client, params: &HighlightFrameParams{set: 1}}
+}
+
+// DoHighlightFrame sends Overlay.highlightFrame with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHighlightFrame(ctx context.Context, params *HighlightFrameParams) error {
+	if params == nil {
+		params = new(HighlightFrameParams)
+	}
+	return (&HighlightFrameRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the frame to highlight.
 func (r *HighlightFrameRequest) FrameId(v string) *HighlightFrameRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The content box highlight fill color (default: transparent). (optional)
 func (r *HighlightFrameRequest) ContentColor(v *dom.RGBA) *HighlightFrameRequest {
-	r.opts["contentColor"] = v
+	r.params.ContentColor = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // The content box highlight outline color (default: transparent). (optional)
 func (r *HighlightFrameRequest) ContentOutlineColor(v *dom.RGBA) *HighlightFrameRequest {
-	r.opts["contentOutlineColor"] = v
+	r.params.ContentOutlineColor = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *HighlightFrameRequest) Do() error {
-	return r.client.Call("Overlay.highlightFrame", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HighlightFrameRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.highlightFrame", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HighlightFrameRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.highlightFrame", r.params, nil)
+}
+
+// HideHighlightParams are the parameters of Overlay.hideHighlight.
+type HideHighlightParams struct {
 }
 
 type HideHighlightRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HideHighlightParams
 }
 
 // Hides any highlight.
 func (d *Client) HideHighlight() *HideHighlightRequest {
-	return &HideHighlightRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HideHighlightRequest{client: d.Client, params: &HideHighlightParams{}}
+}
+
+// DoHideHighlight sends Overlay.hideHighlight with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHideHighlight(ctx context.Context, params *HideHighlightParams) error {
+	if params == nil {
+		params = new(HideHighlightParams)
+	}
+	return (&HideHighlightRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *HideHighlightRequest) Do() error {
-	return r.client.Call("Overlay.hideHighlight", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HideHighlightRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Overlay.hideHighlight", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HideHighlightRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Overlay.hideHighlight", r.params, nil)
+}
+
+// GetHighlightObjectForTestParams are the parameters of Overlay.getHighlightObjectForTest.
+type GetHighlightObjectForTestParams struct {
+	// Id of the node to get highlight object for.
+	NodeId dom.NodeId `json:"nodeId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetHighlightObjectForTestParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "nodeId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Overlay.getHighlightObjectForTest", Missing: missing, Invalid: invalid}
 }
 
 type GetHighlightObjectForTestRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetHighlightObjectForTestParams
 }
 
 // For testing.
 func (d *Client) GetHighlightObjectForTest() *GetHighlightObjectForTestRequest {
-	return &GetHighlightObjectForTestRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetHighlightObjectForTestRequest{client: d.Client, params: &GetHighlightObjectForTestParams{set: 1}}
+}
+
+// DoGetHighlightObjectForTest sends Overlay.getHighlightObjectForTest with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetHighlightObjectForTest(ctx context.Context, params *GetHighlightObjectForTestParams) (*GetHighlightObjectForTestResult, error) {
+	if params == nil {
+		params = new(GetHighlightObjectForTestParams)
+	}
+	return (&GetHighlightObjectForTestRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the node to get highlight object for.
 func (r *GetHighlightObjectForTestRequest) NodeId(v dom.NodeId) *GetHighlightObjectForTestRequest {
-	r.opts["nodeId"] = v
+	r.params.NodeId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -435,11 +1102,33 @@ type GetHighlightObjectForTestResult struct {
 }
 
 func (r *GetHighlightObjectForTestRequest) Do() (*GetHighlightObjectForTestResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetHighlightObjectForTestRequest) DoContext(ctx context.Context) (*GetHighlightObjectForTestResult, error) {
 	var result GetHighlightObjectForTestResult
-	err := r.client.Call("Overlay.getHighlightObjectForTest", r.opts, &result)
+	err := r.client.CallContext(ctx, "Overlay.getHighlightObjectForTest", r.params, &result)
 	return &result, err
 }
 
+type GetHighlightObjectForTestFuture struct {
+	*rpc.Call
+	result GetHighlightObjectForTestResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetHighlightObjectForTestFuture) Get() (*GetHighlightObjectForTestResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetHighlightObjectForTestRequest) DoAsync(ctx context.Context) *GetHighlightObjectForTestFuture {
+	f := new(GetHighlightObjectForTestFuture)
+	f.Call = r.client.Go(ctx, "Overlay.getHighlightObjectForTest", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["Overlay.nodeHighlightRequested"] = func() interface{} { return new(NodeHighlightRequestedEvent) }
 	rpc.EventTypes["Overlay.inspectNodeRequested"] = func() interface{} { return new(InspectNodeRequestedEvent) }
@@ -450,8 +1139,86 @@ type NodeHighlightRequestedEvent struct {
 	NodeId dom.NodeId `json:"nodeId"`
 }
 
+// Fired when the node should be highlighted. This happens after call to <code>setInspectMode</code>.
+func (d *Client) OnNodeHighlightRequested(fn func(*NodeHighlightRequestedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Overlay.nodeHighlightRequested", func(e interface{}) {
+		fn(e.(*NodeHighlightRequestedEvent))
+	}, opts...)
+}
+
+// FilterNodeHighlightRequested is rpc.WithFilter for OnNodeHighlightRequested.
+func FilterNodeHighlightRequested(fn func(*NodeHighlightRequestedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*NodeHighlightRequestedEvent))
+	})
+}
+
+// NodeHighlightRequestedWaiter waits for a Overlay.nodeHighlightRequested event. It is created by WaitForNodeHighlightRequested.
+type NodeHighlightRequestedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *NodeHighlightRequestedWaiter) Wait() (*NodeHighlightRequestedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*NodeHighlightRequestedEvent), nil
+}
+
+// WaitForNodeHighlightRequested arms a waiter for the first Overlay.nodeHighlightRequested event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForNodeHighlightRequested(ctx context.Context, predicate func(*NodeHighlightRequestedEvent) bool) *NodeHighlightRequestedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*NodeHighlightRequestedEvent))
+		}
+	}
+	return &NodeHighlightRequestedWaiter{d.Client.WaitFor(ctx, "Overlay.nodeHighlightRequested", p)}
+}
+
 // Fired when the node should be inspected. This happens after call to <code>setInspectMode</code> or when user manually inspects an element.
 type InspectNodeRequestedEvent struct {
 	// Id of the node to inspect.
 	BackendNodeId dom.BackendNodeId `json:"backendNodeId"`
 }
+
+// Fired when the node should be inspected. This happens after call to <code>setInspectMode</code> or when user manually inspects an element.
+func (d *Client) OnInspectNodeRequested(fn func(*InspectNodeRequestedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Overlay.inspectNodeRequested", func(e interface{}) {
+		fn(e.(*InspectNodeRequestedEvent))
+	}, opts...)
+}
+
+// FilterInspectNodeRequested is rpc.WithFilter for OnInspectNodeRequested.
+func FilterInspectNodeRequested(fn func(*InspectNodeRequestedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*InspectNodeRequestedEvent))
+	})
+}
+
+// InspectNodeRequestedWaiter waits for a Overlay.inspectNodeRequested event. It is created by WaitForInspectNodeRequested.
+type InspectNodeRequestedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *InspectNodeRequestedWaiter) Wait() (*InspectNodeRequestedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*InspectNodeRequestedEvent), nil
+}
+
+// WaitForInspectNodeRequested arms a waiter for the first Overlay.inspectNodeRequested event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForInspectNodeRequested(ctx context.Context, predicate func(*InspectNodeRequestedEvent) bool) *InspectNodeRequestedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*InspectNodeRequestedEvent))
+		}
+	}
+	return &InspectNodeRequestedWaiter{d.Client.WaitFor(ctx, "Overlay.inspectNodeRequested", p)}
+}
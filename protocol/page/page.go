@@ -2,6 +2,8 @@
 package page
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/debugger"
@@ -20,6 +22,31 @@ type Client struct {
 
 type ResourceType string
 
+const (
+	ResourceTypeDocument    ResourceType = "Document"
+	ResourceTypeStylesheet  ResourceType = "Stylesheet"
+	ResourceTypeImage       ResourceType = "Image"
+	ResourceTypeMedia       ResourceType = "Media"
+	ResourceTypeFont        ResourceType = "Font"
+	ResourceTypeScript      ResourceType = "Script"
+	ResourceTypeTextTrack   ResourceType = "TextTrack"
+	ResourceTypeXHR         ResourceType = "XHR"
+	ResourceTypeFetch       ResourceType = "Fetch"
+	ResourceTypeEventSource ResourceType = "EventSource"
+	ResourceTypeWebSocket   ResourceType = "WebSocket"
+	ResourceTypeManifest    ResourceType = "Manifest"
+	ResourceTypeOther       ResourceType = "Other"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ResourceType) IsValid() bool {
+	switch v {
+	case ResourceTypeDocument, ResourceTypeStylesheet, ResourceTypeImage, ResourceTypeMedia, ResourceTypeFont, ResourceTypeScript, ResourceTypeTextTrack, ResourceTypeXHR, ResourceTypeFetch, ResourceTypeEventSource, ResourceTypeWebSocket, ResourceTypeManifest, ResourceTypeOther:
+		return true
+	}
+	return false
+}
+
 // Unique frame identifier.
 
 type FrameId string
@@ -31,13 +58,13 @@ type Frame struct {
 	Id string `json:"id"`
 
 	// Parent frame identifier. (optional)
-	ParentId string `json:"parentId,omitempty"`
+	ParentId *string `json:"parentId,omitempty"`
 
 	// Identifier of the loader associated with this frame.
 	LoaderId network.LoaderId `json:"loaderId"`
 
 	// Frame's name as specified in the tag. (optional)
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	// Frame document's URL.
 	URL string `json:"url"`
@@ -62,16 +89,16 @@ type FrameResource struct {
 	MimeType string `json:"mimeType"`
 
 	// last-modified timestamp as reported by server. (optional)
-	LastModified network.Timestamp `json:"lastModified,omitempty"`
+	LastModified *network.Timestamp `json:"lastModified,omitempty"`
 
 	// Resource content size. (optional)
-	ContentSize float64 `json:"contentSize,omitempty"`
+	ContentSize *float64 `json:"contentSize,omitempty"`
 
 	// True if the resource failed to load. (optional)
-	Failed bool `json:"failed,omitempty"`
+	Failed *bool `json:"failed,omitempty"`
 
 	// True if the resource was canceled during loading. (optional)
-	Canceled bool `json:"canceled,omitempty"`
+	Canceled *bool `json:"canceled,omitempty"`
 }
 
 // Information about the Frame hierarchy along with their cached resources. (experimental)
@@ -95,6 +122,31 @@ type ScriptIdentifier string
 
 type TransitionType string
 
+const (
+	TransitionTypeLink             TransitionType = "link"
+	TransitionTypeTyped            TransitionType = "typed"
+	TransitionTypeAddressBar       TransitionType = "address_bar"
+	TransitionTypeAutoBookmark     TransitionType = "auto_bookmark"
+	TransitionTypeAutoSubframe     TransitionType = "auto_subframe"
+	TransitionTypeManualSubframe   TransitionType = "manual_subframe"
+	TransitionTypeGenerated        TransitionType = "generated"
+	TransitionTypeAutoToplevel     TransitionType = "auto_toplevel"
+	TransitionTypeFormSubmit       TransitionType = "form_submit"
+	TransitionTypeReload           TransitionType = "reload"
+	TransitionTypeKeyword          TransitionType = "keyword"
+	TransitionTypeKeywordGenerated TransitionType = "keyword_generated"
+	TransitionTypeOther            TransitionType = "other"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v TransitionType) IsValid() bool {
+	switch v {
+	case TransitionTypeLink, TransitionTypeTyped, TransitionTypeAddressBar, TransitionTypeAutoBookmark, TransitionTypeAutoSubframe, TransitionTypeManualSubframe, TransitionTypeGenerated, TransitionTypeAutoToplevel, TransitionTypeFormSubmit, TransitionTypeReload, TransitionTypeKeyword, TransitionTypeKeywordGenerated, TransitionTypeOther:
+		return true
+	}
+	return false
+}
+
 // Navigation history entry. (experimental)
 
 type NavigationEntry struct {
@@ -136,13 +188,29 @@ type ScreencastFrameMetadata struct {
 	ScrollOffsetY float64 `json:"scrollOffsetY"`
 
 	// Frame swap timestamp. (optional, experimental)
-	Timestamp float64 `json:"timestamp,omitempty"`
+	Timestamp *float64 `json:"timestamp,omitempty"`
 }
 
 // Javascript dialog type. (experimental)
 
 type DialogType string
 
+const (
+	DialogTypeAlert        DialogType = "alert"
+	DialogTypeConfirm      DialogType = "confirm"
+	DialogTypePrompt       DialogType = "prompt"
+	DialogTypeBeforeunload DialogType = "beforeunload"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v DialogType) IsValid() bool {
+	switch v {
+	case DialogTypeAlert, DialogTypeConfirm, DialogTypePrompt, DialogTypeBeforeunload:
+		return true
+	}
+	return false
+}
+
 // Error while paring app manifest. (experimental)
 
 type AppManifestError struct {
@@ -163,6 +231,21 @@ type AppManifestError struct {
 
 type NavigationResponse string
 
+const (
+	NavigationResponseProceed         NavigationResponse = "Proceed"
+	NavigationResponseCancel          NavigationResponse = "Cancel"
+	NavigationResponseCancelAndIgnore NavigationResponse = "CancelAndIgnore"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v NavigationResponse) IsValid() bool {
+	switch v {
+	case NavigationResponseProceed, NavigationResponseCancel, NavigationResponseCancelAndIgnore:
+		return true
+	}
+	return false
+}
+
 // Layout viewport position and dimensions. (experimental)
 
 type LayoutViewport struct {
@@ -204,46 +287,176 @@ type VisualViewport struct {
 	Scale float64 `json:"scale"`
 }
 
+// Values of the configuration parameter of Page.setTouchEmulationEnabled.
+
+type SetTouchEmulationEnabledConfiguration string
+
+const (
+	SetTouchEmulationEnabledConfigurationMobile  SetTouchEmulationEnabledConfiguration = "mobile"
+	SetTouchEmulationEnabledConfigurationDesktop SetTouchEmulationEnabledConfiguration = "desktop"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v SetTouchEmulationEnabledConfiguration) IsValid() bool {
+	switch v {
+	case SetTouchEmulationEnabledConfigurationMobile, SetTouchEmulationEnabledConfigurationDesktop:
+		return true
+	}
+	return false
+}
+
+// Values of the format parameter of Page.captureScreenshot.
+
+type CaptureScreenshotFormat string
+
+const (
+	CaptureScreenshotFormatJpeg CaptureScreenshotFormat = "jpeg"
+	CaptureScreenshotFormatPng  CaptureScreenshotFormat = "png"
+	CaptureScreenshotFormatWebp CaptureScreenshotFormat = "webp"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v CaptureScreenshotFormat) IsValid() bool {
+	switch v {
+	case CaptureScreenshotFormatJpeg, CaptureScreenshotFormatPng, CaptureScreenshotFormatWebp:
+		return true
+	}
+	return false
+}
+
+// Values of the format parameter of Page.startScreencast.
+
+type StartScreencastFormat string
+
+const (
+	StartScreencastFormatJpeg StartScreencastFormat = "jpeg"
+	StartScreencastFormatPng  StartScreencastFormat = "png"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v StartScreencastFormat) IsValid() bool {
+	switch v {
+	case StartScreencastFormatJpeg, StartScreencastFormatPng:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Page.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables page domain notifications.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Page.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Page.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Page", r.params)
+}
+
+// DisableParams are the parameters of Page.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables page domain notifications.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Page.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Page.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Page", r.params)
+}
+
+// AddScriptToEvaluateOnLoadParams are the parameters of Page.addScriptToEvaluateOnLoad.
+type AddScriptToEvaluateOnLoadParams struct {
+	ScriptSource string `json:"scriptSource"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *AddScriptToEvaluateOnLoadParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScriptSource == "" {
+		missing = append(missing, "scriptSource")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.addScriptToEvaluateOnLoad", Missing: missing, Invalid: invalid}
 }
 
 type AddScriptToEvaluateOnLoadRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *AddScriptToEvaluateOnLoadParams
 }
 
 // (experimental)
 func (d *Client) AddScriptToEvaluateOnLoad() *AddScriptToEvaluateOnLoadRequest {
-	return &AddScriptToEvaluateOnLoadRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &AddScriptToEvaluateOnLoadRequest{client: d.Client, params: &AddScriptToEvaluateOnLoadParams{set: 1}}
+}
+
+// DoAddScriptToEvaluateOnLoad sends Page.addScriptToEvaluateOnLoad with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoAddScriptToEvaluateOnLoad(ctx context.Context, params *AddScriptToEvaluateOnLoadParams) (*AddScriptToEvaluateOnLoadResult, error) {
+	if params == nil {
+		params = new(AddScriptToEvaluateOnLoadParams)
+	}
+	return (&AddScriptToEvaluateOnLoadRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *AddScriptToEvaluateOnLoadRequest) ScriptSource(v string) *AddScriptToEvaluateOnLoadRequest {
-	r.opts["scriptSource"] = v
+	r.params.ScriptSource = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -253,101 +466,289 @@ type AddScriptToEvaluateOnLoadResult struct {
 }
 
 func (r *AddScriptToEvaluateOnLoadRequest) Do() (*AddScriptToEvaluateOnLoadResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *AddScriptToEvaluateOnLoadRequest) DoContext(ctx context.Context) (*AddScriptToEvaluateOnLoadResult, error) {
 	var result AddScriptToEvaluateOnLoadResult
-	err := r.client.Call("Page.addScriptToEvaluateOnLoad", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.addScriptToEvaluateOnLoad", r.params, &result)
 	return &result, err
 }
 
+type AddScriptToEvaluateOnLoadFuture struct {
+	*rpc.Call
+	result AddScriptToEvaluateOnLoadResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *AddScriptToEvaluateOnLoadFuture) Get() (*AddScriptToEvaluateOnLoadResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *AddScriptToEvaluateOnLoadRequest) DoAsync(ctx context.Context) *AddScriptToEvaluateOnLoadFuture {
+	f := new(AddScriptToEvaluateOnLoadFuture)
+	f.Call = r.client.Go(ctx, "Page.addScriptToEvaluateOnLoad", r.params, &f.result)
+	return f
+}
+
+// RemoveScriptToEvaluateOnLoadParams are the parameters of Page.removeScriptToEvaluateOnLoad.
+type RemoveScriptToEvaluateOnLoadParams struct {
+	Identifier ScriptIdentifier `json:"identifier"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RemoveScriptToEvaluateOnLoadParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Identifier == "" {
+		missing = append(missing, "identifier")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.removeScriptToEvaluateOnLoad", Missing: missing, Invalid: invalid}
+}
+
 type RemoveScriptToEvaluateOnLoadRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RemoveScriptToEvaluateOnLoadParams
 }
 
 // (experimental)
 func (d *Client) RemoveScriptToEvaluateOnLoad() *RemoveScriptToEvaluateOnLoadRequest {
-	return &RemoveScriptToEvaluateOnLoadRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RemoveScriptToEvaluateOnLoadRequest{client: d.Client, params: &RemoveScriptToEvaluateOnLoadParams{set: 1}}
+}
+
+// DoRemoveScriptToEvaluateOnLoad sends Page.removeScriptToEvaluateOnLoad with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRemoveScriptToEvaluateOnLoad(ctx context.Context, params *RemoveScriptToEvaluateOnLoadParams) error {
+	if params == nil {
+		params = new(RemoveScriptToEvaluateOnLoadParams)
+	}
+	return (&RemoveScriptToEvaluateOnLoadRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *RemoveScriptToEvaluateOnLoadRequest) Identifier(v ScriptIdentifier) *RemoveScriptToEvaluateOnLoadRequest {
-	r.opts["identifier"] = v
+	r.params.Identifier = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *RemoveScriptToEvaluateOnLoadRequest) Do() error {
-	return r.client.Call("Page.removeScriptToEvaluateOnLoad", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RemoveScriptToEvaluateOnLoadRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.removeScriptToEvaluateOnLoad", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RemoveScriptToEvaluateOnLoadRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.removeScriptToEvaluateOnLoad", r.params, nil)
+}
+
+// SetAutoAttachToCreatedPagesParams are the parameters of Page.setAutoAttachToCreatedPages.
+type SetAutoAttachToCreatedPagesParams struct {
+	// If true, browser will open a new inspector window for every page created from this one.
+	AutoAttach bool `json:"autoAttach"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetAutoAttachToCreatedPagesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "autoAttach")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.setAutoAttachToCreatedPages", Missing: missing, Invalid: invalid}
 }
 
 type SetAutoAttachToCreatedPagesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetAutoAttachToCreatedPagesParams
 }
 
 // Controls whether browser will open a new inspector window for connected pages. (experimental)
 func (d *Client) SetAutoAttachToCreatedPages() *SetAutoAttachToCreatedPagesRequest {
-	return &SetAutoAttachToCreatedPagesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetAutoAttachToCreatedPagesRequest{client: d.Client, params: &SetAutoAttachToCreatedPagesParams{set: 1}}
+}
+
+// DoSetAutoAttachToCreatedPages sends Page.setAutoAttachToCreatedPages with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetAutoAttachToCreatedPages(ctx context.Context, params *SetAutoAttachToCreatedPagesParams) error {
+	if params == nil {
+		params = new(SetAutoAttachToCreatedPagesParams)
+	}
+	return (&SetAutoAttachToCreatedPagesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // If true, browser will open a new inspector window for every page created from this one.
 func (r *SetAutoAttachToCreatedPagesRequest) AutoAttach(v bool) *SetAutoAttachToCreatedPagesRequest {
-	r.opts["autoAttach"] = v
+	r.params.AutoAttach = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetAutoAttachToCreatedPagesRequest) Do() error {
-	return r.client.Call("Page.setAutoAttachToCreatedPages", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetAutoAttachToCreatedPagesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.setAutoAttachToCreatedPages", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetAutoAttachToCreatedPagesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.setAutoAttachToCreatedPages", r.params, nil)
+}
+
+// ReloadParams are the parameters of Page.reload.
+type ReloadParams struct {
+	// If true, browser cache is ignored (as if the user pressed Shift+refresh). (optional)
+	IgnoreCache *bool `json:"ignoreCache,omitempty"`
+
+	// If set, the script will be injected into all frames of the inspected page after reload. (optional)
+	ScriptToEvaluateOnLoad *string `json:"scriptToEvaluateOnLoad,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReloadParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.reload", Missing: missing, Invalid: invalid}
 }
 
 type ReloadRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReloadParams
 }
 
 // Reloads given page optionally ignoring the cache.
 func (d *Client) Reload() *ReloadRequest {
-	return &ReloadRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReloadRequest{client: d.Client, params: &ReloadParams{set: 1}}
+}
+
+// DoReload sends Page.reload with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoReload(ctx context.Context, params *ReloadParams) error {
+	if params == nil {
+		params = new(ReloadParams)
+	}
+	return (&ReloadRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // If true, browser cache is ignored (as if the user pressed Shift+refresh). (optional)
 func (r *ReloadRequest) IgnoreCache(v bool) *ReloadRequest {
-	r.opts["ignoreCache"] = v
+	r.params.IgnoreCache = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // If set, the script will be injected into all frames of the inspected page after reload. (optional)
 func (r *ReloadRequest) ScriptToEvaluateOnLoad(v string) *ReloadRequest {
-	r.opts["scriptToEvaluateOnLoad"] = v
+	r.params.ScriptToEvaluateOnLoad = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *ReloadRequest) Do() error {
-	return r.client.Call("Page.reload", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ReloadRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.reload", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ReloadRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.reload", r.params, nil)
+}
+
+// NavigateParams are the parameters of Page.navigate.
+type NavigateParams struct {
+	// URL to navigate the page to.
+	URL string `json:"url"`
+
+	// Referrer URL. (optional, experimental)
+	Referrer *string `json:"referrer,omitempty"`
+
+	// Intended transition type. (optional, experimental)
+	TransitionType *TransitionType `json:"transitionType,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *NavigateParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if p.TransitionType != nil && !p.TransitionType.IsValid() {
+		invalid = append(invalid, "transitionType")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.navigate", Missing: missing, Invalid: invalid}
 }
 
 type NavigateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *NavigateParams
 }
 
 // Navigates current page to the given URL.
 func (d *Client) Navigate() *NavigateRequest {
-	return &NavigateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &NavigateRequest{client: d.Client, params: &NavigateParams{set: 1}}
+}
+
+// DoNavigate sends Page.navigate with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoNavigate(ctx context.Context, params *NavigateParams) (*NavigateResult, error) {
+	if params == nil {
+		params = new(NavigateParams)
+	}
+	return (&NavigateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // URL to navigate the page to.
 func (r *NavigateRequest) URL(v string) *NavigateRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Referrer URL. (optional, experimental)
 func (r *NavigateRequest) Referrer(v string) *NavigateRequest {
-	r.opts["referrer"] = v
+	r.params.Referrer = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Intended transition type. (optional, experimental)
 func (r *NavigateRequest) TransitionType(v TransitionType) *NavigateRequest {
-	r.opts["transitionType"] = v
+	r.params.TransitionType = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -357,33 +758,88 @@ type NavigateResult struct {
 }
 
 func (r *NavigateRequest) Do() (*NavigateResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *NavigateRequest) DoContext(ctx context.Context) (*NavigateResult, error) {
 	var result NavigateResult
-	err := r.client.Call("Page.navigate", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.navigate", r.params, &result)
 	return &result, err
 }
 
+type NavigateFuture struct {
+	*rpc.Call
+	result NavigateResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *NavigateFuture) Get() (*NavigateResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *NavigateRequest) DoAsync(ctx context.Context) *NavigateFuture {
+	f := new(NavigateFuture)
+	f.Call = r.client.Go(ctx, "Page.navigate", r.params, &f.result)
+	return f
+}
+
+// StopLoadingParams are the parameters of Page.stopLoading.
+type StopLoadingParams struct {
+}
+
 type StopLoadingRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopLoadingParams
 }
 
 // Force the page stop all navigations and pending resource fetches. (experimental)
 func (d *Client) StopLoading() *StopLoadingRequest {
-	return &StopLoadingRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopLoadingRequest{client: d.Client, params: &StopLoadingParams{}}
+}
+
+// DoStopLoading sends Page.stopLoading with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopLoading(ctx context.Context, params *StopLoadingParams) error {
+	if params == nil {
+		params = new(StopLoadingParams)
+	}
+	return (&StopLoadingRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StopLoadingRequest) Do() error {
-	return r.client.Call("Page.stopLoading", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StopLoadingRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.stopLoading", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StopLoadingRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.stopLoading", r.params, nil)
+}
+
+// GetNavigationHistoryParams are the parameters of Page.getNavigationHistory.
+type GetNavigationHistoryParams struct {
 }
 
 type GetNavigationHistoryRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetNavigationHistoryParams
 }
 
 // Returns navigation history for the current page. (experimental)
 func (d *Client) GetNavigationHistory() *GetNavigationHistoryRequest {
-	return &GetNavigationHistoryRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetNavigationHistoryRequest{client: d.Client, params: &GetNavigationHistoryParams{}}
+}
+
+// DoGetNavigationHistory sends Page.getNavigationHistory with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetNavigationHistory(ctx context.Context, params *GetNavigationHistoryParams) (*GetNavigationHistoryResult, error) {
+	if params == nil {
+		params = new(GetNavigationHistoryParams)
+	}
+	return (&GetNavigationHistoryRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetNavigationHistoryResult struct {
@@ -395,39 +851,114 @@ type GetNavigationHistoryResult struct {
 }
 
 func (r *GetNavigationHistoryRequest) Do() (*GetNavigationHistoryResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetNavigationHistoryRequest) DoContext(ctx context.Context) (*GetNavigationHistoryResult, error) {
 	var result GetNavigationHistoryResult
-	err := r.client.Call("Page.getNavigationHistory", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.getNavigationHistory", r.params, &result)
 	return &result, err
 }
 
+type GetNavigationHistoryFuture struct {
+	*rpc.Call
+	result GetNavigationHistoryResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetNavigationHistoryFuture) Get() (*GetNavigationHistoryResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetNavigationHistoryRequest) DoAsync(ctx context.Context) *GetNavigationHistoryFuture {
+	f := new(GetNavigationHistoryFuture)
+	f.Call = r.client.Go(ctx, "Page.getNavigationHistory", r.params, &f.result)
+	return f
+}
+
+// NavigateToHistoryEntryParams are the parameters of Page.navigateToHistoryEntry.
+type NavigateToHistoryEntryParams struct {
+	// Unique id of the entry to navigate to.
+	EntryId int `json:"entryId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *NavigateToHistoryEntryParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "entryId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.navigateToHistoryEntry", Missing: missing, Invalid: invalid}
+}
+
 type NavigateToHistoryEntryRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *NavigateToHistoryEntryParams
 }
 
 // Navigates current page to the given history entry. (experimental)
 func (d *Client) NavigateToHistoryEntry() *NavigateToHistoryEntryRequest {
-	return &NavigateToHistoryEntryRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &NavigateToHistoryEntryRequest{client: d.Client, params: &NavigateToHistoryEntryParams{set: 1}}
+}
+
+// DoNavigateToHistoryEntry sends Page.navigateToHistoryEntry with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoNavigateToHistoryEntry(ctx context.Context, params *NavigateToHistoryEntryParams) error {
+	if params == nil {
+		params = new(NavigateToHistoryEntryParams)
+	}
+	return (&NavigateToHistoryEntryRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Unique id of the entry to navigate to.
 func (r *NavigateToHistoryEntryRequest) EntryId(v int) *NavigateToHistoryEntryRequest {
-	r.opts["entryId"] = v
+	r.params.EntryId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *NavigateToHistoryEntryRequest) Do() error {
-	return r.client.Call("Page.navigateToHistoryEntry", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *NavigateToHistoryEntryRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.navigateToHistoryEntry", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *NavigateToHistoryEntryRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.navigateToHistoryEntry", r.params, nil)
+}
+
+// GetCookiesParams are the parameters of Page.getCookies.
+type GetCookiesParams struct {
 }
 
 type GetCookiesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetCookiesParams
 }
 
 // Returns all browser cookies. Depending on the backend support, will return detailed cookie information in the <code>cookies</code> field. (experimental)
 func (d *Client) GetCookies() *GetCookiesRequest {
-	return &GetCookiesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetCookiesRequest{client: d.Client, params: &GetCookiesParams{}}
+}
+
+// DoGetCookies sends Page.getCookies with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetCookies(ctx context.Context, params *GetCookiesParams) (*GetCookiesResult, error) {
+	if params == nil {
+		params = new(GetCookiesParams)
+	}
+	return (&GetCookiesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetCookiesResult struct {
@@ -436,45 +967,127 @@ type GetCookiesResult struct {
 }
 
 func (r *GetCookiesRequest) Do() (*GetCookiesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetCookiesRequest) DoContext(ctx context.Context) (*GetCookiesResult, error) {
 	var result GetCookiesResult
-	err := r.client.Call("Page.getCookies", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.getCookies", r.params, &result)
 	return &result, err
 }
 
+type GetCookiesFuture struct {
+	*rpc.Call
+	result GetCookiesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetCookiesFuture) Get() (*GetCookiesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetCookiesRequest) DoAsync(ctx context.Context) *GetCookiesFuture {
+	f := new(GetCookiesFuture)
+	f.Call = r.client.Go(ctx, "Page.getCookies", r.params, &f.result)
+	return f
+}
+
+// DeleteCookieParams are the parameters of Page.deleteCookie.
+type DeleteCookieParams struct {
+	// Name of the cookie to remove.
+	CookieName string `json:"cookieName"`
+
+	// URL to match cooke domain and path.
+	URL string `json:"url"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DeleteCookieParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.CookieName == "" {
+		missing = append(missing, "cookieName")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.deleteCookie", Missing: missing, Invalid: invalid}
+}
+
 type DeleteCookieRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DeleteCookieParams
 }
 
 // Deletes browser cookie with given name, domain and path. (experimental)
 func (d *Client) DeleteCookie() *DeleteCookieRequest {
-	return &DeleteCookieRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DeleteCookieRequest{client: d.Client, params: &DeleteCookieParams{set: 1}}
+}
+
+// DoDeleteCookie sends Page.deleteCookie with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDeleteCookie(ctx context.Context, params *DeleteCookieParams) error {
+	if params == nil {
+		params = new(DeleteCookieParams)
+	}
+	return (&DeleteCookieRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Name of the cookie to remove.
 func (r *DeleteCookieRequest) CookieName(v string) *DeleteCookieRequest {
-	r.opts["cookieName"] = v
+	r.params.CookieName = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // URL to match cooke domain and path.
 func (r *DeleteCookieRequest) URL(v string) *DeleteCookieRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *DeleteCookieRequest) Do() error {
-	return r.client.Call("Page.deleteCookie", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DeleteCookieRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.deleteCookie", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DeleteCookieRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.deleteCookie", r.params, nil)
+}
+
+// GetResourceTreeParams are the parameters of Page.getResourceTree.
+type GetResourceTreeParams struct {
 }
 
 type GetResourceTreeRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetResourceTreeParams
 }
 
 // Returns present frame / resource tree structure. (experimental)
 func (d *Client) GetResourceTree() *GetResourceTreeRequest {
-	return &GetResourceTreeRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetResourceTreeRequest{client: d.Client, params: &GetResourceTreeParams{}}
+}
+
+// DoGetResourceTree sends Page.getResourceTree with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetResourceTree(ctx context.Context, params *GetResourceTreeParams) (*GetResourceTreeResult, error) {
+	if params == nil {
+		params = new(GetResourceTreeParams)
+	}
+	return (&GetResourceTreeRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetResourceTreeResult struct {
@@ -483,30 +1096,91 @@ type GetResourceTreeResult struct {
 }
 
 func (r *GetResourceTreeRequest) Do() (*GetResourceTreeResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetResourceTreeRequest) DoContext(ctx context.Context) (*GetResourceTreeResult, error) {
 	var result GetResourceTreeResult
-	err := r.client.Call("Page.getResourceTree", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.getResourceTree", r.params, &result)
 	return &result, err
 }
 
+type GetResourceTreeFuture struct {
+	*rpc.Call
+	result GetResourceTreeResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetResourceTreeFuture) Get() (*GetResourceTreeResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetResourceTreeRequest) DoAsync(ctx context.Context) *GetResourceTreeFuture {
+	f := new(GetResourceTreeFuture)
+	f.Call = r.client.Go(ctx, "Page.getResourceTree", r.params, &f.result)
+	return f
+}
+
+// GetResourceContentParams are the parameters of Page.getResourceContent.
+type GetResourceContentParams struct {
+	// Frame id to get resource for.
+	FrameId FrameId `json:"frameId"`
+
+	// URL of the resource to get content for.
+	URL string `json:"url"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetResourceContentParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.getResourceContent", Missing: missing, Invalid: invalid}
+}
+
 type GetResourceContentRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetResourceContentParams
 }
 
 // Returns content of the given resource. (experimental)
 func (d *Client) GetResourceContent() *GetResourceContentRequest {
-	return &GetResourceContentRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetResourceContentRequest{client: d.Client, params: &GetResourceContentParams{set: 1}}
+}
+
+// DoGetResourceContent sends Page.getResourceContent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetResourceContent(ctx context.Context, params *GetResourceContentParams) (*GetResourceContentResult, error) {
+	if params == nil {
+		params = new(GetResourceContentParams)
+	}
+	return (&GetResourceContentRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Frame id to get resource for.
 func (r *GetResourceContentRequest) FrameId(v FrameId) *GetResourceContentRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // URL of the resource to get content for.
 func (r *GetResourceContentRequest) URL(v string) *GetResourceContentRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 2
 	return r
 }
 
@@ -519,48 +1193,124 @@ type GetResourceContentResult struct {
 }
 
 func (r *GetResourceContentRequest) Do() (*GetResourceContentResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetResourceContentRequest) DoContext(ctx context.Context) (*GetResourceContentResult, error) {
 	var result GetResourceContentResult
-	err := r.client.Call("Page.getResourceContent", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.getResourceContent", r.params, &result)
 	return &result, err
 }
 
+type GetResourceContentFuture struct {
+	*rpc.Call
+	result GetResourceContentResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetResourceContentFuture) Get() (*GetResourceContentResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetResourceContentRequest) DoAsync(ctx context.Context) *GetResourceContentFuture {
+	f := new(GetResourceContentFuture)
+	f.Call = r.client.Go(ctx, "Page.getResourceContent", r.params, &f.result)
+	return f
+}
+
+// SearchInResourceParams are the parameters of Page.searchInResource.
+type SearchInResourceParams struct {
+	// Frame id for resource to search in.
+	FrameId FrameId `json:"frameId"`
+
+	// URL of the resource to search in.
+	URL string `json:"url"`
+
+	// String to search for.
+	Query string `json:"query"`
+
+	// If true, search is case sensitive. (optional)
+	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+
+	// If true, treats string parameter as regex. (optional)
+	IsRegex *bool `json:"isRegex,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SearchInResourceParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Query == "" {
+		missing = append(missing, "query")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.searchInResource", Missing: missing, Invalid: invalid}
+}
+
 type SearchInResourceRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SearchInResourceParams
 }
 
 // Searches for given string in resource content. (experimental)
 func (d *Client) SearchInResource() *SearchInResourceRequest {
-	return &SearchInResourceRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SearchInResourceRequest{client: d.Client, params: &SearchInResourceParams{set: 1}}
+}
+
+// DoSearchInResource sends Page.searchInResource with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSearchInResource(ctx context.Context, params *SearchInResourceParams) (*SearchInResourceResult, error) {
+	if params == nil {
+		params = new(SearchInResourceParams)
+	}
+	return (&SearchInResourceRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Frame id for resource to search in.
 func (r *SearchInResourceRequest) FrameId(v FrameId) *SearchInResourceRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // URL of the resource to search in.
 func (r *SearchInResourceRequest) URL(v string) *SearchInResourceRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // String to search for.
 func (r *SearchInResourceRequest) Query(v string) *SearchInResourceRequest {
-	r.opts["query"] = v
+	r.params.Query = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // If true, search is case sensitive. (optional)
 func (r *SearchInResourceRequest) CaseSensitive(v bool) *SearchInResourceRequest {
-	r.opts["caseSensitive"] = v
+	r.params.CaseSensitive = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // If true, treats string parameter as regex. (optional)
 func (r *SearchInResourceRequest) IsRegex(v bool) *SearchInResourceRequest {
-	r.opts["isRegex"] = v
+	r.params.IsRegex = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
@@ -570,286 +1320,709 @@ type SearchInResourceResult struct {
 }
 
 func (r *SearchInResourceRequest) Do() (*SearchInResourceResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *SearchInResourceRequest) DoContext(ctx context.Context) (*SearchInResourceResult, error) {
 	var result SearchInResourceResult
-	err := r.client.Call("Page.searchInResource", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.searchInResource", r.params, &result)
 	return &result, err
 }
 
+type SearchInResourceFuture struct {
+	*rpc.Call
+	result SearchInResourceResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *SearchInResourceFuture) Get() (*SearchInResourceResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *SearchInResourceRequest) DoAsync(ctx context.Context) *SearchInResourceFuture {
+	f := new(SearchInResourceFuture)
+	f.Call = r.client.Go(ctx, "Page.searchInResource", r.params, &f.result)
+	return f
+}
+
+// SetDocumentContentParams are the parameters of Page.setDocumentContent.
+type SetDocumentContentParams struct {
+	// Frame id to set HTML for.
+	FrameId FrameId `json:"frameId"`
+
+	// HTML content to set.
+	Html string `json:"html"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDocumentContentParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Html == "" {
+		missing = append(missing, "html")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.setDocumentContent", Missing: missing, Invalid: invalid}
+}
+
 type SetDocumentContentRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDocumentContentParams
 }
 
 // Sets given markup as the document's HTML. (experimental)
 func (d *Client) SetDocumentContent() *SetDocumentContentRequest {
-	return &SetDocumentContentRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDocumentContentRequest{client: d.Client, params: &SetDocumentContentParams{set: 1}}
+}
+
+// DoSetDocumentContent sends Page.setDocumentContent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDocumentContent(ctx context.Context, params *SetDocumentContentParams) error {
+	if params == nil {
+		params = new(SetDocumentContentParams)
+	}
+	return (&SetDocumentContentRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Frame id to set HTML for.
 func (r *SetDocumentContentRequest) FrameId(v FrameId) *SetDocumentContentRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // HTML content to set.
 func (r *SetDocumentContentRequest) Html(v string) *SetDocumentContentRequest {
-	r.opts["html"] = v
+	r.params.Html = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetDocumentContentRequest) Do() error {
-	return r.client.Call("Page.setDocumentContent", r.opts, nil)
+	return r.DoContext(context.Background())
 }
 
-type SetDeviceMetricsOverrideRequest struct {
-	client *rpc.Client
-	opts   map[string]interface{}
+func (r *SetDocumentContentRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.setDocumentContent", r.params, nil)
 }
 
-// Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media query results). (experimental)
-func (d *Client) SetDeviceMetricsOverride() *SetDeviceMetricsOverrideRequest {
-	return &SetDeviceMetricsOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDocumentContentRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.setDocumentContent", r.params, nil)
 }
 
-// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
-func (r *SetDeviceMetricsOverrideRequest) Width(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["width"] = v
-	return r
-}
+// SetDeviceMetricsOverrideParams are the parameters of Page.setDeviceMetricsOverride.
+type SetDeviceMetricsOverrideParams struct {
+	// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Width int `json:"width"`
 
-// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
-func (r *SetDeviceMetricsOverrideRequest) Height(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["height"] = v
-	return r
-}
+	// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+	Height int `json:"height"`
 
-// Overriding device scale factor value. 0 disables the override.
-func (r *SetDeviceMetricsOverrideRequest) DeviceScaleFactor(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["deviceScaleFactor"] = v
-	return r
-}
+	// Overriding device scale factor value. 0 disables the override.
+	DeviceScaleFactor float64 `json:"deviceScaleFactor"`
 
-// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
-func (r *SetDeviceMetricsOverrideRequest) Mobile(v bool) *SetDeviceMetricsOverrideRequest {
-	r.opts["mobile"] = v
-	return r
-}
+	// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
+	Mobile bool `json:"mobile"`
 
-// Whether a view that exceeds the available browser window area should be scaled down to fit.
+	// Whether a view that exceeds the available browser window area should be scaled down to fit.
+	FitWindow bool `json:"fitWindow"`
+
+	// Scale to apply to resulting view image. Ignored in |fitWindow| mode. (optional)
+	Scale *float64 `json:"scale,omitempty"`
+
+	// X offset to shift resulting view image by. Ignored in |fitWindow| mode. (optional)
+	OffsetX *float64 `json:"offsetX,omitempty"`
+
+	// Y offset to shift resulting view image by. Ignored in |fitWindow| mode. (optional)
+	OffsetY *float64 `json:"offsetY,omitempty"`
+
+	// Overriding screen width value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
+	ScreenWidth *int `json:"screenWidth,omitempty"`
+
+	// Overriding screen height value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
+	ScreenHeight *int `json:"screenHeight,omitempty"`
+
+	// Overriding view X position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
+	PositionX *int `json:"positionX,omitempty"`
+
+	// Overriding view Y position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
+	PositionY *int `json:"positionY,omitempty"`
+
+	// Screen orientation override. (optional)
+	ScreenOrientation *emulation.ScreenOrientation `json:"screenOrientation,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDeviceMetricsOverrideParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "width")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "height")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "deviceScaleFactor")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 {
+		missing = append(missing, "mobile")
+	}
+	if p.set&1 != 0 && p.set&(1<<5) == 0 {
+		missing = append(missing, "fitWindow")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.setDeviceMetricsOverride", Missing: missing, Invalid: invalid}
+}
+
+type SetDeviceMetricsOverrideRequest struct {
+	client *rpc.Client
+	params *SetDeviceMetricsOverrideParams
+}
+
+// Overrides the values of device screen dimensions (window.screen.width, window.screen.height, window.innerWidth, window.innerHeight, and "device-width"/"device-height"-related CSS media query results). (experimental)
+func (d *Client) SetDeviceMetricsOverride() *SetDeviceMetricsOverrideRequest {
+	return &SetDeviceMetricsOverrideRequest{client: d.Client, params: &SetDeviceMetricsOverrideParams{set: 1}}
+}
+
+// DoSetDeviceMetricsOverride sends Page.setDeviceMetricsOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDeviceMetricsOverride(ctx context.Context, params *SetDeviceMetricsOverrideParams) error {
+	if params == nil {
+		params = new(SetDeviceMetricsOverrideParams)
+	}
+	return (&SetDeviceMetricsOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
+}
+
+// Overriding width value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+func (r *SetDeviceMetricsOverrideRequest) Width(v int) *SetDeviceMetricsOverrideRequest {
+	r.params.Width = v
+	r.params.set |= 1 << 1
+	return r
+}
+
+// Overriding height value in pixels (minimum 0, maximum 10000000). 0 disables the override.
+func (r *SetDeviceMetricsOverrideRequest) Height(v int) *SetDeviceMetricsOverrideRequest {
+	r.params.Height = v
+	r.params.set |= 1 << 2
+	return r
+}
+
+// Overriding device scale factor value. 0 disables the override.
+func (r *SetDeviceMetricsOverrideRequest) DeviceScaleFactor(v float64) *SetDeviceMetricsOverrideRequest {
+	r.params.DeviceScaleFactor = v
+	r.params.set |= 1 << 3
+	return r
+}
+
+// Whether to emulate mobile device. This includes viewport meta tag, overlay scrollbars, text autosizing and more.
+func (r *SetDeviceMetricsOverrideRequest) Mobile(v bool) *SetDeviceMetricsOverrideRequest {
+	r.params.Mobile = v
+	r.params.set |= 1 << 4
+	return r
+}
+
+// Whether a view that exceeds the available browser window area should be scaled down to fit.
 func (r *SetDeviceMetricsOverrideRequest) FitWindow(v bool) *SetDeviceMetricsOverrideRequest {
-	r.opts["fitWindow"] = v
+	r.params.FitWindow = v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Scale to apply to resulting view image. Ignored in |fitWindow| mode. (optional)
 func (r *SetDeviceMetricsOverrideRequest) Scale(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["scale"] = v
+	r.params.Scale = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // X offset to shift resulting view image by. Ignored in |fitWindow| mode. (optional)
 func (r *SetDeviceMetricsOverrideRequest) OffsetX(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["offsetX"] = v
+	r.params.OffsetX = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Y offset to shift resulting view image by. Ignored in |fitWindow| mode. (optional)
 func (r *SetDeviceMetricsOverrideRequest) OffsetY(v float64) *SetDeviceMetricsOverrideRequest {
-	r.opts["offsetY"] = v
+	r.params.OffsetY = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // Overriding screen width value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
 func (r *SetDeviceMetricsOverrideRequest) ScreenWidth(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["screenWidth"] = v
+	r.params.ScreenWidth = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
 // Overriding screen height value in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
 func (r *SetDeviceMetricsOverrideRequest) ScreenHeight(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["screenHeight"] = v
+	r.params.ScreenHeight = &v
+	r.params.set |= 1 << 10
 	return r
 }
 
 // Overriding view X position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
 func (r *SetDeviceMetricsOverrideRequest) PositionX(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["positionX"] = v
+	r.params.PositionX = &v
+	r.params.set |= 1 << 11
 	return r
 }
 
 // Overriding view Y position on screen in pixels (minimum 0, maximum 10000000). Only used for |mobile==true|. (optional)
 func (r *SetDeviceMetricsOverrideRequest) PositionY(v int) *SetDeviceMetricsOverrideRequest {
-	r.opts["positionY"] = v
+	r.params.PositionY = &v
+	r.params.set |= 1 << 12
 	return r
 }
 
 // Screen orientation override. (optional)
 func (r *SetDeviceMetricsOverrideRequest) ScreenOrientation(v *emulation.ScreenOrientation) *SetDeviceMetricsOverrideRequest {
-	r.opts["screenOrientation"] = v
+	r.params.ScreenOrientation = v
+	r.params.set |= 1 << 13
 	return r
 }
 
 func (r *SetDeviceMetricsOverrideRequest) Do() error {
-	return r.client.Call("Page.setDeviceMetricsOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDeviceMetricsOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.setDeviceMetricsOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDeviceMetricsOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.setDeviceMetricsOverride", r.params, nil)
+}
+
+// ClearDeviceMetricsOverrideParams are the parameters of Page.clearDeviceMetricsOverride.
+type ClearDeviceMetricsOverrideParams struct {
 }
 
 type ClearDeviceMetricsOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearDeviceMetricsOverrideParams
 }
 
 // Clears the overriden device metrics. (experimental)
 func (d *Client) ClearDeviceMetricsOverride() *ClearDeviceMetricsOverrideRequest {
-	return &ClearDeviceMetricsOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearDeviceMetricsOverrideRequest{client: d.Client, params: &ClearDeviceMetricsOverrideParams{}}
+}
+
+// DoClearDeviceMetricsOverride sends Page.clearDeviceMetricsOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearDeviceMetricsOverride(ctx context.Context, params *ClearDeviceMetricsOverrideParams) error {
+	if params == nil {
+		params = new(ClearDeviceMetricsOverrideParams)
+	}
+	return (&ClearDeviceMetricsOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearDeviceMetricsOverrideRequest) Do() error {
-	return r.client.Call("Page.clearDeviceMetricsOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearDeviceMetricsOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.clearDeviceMetricsOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearDeviceMetricsOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.clearDeviceMetricsOverride", r.params, nil)
+}
+
+// SetGeolocationOverrideParams are the parameters of Page.setGeolocationOverride.
+type SetGeolocationOverrideParams struct {
+	// Mock latitude (optional)
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Mock longitude (optional)
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Mock accuracy (optional)
+	Accuracy *float64 `json:"accuracy,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetGeolocationOverrideParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.setGeolocationOverride", Missing: missing, Invalid: invalid}
 }
 
 type SetGeolocationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetGeolocationOverrideParams
 }
 
 // Overrides the Geolocation Position or Error. Omitting any of the parameters emulates position unavailable.
 func (d *Client) SetGeolocationOverride() *SetGeolocationOverrideRequest {
-	return &SetGeolocationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetGeolocationOverrideRequest{client: d.Client, params: &SetGeolocationOverrideParams{set: 1}}
+}
+
+// DoSetGeolocationOverride sends Page.setGeolocationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetGeolocationOverride(ctx context.Context, params *SetGeolocationOverrideParams) error {
+	if params == nil {
+		params = new(SetGeolocationOverrideParams)
+	}
+	return (&SetGeolocationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Mock latitude (optional)
 func (r *SetGeolocationOverrideRequest) Latitude(v float64) *SetGeolocationOverrideRequest {
-	r.opts["latitude"] = v
+	r.params.Latitude = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Mock longitude (optional)
 func (r *SetGeolocationOverrideRequest) Longitude(v float64) *SetGeolocationOverrideRequest {
-	r.opts["longitude"] = v
+	r.params.Longitude = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Mock accuracy (optional)
 func (r *SetGeolocationOverrideRequest) Accuracy(v float64) *SetGeolocationOverrideRequest {
-	r.opts["accuracy"] = v
+	r.params.Accuracy = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetGeolocationOverrideRequest) Do() error {
-	return r.client.Call("Page.setGeolocationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetGeolocationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.setGeolocationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetGeolocationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.setGeolocationOverride", r.params, nil)
+}
+
+// ClearGeolocationOverrideParams are the parameters of Page.clearGeolocationOverride.
+type ClearGeolocationOverrideParams struct {
 }
 
 type ClearGeolocationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearGeolocationOverrideParams
 }
 
 // Clears the overriden Geolocation Position and Error.
 func (d *Client) ClearGeolocationOverride() *ClearGeolocationOverrideRequest {
-	return &ClearGeolocationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearGeolocationOverrideRequest{client: d.Client, params: &ClearGeolocationOverrideParams{}}
+}
+
+// DoClearGeolocationOverride sends Page.clearGeolocationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearGeolocationOverride(ctx context.Context, params *ClearGeolocationOverrideParams) error {
+	if params == nil {
+		params = new(ClearGeolocationOverrideParams)
+	}
+	return (&ClearGeolocationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearGeolocationOverrideRequest) Do() error {
-	return r.client.Call("Page.clearGeolocationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearGeolocationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.clearGeolocationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearGeolocationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.clearGeolocationOverride", r.params, nil)
+}
+
+// SetDeviceOrientationOverrideParams are the parameters of Page.setDeviceOrientationOverride.
+type SetDeviceOrientationOverrideParams struct {
+	// Mock alpha
+	Alpha float64 `json:"alpha"`
+
+	// Mock beta
+	Beta float64 `json:"beta"`
+
+	// Mock gamma
+	Gamma float64 `json:"gamma"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDeviceOrientationOverrideParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "alpha")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "beta")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "gamma")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.setDeviceOrientationOverride", Missing: missing, Invalid: invalid}
 }
 
 type SetDeviceOrientationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDeviceOrientationOverrideParams
 }
 
 // Overrides the Device Orientation. (experimental)
 func (d *Client) SetDeviceOrientationOverride() *SetDeviceOrientationOverrideRequest {
-	return &SetDeviceOrientationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDeviceOrientationOverrideRequest{client: d.Client, params: &SetDeviceOrientationOverrideParams{set: 1}}
+}
+
+// DoSetDeviceOrientationOverride sends Page.setDeviceOrientationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDeviceOrientationOverride(ctx context.Context, params *SetDeviceOrientationOverrideParams) error {
+	if params == nil {
+		params = new(SetDeviceOrientationOverrideParams)
+	}
+	return (&SetDeviceOrientationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Mock alpha
 func (r *SetDeviceOrientationOverrideRequest) Alpha(v float64) *SetDeviceOrientationOverrideRequest {
-	r.opts["alpha"] = v
+	r.params.Alpha = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Mock beta
 func (r *SetDeviceOrientationOverrideRequest) Beta(v float64) *SetDeviceOrientationOverrideRequest {
-	r.opts["beta"] = v
+	r.params.Beta = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Mock gamma
 func (r *SetDeviceOrientationOverrideRequest) Gamma(v float64) *SetDeviceOrientationOverrideRequest {
-	r.opts["gamma"] = v
+	r.params.Gamma = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *SetDeviceOrientationOverrideRequest) Do() error {
-	return r.client.Call("Page.setDeviceOrientationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDeviceOrientationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.setDeviceOrientationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDeviceOrientationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.setDeviceOrientationOverride", r.params, nil)
+}
+
+// ClearDeviceOrientationOverrideParams are the parameters of Page.clearDeviceOrientationOverride.
+type ClearDeviceOrientationOverrideParams struct {
 }
 
 type ClearDeviceOrientationOverrideRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearDeviceOrientationOverrideParams
 }
 
 // Clears the overridden Device Orientation. (experimental)
 func (d *Client) ClearDeviceOrientationOverride() *ClearDeviceOrientationOverrideRequest {
-	return &ClearDeviceOrientationOverrideRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearDeviceOrientationOverrideRequest{client: d.Client, params: &ClearDeviceOrientationOverrideParams{}}
+}
+
+// DoClearDeviceOrientationOverride sends Page.clearDeviceOrientationOverride with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearDeviceOrientationOverride(ctx context.Context, params *ClearDeviceOrientationOverrideParams) error {
+	if params == nil {
+		params = new(ClearDeviceOrientationOverrideParams)
+	}
+	return (&ClearDeviceOrientationOverrideRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ClearDeviceOrientationOverrideRequest) Do() error {
-	return r.client.Call("Page.clearDeviceOrientationOverride", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearDeviceOrientationOverrideRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.clearDeviceOrientationOverride", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearDeviceOrientationOverrideRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.clearDeviceOrientationOverride", r.params, nil)
+}
+
+// SetTouchEmulationEnabledParams are the parameters of Page.setTouchEmulationEnabled.
+type SetTouchEmulationEnabledParams struct {
+	// Whether the touch event emulation should be enabled.
+	Enabled bool `json:"enabled"`
+
+	// Touch/gesture events configuration. Default: current platform. (optional)
+	Configuration *SetTouchEmulationEnabledConfiguration `json:"configuration,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetTouchEmulationEnabledParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "enabled")
+	}
+	if p.Configuration != nil && !p.Configuration.IsValid() {
+		invalid = append(invalid, "configuration")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.setTouchEmulationEnabled", Missing: missing, Invalid: invalid}
 }
 
 type SetTouchEmulationEnabledRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetTouchEmulationEnabledParams
 }
 
 // Toggles mouse event-based touch event emulation. (experimental)
 func (d *Client) SetTouchEmulationEnabled() *SetTouchEmulationEnabledRequest {
-	return &SetTouchEmulationEnabledRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetTouchEmulationEnabledRequest{client: d.Client, params: &SetTouchEmulationEnabledParams{set: 1}}
+}
+
+// DoSetTouchEmulationEnabled sends Page.setTouchEmulationEnabled with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetTouchEmulationEnabled(ctx context.Context, params *SetTouchEmulationEnabledParams) error {
+	if params == nil {
+		params = new(SetTouchEmulationEnabledParams)
+	}
+	return (&SetTouchEmulationEnabledRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether the touch event emulation should be enabled.
 func (r *SetTouchEmulationEnabledRequest) Enabled(v bool) *SetTouchEmulationEnabledRequest {
-	r.opts["enabled"] = v
+	r.params.Enabled = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Touch/gesture events configuration. Default: current platform. (optional)
-func (r *SetTouchEmulationEnabledRequest) Configuration(v string) *SetTouchEmulationEnabledRequest {
-	r.opts["configuration"] = v
+func (r *SetTouchEmulationEnabledRequest) Configuration(v SetTouchEmulationEnabledConfiguration) *SetTouchEmulationEnabledRequest {
+	r.params.Configuration = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetTouchEmulationEnabledRequest) Do() error {
-	return r.client.Call("Page.setTouchEmulationEnabled", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetTouchEmulationEnabledRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.setTouchEmulationEnabled", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetTouchEmulationEnabledRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.setTouchEmulationEnabled", r.params, nil)
+}
+
+// CaptureScreenshotParams are the parameters of Page.captureScreenshot.
+type CaptureScreenshotParams struct {
+	// Image compression format (defaults to png). (optional)
+	Format *CaptureScreenshotFormat `json:"format,omitempty"`
+
+	// Compression quality from range [0..100] (jpeg only). (optional)
+	Quality *int `json:"quality,omitempty"`
+
+	// Capture the screenshot from the surface, rather than the view. Defaults to true. (optional, experimental)
+	FromSurface *bool `json:"fromSurface,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CaptureScreenshotParams) Validate() error {
+	var missing, invalid []string
+	if p.Format != nil && !p.Format.IsValid() {
+		invalid = append(invalid, "format")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.captureScreenshot", Missing: missing, Invalid: invalid}
 }
 
 type CaptureScreenshotRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CaptureScreenshotParams
 }
 
 // Capture page screenshot. (experimental)
 func (d *Client) CaptureScreenshot() *CaptureScreenshotRequest {
-	return &CaptureScreenshotRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CaptureScreenshotRequest{client: d.Client, params: &CaptureScreenshotParams{set: 1}}
+}
+
+// DoCaptureScreenshot sends Page.captureScreenshot with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCaptureScreenshot(ctx context.Context, params *CaptureScreenshotParams) (*CaptureScreenshotResult, error) {
+	if params == nil {
+		params = new(CaptureScreenshotParams)
+	}
+	return (&CaptureScreenshotRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Image compression format (defaults to png). (optional)
-func (r *CaptureScreenshotRequest) Format(v string) *CaptureScreenshotRequest {
-	r.opts["format"] = v
+func (r *CaptureScreenshotRequest) Format(v CaptureScreenshotFormat) *CaptureScreenshotRequest {
+	r.params.Format = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Compression quality from range [0..100] (jpeg only). (optional)
 func (r *CaptureScreenshotRequest) Quality(v int) *CaptureScreenshotRequest {
-	r.opts["quality"] = v
+	r.params.Quality = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Capture the screenshot from the surface, rather than the view. Defaults to true. (optional, experimental)
 func (r *CaptureScreenshotRequest) FromSurface(v bool) *CaptureScreenshotRequest {
-	r.opts["fromSurface"] = v
+	r.params.FromSurface = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -859,84 +2032,175 @@ type CaptureScreenshotResult struct {
 }
 
 func (r *CaptureScreenshotRequest) Do() (*CaptureScreenshotResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CaptureScreenshotRequest) DoContext(ctx context.Context) (*CaptureScreenshotResult, error) {
 	var result CaptureScreenshotResult
-	err := r.client.Call("Page.captureScreenshot", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.captureScreenshot", r.params, &result)
 	return &result, err
 }
 
+type CaptureScreenshotFuture struct {
+	*rpc.Call
+	result CaptureScreenshotResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CaptureScreenshotFuture) Get() (*CaptureScreenshotResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CaptureScreenshotRequest) DoAsync(ctx context.Context) *CaptureScreenshotFuture {
+	f := new(CaptureScreenshotFuture)
+	f.Call = r.client.Go(ctx, "Page.captureScreenshot", r.params, &f.result)
+	return f
+}
+
+// PrintToPDFParams are the parameters of Page.printToPDF.
+type PrintToPDFParams struct {
+	// Paper orientation. Defaults to false. (optional)
+	Landscape *bool `json:"landscape,omitempty"`
+
+	// Display header and footer. Defaults to false. (optional)
+	DisplayHeaderFooter *bool `json:"displayHeaderFooter,omitempty"`
+
+	// Print background graphics. Defaults to false. (optional)
+	PrintBackground *bool `json:"printBackground,omitempty"`
+
+	// Scale of the webpage rendering. Defaults to 1. (optional)
+	Scale *float64 `json:"scale,omitempty"`
+
+	// Paper width in inches. Defaults to 8.5 inches. (optional)
+	PaperWidth *float64 `json:"paperWidth,omitempty"`
+
+	// Paper height in inches. Defaults to 11 inches. (optional)
+	PaperHeight *float64 `json:"paperHeight,omitempty"`
+
+	// Top margin in inches. Defaults to 1cm (~0.4 inches). (optional)
+	MarginTop *float64 `json:"marginTop,omitempty"`
+
+	// Bottom margin in inches. Defaults to 1cm (~0.4 inches). (optional)
+	MarginBottom *float64 `json:"marginBottom,omitempty"`
+
+	// Left margin in inches. Defaults to 1cm (~0.4 inches). (optional)
+	MarginLeft *float64 `json:"marginLeft,omitempty"`
+
+	// Right margin in inches. Defaults to 1cm (~0.4 inches). (optional)
+	MarginRight *float64 `json:"marginRight,omitempty"`
+
+	// Paper ranges to print, e.g., '1-5, 8, 11-13'. Defaults to the empty string, which means print all pages. (optional)
+	PageRanges *string `json:"pageRanges,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *PrintToPDFParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.printToPDF", Missing: missing, Invalid: invalid}
+}
+
 type PrintToPDFRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *PrintToPDFParams
 }
 
 // Print page as PDF. (experimental)
 func (d *Client) PrintToPDF() *PrintToPDFRequest {
-	return &PrintToPDFRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &PrintToPDFRequest{client: d.Client, params: &PrintToPDFParams{set: 1}}
+}
+
+// DoPrintToPDF sends Page.printToPDF with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoPrintToPDF(ctx context.Context, params *PrintToPDFParams) (*PrintToPDFResult, error) {
+	if params == nil {
+		params = new(PrintToPDFParams)
+	}
+	return (&PrintToPDFRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Paper orientation. Defaults to false. (optional)
 func (r *PrintToPDFRequest) Landscape(v bool) *PrintToPDFRequest {
-	r.opts["landscape"] = v
+	r.params.Landscape = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Display header and footer. Defaults to false. (optional)
 func (r *PrintToPDFRequest) DisplayHeaderFooter(v bool) *PrintToPDFRequest {
-	r.opts["displayHeaderFooter"] = v
+	r.params.DisplayHeaderFooter = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Print background graphics. Defaults to false. (optional)
 func (r *PrintToPDFRequest) PrintBackground(v bool) *PrintToPDFRequest {
-	r.opts["printBackground"] = v
+	r.params.PrintBackground = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Scale of the webpage rendering. Defaults to 1. (optional)
 func (r *PrintToPDFRequest) Scale(v float64) *PrintToPDFRequest {
-	r.opts["scale"] = v
+	r.params.Scale = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Paper width in inches. Defaults to 8.5 inches. (optional)
 func (r *PrintToPDFRequest) PaperWidth(v float64) *PrintToPDFRequest {
-	r.opts["paperWidth"] = v
+	r.params.PaperWidth = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Paper height in inches. Defaults to 11 inches. (optional)
 func (r *PrintToPDFRequest) PaperHeight(v float64) *PrintToPDFRequest {
-	r.opts["paperHeight"] = v
+	r.params.PaperHeight = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Top margin in inches. Defaults to 1cm (~0.4 inches). (optional)
 func (r *PrintToPDFRequest) MarginTop(v float64) *PrintToPDFRequest {
-	r.opts["marginTop"] = v
+	r.params.MarginTop = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Bottom margin in inches. Defaults to 1cm (~0.4 inches). (optional)
 func (r *PrintToPDFRequest) MarginBottom(v float64) *PrintToPDFRequest {
-	r.opts["marginBottom"] = v
+	r.params.MarginBottom = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // Left margin in inches. Defaults to 1cm (~0.4 inches). (optional)
 func (r *PrintToPDFRequest) MarginLeft(v float64) *PrintToPDFRequest {
-	r.opts["marginLeft"] = v
+	r.params.MarginLeft = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
 // Right margin in inches. Defaults to 1cm (~0.4 inches). (optional)
 func (r *PrintToPDFRequest) MarginRight(v float64) *PrintToPDFRequest {
-	r.opts["marginRight"] = v
+	r.params.MarginRight = &v
+	r.params.set |= 1 << 10
 	return r
 }
 
 // Paper ranges to print, e.g., '1-5, 8, 11-13'. Defaults to the empty string, which means print all pages. (optional)
 func (r *PrintToPDFRequest) PageRanges(v string) *PrintToPDFRequest {
-	r.opts["pageRanges"] = v
+	r.params.PageRanges = &v
+	r.params.set |= 1 << 11
 	return r
 }
 
@@ -946,123 +2210,321 @@ type PrintToPDFResult struct {
 }
 
 func (r *PrintToPDFRequest) Do() (*PrintToPDFResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *PrintToPDFRequest) DoContext(ctx context.Context) (*PrintToPDFResult, error) {
 	var result PrintToPDFResult
-	err := r.client.Call("Page.printToPDF", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.printToPDF", r.params, &result)
 	return &result, err
 }
 
+type PrintToPDFFuture struct {
+	*rpc.Call
+	result PrintToPDFResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *PrintToPDFFuture) Get() (*PrintToPDFResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *PrintToPDFRequest) DoAsync(ctx context.Context) *PrintToPDFFuture {
+	f := new(PrintToPDFFuture)
+	f.Call = r.client.Go(ctx, "Page.printToPDF", r.params, &f.result)
+	return f
+}
+
+// StartScreencastParams are the parameters of Page.startScreencast.
+type StartScreencastParams struct {
+	// Image compression format. (optional)
+	Format *StartScreencastFormat `json:"format,omitempty"`
+
+	// Compression quality from range [0..100]. (optional)
+	Quality *int `json:"quality,omitempty"`
+
+	// Maximum screenshot width. (optional)
+	MaxWidth *int `json:"maxWidth,omitempty"`
+
+	// Maximum screenshot height. (optional)
+	MaxHeight *int `json:"maxHeight,omitempty"`
+
+	// Send every n-th frame. (optional)
+	EveryNthFrame *int `json:"everyNthFrame,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StartScreencastParams) Validate() error {
+	var missing, invalid []string
+	if p.Format != nil && !p.Format.IsValid() {
+		invalid = append(invalid, "format")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.startScreencast", Missing: missing, Invalid: invalid}
+}
+
 type StartScreencastRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartScreencastParams
 }
 
 // Starts sending each frame using the <code>screencastFrame</code> event. (experimental)
 func (d *Client) StartScreencast() *StartScreencastRequest {
-	return &StartScreencastRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartScreencastRequest{client: d.Client, params: &StartScreencastParams{set: 1}}
+}
+
+// DoStartScreencast sends Page.startScreencast with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStartScreencast(ctx context.Context, params *StartScreencastParams) error {
+	if params == nil {
+		params = new(StartScreencastParams)
+	}
+	return (&StartScreencastRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Image compression format. (optional)
-func (r *StartScreencastRequest) Format(v string) *StartScreencastRequest {
-	r.opts["format"] = v
+func (r *StartScreencastRequest) Format(v StartScreencastFormat) *StartScreencastRequest {
+	r.params.Format = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Compression quality from range [0..100]. (optional)
 func (r *StartScreencastRequest) Quality(v int) *StartScreencastRequest {
-	r.opts["quality"] = v
+	r.params.Quality = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Maximum screenshot width. (optional)
 func (r *StartScreencastRequest) MaxWidth(v int) *StartScreencastRequest {
-	r.opts["maxWidth"] = v
+	r.params.MaxWidth = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Maximum screenshot height. (optional)
 func (r *StartScreencastRequest) MaxHeight(v int) *StartScreencastRequest {
-	r.opts["maxHeight"] = v
+	r.params.MaxHeight = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Send every n-th frame. (optional)
 func (r *StartScreencastRequest) EveryNthFrame(v int) *StartScreencastRequest {
-	r.opts["everyNthFrame"] = v
+	r.params.EveryNthFrame = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 func (r *StartScreencastRequest) Do() error {
-	return r.client.Call("Page.startScreencast", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartScreencastRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.startScreencast", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartScreencastRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.startScreencast", r.params, nil)
+}
+
+// StopScreencastParams are the parameters of Page.stopScreencast.
+type StopScreencastParams struct {
 }
 
 type StopScreencastRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopScreencastParams
 }
 
 // Stops sending each frame in the <code>screencastFrame</code>. (experimental)
 func (d *Client) StopScreencast() *StopScreencastRequest {
-	return &StopScreencastRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopScreencastRequest{client: d.Client, params: &StopScreencastParams{}}
+}
+
+// DoStopScreencast sends Page.stopScreencast with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopScreencast(ctx context.Context, params *StopScreencastParams) error {
+	if params == nil {
+		params = new(StopScreencastParams)
+	}
+	return (&StopScreencastRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StopScreencastRequest) Do() error {
-	return r.client.Call("Page.stopScreencast", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StopScreencastRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.stopScreencast", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StopScreencastRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.stopScreencast", r.params, nil)
+}
+
+// ScreencastFrameAckParams are the parameters of Page.screencastFrameAck.
+type ScreencastFrameAckParams struct {
+	// Frame number.
+	SessionId int `json:"sessionId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ScreencastFrameAckParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "sessionId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.screencastFrameAck", Missing: missing, Invalid: invalid}
 }
 
 type ScreencastFrameAckRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ScreencastFrameAckParams
 }
 
 // Acknowledges that a screencast frame has been received by the frontend. (experimental)
 func (d *Client) ScreencastFrameAck() *ScreencastFrameAckRequest {
-	return &ScreencastFrameAckRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ScreencastFrameAckRequest{client: d.Client, params: &ScreencastFrameAckParams{set: 1}}
+}
+
+// DoScreencastFrameAck sends Page.screencastFrameAck with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoScreencastFrameAck(ctx context.Context, params *ScreencastFrameAckParams) error {
+	if params == nil {
+		params = new(ScreencastFrameAckParams)
+	}
+	return (&ScreencastFrameAckRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Frame number.
 func (r *ScreencastFrameAckRequest) SessionId(v int) *ScreencastFrameAckRequest {
-	r.opts["sessionId"] = v
+	r.params.SessionId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ScreencastFrameAckRequest) Do() error {
-	return r.client.Call("Page.screencastFrameAck", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ScreencastFrameAckRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.screencastFrameAck", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ScreencastFrameAckRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.screencastFrameAck", r.params, nil)
+}
+
+// HandleJavaScriptDialogParams are the parameters of Page.handleJavaScriptDialog.
+type HandleJavaScriptDialogParams struct {
+	// Whether to accept or dismiss the dialog.
+	Accept bool `json:"accept"`
+
+	// The text to enter into the dialog prompt before accepting. Used only if this is a prompt dialog. (optional)
+	PromptText *string `json:"promptText,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *HandleJavaScriptDialogParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "accept")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.handleJavaScriptDialog", Missing: missing, Invalid: invalid}
 }
 
 type HandleJavaScriptDialogRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HandleJavaScriptDialogParams
 }
 
 // Accepts or dismisses a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload).
 func (d *Client) HandleJavaScriptDialog() *HandleJavaScriptDialogRequest {
-	return &HandleJavaScriptDialogRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HandleJavaScriptDialogRequest{client: d.Client, params: &HandleJavaScriptDialogParams{set: 1}}
+}
+
+// DoHandleJavaScriptDialog sends Page.handleJavaScriptDialog with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHandleJavaScriptDialog(ctx context.Context, params *HandleJavaScriptDialogParams) error {
+	if params == nil {
+		params = new(HandleJavaScriptDialogParams)
+	}
+	return (&HandleJavaScriptDialogRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether to accept or dismiss the dialog.
 func (r *HandleJavaScriptDialogRequest) Accept(v bool) *HandleJavaScriptDialogRequest {
-	r.opts["accept"] = v
+	r.params.Accept = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The text to enter into the dialog prompt before accepting. Used only if this is a prompt dialog. (optional)
 func (r *HandleJavaScriptDialogRequest) PromptText(v string) *HandleJavaScriptDialogRequest {
-	r.opts["promptText"] = v
+	r.params.PromptText = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *HandleJavaScriptDialogRequest) Do() error {
-	return r.client.Call("Page.handleJavaScriptDialog", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HandleJavaScriptDialogRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.handleJavaScriptDialog", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HandleJavaScriptDialogRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.handleJavaScriptDialog", r.params, nil)
+}
+
+// GetAppManifestParams are the parameters of Page.getAppManifest.
+type GetAppManifestParams struct {
 }
 
 type GetAppManifestRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetAppManifestParams
 }
 
 // (experimental)
 func (d *Client) GetAppManifest() *GetAppManifestRequest {
-	return &GetAppManifestRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetAppManifestRequest{client: d.Client, params: &GetAppManifestParams{}}
+}
+
+// DoGetAppManifest sends Page.getAppManifest with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetAppManifest(ctx context.Context, params *GetAppManifestParams) (*GetAppManifestResult, error) {
+	if params == nil {
+		params = new(GetAppManifestParams)
+	}
+	return (&GetAppManifestRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetAppManifestResult struct {
@@ -1072,80 +2534,223 @@ type GetAppManifestResult struct {
 	Errors []*AppManifestError `json:"errors"`
 
 	// Manifest content. (optional)
-	Data string `json:"data"`
+	Data *string `json:"data,omitempty"`
 }
 
 func (r *GetAppManifestRequest) Do() (*GetAppManifestResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetAppManifestRequest) DoContext(ctx context.Context) (*GetAppManifestResult, error) {
 	var result GetAppManifestResult
-	err := r.client.Call("Page.getAppManifest", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.getAppManifest", r.params, &result)
 	return &result, err
 }
 
+type GetAppManifestFuture struct {
+	*rpc.Call
+	result GetAppManifestResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetAppManifestFuture) Get() (*GetAppManifestResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetAppManifestRequest) DoAsync(ctx context.Context) *GetAppManifestFuture {
+	f := new(GetAppManifestFuture)
+	f.Call = r.client.Go(ctx, "Page.getAppManifest", r.params, &f.result)
+	return f
+}
+
+// RequestAppBannerParams are the parameters of Page.requestAppBanner.
+type RequestAppBannerParams struct {
+}
+
 type RequestAppBannerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestAppBannerParams
 }
 
 // (experimental)
 func (d *Client) RequestAppBanner() *RequestAppBannerRequest {
-	return &RequestAppBannerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestAppBannerRequest{client: d.Client, params: &RequestAppBannerParams{}}
+}
+
+// DoRequestAppBanner sends Page.requestAppBanner with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestAppBanner(ctx context.Context, params *RequestAppBannerParams) error {
+	if params == nil {
+		params = new(RequestAppBannerParams)
+	}
+	return (&RequestAppBannerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *RequestAppBannerRequest) Do() error {
-	return r.client.Call("Page.requestAppBanner", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestAppBannerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.requestAppBanner", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RequestAppBannerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.requestAppBanner", r.params, nil)
+}
+
+// SetControlNavigationsParams are the parameters of Page.setControlNavigations.
+type SetControlNavigationsParams struct {
+	Enabled bool `json:"enabled"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetControlNavigationsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "enabled")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.setControlNavigations", Missing: missing, Invalid: invalid}
 }
 
 type SetControlNavigationsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetControlNavigationsParams
 }
 
 // Toggles navigation throttling which allows programatic control over navigation and redirect response. (experimental)
 func (d *Client) SetControlNavigations() *SetControlNavigationsRequest {
-	return &SetControlNavigationsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetControlNavigationsRequest{client: d.Client, params: &SetControlNavigationsParams{set: 1}}
+}
+
+// DoSetControlNavigations sends Page.setControlNavigations with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetControlNavigations(ctx context.Context, params *SetControlNavigationsParams) error {
+	if params == nil {
+		params = new(SetControlNavigationsParams)
+	}
+	return (&SetControlNavigationsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetControlNavigationsRequest) Enabled(v bool) *SetControlNavigationsRequest {
-	r.opts["enabled"] = v
+	r.params.Enabled = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetControlNavigationsRequest) Do() error {
-	return r.client.Call("Page.setControlNavigations", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetControlNavigationsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.setControlNavigations", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetControlNavigationsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.setControlNavigations", r.params, nil)
+}
+
+// ProcessNavigationParams are the parameters of Page.processNavigation.
+type ProcessNavigationParams struct {
+	Response NavigationResponse `json:"response"`
+
+	NavigationId int `json:"navigationId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ProcessNavigationParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Response == "" {
+		missing = append(missing, "response")
+	} else if !p.Response.IsValid() {
+		invalid = append(invalid, "response")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "navigationId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.processNavigation", Missing: missing, Invalid: invalid}
 }
 
 type ProcessNavigationRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ProcessNavigationParams
 }
 
 // Should be sent in response to a navigationRequested or a redirectRequested event, telling the browser how to handle the navigation. (experimental)
 func (d *Client) ProcessNavigation() *ProcessNavigationRequest {
-	return &ProcessNavigationRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ProcessNavigationRequest{client: d.Client, params: &ProcessNavigationParams{set: 1}}
+}
+
+// DoProcessNavigation sends Page.processNavigation with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoProcessNavigation(ctx context.Context, params *ProcessNavigationParams) error {
+	if params == nil {
+		params = new(ProcessNavigationParams)
+	}
+	return (&ProcessNavigationRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ProcessNavigationRequest) Response(v NavigationResponse) *ProcessNavigationRequest {
-	r.opts["response"] = v
+	r.params.Response = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ProcessNavigationRequest) NavigationId(v int) *ProcessNavigationRequest {
-	r.opts["navigationId"] = v
+	r.params.NavigationId = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *ProcessNavigationRequest) Do() error {
-	return r.client.Call("Page.processNavigation", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ProcessNavigationRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.processNavigation", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ProcessNavigationRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.processNavigation", r.params, nil)
+}
+
+// GetLayoutMetricsParams are the parameters of Page.getLayoutMetrics.
+type GetLayoutMetricsParams struct {
 }
 
 type GetLayoutMetricsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetLayoutMetricsParams
 }
 
 // Returns metrics relating to the layouting of the page, such as viewport bounds/scale. (experimental)
 func (d *Client) GetLayoutMetrics() *GetLayoutMetricsRequest {
-	return &GetLayoutMetricsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetLayoutMetricsRequest{client: d.Client, params: &GetLayoutMetricsParams{}}
+}
+
+// DoGetLayoutMetrics sends Page.getLayoutMetrics with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetLayoutMetrics(ctx context.Context, params *GetLayoutMetricsParams) (*GetLayoutMetricsResult, error) {
+	if params == nil {
+		params = new(GetLayoutMetricsParams)
+	}
+	return (&GetLayoutMetricsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetLayoutMetricsResult struct {
@@ -1160,41 +2765,112 @@ type GetLayoutMetricsResult struct {
 }
 
 func (r *GetLayoutMetricsRequest) Do() (*GetLayoutMetricsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetLayoutMetricsRequest) DoContext(ctx context.Context) (*GetLayoutMetricsResult, error) {
 	var result GetLayoutMetricsResult
-	err := r.client.Call("Page.getLayoutMetrics", r.opts, &result)
+	err := r.client.CallContext(ctx, "Page.getLayoutMetrics", r.params, &result)
 	return &result, err
 }
 
+type GetLayoutMetricsFuture struct {
+	*rpc.Call
+	result GetLayoutMetricsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetLayoutMetricsFuture) Get() (*GetLayoutMetricsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetLayoutMetricsRequest) DoAsync(ctx context.Context) *GetLayoutMetricsFuture {
+	f := new(GetLayoutMetricsFuture)
+	f.Call = r.client.Go(ctx, "Page.getLayoutMetrics", r.params, &f.result)
+	return f
+}
+
+// CreateIsolatedWorldParams are the parameters of Page.createIsolatedWorld.
+type CreateIsolatedWorldParams struct {
+	// Id of the frame in which the isolated world should be created.
+	FrameId FrameId `json:"frameId"`
+
+	// An optional name which is reported in the Execution Context. (optional)
+	WorldName *string `json:"worldName,omitempty"`
+
+	// Whether or not universal access should be granted to the isolated world. This is a powerful option, use with caution. (optional)
+	GrantUniveralAccess *bool `json:"grantUniveralAccess,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CreateIsolatedWorldParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.FrameId == "" {
+		missing = append(missing, "frameId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Page.createIsolatedWorld", Missing: missing, Invalid: invalid}
+}
+
 type CreateIsolatedWorldRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CreateIsolatedWorldParams
 }
 
 // Creates an isolated world for the given frame. (experimental)
 func (d *Client) CreateIsolatedWorld() *CreateIsolatedWorldRequest {
-	return &CreateIsolatedWorldRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CreateIsolatedWorldRequest{client: d.Client, params: &CreateIsolatedWorldParams{set: 1}}
+}
+
+// DoCreateIsolatedWorld sends Page.createIsolatedWorld with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCreateIsolatedWorld(ctx context.Context, params *CreateIsolatedWorldParams) error {
+	if params == nil {
+		params = new(CreateIsolatedWorldParams)
+	}
+	return (&CreateIsolatedWorldRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the frame in which the isolated world should be created.
 func (r *CreateIsolatedWorldRequest) FrameId(v FrameId) *CreateIsolatedWorldRequest {
-	r.opts["frameId"] = v
+	r.params.FrameId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // An optional name which is reported in the Execution Context. (optional)
 func (r *CreateIsolatedWorldRequest) WorldName(v string) *CreateIsolatedWorldRequest {
-	r.opts["worldName"] = v
+	r.params.WorldName = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Whether or not universal access should be granted to the isolated world. This is a powerful option, use with caution. (optional)
 func (r *CreateIsolatedWorldRequest) GrantUniveralAccess(v bool) *CreateIsolatedWorldRequest {
-	r.opts["grantUniveralAccess"] = v
+	r.params.GrantUniveralAccess = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *CreateIsolatedWorldRequest) Do() error {
-	return r.client.Call("Page.createIsolatedWorld", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *CreateIsolatedWorldRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Page.createIsolatedWorld", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *CreateIsolatedWorldRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Page.createIsolatedWorld", r.params, nil)
 }
 
 func init() {
@@ -1221,10 +2897,86 @@ type DomContentEventFiredEvent struct {
 	Timestamp float64 `json:"timestamp"`
 }
 
+func (d *Client) OnDomContentEventFired(fn func(*DomContentEventFiredEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.domContentEventFired", func(e interface{}) {
+		fn(e.(*DomContentEventFiredEvent))
+	}, opts...)
+}
+
+// FilterDomContentEventFired is rpc.WithFilter for OnDomContentEventFired.
+func FilterDomContentEventFired(fn func(*DomContentEventFiredEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DomContentEventFiredEvent))
+	})
+}
+
+// DomContentEventFiredWaiter waits for a Page.domContentEventFired event. It is created by WaitForDomContentEventFired.
+type DomContentEventFiredWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DomContentEventFiredWaiter) Wait() (*DomContentEventFiredEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DomContentEventFiredEvent), nil
+}
+
+// WaitForDomContentEventFired arms a waiter for the first Page.domContentEventFired event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDomContentEventFired(ctx context.Context, predicate func(*DomContentEventFiredEvent) bool) *DomContentEventFiredWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DomContentEventFiredEvent))
+		}
+	}
+	return &DomContentEventFiredWaiter{d.Client.WaitFor(ctx, "Page.domContentEventFired", p)}
+}
+
 type LoadEventFiredEvent struct {
 	Timestamp float64 `json:"timestamp"`
 }
 
+func (d *Client) OnLoadEventFired(fn func(*LoadEventFiredEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.loadEventFired", func(e interface{}) {
+		fn(e.(*LoadEventFiredEvent))
+	}, opts...)
+}
+
+// FilterLoadEventFired is rpc.WithFilter for OnLoadEventFired.
+func FilterLoadEventFired(fn func(*LoadEventFiredEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*LoadEventFiredEvent))
+	})
+}
+
+// LoadEventFiredWaiter waits for a Page.loadEventFired event. It is created by WaitForLoadEventFired.
+type LoadEventFiredWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *LoadEventFiredWaiter) Wait() (*LoadEventFiredEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*LoadEventFiredEvent), nil
+}
+
+// WaitForLoadEventFired arms a waiter for the first Page.loadEventFired event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForLoadEventFired(ctx context.Context, predicate func(*LoadEventFiredEvent) bool) *LoadEventFiredWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*LoadEventFiredEvent))
+		}
+	}
+	return &LoadEventFiredWaiter{d.Client.WaitFor(ctx, "Page.loadEventFired", p)}
+}
+
 // Fired when frame has been attached to its parent.
 type FrameAttachedEvent struct {
 	// Id of the frame that has been attached.
@@ -1234,7 +2986,46 @@ type FrameAttachedEvent struct {
 	ParentFrameId FrameId `json:"parentFrameId"`
 
 	// JavaScript stack trace of when frame was attached, only set if frame initiated from script. (optional, experimental)
-	Stack *runtime.StackTrace `json:"stack"`
+	Stack *runtime.StackTrace `json:"stack,omitempty"`
+}
+
+// Fired when frame has been attached to its parent.
+func (d *Client) OnFrameAttached(fn func(*FrameAttachedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameAttached", func(e interface{}) {
+		fn(e.(*FrameAttachedEvent))
+	}, opts...)
+}
+
+// FilterFrameAttached is rpc.WithFilter for OnFrameAttached.
+func FilterFrameAttached(fn func(*FrameAttachedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameAttachedEvent))
+	})
+}
+
+// FrameAttachedWaiter waits for a Page.frameAttached event. It is created by WaitForFrameAttached.
+type FrameAttachedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameAttachedWaiter) Wait() (*FrameAttachedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameAttachedEvent), nil
+}
+
+// WaitForFrameAttached arms a waiter for the first Page.frameAttached event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameAttached(ctx context.Context, predicate func(*FrameAttachedEvent) bool) *FrameAttachedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameAttachedEvent))
+		}
+	}
+	return &FrameAttachedWaiter{d.Client.WaitFor(ctx, "Page.frameAttached", p)}
 }
 
 // Fired once navigation of the frame has completed. Frame is now associated with the new loader.
@@ -1243,24 +3034,180 @@ type FrameNavigatedEvent struct {
 	Frame *Frame `json:"frame"`
 }
 
+// Fired once navigation of the frame has completed. Frame is now associated with the new loader.
+func (d *Client) OnFrameNavigated(fn func(*FrameNavigatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameNavigated", func(e interface{}) {
+		fn(e.(*FrameNavigatedEvent))
+	}, opts...)
+}
+
+// FilterFrameNavigated is rpc.WithFilter for OnFrameNavigated.
+func FilterFrameNavigated(fn func(*FrameNavigatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameNavigatedEvent))
+	})
+}
+
+// FrameNavigatedWaiter waits for a Page.frameNavigated event. It is created by WaitForFrameNavigated.
+type FrameNavigatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameNavigatedWaiter) Wait() (*FrameNavigatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameNavigatedEvent), nil
+}
+
+// WaitForFrameNavigated arms a waiter for the first Page.frameNavigated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameNavigated(ctx context.Context, predicate func(*FrameNavigatedEvent) bool) *FrameNavigatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameNavigatedEvent))
+		}
+	}
+	return &FrameNavigatedWaiter{d.Client.WaitFor(ctx, "Page.frameNavigated", p)}
+}
+
 // Fired when frame has been detached from its parent.
 type FrameDetachedEvent struct {
 	// Id of the frame that has been detached.
 	FrameId FrameId `json:"frameId"`
 }
 
+// Fired when frame has been detached from its parent.
+func (d *Client) OnFrameDetached(fn func(*FrameDetachedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameDetached", func(e interface{}) {
+		fn(e.(*FrameDetachedEvent))
+	}, opts...)
+}
+
+// FilterFrameDetached is rpc.WithFilter for OnFrameDetached.
+func FilterFrameDetached(fn func(*FrameDetachedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameDetachedEvent))
+	})
+}
+
+// FrameDetachedWaiter waits for a Page.frameDetached event. It is created by WaitForFrameDetached.
+type FrameDetachedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameDetachedWaiter) Wait() (*FrameDetachedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameDetachedEvent), nil
+}
+
+// WaitForFrameDetached arms a waiter for the first Page.frameDetached event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameDetached(ctx context.Context, predicate func(*FrameDetachedEvent) bool) *FrameDetachedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameDetachedEvent))
+		}
+	}
+	return &FrameDetachedWaiter{d.Client.WaitFor(ctx, "Page.frameDetached", p)}
+}
+
 // Fired when frame has started loading. (experimental)
 type FrameStartedLoadingEvent struct {
 	// Id of the frame that has started loading.
 	FrameId FrameId `json:"frameId"`
 }
 
+// Fired when frame has started loading. (experimental)
+func (d *Client) OnFrameStartedLoading(fn func(*FrameStartedLoadingEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameStartedLoading", func(e interface{}) {
+		fn(e.(*FrameStartedLoadingEvent))
+	}, opts...)
+}
+
+// FilterFrameStartedLoading is rpc.WithFilter for OnFrameStartedLoading.
+func FilterFrameStartedLoading(fn func(*FrameStartedLoadingEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameStartedLoadingEvent))
+	})
+}
+
+// FrameStartedLoadingWaiter waits for a Page.frameStartedLoading event. It is created by WaitForFrameStartedLoading.
+type FrameStartedLoadingWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameStartedLoadingWaiter) Wait() (*FrameStartedLoadingEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameStartedLoadingEvent), nil
+}
+
+// WaitForFrameStartedLoading arms a waiter for the first Page.frameStartedLoading event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameStartedLoading(ctx context.Context, predicate func(*FrameStartedLoadingEvent) bool) *FrameStartedLoadingWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameStartedLoadingEvent))
+		}
+	}
+	return &FrameStartedLoadingWaiter{d.Client.WaitFor(ctx, "Page.frameStartedLoading", p)}
+}
+
 // Fired when frame has stopped loading. (experimental)
 type FrameStoppedLoadingEvent struct {
 	// Id of the frame that has stopped loading.
 	FrameId FrameId `json:"frameId"`
 }
 
+// Fired when frame has stopped loading. (experimental)
+func (d *Client) OnFrameStoppedLoading(fn func(*FrameStoppedLoadingEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameStoppedLoading", func(e interface{}) {
+		fn(e.(*FrameStoppedLoadingEvent))
+	}, opts...)
+}
+
+// FilterFrameStoppedLoading is rpc.WithFilter for OnFrameStoppedLoading.
+func FilterFrameStoppedLoading(fn func(*FrameStoppedLoadingEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameStoppedLoadingEvent))
+	})
+}
+
+// FrameStoppedLoadingWaiter waits for a Page.frameStoppedLoading event. It is created by WaitForFrameStoppedLoading.
+type FrameStoppedLoadingWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameStoppedLoadingWaiter) Wait() (*FrameStoppedLoadingEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameStoppedLoadingEvent), nil
+}
+
+// WaitForFrameStoppedLoading arms a waiter for the first Page.frameStoppedLoading event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameStoppedLoading(ctx context.Context, predicate func(*FrameStoppedLoadingEvent) bool) *FrameStoppedLoadingWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameStoppedLoadingEvent))
+		}
+	}
+	return &FrameStoppedLoadingWaiter{d.Client.WaitFor(ctx, "Page.frameStoppedLoading", p)}
+}
+
 // Fired when frame schedules a potential navigation. (experimental)
 type FrameScheduledNavigationEvent struct {
 	// Id of the frame that has scheduled a navigation.
@@ -1270,16 +3217,133 @@ type FrameScheduledNavigationEvent struct {
 	Delay float64 `json:"delay"`
 }
 
+// Fired when frame schedules a potential navigation. (experimental)
+func (d *Client) OnFrameScheduledNavigation(fn func(*FrameScheduledNavigationEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameScheduledNavigation", func(e interface{}) {
+		fn(e.(*FrameScheduledNavigationEvent))
+	}, opts...)
+}
+
+// FilterFrameScheduledNavigation is rpc.WithFilter for OnFrameScheduledNavigation.
+func FilterFrameScheduledNavigation(fn func(*FrameScheduledNavigationEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameScheduledNavigationEvent))
+	})
+}
+
+// FrameScheduledNavigationWaiter waits for a Page.frameScheduledNavigation event. It is created by WaitForFrameScheduledNavigation.
+type FrameScheduledNavigationWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameScheduledNavigationWaiter) Wait() (*FrameScheduledNavigationEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameScheduledNavigationEvent), nil
+}
+
+// WaitForFrameScheduledNavigation arms a waiter for the first Page.frameScheduledNavigation event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameScheduledNavigation(ctx context.Context, predicate func(*FrameScheduledNavigationEvent) bool) *FrameScheduledNavigationWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameScheduledNavigationEvent))
+		}
+	}
+	return &FrameScheduledNavigationWaiter{d.Client.WaitFor(ctx, "Page.frameScheduledNavigation", p)}
+}
+
 // Fired when frame no longer has a scheduled navigation. (experimental)
 type FrameClearedScheduledNavigationEvent struct {
 	// Id of the frame that has cleared its scheduled navigation.
 	FrameId FrameId `json:"frameId"`
 }
 
+// Fired when frame no longer has a scheduled navigation. (experimental)
+func (d *Client) OnFrameClearedScheduledNavigation(fn func(*FrameClearedScheduledNavigationEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameClearedScheduledNavigation", func(e interface{}) {
+		fn(e.(*FrameClearedScheduledNavigationEvent))
+	}, opts...)
+}
+
+// FilterFrameClearedScheduledNavigation is rpc.WithFilter for OnFrameClearedScheduledNavigation.
+func FilterFrameClearedScheduledNavigation(fn func(*FrameClearedScheduledNavigationEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameClearedScheduledNavigationEvent))
+	})
+}
+
+// FrameClearedScheduledNavigationWaiter waits for a Page.frameClearedScheduledNavigation event. It is created by WaitForFrameClearedScheduledNavigation.
+type FrameClearedScheduledNavigationWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameClearedScheduledNavigationWaiter) Wait() (*FrameClearedScheduledNavigationEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameClearedScheduledNavigationEvent), nil
+}
+
+// WaitForFrameClearedScheduledNavigation arms a waiter for the first Page.frameClearedScheduledNavigation event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameClearedScheduledNavigation(ctx context.Context, predicate func(*FrameClearedScheduledNavigationEvent) bool) *FrameClearedScheduledNavigationWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameClearedScheduledNavigationEvent))
+		}
+	}
+	return &FrameClearedScheduledNavigationWaiter{d.Client.WaitFor(ctx, "Page.frameClearedScheduledNavigation", p)}
+}
+
 // (experimental)
 type FrameResizedEvent struct {
 }
 
+// (experimental)
+func (d *Client) OnFrameResized(fn func(*FrameResizedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.frameResized", func(e interface{}) {
+		fn(e.(*FrameResizedEvent))
+	}, opts...)
+}
+
+// FilterFrameResized is rpc.WithFilter for OnFrameResized.
+func FilterFrameResized(fn func(*FrameResizedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*FrameResizedEvent))
+	})
+}
+
+// FrameResizedWaiter waits for a Page.frameResized event. It is created by WaitForFrameResized.
+type FrameResizedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *FrameResizedWaiter) Wait() (*FrameResizedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*FrameResizedEvent), nil
+}
+
+// WaitForFrameResized arms a waiter for the first Page.frameResized event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForFrameResized(ctx context.Context, predicate func(*FrameResizedEvent) bool) *FrameResizedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*FrameResizedEvent))
+		}
+	}
+	return &FrameResizedWaiter{d.Client.WaitFor(ctx, "Page.frameResized", p)}
+}
+
 // Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to open.
 type JavascriptDialogOpeningEvent struct {
 	// Message that will be displayed by the dialog.
@@ -1289,12 +3353,90 @@ type JavascriptDialogOpeningEvent struct {
 	Type DialogType `json:"type"`
 }
 
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) is about to open.
+func (d *Client) OnJavascriptDialogOpening(fn func(*JavascriptDialogOpeningEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.javascriptDialogOpening", func(e interface{}) {
+		fn(e.(*JavascriptDialogOpeningEvent))
+	}, opts...)
+}
+
+// FilterJavascriptDialogOpening is rpc.WithFilter for OnJavascriptDialogOpening.
+func FilterJavascriptDialogOpening(fn func(*JavascriptDialogOpeningEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*JavascriptDialogOpeningEvent))
+	})
+}
+
+// JavascriptDialogOpeningWaiter waits for a Page.javascriptDialogOpening event. It is created by WaitForJavascriptDialogOpening.
+type JavascriptDialogOpeningWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *JavascriptDialogOpeningWaiter) Wait() (*JavascriptDialogOpeningEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*JavascriptDialogOpeningEvent), nil
+}
+
+// WaitForJavascriptDialogOpening arms a waiter for the first Page.javascriptDialogOpening event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForJavascriptDialogOpening(ctx context.Context, predicate func(*JavascriptDialogOpeningEvent) bool) *JavascriptDialogOpeningWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*JavascriptDialogOpeningEvent))
+		}
+	}
+	return &JavascriptDialogOpeningWaiter{d.Client.WaitFor(ctx, "Page.javascriptDialogOpening", p)}
+}
+
 // Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been closed.
 type JavascriptDialogClosedEvent struct {
 	// Whether dialog was confirmed.
 	Result bool `json:"result"`
 }
 
+// Fired when a JavaScript initiated dialog (alert, confirm, prompt, or onbeforeunload) has been closed.
+func (d *Client) OnJavascriptDialogClosed(fn func(*JavascriptDialogClosedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.javascriptDialogClosed", func(e interface{}) {
+		fn(e.(*JavascriptDialogClosedEvent))
+	}, opts...)
+}
+
+// FilterJavascriptDialogClosed is rpc.WithFilter for OnJavascriptDialogClosed.
+func FilterJavascriptDialogClosed(fn func(*JavascriptDialogClosedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*JavascriptDialogClosedEvent))
+	})
+}
+
+// JavascriptDialogClosedWaiter waits for a Page.javascriptDialogClosed event. It is created by WaitForJavascriptDialogClosed.
+type JavascriptDialogClosedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *JavascriptDialogClosedWaiter) Wait() (*JavascriptDialogClosedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*JavascriptDialogClosedEvent), nil
+}
+
+// WaitForJavascriptDialogClosed arms a waiter for the first Page.javascriptDialogClosed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForJavascriptDialogClosed(ctx context.Context, predicate func(*JavascriptDialogClosedEvent) bool) *JavascriptDialogClosedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*JavascriptDialogClosedEvent))
+		}
+	}
+	return &JavascriptDialogClosedWaiter{d.Client.WaitFor(ctx, "Page.javascriptDialogClosed", p)}
+}
+
 // Compressed image data requested by the <code>startScreencast</code>. (experimental)
 type ScreencastFrameEvent struct {
 	// Base64-encoded compressed image.
@@ -1307,20 +3449,176 @@ type ScreencastFrameEvent struct {
 	SessionId int `json:"sessionId"`
 }
 
+// Compressed image data requested by the <code>startScreencast</code>. (experimental)
+func (d *Client) OnScreencastFrame(fn func(*ScreencastFrameEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.screencastFrame", func(e interface{}) {
+		fn(e.(*ScreencastFrameEvent))
+	}, opts...)
+}
+
+// FilterScreencastFrame is rpc.WithFilter for OnScreencastFrame.
+func FilterScreencastFrame(fn func(*ScreencastFrameEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ScreencastFrameEvent))
+	})
+}
+
+// ScreencastFrameWaiter waits for a Page.screencastFrame event. It is created by WaitForScreencastFrame.
+type ScreencastFrameWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ScreencastFrameWaiter) Wait() (*ScreencastFrameEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ScreencastFrameEvent), nil
+}
+
+// WaitForScreencastFrame arms a waiter for the first Page.screencastFrame event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForScreencastFrame(ctx context.Context, predicate func(*ScreencastFrameEvent) bool) *ScreencastFrameWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ScreencastFrameEvent))
+		}
+	}
+	return &ScreencastFrameWaiter{d.Client.WaitFor(ctx, "Page.screencastFrame", p)}
+}
+
 // Fired when the page with currently enabled screencast was shown or hidden </code>. (experimental)
 type ScreencastVisibilityChangedEvent struct {
 	// True if the page is visible.
 	Visible bool `json:"visible"`
 }
 
+// Fired when the page with currently enabled screencast was shown or hidden </code>. (experimental)
+func (d *Client) OnScreencastVisibilityChanged(fn func(*ScreencastVisibilityChangedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.screencastVisibilityChanged", func(e interface{}) {
+		fn(e.(*ScreencastVisibilityChangedEvent))
+	}, opts...)
+}
+
+// FilterScreencastVisibilityChanged is rpc.WithFilter for OnScreencastVisibilityChanged.
+func FilterScreencastVisibilityChanged(fn func(*ScreencastVisibilityChangedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ScreencastVisibilityChangedEvent))
+	})
+}
+
+// ScreencastVisibilityChangedWaiter waits for a Page.screencastVisibilityChanged event. It is created by WaitForScreencastVisibilityChanged.
+type ScreencastVisibilityChangedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ScreencastVisibilityChangedWaiter) Wait() (*ScreencastVisibilityChangedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ScreencastVisibilityChangedEvent), nil
+}
+
+// WaitForScreencastVisibilityChanged arms a waiter for the first Page.screencastVisibilityChanged event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForScreencastVisibilityChanged(ctx context.Context, predicate func(*ScreencastVisibilityChangedEvent) bool) *ScreencastVisibilityChangedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ScreencastVisibilityChangedEvent))
+		}
+	}
+	return &ScreencastVisibilityChangedWaiter{d.Client.WaitFor(ctx, "Page.screencastVisibilityChanged", p)}
+}
+
 // Fired when interstitial page was shown
 type InterstitialShownEvent struct {
 }
 
+// Fired when interstitial page was shown
+func (d *Client) OnInterstitialShown(fn func(*InterstitialShownEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.interstitialShown", func(e interface{}) {
+		fn(e.(*InterstitialShownEvent))
+	}, opts...)
+}
+
+// FilterInterstitialShown is rpc.WithFilter for OnInterstitialShown.
+func FilterInterstitialShown(fn func(*InterstitialShownEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*InterstitialShownEvent))
+	})
+}
+
+// InterstitialShownWaiter waits for a Page.interstitialShown event. It is created by WaitForInterstitialShown.
+type InterstitialShownWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *InterstitialShownWaiter) Wait() (*InterstitialShownEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*InterstitialShownEvent), nil
+}
+
+// WaitForInterstitialShown arms a waiter for the first Page.interstitialShown event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForInterstitialShown(ctx context.Context, predicate func(*InterstitialShownEvent) bool) *InterstitialShownWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*InterstitialShownEvent))
+		}
+	}
+	return &InterstitialShownWaiter{d.Client.WaitFor(ctx, "Page.interstitialShown", p)}
+}
+
 // Fired when interstitial page was hidden
 type InterstitialHiddenEvent struct {
 }
 
+// Fired when interstitial page was hidden
+func (d *Client) OnInterstitialHidden(fn func(*InterstitialHiddenEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.interstitialHidden", func(e interface{}) {
+		fn(e.(*InterstitialHiddenEvent))
+	}, opts...)
+}
+
+// FilterInterstitialHidden is rpc.WithFilter for OnInterstitialHidden.
+func FilterInterstitialHidden(fn func(*InterstitialHiddenEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*InterstitialHiddenEvent))
+	})
+}
+
+// InterstitialHiddenWaiter waits for a Page.interstitialHidden event. It is created by WaitForInterstitialHidden.
+type InterstitialHiddenWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *InterstitialHiddenWaiter) Wait() (*InterstitialHiddenEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*InterstitialHiddenEvent), nil
+}
+
+// WaitForInterstitialHidden arms a waiter for the first Page.interstitialHidden event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForInterstitialHidden(ctx context.Context, predicate func(*InterstitialHiddenEvent) bool) *InterstitialHiddenWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*InterstitialHiddenEvent))
+		}
+	}
+	return &InterstitialHiddenWaiter{d.Client.WaitFor(ctx, "Page.interstitialHidden", p)}
+}
+
 // Fired when a navigation is started if navigation throttles are enabled.  The navigation will be deferred until processNavigation is called.
 type NavigationRequestedEvent struct {
 	// Whether the navigation is taking place in the main frame or in a subframe.
@@ -1334,3 +3632,42 @@ type NavigationRequestedEvent struct {
 	// URL of requested navigation.
 	URL string `json:"url"`
 }
+
+// Fired when a navigation is started if navigation throttles are enabled.  The navigation will be deferred until processNavigation is called.
+func (d *Client) OnNavigationRequested(fn func(*NavigationRequestedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Page.navigationRequested", func(e interface{}) {
+		fn(e.(*NavigationRequestedEvent))
+	}, opts...)
+}
+
+// FilterNavigationRequested is rpc.WithFilter for OnNavigationRequested.
+func FilterNavigationRequested(fn func(*NavigationRequestedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*NavigationRequestedEvent))
+	})
+}
+
+// NavigationRequestedWaiter waits for a Page.navigationRequested event. It is created by WaitForNavigationRequested.
+type NavigationRequestedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *NavigationRequestedWaiter) Wait() (*NavigationRequestedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*NavigationRequestedEvent), nil
+}
+
+// WaitForNavigationRequested arms a waiter for the first Page.navigationRequested event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForNavigationRequested(ctx context.Context, predicate func(*NavigationRequestedEvent) bool) *NavigationRequestedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*NavigationRequestedEvent))
+		}
+	}
+	return &NavigationRequestedWaiter{d.Client.WaitFor(ctx, "Page.navigationRequested", p)}
+}
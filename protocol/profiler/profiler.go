@@ -1,6 +1,8 @@
 package profiler
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/debugger"
@@ -21,13 +23,13 @@ type ProfileNode struct {
 	CallFrame *runtime.CallFrame `json:"callFrame"`
 
 	// Number of samples where this node was on top of the call stack. (optional, experimental)
-	HitCount int `json:"hitCount,omitempty"`
+	HitCount *int `json:"hitCount,omitempty"`
 
 	// Child node ids. (optional)
 	Children []int `json:"children,omitempty"`
 
 	// The reason of being not optimized. The function may be deoptimized or marked as don't optimize. (optional)
-	DeoptReason string `json:"deoptReason,omitempty"`
+	DeoptReason *string `json:"deoptReason,omitempty"`
 
 	// An array of source position ticks. (optional, experimental)
 	PositionTicks []*PositionTickInfo `json:"positionTicks,omitempty"`
@@ -98,72 +100,190 @@ type ScriptCoverage struct {
 	Functions []*FunctionCoverage `json:"functions"`
 }
 
+// EnableParams are the parameters of Profiler.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Profiler.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Profiler.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Profiler", r.params)
+}
+
+// DisableParams are the parameters of Profiler.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Profiler.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Profiler.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Profiler", r.params)
+}
+
+// SetSamplingIntervalParams are the parameters of Profiler.setSamplingInterval.
+type SetSamplingIntervalParams struct {
+	// New sampling interval in microseconds.
+	Interval int `json:"interval"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetSamplingIntervalParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "interval")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Profiler.setSamplingInterval", Missing: missing, Invalid: invalid}
 }
 
 type SetSamplingIntervalRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetSamplingIntervalParams
 }
 
 // Changes CPU profiler sampling interval. Must be called before CPU profiles recording started.
 func (d *Client) SetSamplingInterval() *SetSamplingIntervalRequest {
-	return &SetSamplingIntervalRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetSamplingIntervalRequest{client: d.Client, params: &SetSamplingIntervalParams{set: 1}}
+}
+
+// DoSetSamplingInterval sends Profiler.setSamplingInterval with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetSamplingInterval(ctx context.Context, params *SetSamplingIntervalParams) error {
+	if params == nil {
+		params = new(SetSamplingIntervalParams)
+	}
+	return (&SetSamplingIntervalRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // New sampling interval in microseconds.
 func (r *SetSamplingIntervalRequest) Interval(v int) *SetSamplingIntervalRequest {
-	r.opts["interval"] = v
+	r.params.Interval = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetSamplingIntervalRequest) Do() error {
-	return r.client.Call("Profiler.setSamplingInterval", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetSamplingIntervalRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Profiler.setSamplingInterval", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetSamplingIntervalRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Profiler.setSamplingInterval", r.params, nil)
+}
+
+// StartParams are the parameters of Profiler.start.
+type StartParams struct {
 }
 
 type StartRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartParams
 }
 
 func (d *Client) Start() *StartRequest {
-	return &StartRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartRequest{client: d.Client, params: &StartParams{}}
+}
+
+// DoStart sends Profiler.start with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStart(ctx context.Context, params *StartParams) error {
+	if params == nil {
+		params = new(StartParams)
+	}
+	return (&StartRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StartRequest) Do() error {
-	return r.client.Call("Profiler.start", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Profiler.start", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Profiler.start", r.params, nil)
+}
+
+// StopParams are the parameters of Profiler.stop.
+type StopParams struct {
 }
 
 type StopRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopParams
 }
 
 func (d *Client) Stop() *StopRequest {
-	return &StopRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopRequest{client: d.Client, params: &StopParams{}}
+}
+
+// DoStop sends Profiler.stop with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStop(ctx context.Context, params *StopParams) (*StopResult, error) {
+	if params == nil {
+		params = new(StopParams)
+	}
+	return (&StopRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type StopResult struct {
@@ -172,53 +292,146 @@ type StopResult struct {
 }
 
 func (r *StopRequest) Do() (*StopResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *StopRequest) DoContext(ctx context.Context) (*StopResult, error) {
 	var result StopResult
-	err := r.client.Call("Profiler.stop", r.opts, &result)
+	err := r.client.CallContext(ctx, "Profiler.stop", r.params, &result)
 	return &result, err
 }
 
+type StopFuture struct {
+	*rpc.Call
+	result StopResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *StopFuture) Get() (*StopResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *StopRequest) DoAsync(ctx context.Context) *StopFuture {
+	f := new(StopFuture)
+	f.Call = r.client.Go(ctx, "Profiler.stop", r.params, &f.result)
+	return f
+}
+
+// StartPreciseCoverageParams are the parameters of Profiler.startPreciseCoverage.
+type StartPreciseCoverageParams struct {
+	// Collect accurate call counts beyond simple 'covered' or 'not covered'. (optional)
+	CallCount *bool `json:"callCount,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StartPreciseCoverageParams) Validate() error {
+	var missing, invalid []string
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Profiler.startPreciseCoverage", Missing: missing, Invalid: invalid}
+}
+
 type StartPreciseCoverageRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartPreciseCoverageParams
 }
 
 // Enable precise code coverage. Coverage data for JavaScript executed before enabling precise code coverage may be incomplete. Enabling prevents running optimized code and resets execution counters. (experimental)
 func (d *Client) StartPreciseCoverage() *StartPreciseCoverageRequest {
-	return &StartPreciseCoverageRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartPreciseCoverageRequest{client: d.Client, params: &StartPreciseCoverageParams{set: 1}}
+}
+
+// DoStartPreciseCoverage sends Profiler.startPreciseCoverage with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStartPreciseCoverage(ctx context.Context, params *StartPreciseCoverageParams) error {
+	if params == nil {
+		params = new(StartPreciseCoverageParams)
+	}
+	return (&StartPreciseCoverageRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Collect accurate call counts beyond simple 'covered' or 'not covered'. (optional)
 func (r *StartPreciseCoverageRequest) CallCount(v bool) *StartPreciseCoverageRequest {
-	r.opts["callCount"] = v
+	r.params.CallCount = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *StartPreciseCoverageRequest) Do() error {
-	return r.client.Call("Profiler.startPreciseCoverage", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartPreciseCoverageRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Profiler.startPreciseCoverage", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartPreciseCoverageRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Profiler.startPreciseCoverage", r.params, nil)
+}
+
+// StopPreciseCoverageParams are the parameters of Profiler.stopPreciseCoverage.
+type StopPreciseCoverageParams struct {
 }
 
 type StopPreciseCoverageRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopPreciseCoverageParams
 }
 
 // Disable precise code coverage. Disabling releases unnecessary execution count records and allows executing optimized code. (experimental)
 func (d *Client) StopPreciseCoverage() *StopPreciseCoverageRequest {
-	return &StopPreciseCoverageRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopPreciseCoverageRequest{client: d.Client, params: &StopPreciseCoverageParams{}}
+}
+
+// DoStopPreciseCoverage sends Profiler.stopPreciseCoverage with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopPreciseCoverage(ctx context.Context, params *StopPreciseCoverageParams) error {
+	if params == nil {
+		params = new(StopPreciseCoverageParams)
+	}
+	return (&StopPreciseCoverageRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StopPreciseCoverageRequest) Do() error {
-	return r.client.Call("Profiler.stopPreciseCoverage", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StopPreciseCoverageRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Profiler.stopPreciseCoverage", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StopPreciseCoverageRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Profiler.stopPreciseCoverage", r.params, nil)
+}
+
+// TakePreciseCoverageParams are the parameters of Profiler.takePreciseCoverage.
+type TakePreciseCoverageParams struct {
 }
 
 type TakePreciseCoverageRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *TakePreciseCoverageParams
 }
 
 // Collect coverage data for the current isolate, and resets execution counters. Precise code coverage needs to have started. (experimental)
 func (d *Client) TakePreciseCoverage() *TakePreciseCoverageRequest {
-	return &TakePreciseCoverageRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &TakePreciseCoverageRequest{client: d.Client, params: &TakePreciseCoverageParams{}}
+}
+
+// DoTakePreciseCoverage sends Profiler.takePreciseCoverage with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoTakePreciseCoverage(ctx context.Context, params *TakePreciseCoverageParams) (*TakePreciseCoverageResult, error) {
+	if params == nil {
+		params = new(TakePreciseCoverageParams)
+	}
+	return (&TakePreciseCoverageRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type TakePreciseCoverageResult struct {
@@ -227,19 +440,53 @@ type TakePreciseCoverageResult struct {
 }
 
 func (r *TakePreciseCoverageRequest) Do() (*TakePreciseCoverageResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *TakePreciseCoverageRequest) DoContext(ctx context.Context) (*TakePreciseCoverageResult, error) {
 	var result TakePreciseCoverageResult
-	err := r.client.Call("Profiler.takePreciseCoverage", r.opts, &result)
+	err := r.client.CallContext(ctx, "Profiler.takePreciseCoverage", r.params, &result)
 	return &result, err
 }
 
+type TakePreciseCoverageFuture struct {
+	*rpc.Call
+	result TakePreciseCoverageResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *TakePreciseCoverageFuture) Get() (*TakePreciseCoverageResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *TakePreciseCoverageRequest) DoAsync(ctx context.Context) *TakePreciseCoverageFuture {
+	f := new(TakePreciseCoverageFuture)
+	f.Call = r.client.Go(ctx, "Profiler.takePreciseCoverage", r.params, &f.result)
+	return f
+}
+
+// GetBestEffortCoverageParams are the parameters of Profiler.getBestEffortCoverage.
+type GetBestEffortCoverageParams struct {
+}
+
 type GetBestEffortCoverageRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetBestEffortCoverageParams
 }
 
 // Collect coverage data for the current isolate. The coverage data may be incomplete due to garbage collection. (experimental)
 func (d *Client) GetBestEffortCoverage() *GetBestEffortCoverageRequest {
-	return &GetBestEffortCoverageRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetBestEffortCoverageRequest{client: d.Client, params: &GetBestEffortCoverageParams{}}
+}
+
+// DoGetBestEffortCoverage sends Profiler.getBestEffortCoverage with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetBestEffortCoverage(ctx context.Context, params *GetBestEffortCoverageParams) (*GetBestEffortCoverageResult, error) {
+	if params == nil {
+		params = new(GetBestEffortCoverageParams)
+	}
+	return (&GetBestEffortCoverageRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetBestEffortCoverageResult struct {
@@ -248,11 +495,33 @@ type GetBestEffortCoverageResult struct {
 }
 
 func (r *GetBestEffortCoverageRequest) Do() (*GetBestEffortCoverageResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetBestEffortCoverageRequest) DoContext(ctx context.Context) (*GetBestEffortCoverageResult, error) {
 	var result GetBestEffortCoverageResult
-	err := r.client.Call("Profiler.getBestEffortCoverage", r.opts, &result)
+	err := r.client.CallContext(ctx, "Profiler.getBestEffortCoverage", r.params, &result)
 	return &result, err
 }
 
+type GetBestEffortCoverageFuture struct {
+	*rpc.Call
+	result GetBestEffortCoverageResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetBestEffortCoverageFuture) Get() (*GetBestEffortCoverageResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetBestEffortCoverageRequest) DoAsync(ctx context.Context) *GetBestEffortCoverageFuture {
+	f := new(GetBestEffortCoverageFuture)
+	f.Call = r.client.Go(ctx, "Profiler.getBestEffortCoverage", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["Profiler.consoleProfileStarted"] = func() interface{} { return new(ConsoleProfileStartedEvent) }
 	rpc.EventTypes["Profiler.consoleProfileFinished"] = func() interface{} { return new(ConsoleProfileFinishedEvent) }
@@ -266,7 +535,46 @@ type ConsoleProfileStartedEvent struct {
 	Location *debugger.Location `json:"location"`
 
 	// Profile title passed as an argument to console.profile(). (optional)
-	Title string `json:"title"`
+	Title *string `json:"title,omitempty"`
+}
+
+// Sent when new profile recording is started using console.profile() call.
+func (d *Client) OnConsoleProfileStarted(fn func(*ConsoleProfileStartedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Profiler.consoleProfileStarted", func(e interface{}) {
+		fn(e.(*ConsoleProfileStartedEvent))
+	}, opts...)
+}
+
+// FilterConsoleProfileStarted is rpc.WithFilter for OnConsoleProfileStarted.
+func FilterConsoleProfileStarted(fn func(*ConsoleProfileStartedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ConsoleProfileStartedEvent))
+	})
+}
+
+// ConsoleProfileStartedWaiter waits for a Profiler.consoleProfileStarted event. It is created by WaitForConsoleProfileStarted.
+type ConsoleProfileStartedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ConsoleProfileStartedWaiter) Wait() (*ConsoleProfileStartedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ConsoleProfileStartedEvent), nil
+}
+
+// WaitForConsoleProfileStarted arms a waiter for the first Profiler.consoleProfileStarted event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForConsoleProfileStarted(ctx context.Context, predicate func(*ConsoleProfileStartedEvent) bool) *ConsoleProfileStartedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ConsoleProfileStartedEvent))
+		}
+	}
+	return &ConsoleProfileStartedWaiter{d.Client.WaitFor(ctx, "Profiler.consoleProfileStarted", p)}
 }
 
 type ConsoleProfileFinishedEvent struct {
@@ -278,5 +586,43 @@ type ConsoleProfileFinishedEvent struct {
 	Profile *Profile `json:"profile"`
 
 	// Profile title passed as an argument to console.profile(). (optional)
-	Title string `json:"title"`
+	Title *string `json:"title,omitempty"`
+}
+
+func (d *Client) OnConsoleProfileFinished(fn func(*ConsoleProfileFinishedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Profiler.consoleProfileFinished", func(e interface{}) {
+		fn(e.(*ConsoleProfileFinishedEvent))
+	}, opts...)
+}
+
+// FilterConsoleProfileFinished is rpc.WithFilter for OnConsoleProfileFinished.
+func FilterConsoleProfileFinished(fn func(*ConsoleProfileFinishedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ConsoleProfileFinishedEvent))
+	})
+}
+
+// ConsoleProfileFinishedWaiter waits for a Profiler.consoleProfileFinished event. It is created by WaitForConsoleProfileFinished.
+type ConsoleProfileFinishedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ConsoleProfileFinishedWaiter) Wait() (*ConsoleProfileFinishedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ConsoleProfileFinishedEvent), nil
+}
+
+// WaitForConsoleProfileFinished arms a waiter for the first Profiler.consoleProfileFinished event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForConsoleProfileFinished(ctx context.Context, predicate func(*ConsoleProfileFinishedEvent) bool) *ConsoleProfileFinishedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ConsoleProfileFinishedEvent))
+		}
+	}
+	return &ConsoleProfileFinishedWaiter{d.Client.WaitFor(ctx, "Profiler.consoleProfileFinished", p)}
 }
@@ -2,6 +2,9 @@
 package runtime
 
 import (
+	"context"
+	"encoding/json"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -26,25 +29,25 @@ type UnserializableValue string
 
 type RemoteObject struct {
 	// Object type.
-	Type string `json:"type"`
+	Type RemoteObjectType `json:"type"`
 
 	// Object subtype hint. Specified for <code>object</code> type values only. (optional)
-	Subtype string `json:"subtype,omitempty"`
+	Subtype *RemoteObjectSubtype `json:"subtype,omitempty"`
 
 	// Object class (constructor) name. Specified for <code>object</code> type values only. (optional)
-	ClassName string `json:"className,omitempty"`
+	ClassName *string `json:"className,omitempty"`
 
 	// Remote object value in case of primitive values or JSON values (if it was requested). (optional)
-	Value interface{} `json:"value,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
 
 	// Primitive value which can not be JSON-stringified does not have <code>value</code>, but gets this property. (optional)
-	UnserializableValue UnserializableValue `json:"unserializableValue,omitempty"`
+	UnserializableValue *UnserializableValue `json:"unserializableValue,omitempty"`
 
 	// String representation of the object. (optional)
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty"`
 
 	// Unique object identifier (for non-primitive values). (optional)
-	ObjectId RemoteObjectId `json:"objectId,omitempty"`
+	ObjectId *RemoteObjectId `json:"objectId,omitempty"`
 
 	// Preview containing abbreviated property values. Specified for <code>object</code> type values only. (optional, experimental)
 	Preview *ObjectPreview `json:"preview,omitempty"`
@@ -65,20 +68,20 @@ type CustomPreview struct {
 	BindRemoteObjectFunctionId RemoteObjectId `json:"bindRemoteObjectFunctionId"`
 
 	// (optional)
-	ConfigObjectId RemoteObjectId `json:"configObjectId,omitempty"`
+	ConfigObjectId *RemoteObjectId `json:"configObjectId,omitempty"`
 }
 
 // Object containing abbreviated remote object value. (experimental)
 
 type ObjectPreview struct {
 	// Object type.
-	Type string `json:"type"`
+	Type ObjectPreviewType `json:"type"`
 
 	// Object subtype hint. Specified for <code>object</code> type values only. (optional)
-	Subtype string `json:"subtype,omitempty"`
+	Subtype *ObjectPreviewSubtype `json:"subtype,omitempty"`
 
 	// String representation of the object. (optional)
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty"`
 
 	// True iff some of the properties or entries of the original object did not fit.
 	Overflow bool `json:"overflow"`
@@ -97,16 +100,16 @@ type PropertyPreview struct {
 	Name string `json:"name"`
 
 	// Object type. Accessor means that the property itself is an accessor property.
-	Type string `json:"type"`
+	Type PropertyPreviewType `json:"type"`
 
 	// User-friendly property value string. (optional)
-	Value string `json:"value,omitempty"`
+	Value *string `json:"value,omitempty"`
 
 	// Nested value preview. (optional)
 	ValuePreview *ObjectPreview `json:"valuePreview,omitempty"`
 
 	// Object subtype hint. Specified for <code>object</code> type values only. (optional)
-	Subtype string `json:"subtype,omitempty"`
+	Subtype *PropertyPreviewSubtype `json:"subtype,omitempty"`
 }
 
 // (experimental)
@@ -129,7 +132,7 @@ type PropertyDescriptor struct {
 	Value *RemoteObject `json:"value,omitempty"`
 
 	// True if the value associated with the property may be changed (data descriptors only). (optional)
-	Writable bool `json:"writable,omitempty"`
+	Writable *bool `json:"writable,omitempty"`
 
 	// A function which serves as a getter for the property, or <code>undefined</code> if there is no getter (accessor descriptors only). (optional)
 	Get *RemoteObject `json:"get,omitempty"`
@@ -144,10 +147,10 @@ type PropertyDescriptor struct {
 	Enumerable bool `json:"enumerable"`
 
 	// True if the result was thrown during the evaluation. (optional)
-	WasThrown bool `json:"wasThrown,omitempty"`
+	WasThrown *bool `json:"wasThrown,omitempty"`
 
 	// True if the property is owned for the object. (optional)
-	IsOwn bool `json:"isOwn,omitempty"`
+	IsOwn *bool `json:"isOwn,omitempty"`
 
 	// Property symbol object, if the property is of the <code>symbol</code> type. (optional)
 	Symbol *RemoteObject `json:"symbol,omitempty"`
@@ -167,13 +170,13 @@ type InternalPropertyDescriptor struct {
 
 type CallArgument struct {
 	// Primitive value. (optional)
-	Value interface{} `json:"value,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
 
 	// Primitive value which can not be JSON-stringified. (optional)
-	UnserializableValue UnserializableValue `json:"unserializableValue,omitempty"`
+	UnserializableValue *UnserializableValue `json:"unserializableValue,omitempty"`
 
 	// Remote object handle. (optional)
-	ObjectId RemoteObjectId `json:"objectId,omitempty"`
+	ObjectId *RemoteObjectId `json:"objectId,omitempty"`
 }
 
 // Id of an execution context.
@@ -212,10 +215,10 @@ type ExceptionDetails struct {
 	ColumnNumber int `json:"columnNumber"`
 
 	// Script ID of the exception location. (optional)
-	ScriptId ScriptId `json:"scriptId,omitempty"`
+	ScriptId *ScriptId `json:"scriptId,omitempty"`
 
 	// URL of the exception location, to be used when the script was not reported. (optional)
-	URL string `json:"url,omitempty"`
+	URL *string `json:"url,omitempty"`
 
 	// JavaScript stack trace if available. (optional)
 	StackTrace *StackTrace `json:"stackTrace,omitempty"`
@@ -224,7 +227,7 @@ type ExceptionDetails struct {
 	Exception *RemoteObject `json:"exception,omitempty"`
 
 	// Identifier of the context where exception happened. (optional)
-	ExecutionContextId ExecutionContextId `json:"executionContextId,omitempty"`
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
 }
 
 // Number of milliseconds since epoch.
@@ -254,7 +257,7 @@ type CallFrame struct {
 
 type StackTrace struct {
 	// String label of this stack trace. For async traces this may be a name of the function that initiated the async call. (optional)
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty"`
 
 	// JavaScript function name.
 	CallFrames []*CallFrame `json:"callFrames"`
@@ -266,67 +269,343 @@ type StackTrace struct {
 	PromiseCreationFrame *CallFrame `json:"promiseCreationFrame,omitempty"`
 }
 
+// Values of the type property of RemoteObject.
+
+type RemoteObjectType string
+
+const (
+	RemoteObjectTypeObject    RemoteObjectType = "object"
+	RemoteObjectTypeFunction  RemoteObjectType = "function"
+	RemoteObjectTypeUndefined RemoteObjectType = "undefined"
+	RemoteObjectTypeString    RemoteObjectType = "string"
+	RemoteObjectTypeNumber    RemoteObjectType = "number"
+	RemoteObjectTypeBoolean   RemoteObjectType = "boolean"
+	RemoteObjectTypeSymbol    RemoteObjectType = "symbol"
+	RemoteObjectTypeBigint    RemoteObjectType = "bigint"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v RemoteObjectType) IsValid() bool {
+	switch v {
+	case RemoteObjectTypeObject, RemoteObjectTypeFunction, RemoteObjectTypeUndefined, RemoteObjectTypeString, RemoteObjectTypeNumber, RemoteObjectTypeBoolean, RemoteObjectTypeSymbol, RemoteObjectTypeBigint:
+		return true
+	}
+	return false
+}
+
+// Values of the subtype property of RemoteObject.
+
+type RemoteObjectSubtype string
+
+const (
+	RemoteObjectSubtypeArray             RemoteObjectSubtype = "array"
+	RemoteObjectSubtypeNull              RemoteObjectSubtype = "null"
+	RemoteObjectSubtypeNode              RemoteObjectSubtype = "node"
+	RemoteObjectSubtypeRegexp            RemoteObjectSubtype = "regexp"
+	RemoteObjectSubtypeDate              RemoteObjectSubtype = "date"
+	RemoteObjectSubtypeMap               RemoteObjectSubtype = "map"
+	RemoteObjectSubtypeSet               RemoteObjectSubtype = "set"
+	RemoteObjectSubtypeWeakmap           RemoteObjectSubtype = "weakmap"
+	RemoteObjectSubtypeWeakset           RemoteObjectSubtype = "weakset"
+	RemoteObjectSubtypeIterator          RemoteObjectSubtype = "iterator"
+	RemoteObjectSubtypeGenerator         RemoteObjectSubtype = "generator"
+	RemoteObjectSubtypeError             RemoteObjectSubtype = "error"
+	RemoteObjectSubtypeProxy             RemoteObjectSubtype = "proxy"
+	RemoteObjectSubtypePromise           RemoteObjectSubtype = "promise"
+	RemoteObjectSubtypeTypedarray        RemoteObjectSubtype = "typedarray"
+	RemoteObjectSubtypeArraybuffer       RemoteObjectSubtype = "arraybuffer"
+	RemoteObjectSubtypeDataview          RemoteObjectSubtype = "dataview"
+	RemoteObjectSubtypeWebassemblymemory RemoteObjectSubtype = "webassemblymemory"
+	RemoteObjectSubtypeWasmvalue         RemoteObjectSubtype = "wasmvalue"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v RemoteObjectSubtype) IsValid() bool {
+	switch v {
+	case RemoteObjectSubtypeArray, RemoteObjectSubtypeNull, RemoteObjectSubtypeNode, RemoteObjectSubtypeRegexp, RemoteObjectSubtypeDate, RemoteObjectSubtypeMap, RemoteObjectSubtypeSet, RemoteObjectSubtypeWeakmap, RemoteObjectSubtypeWeakset, RemoteObjectSubtypeIterator, RemoteObjectSubtypeGenerator, RemoteObjectSubtypeError, RemoteObjectSubtypeProxy, RemoteObjectSubtypePromise, RemoteObjectSubtypeTypedarray, RemoteObjectSubtypeArraybuffer, RemoteObjectSubtypeDataview, RemoteObjectSubtypeWebassemblymemory, RemoteObjectSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Values of the type property of ObjectPreview.
+
+type ObjectPreviewType string
+
+const (
+	ObjectPreviewTypeObject    ObjectPreviewType = "object"
+	ObjectPreviewTypeFunction  ObjectPreviewType = "function"
+	ObjectPreviewTypeUndefined ObjectPreviewType = "undefined"
+	ObjectPreviewTypeString    ObjectPreviewType = "string"
+	ObjectPreviewTypeNumber    ObjectPreviewType = "number"
+	ObjectPreviewTypeBoolean   ObjectPreviewType = "boolean"
+	ObjectPreviewTypeSymbol    ObjectPreviewType = "symbol"
+	ObjectPreviewTypeBigint    ObjectPreviewType = "bigint"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ObjectPreviewType) IsValid() bool {
+	switch v {
+	case ObjectPreviewTypeObject, ObjectPreviewTypeFunction, ObjectPreviewTypeUndefined, ObjectPreviewTypeString, ObjectPreviewTypeNumber, ObjectPreviewTypeBoolean, ObjectPreviewTypeSymbol, ObjectPreviewTypeBigint:
+		return true
+	}
+	return false
+}
+
+// Values of the subtype property of ObjectPreview.
+
+type ObjectPreviewSubtype string
+
+const (
+	ObjectPreviewSubtypeArray             ObjectPreviewSubtype = "array"
+	ObjectPreviewSubtypeNull              ObjectPreviewSubtype = "null"
+	ObjectPreviewSubtypeNode              ObjectPreviewSubtype = "node"
+	ObjectPreviewSubtypeRegexp            ObjectPreviewSubtype = "regexp"
+	ObjectPreviewSubtypeDate              ObjectPreviewSubtype = "date"
+	ObjectPreviewSubtypeMap               ObjectPreviewSubtype = "map"
+	ObjectPreviewSubtypeSet               ObjectPreviewSubtype = "set"
+	ObjectPreviewSubtypeWeakmap           ObjectPreviewSubtype = "weakmap"
+	ObjectPreviewSubtypeWeakset           ObjectPreviewSubtype = "weakset"
+	ObjectPreviewSubtypeIterator          ObjectPreviewSubtype = "iterator"
+	ObjectPreviewSubtypeGenerator         ObjectPreviewSubtype = "generator"
+	ObjectPreviewSubtypeError             ObjectPreviewSubtype = "error"
+	ObjectPreviewSubtypeProxy             ObjectPreviewSubtype = "proxy"
+	ObjectPreviewSubtypePromise           ObjectPreviewSubtype = "promise"
+	ObjectPreviewSubtypeTypedarray        ObjectPreviewSubtype = "typedarray"
+	ObjectPreviewSubtypeArraybuffer       ObjectPreviewSubtype = "arraybuffer"
+	ObjectPreviewSubtypeDataview          ObjectPreviewSubtype = "dataview"
+	ObjectPreviewSubtypeWebassemblymemory ObjectPreviewSubtype = "webassemblymemory"
+	ObjectPreviewSubtypeWasmvalue         ObjectPreviewSubtype = "wasmvalue"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ObjectPreviewSubtype) IsValid() bool {
+	switch v {
+	case ObjectPreviewSubtypeArray, ObjectPreviewSubtypeNull, ObjectPreviewSubtypeNode, ObjectPreviewSubtypeRegexp, ObjectPreviewSubtypeDate, ObjectPreviewSubtypeMap, ObjectPreviewSubtypeSet, ObjectPreviewSubtypeWeakmap, ObjectPreviewSubtypeWeakset, ObjectPreviewSubtypeIterator, ObjectPreviewSubtypeGenerator, ObjectPreviewSubtypeError, ObjectPreviewSubtypeProxy, ObjectPreviewSubtypePromise, ObjectPreviewSubtypeTypedarray, ObjectPreviewSubtypeArraybuffer, ObjectPreviewSubtypeDataview, ObjectPreviewSubtypeWebassemblymemory, ObjectPreviewSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Values of the type property of PropertyPreview.
+
+type PropertyPreviewType string
+
+const (
+	PropertyPreviewTypeObject    PropertyPreviewType = "object"
+	PropertyPreviewTypeFunction  PropertyPreviewType = "function"
+	PropertyPreviewTypeUndefined PropertyPreviewType = "undefined"
+	PropertyPreviewTypeString    PropertyPreviewType = "string"
+	PropertyPreviewTypeNumber    PropertyPreviewType = "number"
+	PropertyPreviewTypeBoolean   PropertyPreviewType = "boolean"
+	PropertyPreviewTypeSymbol    PropertyPreviewType = "symbol"
+	PropertyPreviewTypeAccessor  PropertyPreviewType = "accessor"
+	PropertyPreviewTypeBigint    PropertyPreviewType = "bigint"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v PropertyPreviewType) IsValid() bool {
+	switch v {
+	case PropertyPreviewTypeObject, PropertyPreviewTypeFunction, PropertyPreviewTypeUndefined, PropertyPreviewTypeString, PropertyPreviewTypeNumber, PropertyPreviewTypeBoolean, PropertyPreviewTypeSymbol, PropertyPreviewTypeAccessor, PropertyPreviewTypeBigint:
+		return true
+	}
+	return false
+}
+
+// Values of the subtype property of PropertyPreview.
+
+type PropertyPreviewSubtype string
+
+const (
+	PropertyPreviewSubtypeArray             PropertyPreviewSubtype = "array"
+	PropertyPreviewSubtypeNull              PropertyPreviewSubtype = "null"
+	PropertyPreviewSubtypeNode              PropertyPreviewSubtype = "node"
+	PropertyPreviewSubtypeRegexp            PropertyPreviewSubtype = "regexp"
+	PropertyPreviewSubtypeDate              PropertyPreviewSubtype = "date"
+	PropertyPreviewSubtypeMap               PropertyPreviewSubtype = "map"
+	PropertyPreviewSubtypeSet               PropertyPreviewSubtype = "set"
+	PropertyPreviewSubtypeWeakmap           PropertyPreviewSubtype = "weakmap"
+	PropertyPreviewSubtypeWeakset           PropertyPreviewSubtype = "weakset"
+	PropertyPreviewSubtypeIterator          PropertyPreviewSubtype = "iterator"
+	PropertyPreviewSubtypeGenerator         PropertyPreviewSubtype = "generator"
+	PropertyPreviewSubtypeError             PropertyPreviewSubtype = "error"
+	PropertyPreviewSubtypeProxy             PropertyPreviewSubtype = "proxy"
+	PropertyPreviewSubtypePromise           PropertyPreviewSubtype = "promise"
+	PropertyPreviewSubtypeTypedarray        PropertyPreviewSubtype = "typedarray"
+	PropertyPreviewSubtypeArraybuffer       PropertyPreviewSubtype = "arraybuffer"
+	PropertyPreviewSubtypeDataview          PropertyPreviewSubtype = "dataview"
+	PropertyPreviewSubtypeWebassemblymemory PropertyPreviewSubtype = "webassemblymemory"
+	PropertyPreviewSubtypeWasmvalue         PropertyPreviewSubtype = "wasmvalue"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v PropertyPreviewSubtype) IsValid() bool {
+	switch v {
+	case PropertyPreviewSubtypeArray, PropertyPreviewSubtypeNull, PropertyPreviewSubtypeNode, PropertyPreviewSubtypeRegexp, PropertyPreviewSubtypeDate, PropertyPreviewSubtypeMap, PropertyPreviewSubtypeSet, PropertyPreviewSubtypeWeakmap, PropertyPreviewSubtypeWeakset, PropertyPreviewSubtypeIterator, PropertyPreviewSubtypeGenerator, PropertyPreviewSubtypeError, PropertyPreviewSubtypeProxy, PropertyPreviewSubtypePromise, PropertyPreviewSubtypeTypedarray, PropertyPreviewSubtypeArraybuffer, PropertyPreviewSubtypeDataview, PropertyPreviewSubtypeWebassemblymemory, PropertyPreviewSubtypeWasmvalue:
+		return true
+	}
+	return false
+}
+
+// Values of the type parameter of Runtime.consoleAPICalled.
+
+type ConsoleAPICalledType string
+
+const (
+	ConsoleAPICalledTypeLog                 ConsoleAPICalledType = "log"
+	ConsoleAPICalledTypeDebug               ConsoleAPICalledType = "debug"
+	ConsoleAPICalledTypeInfo                ConsoleAPICalledType = "info"
+	ConsoleAPICalledTypeError               ConsoleAPICalledType = "error"
+	ConsoleAPICalledTypeWarning             ConsoleAPICalledType = "warning"
+	ConsoleAPICalledTypeDir                 ConsoleAPICalledType = "dir"
+	ConsoleAPICalledTypeDirxml              ConsoleAPICalledType = "dirxml"
+	ConsoleAPICalledTypeTable               ConsoleAPICalledType = "table"
+	ConsoleAPICalledTypeTrace               ConsoleAPICalledType = "trace"
+	ConsoleAPICalledTypeClear               ConsoleAPICalledType = "clear"
+	ConsoleAPICalledTypeStartGroup          ConsoleAPICalledType = "startGroup"
+	ConsoleAPICalledTypeStartGroupCollapsed ConsoleAPICalledType = "startGroupCollapsed"
+	ConsoleAPICalledTypeEndGroup            ConsoleAPICalledType = "endGroup"
+	ConsoleAPICalledTypeAssert              ConsoleAPICalledType = "assert"
+	ConsoleAPICalledTypeProfile             ConsoleAPICalledType = "profile"
+	ConsoleAPICalledTypeProfileEnd          ConsoleAPICalledType = "profileEnd"
+	ConsoleAPICalledTypeCount               ConsoleAPICalledType = "count"
+	ConsoleAPICalledTypeTimeEnd             ConsoleAPICalledType = "timeEnd"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ConsoleAPICalledType) IsValid() bool {
+	switch v {
+	case ConsoleAPICalledTypeLog, ConsoleAPICalledTypeDebug, ConsoleAPICalledTypeInfo, ConsoleAPICalledTypeError, ConsoleAPICalledTypeWarning, ConsoleAPICalledTypeDir, ConsoleAPICalledTypeDirxml, ConsoleAPICalledTypeTable, ConsoleAPICalledTypeTrace, ConsoleAPICalledTypeClear, ConsoleAPICalledTypeStartGroup, ConsoleAPICalledTypeStartGroupCollapsed, ConsoleAPICalledTypeEndGroup, ConsoleAPICalledTypeAssert, ConsoleAPICalledTypeProfile, ConsoleAPICalledTypeProfileEnd, ConsoleAPICalledTypeCount, ConsoleAPICalledTypeTimeEnd:
+		return true
+	}
+	return false
+}
+
+// EvaluateParams are the parameters of Runtime.evaluate.
+type EvaluateParams struct {
+	// Expression to evaluate.
+	Expression string `json:"expression"`
+
+	// Symbolic group name that can be used to release multiple objects. (optional)
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	// Determines whether Command Line API should be available during the evaluation. (optional)
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
+	Silent *bool `json:"silent,omitempty"`
+
+	// Specifies in which execution context to perform evaluation. If the parameter is omitted the evaluation will be performed in the context of the inspected page. (optional)
+	ContextId *ExecutionContextId `json:"contextId,omitempty"`
+
+	// Whether the result is expected to be a JSON object that should be sent by value. (optional)
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+
+	// Whether preview should be generated for the result. (optional, experimental)
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	// Whether execution should be treated as initiated by user in the UI. (optional, experimental)
+	UserGesture *bool `json:"userGesture,omitempty"`
+
+	// Whether execution should wait for promise to be resolved. If the result of evaluation is not a Promise, it's considered to be an error. (optional)
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *EvaluateParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Expression == "" {
+		missing = append(missing, "expression")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.evaluate", Missing: missing, Invalid: invalid}
+}
+
 type EvaluateRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EvaluateParams
 }
 
 // Evaluates expression on global object.
 func (d *Client) Evaluate() *EvaluateRequest {
-	return &EvaluateRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EvaluateRequest{client: d.Client, params: &EvaluateParams{set: 1}}
+}
+
+// DoEvaluate sends Runtime.evaluate with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEvaluate(ctx context.Context, params *EvaluateParams) (*EvaluateResult, error) {
+	if params == nil {
+		params = new(EvaluateParams)
+	}
+	return (&EvaluateRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Expression to evaluate.
 func (r *EvaluateRequest) Expression(v string) *EvaluateRequest {
-	r.opts["expression"] = v
+	r.params.Expression = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Symbolic group name that can be used to release multiple objects. (optional)
 func (r *EvaluateRequest) ObjectGroup(v string) *EvaluateRequest {
-	r.opts["objectGroup"] = v
+	r.params.ObjectGroup = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Determines whether Command Line API should be available during the evaluation. (optional)
 func (r *EvaluateRequest) IncludeCommandLineAPI(v bool) *EvaluateRequest {
-	r.opts["includeCommandLineAPI"] = v
+	r.params.IncludeCommandLineAPI = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
 func (r *EvaluateRequest) Silent(v bool) *EvaluateRequest {
-	r.opts["silent"] = v
+	r.params.Silent = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Specifies in which execution context to perform evaluation. If the parameter is omitted the evaluation will be performed in the context of the inspected page. (optional)
 func (r *EvaluateRequest) ContextId(v ExecutionContextId) *EvaluateRequest {
-	r.opts["contextId"] = v
+	r.params.ContextId = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Whether the result is expected to be a JSON object that should be sent by value. (optional)
 func (r *EvaluateRequest) ReturnByValue(v bool) *EvaluateRequest {
-	r.opts["returnByValue"] = v
+	r.params.ReturnByValue = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Whether preview should be generated for the result. (optional, experimental)
 func (r *EvaluateRequest) GeneratePreview(v bool) *EvaluateRequest {
-	r.opts["generatePreview"] = v
+	r.params.GeneratePreview = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Whether execution should be treated as initiated by user in the UI. (optional, experimental)
 func (r *EvaluateRequest) UserGesture(v bool) *EvaluateRequest {
-	r.opts["userGesture"] = v
+	r.params.UserGesture = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
 // Whether execution should wait for promise to be resolved. If the result of evaluation is not a Promise, it's considered to be an error. (optional)
 func (r *EvaluateRequest) AwaitPromise(v bool) *EvaluateRequest {
-	r.opts["awaitPromise"] = v
+	r.params.AwaitPromise = &v
+	r.params.set |= 1 << 9
 	return r
 }
 
@@ -335,40 +614,102 @@ type EvaluateResult struct {
 	Result *RemoteObject `json:"result"`
 
 	// Exception details. (optional)
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *EvaluateRequest) Do() (*EvaluateResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *EvaluateRequest) DoContext(ctx context.Context) (*EvaluateResult, error) {
 	var result EvaluateResult
-	err := r.client.Call("Runtime.evaluate", r.opts, &result)
+	err := r.client.CallContext(ctx, "Runtime.evaluate", r.params, &result)
 	return &result, err
 }
 
+type EvaluateFuture struct {
+	*rpc.Call
+	result EvaluateResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *EvaluateFuture) Get() (*EvaluateResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *EvaluateRequest) DoAsync(ctx context.Context) *EvaluateFuture {
+	f := new(EvaluateFuture)
+	f.Call = r.client.Go(ctx, "Runtime.evaluate", r.params, &f.result)
+	return f
+}
+
+// AwaitPromiseParams are the parameters of Runtime.awaitPromise.
+type AwaitPromiseParams struct {
+	// Identifier of the promise.
+	PromiseObjectId RemoteObjectId `json:"promiseObjectId"`
+
+	// Whether the result is expected to be a JSON object that should be sent by value. (optional)
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+
+	// Whether preview should be generated for the result. (optional)
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *AwaitPromiseParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.PromiseObjectId == "" {
+		missing = append(missing, "promiseObjectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.awaitPromise", Missing: missing, Invalid: invalid}
+}
+
 type AwaitPromiseRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *AwaitPromiseParams
 }
 
 // Add handler to promise with given promise object id.
 func (d *Client) AwaitPromise() *AwaitPromiseRequest {
-	return &AwaitPromiseRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &AwaitPromiseRequest{client: d.Client, params: &AwaitPromiseParams{set: 1}}
+}
+
+// DoAwaitPromise sends Runtime.awaitPromise with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoAwaitPromise(ctx context.Context, params *AwaitPromiseParams) (*AwaitPromiseResult, error) {
+	if params == nil {
+		params = new(AwaitPromiseParams)
+	}
+	return (&AwaitPromiseRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the promise.
 func (r *AwaitPromiseRequest) PromiseObjectId(v RemoteObjectId) *AwaitPromiseRequest {
-	r.opts["promiseObjectId"] = v
+	r.params.PromiseObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Whether the result is expected to be a JSON object that should be sent by value. (optional)
 func (r *AwaitPromiseRequest) ReturnByValue(v bool) *AwaitPromiseRequest {
-	r.opts["returnByValue"] = v
+	r.params.ReturnByValue = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Whether preview should be generated for the result. (optional)
 func (r *AwaitPromiseRequest) GeneratePreview(v bool) *AwaitPromiseRequest {
-	r.opts["generatePreview"] = v
+	r.params.GeneratePreview = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
@@ -377,70 +718,155 @@ type AwaitPromiseResult struct {
 	Result *RemoteObject `json:"result"`
 
 	// Exception details if stack strace is available. (optional)
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *AwaitPromiseRequest) Do() (*AwaitPromiseResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *AwaitPromiseRequest) DoContext(ctx context.Context) (*AwaitPromiseResult, error) {
 	var result AwaitPromiseResult
-	err := r.client.Call("Runtime.awaitPromise", r.opts, &result)
+	err := r.client.CallContext(ctx, "Runtime.awaitPromise", r.params, &result)
 	return &result, err
 }
 
+type AwaitPromiseFuture struct {
+	*rpc.Call
+	result AwaitPromiseResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *AwaitPromiseFuture) Get() (*AwaitPromiseResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *AwaitPromiseRequest) DoAsync(ctx context.Context) *AwaitPromiseFuture {
+	f := new(AwaitPromiseFuture)
+	f.Call = r.client.Go(ctx, "Runtime.awaitPromise", r.params, &f.result)
+	return f
+}
+
+// CallFunctionOnParams are the parameters of Runtime.callFunctionOn.
+type CallFunctionOnParams struct {
+	// Identifier of the object to call function on.
+	ObjectId RemoteObjectId `json:"objectId"`
+
+	// Declaration of the function to call.
+	FunctionDeclaration string `json:"functionDeclaration"`
+
+	// Call arguments. All call arguments must belong to the same JavaScript world as the target object. (optional)
+	Arguments []*CallArgument `json:"arguments,omitempty"`
+
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
+	Silent *bool `json:"silent,omitempty"`
+
+	// Whether the result is expected to be a JSON object which should be sent by value. (optional)
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+
+	// Whether preview should be generated for the result. (optional, experimental)
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	// Whether execution should be treated as initiated by user in the UI. (optional, experimental)
+	UserGesture *bool `json:"userGesture,omitempty"`
+
+	// Whether execution should wait for promise to be resolved. If the result of evaluation is not a Promise, it's considered to be an error. (optional)
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CallFunctionOnParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectId == "" {
+		missing = append(missing, "objectId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.FunctionDeclaration == "" {
+		missing = append(missing, "functionDeclaration")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.callFunctionOn", Missing: missing, Invalid: invalid}
+}
+
 type CallFunctionOnRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CallFunctionOnParams
 }
 
 // Calls function with given declaration on the given object. Object group of the result is inherited from the target object.
 func (d *Client) CallFunctionOn() *CallFunctionOnRequest {
-	return &CallFunctionOnRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CallFunctionOnRequest{client: d.Client, params: &CallFunctionOnParams{set: 1}}
+}
+
+// DoCallFunctionOn sends Runtime.callFunctionOn with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCallFunctionOn(ctx context.Context, params *CallFunctionOnParams) (*CallFunctionOnResult, error) {
+	if params == nil {
+		params = new(CallFunctionOnParams)
+	}
+	return (&CallFunctionOnRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the object to call function on.
 func (r *CallFunctionOnRequest) ObjectId(v RemoteObjectId) *CallFunctionOnRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Declaration of the function to call.
 func (r *CallFunctionOnRequest) FunctionDeclaration(v string) *CallFunctionOnRequest {
-	r.opts["functionDeclaration"] = v
+	r.params.FunctionDeclaration = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Call arguments. All call arguments must belong to the same JavaScript world as the target object. (optional)
 func (r *CallFunctionOnRequest) Arguments(v []*CallArgument) *CallFunctionOnRequest {
-	r.opts["arguments"] = v
+	r.params.Arguments = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
 func (r *CallFunctionOnRequest) Silent(v bool) *CallFunctionOnRequest {
-	r.opts["silent"] = v
+	r.params.Silent = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Whether the result is expected to be a JSON object which should be sent by value. (optional)
 func (r *CallFunctionOnRequest) ReturnByValue(v bool) *CallFunctionOnRequest {
-	r.opts["returnByValue"] = v
+	r.params.ReturnByValue = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Whether preview should be generated for the result. (optional, experimental)
 func (r *CallFunctionOnRequest) GeneratePreview(v bool) *CallFunctionOnRequest {
-	r.opts["generatePreview"] = v
+	r.params.GeneratePreview = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Whether execution should be treated as initiated by user in the UI. (optional, experimental)
 func (r *CallFunctionOnRequest) UserGesture(v bool) *CallFunctionOnRequest {
-	r.opts["userGesture"] = v
+	r.params.UserGesture = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Whether execution should wait for promise to be resolved. If the result of evaluation is not a Promise, it's considered to be an error. (optional)
 func (r *CallFunctionOnRequest) AwaitPromise(v bool) *CallFunctionOnRequest {
-	r.opts["awaitPromise"] = v
+	r.params.AwaitPromise = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
@@ -449,46 +875,112 @@ type CallFunctionOnResult struct {
 	Result *RemoteObject `json:"result"`
 
 	// Exception details. (optional)
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *CallFunctionOnRequest) Do() (*CallFunctionOnResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CallFunctionOnRequest) DoContext(ctx context.Context) (*CallFunctionOnResult, error) {
 	var result CallFunctionOnResult
-	err := r.client.Call("Runtime.callFunctionOn", r.opts, &result)
+	err := r.client.CallContext(ctx, "Runtime.callFunctionOn", r.params, &result)
 	return &result, err
 }
 
+type CallFunctionOnFuture struct {
+	*rpc.Call
+	result CallFunctionOnResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CallFunctionOnFuture) Get() (*CallFunctionOnResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CallFunctionOnRequest) DoAsync(ctx context.Context) *CallFunctionOnFuture {
+	f := new(CallFunctionOnFuture)
+	f.Call = r.client.Go(ctx, "Runtime.callFunctionOn", r.params, &f.result)
+	return f
+}
+
+// GetPropertiesParams are the parameters of Runtime.getProperties.
+type GetPropertiesParams struct {
+	// Identifier of the object to return properties for.
+	ObjectId RemoteObjectId `json:"objectId"`
+
+	// If true, returns properties belonging only to the element itself, not to its prototype chain. (optional)
+	OwnProperties *bool `json:"ownProperties,omitempty"`
+
+	// If true, returns accessor properties (with getter/setter) only; internal properties are not returned either. (optional, experimental)
+	AccessorPropertiesOnly *bool `json:"accessorPropertiesOnly,omitempty"`
+
+	// Whether preview should be generated for the results. (optional, experimental)
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetPropertiesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectId == "" {
+		missing = append(missing, "objectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.getProperties", Missing: missing, Invalid: invalid}
+}
+
 type GetPropertiesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetPropertiesParams
 }
 
 // Returns properties of a given object. Object group of the result is inherited from the target object.
 func (d *Client) GetProperties() *GetPropertiesRequest {
-	return &GetPropertiesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetPropertiesRequest{client: d.Client, params: &GetPropertiesParams{set: 1}}
+}
+
+// DoGetProperties sends Runtime.getProperties with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetProperties(ctx context.Context, params *GetPropertiesParams) (*GetPropertiesResult, error) {
+	if params == nil {
+		params = new(GetPropertiesParams)
+	}
+	return (&GetPropertiesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the object to return properties for.
 func (r *GetPropertiesRequest) ObjectId(v RemoteObjectId) *GetPropertiesRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // If true, returns properties belonging only to the element itself, not to its prototype chain. (optional)
 func (r *GetPropertiesRequest) OwnProperties(v bool) *GetPropertiesRequest {
-	r.opts["ownProperties"] = v
+	r.params.OwnProperties = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // If true, returns accessor properties (with getter/setter) only; internal properties are not returned either. (optional, experimental)
 func (r *GetPropertiesRequest) AccessorPropertiesOnly(v bool) *GetPropertiesRequest {
-	r.opts["accessorPropertiesOnly"] = v
+	r.params.AccessorPropertiesOnly = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Whether preview should be generated for the results. (optional, experimental)
 func (r *GetPropertiesRequest) GeneratePreview(v bool) *GetPropertiesRequest {
-	r.opts["generatePreview"] = v
+	r.params.GeneratePreview = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
@@ -497,236 +989,598 @@ type GetPropertiesResult struct {
 	Result []*PropertyDescriptor `json:"result"`
 
 	// Internal object properties (only of the element itself). (optional)
-	InternalProperties []*InternalPropertyDescriptor `json:"internalProperties"`
+	InternalProperties []*InternalPropertyDescriptor `json:"internalProperties,omitempty"`
 
 	// Exception details. (optional)
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *GetPropertiesRequest) Do() (*GetPropertiesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetPropertiesRequest) DoContext(ctx context.Context) (*GetPropertiesResult, error) {
 	var result GetPropertiesResult
-	err := r.client.Call("Runtime.getProperties", r.opts, &result)
+	err := r.client.CallContext(ctx, "Runtime.getProperties", r.params, &result)
 	return &result, err
 }
 
+type GetPropertiesFuture struct {
+	*rpc.Call
+	result GetPropertiesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetPropertiesFuture) Get() (*GetPropertiesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetPropertiesRequest) DoAsync(ctx context.Context) *GetPropertiesFuture {
+	f := new(GetPropertiesFuture)
+	f.Call = r.client.Go(ctx, "Runtime.getProperties", r.params, &f.result)
+	return f
+}
+
+// ReleaseObjectParams are the parameters of Runtime.releaseObject.
+type ReleaseObjectParams struct {
+	// Identifier of the object to release.
+	ObjectId RemoteObjectId `json:"objectId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReleaseObjectParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectId == "" {
+		missing = append(missing, "objectId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.releaseObject", Missing: missing, Invalid: invalid}
+}
+
 type ReleaseObjectRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReleaseObjectParams
 }
 
 // Releases remote object with given id.
 func (d *Client) ReleaseObject() *ReleaseObjectRequest {
-	return &ReleaseObjectRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReleaseObjectRequest{client: d.Client, params: &ReleaseObjectParams{set: 1}}
+}
+
+// DoReleaseObject sends Runtime.releaseObject with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoReleaseObject(ctx context.Context, params *ReleaseObjectParams) error {
+	if params == nil {
+		params = new(ReleaseObjectParams)
+	}
+	return (&ReleaseObjectRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Identifier of the object to release.
 func (r *ReleaseObjectRequest) ObjectId(v RemoteObjectId) *ReleaseObjectRequest {
-	r.opts["objectId"] = v
+	r.params.ObjectId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ReleaseObjectRequest) Do() error {
-	return r.client.Call("Runtime.releaseObject", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ReleaseObjectRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Runtime.releaseObject", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ReleaseObjectRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Runtime.releaseObject", r.params, nil)
+}
+
+// ReleaseObjectGroupParams are the parameters of Runtime.releaseObjectGroup.
+type ReleaseObjectGroupParams struct {
+	// Symbolic object group name.
+	ObjectGroup string `json:"objectGroup"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ReleaseObjectGroupParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ObjectGroup == "" {
+		missing = append(missing, "objectGroup")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.releaseObjectGroup", Missing: missing, Invalid: invalid}
 }
 
 type ReleaseObjectGroupRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ReleaseObjectGroupParams
 }
 
 // Releases all remote objects that belong to a given group.
 func (d *Client) ReleaseObjectGroup() *ReleaseObjectGroupRequest {
-	return &ReleaseObjectGroupRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ReleaseObjectGroupRequest{client: d.Client, params: &ReleaseObjectGroupParams{set: 1}}
+}
+
+// DoReleaseObjectGroup sends Runtime.releaseObjectGroup with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoReleaseObjectGroup(ctx context.Context, params *ReleaseObjectGroupParams) error {
+	if params == nil {
+		params = new(ReleaseObjectGroupParams)
+	}
+	return (&ReleaseObjectGroupRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Symbolic object group name.
 func (r *ReleaseObjectGroupRequest) ObjectGroup(v string) *ReleaseObjectGroupRequest {
-	r.opts["objectGroup"] = v
+	r.params.ObjectGroup = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ReleaseObjectGroupRequest) Do() error {
-	return r.client.Call("Runtime.releaseObjectGroup", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ReleaseObjectGroupRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Runtime.releaseObjectGroup", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ReleaseObjectGroupRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Runtime.releaseObjectGroup", r.params, nil)
+}
+
+// RunIfWaitingForDebuggerParams are the parameters of Runtime.runIfWaitingForDebugger.
+type RunIfWaitingForDebuggerParams struct {
 }
 
 type RunIfWaitingForDebuggerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RunIfWaitingForDebuggerParams
 }
 
 // Tells inspected instance to run if it was waiting for debugger to attach.
 func (d *Client) RunIfWaitingForDebugger() *RunIfWaitingForDebuggerRequest {
-	return &RunIfWaitingForDebuggerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RunIfWaitingForDebuggerRequest{client: d.Client, params: &RunIfWaitingForDebuggerParams{}}
+}
+
+// DoRunIfWaitingForDebugger sends Runtime.runIfWaitingForDebugger with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRunIfWaitingForDebugger(ctx context.Context, params *RunIfWaitingForDebuggerParams) error {
+	if params == nil {
+		params = new(RunIfWaitingForDebuggerParams)
+	}
+	return (&RunIfWaitingForDebuggerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *RunIfWaitingForDebuggerRequest) Do() error {
-	return r.client.Call("Runtime.runIfWaitingForDebugger", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RunIfWaitingForDebuggerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Runtime.runIfWaitingForDebugger", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RunIfWaitingForDebuggerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Runtime.runIfWaitingForDebugger", r.params, nil)
+}
+
+// EnableParams are the parameters of Runtime.enable.
+type EnableParams struct {
 }
 
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables reporting of execution contexts creation by means of <code>executionContextCreated</code> event. When the reporting gets enabled the event will be sent immediately for each existing execution context.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Runtime.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Runtime.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Runtime", r.params)
+}
+
+// DisableParams are the parameters of Runtime.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables reporting of execution contexts creation.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Runtime.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Runtime.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Runtime", r.params)
+}
+
+// DiscardConsoleEntriesParams are the parameters of Runtime.discardConsoleEntries.
+type DiscardConsoleEntriesParams struct {
 }
 
 type DiscardConsoleEntriesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DiscardConsoleEntriesParams
 }
 
 // Discards collected exceptions and console API calls.
 func (d *Client) DiscardConsoleEntries() *DiscardConsoleEntriesRequest {
-	return &DiscardConsoleEntriesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DiscardConsoleEntriesRequest{client: d.Client, params: &DiscardConsoleEntriesParams{}}
+}
+
+// DoDiscardConsoleEntries sends Runtime.discardConsoleEntries with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDiscardConsoleEntries(ctx context.Context, params *DiscardConsoleEntriesParams) error {
+	if params == nil {
+		params = new(DiscardConsoleEntriesParams)
+	}
+	return (&DiscardConsoleEntriesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DiscardConsoleEntriesRequest) Do() error {
-	return r.client.Call("Runtime.discardConsoleEntries", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DiscardConsoleEntriesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Runtime.discardConsoleEntries", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DiscardConsoleEntriesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Runtime.discardConsoleEntries", r.params, nil)
+}
+
+// SetCustomObjectFormatterEnabledParams are the parameters of Runtime.setCustomObjectFormatterEnabled.
+type SetCustomObjectFormatterEnabledParams struct {
+	Enabled bool `json:"enabled"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetCustomObjectFormatterEnabledParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "enabled")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.setCustomObjectFormatterEnabled", Missing: missing, Invalid: invalid}
 }
 
 type SetCustomObjectFormatterEnabledRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetCustomObjectFormatterEnabledParams
 }
 
 // (experimental)
 func (d *Client) SetCustomObjectFormatterEnabled() *SetCustomObjectFormatterEnabledRequest {
-	return &SetCustomObjectFormatterEnabledRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetCustomObjectFormatterEnabledRequest{client: d.Client, params: &SetCustomObjectFormatterEnabledParams{set: 1}}
+}
+
+// DoSetCustomObjectFormatterEnabled sends Runtime.setCustomObjectFormatterEnabled with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetCustomObjectFormatterEnabled(ctx context.Context, params *SetCustomObjectFormatterEnabledParams) error {
+	if params == nil {
+		params = new(SetCustomObjectFormatterEnabledParams)
+	}
+	return (&SetCustomObjectFormatterEnabledRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetCustomObjectFormatterEnabledRequest) Enabled(v bool) *SetCustomObjectFormatterEnabledRequest {
-	r.opts["enabled"] = v
+	r.params.Enabled = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetCustomObjectFormatterEnabledRequest) Do() error {
-	return r.client.Call("Runtime.setCustomObjectFormatterEnabled", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetCustomObjectFormatterEnabledRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Runtime.setCustomObjectFormatterEnabled", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetCustomObjectFormatterEnabledRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Runtime.setCustomObjectFormatterEnabled", r.params, nil)
+}
+
+// CompileScriptParams are the parameters of Runtime.compileScript.
+type CompileScriptParams struct {
+	// Expression to compile.
+	Expression string `json:"expression"`
+
+	// Source url to be set for the script.
+	SourceURL string `json:"sourceURL"`
+
+	// Specifies whether the compiled script should be persisted.
+	PersistScript bool `json:"persistScript"`
+
+	// Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page. (optional)
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CompileScriptParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Expression == "" {
+		missing = append(missing, "expression")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.SourceURL == "" {
+		missing = append(missing, "sourceURL")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 {
+		missing = append(missing, "persistScript")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.compileScript", Missing: missing, Invalid: invalid}
 }
 
 type CompileScriptRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CompileScriptParams
 }
 
 // Compiles expression.
 func (d *Client) CompileScript() *CompileScriptRequest {
-	return &CompileScriptRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CompileScriptRequest{client: d.Client, params: &CompileScriptParams{set: 1}}
+}
+
+// DoCompileScript sends Runtime.compileScript with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCompileScript(ctx context.Context, params *CompileScriptParams) (*CompileScriptResult, error) {
+	if params == nil {
+		params = new(CompileScriptParams)
+	}
+	return (&CompileScriptRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Expression to compile.
 func (r *CompileScriptRequest) Expression(v string) *CompileScriptRequest {
-	r.opts["expression"] = v
+	r.params.Expression = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Source url to be set for the script.
 func (r *CompileScriptRequest) SourceURL(v string) *CompileScriptRequest {
-	r.opts["sourceURL"] = v
+	r.params.SourceURL = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Specifies whether the compiled script should be persisted.
 func (r *CompileScriptRequest) PersistScript(v bool) *CompileScriptRequest {
-	r.opts["persistScript"] = v
+	r.params.PersistScript = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page. (optional)
 func (r *CompileScriptRequest) ExecutionContextId(v ExecutionContextId) *CompileScriptRequest {
-	r.opts["executionContextId"] = v
+	r.params.ExecutionContextId = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 type CompileScriptResult struct {
 	// Id of the script. (optional)
-	ScriptId ScriptId `json:"scriptId"`
+	ScriptId *ScriptId `json:"scriptId,omitempty"`
 
 	// Exception details. (optional)
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *CompileScriptRequest) Do() (*CompileScriptResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CompileScriptRequest) DoContext(ctx context.Context) (*CompileScriptResult, error) {
 	var result CompileScriptResult
-	err := r.client.Call("Runtime.compileScript", r.opts, &result)
+	err := r.client.CallContext(ctx, "Runtime.compileScript", r.params, &result)
 	return &result, err
 }
 
+type CompileScriptFuture struct {
+	*rpc.Call
+	result CompileScriptResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CompileScriptFuture) Get() (*CompileScriptResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CompileScriptRequest) DoAsync(ctx context.Context) *CompileScriptFuture {
+	f := new(CompileScriptFuture)
+	f.Call = r.client.Go(ctx, "Runtime.compileScript", r.params, &f.result)
+	return f
+}
+
+// RunScriptParams are the parameters of Runtime.runScript.
+type RunScriptParams struct {
+	// Id of the script to run.
+	ScriptId ScriptId `json:"scriptId"`
+
+	// Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page. (optional)
+	ExecutionContextId *ExecutionContextId `json:"executionContextId,omitempty"`
+
+	// Symbolic group name that can be used to release multiple objects. (optional)
+	ObjectGroup *string `json:"objectGroup,omitempty"`
+
+	// In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
+	Silent *bool `json:"silent,omitempty"`
+
+	// Determines whether Command Line API should be available during the evaluation. (optional)
+	IncludeCommandLineAPI *bool `json:"includeCommandLineAPI,omitempty"`
+
+	// Whether the result is expected to be a JSON object which should be sent by value. (optional)
+	ReturnByValue *bool `json:"returnByValue,omitempty"`
+
+	// Whether preview should be generated for the result. (optional)
+	GeneratePreview *bool `json:"generatePreview,omitempty"`
+
+	// Whether execution should wait for promise to be resolved. If the result of evaluation is not a Promise, it's considered to be an error. (optional)
+	AwaitPromise *bool `json:"awaitPromise,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RunScriptParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScriptId == "" {
+		missing = append(missing, "scriptId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Runtime.runScript", Missing: missing, Invalid: invalid}
+}
+
 type RunScriptRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RunScriptParams
 }
 
 // Runs script with given id in a given context.
 func (d *Client) RunScript() *RunScriptRequest {
-	return &RunScriptRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RunScriptRequest{client: d.Client, params: &RunScriptParams{set: 1}}
+}
+
+// DoRunScript sends Runtime.runScript with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRunScript(ctx context.Context, params *RunScriptParams) (*RunScriptResult, error) {
+	if params == nil {
+		params = new(RunScriptParams)
+	}
+	return (&RunScriptRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Id of the script to run.
 func (r *RunScriptRequest) ScriptId(v ScriptId) *RunScriptRequest {
-	r.opts["scriptId"] = v
+	r.params.ScriptId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Specifies in which execution context to perform script run. If the parameter is omitted the evaluation will be performed in the context of the inspected page. (optional)
 func (r *RunScriptRequest) ExecutionContextId(v ExecutionContextId) *RunScriptRequest {
-	r.opts["executionContextId"] = v
+	r.params.ExecutionContextId = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Symbolic group name that can be used to release multiple objects. (optional)
 func (r *RunScriptRequest) ObjectGroup(v string) *RunScriptRequest {
-	r.opts["objectGroup"] = v
+	r.params.ObjectGroup = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // In silent mode exceptions thrown during evaluation are not reported and do not pause execution. Overrides <code>setPauseOnException</code> state. (optional)
 func (r *RunScriptRequest) Silent(v bool) *RunScriptRequest {
-	r.opts["silent"] = v
+	r.params.Silent = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // Determines whether Command Line API should be available during the evaluation. (optional)
 func (r *RunScriptRequest) IncludeCommandLineAPI(v bool) *RunScriptRequest {
-	r.opts["includeCommandLineAPI"] = v
+	r.params.IncludeCommandLineAPI = &v
+	r.params.set |= 1 << 5
 	return r
 }
 
 // Whether the result is expected to be a JSON object which should be sent by value. (optional)
 func (r *RunScriptRequest) ReturnByValue(v bool) *RunScriptRequest {
-	r.opts["returnByValue"] = v
+	r.params.ReturnByValue = &v
+	r.params.set |= 1 << 6
 	return r
 }
 
 // Whether preview should be generated for the result. (optional)
 func (r *RunScriptRequest) GeneratePreview(v bool) *RunScriptRequest {
-	r.opts["generatePreview"] = v
+	r.params.GeneratePreview = &v
+	r.params.set |= 1 << 7
 	return r
 }
 
 // Whether execution should wait for promise to be resolved. If the result of evaluation is not a Promise, it's considered to be an error. (optional)
 func (r *RunScriptRequest) AwaitPromise(v bool) *RunScriptRequest {
-	r.opts["awaitPromise"] = v
+	r.params.AwaitPromise = &v
+	r.params.set |= 1 << 8
 	return r
 }
 
@@ -735,15 +1589,37 @@ type RunScriptResult struct {
 	Result *RemoteObject `json:"result"`
 
 	// Exception details. (optional)
-	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
+	ExceptionDetails *ExceptionDetails `json:"exceptionDetails,omitempty"`
 }
 
 func (r *RunScriptRequest) Do() (*RunScriptResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RunScriptRequest) DoContext(ctx context.Context) (*RunScriptResult, error) {
 	var result RunScriptResult
-	err := r.client.Call("Runtime.runScript", r.opts, &result)
+	err := r.client.CallContext(ctx, "Runtime.runScript", r.params, &result)
 	return &result, err
 }
 
+type RunScriptFuture struct {
+	*rpc.Call
+	result RunScriptResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RunScriptFuture) Get() (*RunScriptResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RunScriptRequest) DoAsync(ctx context.Context) *RunScriptFuture {
+	f := new(RunScriptFuture)
+	f.Call = r.client.Go(ctx, "Runtime.runScript", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["Runtime.executionContextCreated"] = func() interface{} { return new(ExecutionContextCreatedEvent) }
 	rpc.EventTypes["Runtime.executionContextDestroyed"] = func() interface{} { return new(ExecutionContextDestroyedEvent) }
@@ -760,16 +1636,133 @@ type ExecutionContextCreatedEvent struct {
 	Context *ExecutionContextDescription `json:"context"`
 }
 
+// Issued when new execution context is created.
+func (d *Client) OnExecutionContextCreated(fn func(*ExecutionContextCreatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Runtime.executionContextCreated", func(e interface{}) {
+		fn(e.(*ExecutionContextCreatedEvent))
+	}, opts...)
+}
+
+// FilterExecutionContextCreated is rpc.WithFilter for OnExecutionContextCreated.
+func FilterExecutionContextCreated(fn func(*ExecutionContextCreatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ExecutionContextCreatedEvent))
+	})
+}
+
+// ExecutionContextCreatedWaiter waits for a Runtime.executionContextCreated event. It is created by WaitForExecutionContextCreated.
+type ExecutionContextCreatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ExecutionContextCreatedWaiter) Wait() (*ExecutionContextCreatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ExecutionContextCreatedEvent), nil
+}
+
+// WaitForExecutionContextCreated arms a waiter for the first Runtime.executionContextCreated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForExecutionContextCreated(ctx context.Context, predicate func(*ExecutionContextCreatedEvent) bool) *ExecutionContextCreatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ExecutionContextCreatedEvent))
+		}
+	}
+	return &ExecutionContextCreatedWaiter{d.Client.WaitFor(ctx, "Runtime.executionContextCreated", p)}
+}
+
 // Issued when execution context is destroyed.
 type ExecutionContextDestroyedEvent struct {
 	// Id of the destroyed context
 	ExecutionContextId ExecutionContextId `json:"executionContextId"`
 }
 
+// Issued when execution context is destroyed.
+func (d *Client) OnExecutionContextDestroyed(fn func(*ExecutionContextDestroyedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Runtime.executionContextDestroyed", func(e interface{}) {
+		fn(e.(*ExecutionContextDestroyedEvent))
+	}, opts...)
+}
+
+// FilterExecutionContextDestroyed is rpc.WithFilter for OnExecutionContextDestroyed.
+func FilterExecutionContextDestroyed(fn func(*ExecutionContextDestroyedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ExecutionContextDestroyedEvent))
+	})
+}
+
+// ExecutionContextDestroyedWaiter waits for a Runtime.executionContextDestroyed event. It is created by WaitForExecutionContextDestroyed.
+type ExecutionContextDestroyedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ExecutionContextDestroyedWaiter) Wait() (*ExecutionContextDestroyedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ExecutionContextDestroyedEvent), nil
+}
+
+// WaitForExecutionContextDestroyed arms a waiter for the first Runtime.executionContextDestroyed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForExecutionContextDestroyed(ctx context.Context, predicate func(*ExecutionContextDestroyedEvent) bool) *ExecutionContextDestroyedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ExecutionContextDestroyedEvent))
+		}
+	}
+	return &ExecutionContextDestroyedWaiter{d.Client.WaitFor(ctx, "Runtime.executionContextDestroyed", p)}
+}
+
 // Issued when all executionContexts were cleared in browser
 type ExecutionContextsClearedEvent struct {
 }
 
+// Issued when all executionContexts were cleared in browser
+func (d *Client) OnExecutionContextsCleared(fn func(*ExecutionContextsClearedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Runtime.executionContextsCleared", func(e interface{}) {
+		fn(e.(*ExecutionContextsClearedEvent))
+	}, opts...)
+}
+
+// FilterExecutionContextsCleared is rpc.WithFilter for OnExecutionContextsCleared.
+func FilterExecutionContextsCleared(fn func(*ExecutionContextsClearedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ExecutionContextsClearedEvent))
+	})
+}
+
+// ExecutionContextsClearedWaiter waits for a Runtime.executionContextsCleared event. It is created by WaitForExecutionContextsCleared.
+type ExecutionContextsClearedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ExecutionContextsClearedWaiter) Wait() (*ExecutionContextsClearedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ExecutionContextsClearedEvent), nil
+}
+
+// WaitForExecutionContextsCleared arms a waiter for the first Runtime.executionContextsCleared event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForExecutionContextsCleared(ctx context.Context, predicate func(*ExecutionContextsClearedEvent) bool) *ExecutionContextsClearedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ExecutionContextsClearedEvent))
+		}
+	}
+	return &ExecutionContextsClearedWaiter{d.Client.WaitFor(ctx, "Runtime.executionContextsCleared", p)}
+}
+
 // Issued when exception was thrown and unhandled.
 type ExceptionThrownEvent struct {
 	// Timestamp of the exception.
@@ -778,6 +1771,45 @@ type ExceptionThrownEvent struct {
 	ExceptionDetails *ExceptionDetails `json:"exceptionDetails"`
 }
 
+// Issued when exception was thrown and unhandled.
+func (d *Client) OnExceptionThrown(fn func(*ExceptionThrownEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Runtime.exceptionThrown", func(e interface{}) {
+		fn(e.(*ExceptionThrownEvent))
+	}, opts...)
+}
+
+// FilterExceptionThrown is rpc.WithFilter for OnExceptionThrown.
+func FilterExceptionThrown(fn func(*ExceptionThrownEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ExceptionThrownEvent))
+	})
+}
+
+// ExceptionThrownWaiter waits for a Runtime.exceptionThrown event. It is created by WaitForExceptionThrown.
+type ExceptionThrownWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ExceptionThrownWaiter) Wait() (*ExceptionThrownEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ExceptionThrownEvent), nil
+}
+
+// WaitForExceptionThrown arms a waiter for the first Runtime.exceptionThrown event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForExceptionThrown(ctx context.Context, predicate func(*ExceptionThrownEvent) bool) *ExceptionThrownWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ExceptionThrownEvent))
+		}
+	}
+	return &ExceptionThrownWaiter{d.Client.WaitFor(ctx, "Runtime.exceptionThrown", p)}
+}
+
 // Issued when unhandled exception was revoked.
 type ExceptionRevokedEvent struct {
 	// Reason describing why exception was revoked.
@@ -787,10 +1819,49 @@ type ExceptionRevokedEvent struct {
 	ExceptionId int `json:"exceptionId"`
 }
 
+// Issued when unhandled exception was revoked.
+func (d *Client) OnExceptionRevoked(fn func(*ExceptionRevokedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Runtime.exceptionRevoked", func(e interface{}) {
+		fn(e.(*ExceptionRevokedEvent))
+	}, opts...)
+}
+
+// FilterExceptionRevoked is rpc.WithFilter for OnExceptionRevoked.
+func FilterExceptionRevoked(fn func(*ExceptionRevokedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ExceptionRevokedEvent))
+	})
+}
+
+// ExceptionRevokedWaiter waits for a Runtime.exceptionRevoked event. It is created by WaitForExceptionRevoked.
+type ExceptionRevokedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ExceptionRevokedWaiter) Wait() (*ExceptionRevokedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ExceptionRevokedEvent), nil
+}
+
+// WaitForExceptionRevoked arms a waiter for the first Runtime.exceptionRevoked event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForExceptionRevoked(ctx context.Context, predicate func(*ExceptionRevokedEvent) bool) *ExceptionRevokedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ExceptionRevokedEvent))
+		}
+	}
+	return &ExceptionRevokedWaiter{d.Client.WaitFor(ctx, "Runtime.exceptionRevoked", p)}
+}
+
 // Issued when console API was called.
 type ConsoleAPICalledEvent struct {
 	// Type of the call.
-	Type string `json:"type"`
+	Type ConsoleAPICalledType `json:"type"`
 
 	// Call arguments.
 	Args []*RemoteObject `json:"args"`
@@ -802,10 +1873,49 @@ type ConsoleAPICalledEvent struct {
 	Timestamp Timestamp `json:"timestamp"`
 
 	// Stack trace captured when the call was made. (optional)
-	StackTrace *StackTrace `json:"stackTrace"`
+	StackTrace *StackTrace `json:"stackTrace,omitempty"`
 
 	// Console context descriptor for calls on non-default console context (not console.*): 'anonymous#unique-logger-id' for call on unnamed context, 'name#unique-logger-id' for call on named context. (optional, experimental)
-	Context string `json:"context"`
+	Context *string `json:"context,omitempty"`
+}
+
+// Issued when console API was called.
+func (d *Client) OnConsoleAPICalled(fn func(*ConsoleAPICalledEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Runtime.consoleAPICalled", func(e interface{}) {
+		fn(e.(*ConsoleAPICalledEvent))
+	}, opts...)
+}
+
+// FilterConsoleAPICalled is rpc.WithFilter for OnConsoleAPICalled.
+func FilterConsoleAPICalled(fn func(*ConsoleAPICalledEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ConsoleAPICalledEvent))
+	})
+}
+
+// ConsoleAPICalledWaiter waits for a Runtime.consoleAPICalled event. It is created by WaitForConsoleAPICalled.
+type ConsoleAPICalledWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ConsoleAPICalledWaiter) Wait() (*ConsoleAPICalledEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ConsoleAPICalledEvent), nil
+}
+
+// WaitForConsoleAPICalled arms a waiter for the first Runtime.consoleAPICalled event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForConsoleAPICalled(ctx context.Context, predicate func(*ConsoleAPICalledEvent) bool) *ConsoleAPICalledWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ConsoleAPICalledEvent))
+		}
+	}
+	return &ConsoleAPICalledWaiter{d.Client.WaitFor(ctx, "Runtime.consoleAPICalled", p)}
 }
 
 // Issued when object should be inspected (for example, as a result of inspect() command line API call).
@@ -814,3 +1924,42 @@ type InspectRequestedEvent struct {
 
 	Hints interface{} `json:"hints"`
 }
+
+// Issued when object should be inspected (for example, as a result of inspect() command line API call).
+func (d *Client) OnInspectRequested(fn func(*InspectRequestedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Runtime.inspectRequested", func(e interface{}) {
+		fn(e.(*InspectRequestedEvent))
+	}, opts...)
+}
+
+// FilterInspectRequested is rpc.WithFilter for OnInspectRequested.
+func FilterInspectRequested(fn func(*InspectRequestedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*InspectRequestedEvent))
+	})
+}
+
+// InspectRequestedWaiter waits for a Runtime.inspectRequested event. It is created by WaitForInspectRequested.
+type InspectRequestedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *InspectRequestedWaiter) Wait() (*InspectRequestedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*InspectRequestedEvent), nil
+}
+
+// WaitForInspectRequested arms a waiter for the first Runtime.inspectRequested event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForInspectRequested(ctx context.Context, predicate func(*InspectRequestedEvent) bool) *InspectRequestedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*InspectRequestedEvent))
+		}
+	}
+	return &InspectRequestedWaiter{d.Client.WaitFor(ctx, "Runtime.inspectRequested", p)}
+}
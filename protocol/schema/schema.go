@@ -2,6 +2,8 @@
 package schema
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -20,14 +22,26 @@ type Domain struct {
 	Version string `json:"version"`
 }
 
+// GetDomainsParams are the parameters of Schema.getDomains.
+type GetDomainsParams struct {
+}
+
 type GetDomainsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetDomainsParams
 }
 
 // Returns supported domains.
 func (d *Client) GetDomains() *GetDomainsRequest {
-	return &GetDomainsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetDomainsRequest{client: d.Client, params: &GetDomainsParams{}}
+}
+
+// DoGetDomains sends Schema.getDomains with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetDomains(ctx context.Context, params *GetDomainsParams) (*GetDomainsResult, error) {
+	if params == nil {
+		params = new(GetDomainsParams)
+	}
+	return (&GetDomainsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetDomainsResult struct {
@@ -36,10 +50,32 @@ type GetDomainsResult struct {
 }
 
 func (r *GetDomainsRequest) Do() (*GetDomainsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetDomainsRequest) DoContext(ctx context.Context) (*GetDomainsResult, error) {
 	var result GetDomainsResult
-	err := r.client.Call("Schema.getDomains", r.opts, &result)
+	err := r.client.CallContext(ctx, "Schema.getDomains", r.params, &result)
 	return &result, err
 }
 
+type GetDomainsFuture struct {
+	*rpc.Call
+	result GetDomainsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetDomainsFuture) Get() (*GetDomainsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetDomainsRequest) DoAsync(ctx context.Context) *GetDomainsFuture {
+	f := new(GetDomainsFuture)
+	f.Call = r.client.Go(ctx, "Schema.getDomains", r.params, &f.result)
+	return f
+}
+
 func init() {
 }
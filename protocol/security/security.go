@@ -2,6 +2,8 @@
 package security
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -18,6 +20,24 @@ type CertificateId int
 
 type SecurityState string
 
+const (
+	SecurityStateUnknown        SecurityState = "unknown"
+	SecurityStateNeutral        SecurityState = "neutral"
+	SecurityStateInsecure       SecurityState = "insecure"
+	SecurityStateSecure         SecurityState = "secure"
+	SecurityStateInfo           SecurityState = "info"
+	SecurityStateInsecureBroken SecurityState = "insecure-broken"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v SecurityState) IsValid() bool {
+	switch v {
+	case SecurityStateUnknown, SecurityStateNeutral, SecurityStateInsecure, SecurityStateSecure, SecurityStateInfo, SecurityStateInsecureBroken:
+		return true
+	}
+	return false
+}
+
 // An explanation of an factor contributing to the security state.
 
 type SecurityStateExplanation struct {
@@ -63,92 +83,262 @@ type InsecureContentStatus struct {
 
 type CertificateErrorAction string
 
+const (
+	CertificateErrorActionContinue CertificateErrorAction = "continue"
+	CertificateErrorActionCancel   CertificateErrorAction = "cancel"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v CertificateErrorAction) IsValid() bool {
+	switch v {
+	case CertificateErrorActionContinue, CertificateErrorActionCancel:
+		return true
+	}
+	return false
+}
+
+// EnableParams are the parameters of Security.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 // Enables tracking security state changes.
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends Security.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("Security.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "Security", r.params)
+}
+
+// DisableParams are the parameters of Security.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 // Disables tracking security state changes.
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends Security.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("Security.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "Security", r.params)
+}
+
+// ShowCertificateViewerParams are the parameters of Security.showCertificateViewer.
+type ShowCertificateViewerParams struct {
 }
 
 type ShowCertificateViewerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ShowCertificateViewerParams
 }
 
 // Displays native dialog with the certificate details.
 func (d *Client) ShowCertificateViewer() *ShowCertificateViewerRequest {
-	return &ShowCertificateViewerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ShowCertificateViewerRequest{client: d.Client, params: &ShowCertificateViewerParams{}}
+}
+
+// DoShowCertificateViewer sends Security.showCertificateViewer with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoShowCertificateViewer(ctx context.Context, params *ShowCertificateViewerParams) error {
+	if params == nil {
+		params = new(ShowCertificateViewerParams)
+	}
+	return (&ShowCertificateViewerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ShowCertificateViewerRequest) Do() error {
-	return r.client.Call("Security.showCertificateViewer", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ShowCertificateViewerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Security.showCertificateViewer", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ShowCertificateViewerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Security.showCertificateViewer", r.params, nil)
+}
+
+// HandleCertificateErrorParams are the parameters of Security.handleCertificateError.
+type HandleCertificateErrorParams struct {
+	// The ID of the event.
+	EventId int `json:"eventId"`
+
+	// The action to take on the certificate error.
+	Action CertificateErrorAction `json:"action"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *HandleCertificateErrorParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "eventId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Action == "" {
+		missing = append(missing, "action")
+	} else if !p.Action.IsValid() {
+		invalid = append(invalid, "action")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Security.handleCertificateError", Missing: missing, Invalid: invalid}
 }
 
 type HandleCertificateErrorRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *HandleCertificateErrorParams
 }
 
 // Handles a certificate error that fired a certificateError event.
 func (d *Client) HandleCertificateError() *HandleCertificateErrorRequest {
-	return &HandleCertificateErrorRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &HandleCertificateErrorRequest{client: d.Client, params: &HandleCertificateErrorParams{set: 1}}
+}
+
+// DoHandleCertificateError sends Security.handleCertificateError with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoHandleCertificateError(ctx context.Context, params *HandleCertificateErrorParams) error {
+	if params == nil {
+		params = new(HandleCertificateErrorParams)
+	}
+	return (&HandleCertificateErrorRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The ID of the event.
 func (r *HandleCertificateErrorRequest) EventId(v int) *HandleCertificateErrorRequest {
-	r.opts["eventId"] = v
+	r.params.EventId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // The action to take on the certificate error.
 func (r *HandleCertificateErrorRequest) Action(v CertificateErrorAction) *HandleCertificateErrorRequest {
-	r.opts["action"] = v
+	r.params.Action = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *HandleCertificateErrorRequest) Do() error {
-	return r.client.Call("Security.handleCertificateError", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *HandleCertificateErrorRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Security.handleCertificateError", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *HandleCertificateErrorRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Security.handleCertificateError", r.params, nil)
+}
+
+// SetOverrideCertificateErrorsParams are the parameters of Security.setOverrideCertificateErrors.
+type SetOverrideCertificateErrorsParams struct {
+	// If true, certificate errors will be overridden.
+	Override bool `json:"override"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetOverrideCertificateErrorsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "override")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Security.setOverrideCertificateErrors", Missing: missing, Invalid: invalid}
 }
 
 type SetOverrideCertificateErrorsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetOverrideCertificateErrorsParams
 }
 
 // Enable/disable overriding certificate errors. If enabled, all certificate error events need to be handled by the DevTools client and should be answered with handleCertificateError commands.
 func (d *Client) SetOverrideCertificateErrors() *SetOverrideCertificateErrorsRequest {
-	return &SetOverrideCertificateErrorsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetOverrideCertificateErrorsRequest{client: d.Client, params: &SetOverrideCertificateErrorsParams{set: 1}}
+}
+
+// DoSetOverrideCertificateErrors sends Security.setOverrideCertificateErrors with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetOverrideCertificateErrors(ctx context.Context, params *SetOverrideCertificateErrorsParams) error {
+	if params == nil {
+		params = new(SetOverrideCertificateErrorsParams)
+	}
+	return (&SetOverrideCertificateErrorsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // If true, certificate errors will be overridden.
 func (r *SetOverrideCertificateErrorsRequest) Override(v bool) *SetOverrideCertificateErrorsRequest {
-	r.opts["override"] = v
+	r.params.Override = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetOverrideCertificateErrorsRequest) Do() error {
-	return r.client.Call("Security.setOverrideCertificateErrors", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetOverrideCertificateErrorsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Security.setOverrideCertificateErrors", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetOverrideCertificateErrorsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Security.setOverrideCertificateErrors", r.params, nil)
 }
 
 func init() {
@@ -171,7 +361,46 @@ type SecurityStateChangedEvent struct {
 	InsecureContentStatus *InsecureContentStatus `json:"insecureContentStatus"`
 
 	// Overrides user-visible description of the state. (optional)
-	Summary string `json:"summary"`
+	Summary *string `json:"summary,omitempty"`
+}
+
+// The security state of the page changed.
+func (d *Client) OnSecurityStateChanged(fn func(*SecurityStateChangedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Security.securityStateChanged", func(e interface{}) {
+		fn(e.(*SecurityStateChangedEvent))
+	}, opts...)
+}
+
+// FilterSecurityStateChanged is rpc.WithFilter for OnSecurityStateChanged.
+func FilterSecurityStateChanged(fn func(*SecurityStateChangedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*SecurityStateChangedEvent))
+	})
+}
+
+// SecurityStateChangedWaiter waits for a Security.securityStateChanged event. It is created by WaitForSecurityStateChanged.
+type SecurityStateChangedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *SecurityStateChangedWaiter) Wait() (*SecurityStateChangedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*SecurityStateChangedEvent), nil
+}
+
+// WaitForSecurityStateChanged arms a waiter for the first Security.securityStateChanged event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForSecurityStateChanged(ctx context.Context, predicate func(*SecurityStateChangedEvent) bool) *SecurityStateChangedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*SecurityStateChangedEvent))
+		}
+	}
+	return &SecurityStateChangedWaiter{d.Client.WaitFor(ctx, "Security.securityStateChanged", p)}
 }
 
 // There is a certificate error. If overriding certificate errors is enabled, then it should be handled with the handleCertificateError command. Note: this event does not fire if the certificate error has been allowed internally.
@@ -185,3 +414,42 @@ type CertificateErrorEvent struct {
 	// The url that was requested.
 	RequestURL string `json:"requestURL"`
 }
+
+// There is a certificate error. If overriding certificate errors is enabled, then it should be handled with the handleCertificateError command. Note: this event does not fire if the certificate error has been allowed internally.
+func (d *Client) OnCertificateError(fn func(*CertificateErrorEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Security.certificateError", func(e interface{}) {
+		fn(e.(*CertificateErrorEvent))
+	}, opts...)
+}
+
+// FilterCertificateError is rpc.WithFilter for OnCertificateError.
+func FilterCertificateError(fn func(*CertificateErrorEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*CertificateErrorEvent))
+	})
+}
+
+// CertificateErrorWaiter waits for a Security.certificateError event. It is created by WaitForCertificateError.
+type CertificateErrorWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *CertificateErrorWaiter) Wait() (*CertificateErrorEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*CertificateErrorEvent), nil
+}
+
+// WaitForCertificateError arms a waiter for the first Security.certificateError event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForCertificateError(ctx context.Context, predicate func(*CertificateErrorEvent) bool) *CertificateErrorWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*CertificateErrorEvent))
+		}
+	}
+	return &CertificateErrorWaiter{d.Client.WaitFor(ctx, "Security.certificateError", p)}
+}
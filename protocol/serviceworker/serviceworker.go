@@ -2,6 +2,8 @@
 package serviceworker
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/target"
@@ -24,8 +26,42 @@ type ServiceWorkerRegistration struct {
 
 type ServiceWorkerVersionRunningStatus string
 
+const (
+	ServiceWorkerVersionRunningStatusStopped  ServiceWorkerVersionRunningStatus = "stopped"
+	ServiceWorkerVersionRunningStatusStarting ServiceWorkerVersionRunningStatus = "starting"
+	ServiceWorkerVersionRunningStatusRunning  ServiceWorkerVersionRunningStatus = "running"
+	ServiceWorkerVersionRunningStatusStopping ServiceWorkerVersionRunningStatus = "stopping"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ServiceWorkerVersionRunningStatus) IsValid() bool {
+	switch v {
+	case ServiceWorkerVersionRunningStatusStopped, ServiceWorkerVersionRunningStatusStarting, ServiceWorkerVersionRunningStatusRunning, ServiceWorkerVersionRunningStatusStopping:
+		return true
+	}
+	return false
+}
+
 type ServiceWorkerVersionStatus string
 
+const (
+	ServiceWorkerVersionStatusNew        ServiceWorkerVersionStatus = "new"
+	ServiceWorkerVersionStatusInstalling ServiceWorkerVersionStatus = "installing"
+	ServiceWorkerVersionStatusInstalled  ServiceWorkerVersionStatus = "installed"
+	ServiceWorkerVersionStatusActivating ServiceWorkerVersionStatus = "activating"
+	ServiceWorkerVersionStatusActivated  ServiceWorkerVersionStatus = "activated"
+	ServiceWorkerVersionStatusRedundant  ServiceWorkerVersionStatus = "redundant"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v ServiceWorkerVersionStatus) IsValid() bool {
+	switch v {
+	case ServiceWorkerVersionStatusNew, ServiceWorkerVersionStatusInstalling, ServiceWorkerVersionStatusInstalled, ServiceWorkerVersionStatusActivating, ServiceWorkerVersionStatusActivated, ServiceWorkerVersionStatusRedundant:
+		return true
+	}
+	return false
+}
+
 // ServiceWorker version.
 
 type ServiceWorkerVersion struct {
@@ -40,16 +76,16 @@ type ServiceWorkerVersion struct {
 	Status ServiceWorkerVersionStatus `json:"status"`
 
 	// The Last-Modified header value of the main script. (optional)
-	ScriptLastModified float64 `json:"scriptLastModified,omitempty"`
+	ScriptLastModified *float64 `json:"scriptLastModified,omitempty"`
 
 	// The time at which the response headers of the main script were received from the server.  For cached script it is the last time the cache entry was validated. (optional)
-	ScriptResponseTime float64 `json:"scriptResponseTime,omitempty"`
+	ScriptResponseTime *float64 `json:"scriptResponseTime,omitempty"`
 
 	// (optional)
 	ControlledClients []target.TargetID `json:"controlledClients,omitempty"`
 
 	// (optional)
-	TargetId target.TargetID `json:"targetId,omitempty"`
+	TargetId *target.TargetID `json:"targetId,omitempty"`
 }
 
 // ServiceWorker error message.
@@ -68,217 +104,651 @@ type ServiceWorkerErrorMessage struct {
 	ColumnNumber int `json:"columnNumber"`
 }
 
+// EnableParams are the parameters of ServiceWorker.enable.
+type EnableParams struct {
+}
+
 type EnableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EnableParams
 }
 
 func (d *Client) Enable() *EnableRequest {
-	return &EnableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EnableRequest{client: d.Client, params: &EnableParams{}}
+}
+
+// DoEnable sends ServiceWorker.enable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnable(ctx context.Context, params *EnableParams) error {
+	if params == nil {
+		params = new(EnableParams)
+	}
+	return (&EnableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EnableRequest) Do() error {
-	return r.client.Call("ServiceWorker.enable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext enables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *EnableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync enables the domain without waiting for it to complete.
+func (r *EnableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.EnableDomain(ctx, "ServiceWorker", r.params)
+}
+
+// DisableParams are the parameters of ServiceWorker.disable.
+type DisableParams struct {
 }
 
 type DisableRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisableParams
 }
 
 func (d *Client) Disable() *DisableRequest {
-	return &DisableRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisableRequest{client: d.Client, params: &DisableParams{}}
+}
+
+// DoDisable sends ServiceWorker.disable with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisable(ctx context.Context, params *DisableParams) error {
+	if params == nil {
+		params = new(DisableParams)
+	}
+	return (&DisableRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisableRequest) Do() error {
-	return r.client.Call("ServiceWorker.disable", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+// DoContext disables the domain. Enabling is reference counted, see rpc.Client.EnableDomain.
+func (r *DisableRequest) DoContext(ctx context.Context) error {
+	return r.DoAsync(ctx).Wait()
+}
+
+// DoAsync disables the domain without waiting for it to complete.
+func (r *DisableRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.DisableDomain(ctx, "ServiceWorker", r.params)
+}
+
+// UnregisterParams are the parameters of ServiceWorker.unregister.
+type UnregisterParams struct {
+	ScopeURL string `json:"scopeURL"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *UnregisterParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScopeURL == "" {
+		missing = append(missing, "scopeURL")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.unregister", Missing: missing, Invalid: invalid}
 }
 
 type UnregisterRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *UnregisterParams
 }
 
 func (d *Client) Unregister() *UnregisterRequest {
-	return &UnregisterRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &UnregisterRequest{client: d.Client, params: &UnregisterParams{set: 1}}
+}
+
+// DoUnregister sends ServiceWorker.unregister with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoUnregister(ctx context.Context, params *UnregisterParams) error {
+	if params == nil {
+		params = new(UnregisterParams)
+	}
+	return (&UnregisterRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *UnregisterRequest) ScopeURL(v string) *UnregisterRequest {
-	r.opts["scopeURL"] = v
+	r.params.ScopeURL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *UnregisterRequest) Do() error {
-	return r.client.Call("ServiceWorker.unregister", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *UnregisterRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.unregister", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *UnregisterRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.unregister", r.params, nil)
+}
+
+// UpdateRegistrationParams are the parameters of ServiceWorker.updateRegistration.
+type UpdateRegistrationParams struct {
+	ScopeURL string `json:"scopeURL"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *UpdateRegistrationParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScopeURL == "" {
+		missing = append(missing, "scopeURL")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.updateRegistration", Missing: missing, Invalid: invalid}
 }
 
 type UpdateRegistrationRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *UpdateRegistrationParams
 }
 
 func (d *Client) UpdateRegistration() *UpdateRegistrationRequest {
-	return &UpdateRegistrationRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &UpdateRegistrationRequest{client: d.Client, params: &UpdateRegistrationParams{set: 1}}
+}
+
+// DoUpdateRegistration sends ServiceWorker.updateRegistration with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoUpdateRegistration(ctx context.Context, params *UpdateRegistrationParams) error {
+	if params == nil {
+		params = new(UpdateRegistrationParams)
+	}
+	return (&UpdateRegistrationRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *UpdateRegistrationRequest) ScopeURL(v string) *UpdateRegistrationRequest {
-	r.opts["scopeURL"] = v
+	r.params.ScopeURL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *UpdateRegistrationRequest) Do() error {
-	return r.client.Call("ServiceWorker.updateRegistration", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *UpdateRegistrationRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.updateRegistration", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *UpdateRegistrationRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.updateRegistration", r.params, nil)
+}
+
+// StartWorkerParams are the parameters of ServiceWorker.startWorker.
+type StartWorkerParams struct {
+	ScopeURL string `json:"scopeURL"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StartWorkerParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScopeURL == "" {
+		missing = append(missing, "scopeURL")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.startWorker", Missing: missing, Invalid: invalid}
 }
 
 type StartWorkerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartWorkerParams
 }
 
 func (d *Client) StartWorker() *StartWorkerRequest {
-	return &StartWorkerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartWorkerRequest{client: d.Client, params: &StartWorkerParams{set: 1}}
+}
+
+// DoStartWorker sends ServiceWorker.startWorker with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStartWorker(ctx context.Context, params *StartWorkerParams) error {
+	if params == nil {
+		params = new(StartWorkerParams)
+	}
+	return (&StartWorkerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StartWorkerRequest) ScopeURL(v string) *StartWorkerRequest {
-	r.opts["scopeURL"] = v
+	r.params.ScopeURL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *StartWorkerRequest) Do() error {
-	return r.client.Call("ServiceWorker.startWorker", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartWorkerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.startWorker", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartWorkerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.startWorker", r.params, nil)
+}
+
+// SkipWaitingParams are the parameters of ServiceWorker.skipWaiting.
+type SkipWaitingParams struct {
+	ScopeURL string `json:"scopeURL"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SkipWaitingParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.ScopeURL == "" {
+		missing = append(missing, "scopeURL")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.skipWaiting", Missing: missing, Invalid: invalid}
 }
 
 type SkipWaitingRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SkipWaitingParams
 }
 
 func (d *Client) SkipWaiting() *SkipWaitingRequest {
-	return &SkipWaitingRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SkipWaitingRequest{client: d.Client, params: &SkipWaitingParams{set: 1}}
+}
+
+// DoSkipWaiting sends ServiceWorker.skipWaiting with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSkipWaiting(ctx context.Context, params *SkipWaitingParams) error {
+	if params == nil {
+		params = new(SkipWaitingParams)
+	}
+	return (&SkipWaitingRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SkipWaitingRequest) ScopeURL(v string) *SkipWaitingRequest {
-	r.opts["scopeURL"] = v
+	r.params.ScopeURL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SkipWaitingRequest) Do() error {
-	return r.client.Call("ServiceWorker.skipWaiting", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SkipWaitingRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.skipWaiting", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SkipWaitingRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.skipWaiting", r.params, nil)
+}
+
+// StopWorkerParams are the parameters of ServiceWorker.stopWorker.
+type StopWorkerParams struct {
+	VersionId string `json:"versionId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StopWorkerParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.VersionId == "" {
+		missing = append(missing, "versionId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.stopWorker", Missing: missing, Invalid: invalid}
 }
 
 type StopWorkerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StopWorkerParams
 }
 
 func (d *Client) StopWorker() *StopWorkerRequest {
-	return &StopWorkerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StopWorkerRequest{client: d.Client, params: &StopWorkerParams{set: 1}}
+}
+
+// DoStopWorker sends ServiceWorker.stopWorker with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStopWorker(ctx context.Context, params *StopWorkerParams) error {
+	if params == nil {
+		params = new(StopWorkerParams)
+	}
+	return (&StopWorkerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *StopWorkerRequest) VersionId(v string) *StopWorkerRequest {
-	r.opts["versionId"] = v
+	r.params.VersionId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *StopWorkerRequest) Do() error {
-	return r.client.Call("ServiceWorker.stopWorker", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StopWorkerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.stopWorker", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StopWorkerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.stopWorker", r.params, nil)
+}
+
+// InspectWorkerParams are the parameters of ServiceWorker.inspectWorker.
+type InspectWorkerParams struct {
+	VersionId string `json:"versionId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *InspectWorkerParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.VersionId == "" {
+		missing = append(missing, "versionId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.inspectWorker", Missing: missing, Invalid: invalid}
 }
 
 type InspectWorkerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *InspectWorkerParams
 }
 
 func (d *Client) InspectWorker() *InspectWorkerRequest {
-	return &InspectWorkerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &InspectWorkerRequest{client: d.Client, params: &InspectWorkerParams{set: 1}}
+}
+
+// DoInspectWorker sends ServiceWorker.inspectWorker with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoInspectWorker(ctx context.Context, params *InspectWorkerParams) error {
+	if params == nil {
+		params = new(InspectWorkerParams)
+	}
+	return (&InspectWorkerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *InspectWorkerRequest) VersionId(v string) *InspectWorkerRequest {
-	r.opts["versionId"] = v
+	r.params.VersionId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *InspectWorkerRequest) Do() error {
-	return r.client.Call("ServiceWorker.inspectWorker", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *InspectWorkerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.inspectWorker", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *InspectWorkerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.inspectWorker", r.params, nil)
+}
+
+// SetForceUpdateOnPageLoadParams are the parameters of ServiceWorker.setForceUpdateOnPageLoad.
+type SetForceUpdateOnPageLoadParams struct {
+	ForceUpdateOnPageLoad bool `json:"forceUpdateOnPageLoad"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetForceUpdateOnPageLoadParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "forceUpdateOnPageLoad")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.setForceUpdateOnPageLoad", Missing: missing, Invalid: invalid}
 }
 
 type SetForceUpdateOnPageLoadRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetForceUpdateOnPageLoadParams
 }
 
 func (d *Client) SetForceUpdateOnPageLoad() *SetForceUpdateOnPageLoadRequest {
-	return &SetForceUpdateOnPageLoadRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetForceUpdateOnPageLoadRequest{client: d.Client, params: &SetForceUpdateOnPageLoadParams{set: 1}}
+}
+
+// DoSetForceUpdateOnPageLoad sends ServiceWorker.setForceUpdateOnPageLoad with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetForceUpdateOnPageLoad(ctx context.Context, params *SetForceUpdateOnPageLoadParams) error {
+	if params == nil {
+		params = new(SetForceUpdateOnPageLoadParams)
+	}
+	return (&SetForceUpdateOnPageLoadRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SetForceUpdateOnPageLoadRequest) ForceUpdateOnPageLoad(v bool) *SetForceUpdateOnPageLoadRequest {
-	r.opts["forceUpdateOnPageLoad"] = v
+	r.params.ForceUpdateOnPageLoad = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetForceUpdateOnPageLoadRequest) Do() error {
-	return r.client.Call("ServiceWorker.setForceUpdateOnPageLoad", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetForceUpdateOnPageLoadRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.setForceUpdateOnPageLoad", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetForceUpdateOnPageLoadRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.setForceUpdateOnPageLoad", r.params, nil)
+}
+
+// DeliverPushMessageParams are the parameters of ServiceWorker.deliverPushMessage.
+type DeliverPushMessageParams struct {
+	Origin string `json:"origin"`
+
+	RegistrationId string `json:"registrationId"`
+
+	Data string `json:"data"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DeliverPushMessageParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Origin == "" {
+		missing = append(missing, "origin")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.RegistrationId == "" {
+		missing = append(missing, "registrationId")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Data == "" {
+		missing = append(missing, "data")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.deliverPushMessage", Missing: missing, Invalid: invalid}
 }
 
 type DeliverPushMessageRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DeliverPushMessageParams
 }
 
 func (d *Client) DeliverPushMessage() *DeliverPushMessageRequest {
-	return &DeliverPushMessageRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DeliverPushMessageRequest{client: d.Client, params: &DeliverPushMessageParams{set: 1}}
+}
+
+// DoDeliverPushMessage sends ServiceWorker.deliverPushMessage with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDeliverPushMessage(ctx context.Context, params *DeliverPushMessageParams) error {
+	if params == nil {
+		params = new(DeliverPushMessageParams)
+	}
+	return (&DeliverPushMessageRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DeliverPushMessageRequest) Origin(v string) *DeliverPushMessageRequest {
-	r.opts["origin"] = v
+	r.params.Origin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *DeliverPushMessageRequest) RegistrationId(v string) *DeliverPushMessageRequest {
-	r.opts["registrationId"] = v
+	r.params.RegistrationId = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *DeliverPushMessageRequest) Data(v string) *DeliverPushMessageRequest {
-	r.opts["data"] = v
+	r.params.Data = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *DeliverPushMessageRequest) Do() error {
-	return r.client.Call("ServiceWorker.deliverPushMessage", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DeliverPushMessageRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.deliverPushMessage", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DeliverPushMessageRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.deliverPushMessage", r.params, nil)
+}
+
+// DispatchSyncEventParams are the parameters of ServiceWorker.dispatchSyncEvent.
+type DispatchSyncEventParams struct {
+	Origin string `json:"origin"`
+
+	RegistrationId string `json:"registrationId"`
+
+	Tag string `json:"tag"`
+
+	LastChance bool `json:"lastChance"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DispatchSyncEventParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Origin == "" {
+		missing = append(missing, "origin")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.RegistrationId == "" {
+		missing = append(missing, "registrationId")
+	}
+	if p.set&1 != 0 && p.set&(1<<3) == 0 || p.set&1 == 0 && p.Tag == "" {
+		missing = append(missing, "tag")
+	}
+	if p.set&1 != 0 && p.set&(1<<4) == 0 {
+		missing = append(missing, "lastChance")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "ServiceWorker.dispatchSyncEvent", Missing: missing, Invalid: invalid}
 }
 
 type DispatchSyncEventRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DispatchSyncEventParams
 }
 
 func (d *Client) DispatchSyncEvent() *DispatchSyncEventRequest {
-	return &DispatchSyncEventRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DispatchSyncEventRequest{client: d.Client, params: &DispatchSyncEventParams{set: 1}}
+}
+
+// DoDispatchSyncEvent sends ServiceWorker.dispatchSyncEvent with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDispatchSyncEvent(ctx context.Context, params *DispatchSyncEventParams) error {
+	if params == nil {
+		params = new(DispatchSyncEventParams)
+	}
+	return (&DispatchSyncEventRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DispatchSyncEventRequest) Origin(v string) *DispatchSyncEventRequest {
-	r.opts["origin"] = v
+	r.params.Origin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *DispatchSyncEventRequest) RegistrationId(v string) *DispatchSyncEventRequest {
-	r.opts["registrationId"] = v
+	r.params.RegistrationId = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *DispatchSyncEventRequest) Tag(v string) *DispatchSyncEventRequest {
-	r.opts["tag"] = v
+	r.params.Tag = v
+	r.params.set |= 1 << 3
 	return r
 }
 
 func (r *DispatchSyncEventRequest) LastChance(v bool) *DispatchSyncEventRequest {
-	r.opts["lastChance"] = v
+	r.params.LastChance = v
+	r.params.set |= 1 << 4
 	return r
 }
 
 func (r *DispatchSyncEventRequest) Do() error {
-	return r.client.Call("ServiceWorker.dispatchSyncEvent", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DispatchSyncEventRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "ServiceWorker.dispatchSyncEvent", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DispatchSyncEventRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "ServiceWorker.dispatchSyncEvent", r.params, nil)
 }
 
 func init() {
@@ -291,10 +761,124 @@ type WorkerRegistrationUpdatedEvent struct {
 	Registrations []*ServiceWorkerRegistration `json:"registrations"`
 }
 
+func (d *Client) OnWorkerRegistrationUpdated(fn func(*WorkerRegistrationUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("ServiceWorker.workerRegistrationUpdated", func(e interface{}) {
+		fn(e.(*WorkerRegistrationUpdatedEvent))
+	}, opts...)
+}
+
+// FilterWorkerRegistrationUpdated is rpc.WithFilter for OnWorkerRegistrationUpdated.
+func FilterWorkerRegistrationUpdated(fn func(*WorkerRegistrationUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WorkerRegistrationUpdatedEvent))
+	})
+}
+
+// WorkerRegistrationUpdatedWaiter waits for a ServiceWorker.workerRegistrationUpdated event. It is created by WaitForWorkerRegistrationUpdated.
+type WorkerRegistrationUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WorkerRegistrationUpdatedWaiter) Wait() (*WorkerRegistrationUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WorkerRegistrationUpdatedEvent), nil
+}
+
+// WaitForWorkerRegistrationUpdated arms a waiter for the first ServiceWorker.workerRegistrationUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWorkerRegistrationUpdated(ctx context.Context, predicate func(*WorkerRegistrationUpdatedEvent) bool) *WorkerRegistrationUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WorkerRegistrationUpdatedEvent))
+		}
+	}
+	return &WorkerRegistrationUpdatedWaiter{d.Client.WaitFor(ctx, "ServiceWorker.workerRegistrationUpdated", p)}
+}
+
 type WorkerVersionUpdatedEvent struct {
 	Versions []*ServiceWorkerVersion `json:"versions"`
 }
 
+func (d *Client) OnWorkerVersionUpdated(fn func(*WorkerVersionUpdatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("ServiceWorker.workerVersionUpdated", func(e interface{}) {
+		fn(e.(*WorkerVersionUpdatedEvent))
+	}, opts...)
+}
+
+// FilterWorkerVersionUpdated is rpc.WithFilter for OnWorkerVersionUpdated.
+func FilterWorkerVersionUpdated(fn func(*WorkerVersionUpdatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WorkerVersionUpdatedEvent))
+	})
+}
+
+// WorkerVersionUpdatedWaiter waits for a ServiceWorker.workerVersionUpdated event. It is created by WaitForWorkerVersionUpdated.
+type WorkerVersionUpdatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WorkerVersionUpdatedWaiter) Wait() (*WorkerVersionUpdatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WorkerVersionUpdatedEvent), nil
+}
+
+// WaitForWorkerVersionUpdated arms a waiter for the first ServiceWorker.workerVersionUpdated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWorkerVersionUpdated(ctx context.Context, predicate func(*WorkerVersionUpdatedEvent) bool) *WorkerVersionUpdatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WorkerVersionUpdatedEvent))
+		}
+	}
+	return &WorkerVersionUpdatedWaiter{d.Client.WaitFor(ctx, "ServiceWorker.workerVersionUpdated", p)}
+}
+
 type WorkerErrorReportedEvent struct {
 	ErrorMessage *ServiceWorkerErrorMessage `json:"errorMessage"`
 }
+
+func (d *Client) OnWorkerErrorReported(fn func(*WorkerErrorReportedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("ServiceWorker.workerErrorReported", func(e interface{}) {
+		fn(e.(*WorkerErrorReportedEvent))
+	}, opts...)
+}
+
+// FilterWorkerErrorReported is rpc.WithFilter for OnWorkerErrorReported.
+func FilterWorkerErrorReported(fn func(*WorkerErrorReportedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*WorkerErrorReportedEvent))
+	})
+}
+
+// WorkerErrorReportedWaiter waits for a ServiceWorker.workerErrorReported event. It is created by WaitForWorkerErrorReported.
+type WorkerErrorReportedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *WorkerErrorReportedWaiter) Wait() (*WorkerErrorReportedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*WorkerErrorReportedEvent), nil
+}
+
+// WaitForWorkerErrorReported arms a waiter for the first ServiceWorker.workerErrorReported event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForWorkerErrorReported(ctx context.Context, predicate func(*WorkerErrorReportedEvent) bool) *WorkerErrorReportedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*WorkerErrorReportedEvent))
+		}
+	}
+	return &WorkerErrorReportedWaiter{d.Client.WaitFor(ctx, "ServiceWorker.workerErrorReported", p)}
+}
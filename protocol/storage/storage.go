@@ -2,6 +2,8 @@
 package storage
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -14,30 +16,103 @@ type Client struct {
 
 type StorageType string
 
+const (
+	StorageTypeCookies        StorageType = "cookies"
+	StorageTypeFileSystems    StorageType = "file_systems"
+	StorageTypeIndexeddb      StorageType = "indexeddb"
+	StorageTypeLocalStorage   StorageType = "local_storage"
+	StorageTypeShaderCache    StorageType = "shader_cache"
+	StorageTypeWebsql         StorageType = "websql"
+	StorageTypeServiceWorkers StorageType = "service_workers"
+	StorageTypeCacheStorage   StorageType = "cache_storage"
+	StorageTypeInterestGroups StorageType = "interest_groups"
+	StorageTypeSharedStorage  StorageType = "shared_storage"
+	StorageTypeStorageBuckets StorageType = "storage_buckets"
+	StorageTypeAll            StorageType = "all"
+	StorageTypeOther          StorageType = "other"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v StorageType) IsValid() bool {
+	switch v {
+	case StorageTypeCookies, StorageTypeFileSystems, StorageTypeIndexeddb, StorageTypeLocalStorage, StorageTypeShaderCache, StorageTypeWebsql, StorageTypeServiceWorkers, StorageTypeCacheStorage, StorageTypeInterestGroups, StorageTypeSharedStorage, StorageTypeStorageBuckets, StorageTypeAll, StorageTypeOther:
+		return true
+	}
+	return false
+}
+
+// ClearDataForOriginParams are the parameters of Storage.clearDataForOrigin.
+type ClearDataForOriginParams struct {
+	// Security origin.
+	Origin string `json:"origin"`
+
+	// Comma separated origin names.
+	StorageTypes string `json:"storageTypes"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ClearDataForOriginParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.Origin == "" {
+		missing = append(missing, "origin")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.StorageTypes == "" {
+		missing = append(missing, "storageTypes")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Storage.clearDataForOrigin", Missing: missing, Invalid: invalid}
+}
+
 type ClearDataForOriginRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ClearDataForOriginParams
 }
 
 // Clears storage for origin.
 func (d *Client) ClearDataForOrigin() *ClearDataForOriginRequest {
-	return &ClearDataForOriginRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ClearDataForOriginRequest{client: d.Client, params: &ClearDataForOriginParams{set: 1}}
+}
+
+// DoClearDataForOrigin sends Storage.clearDataForOrigin with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoClearDataForOrigin(ctx context.Context, params *ClearDataForOriginParams) error {
+	if params == nil {
+		params = new(ClearDataForOriginParams)
+	}
+	return (&ClearDataForOriginRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Security origin.
 func (r *ClearDataForOriginRequest) Origin(v string) *ClearDataForOriginRequest {
-	r.opts["origin"] = v
+	r.params.Origin = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Comma separated origin names.
 func (r *ClearDataForOriginRequest) StorageTypes(v string) *ClearDataForOriginRequest {
-	r.opts["storageTypes"] = v
+	r.params.StorageTypes = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *ClearDataForOriginRequest) Do() error {
-	return r.client.Call("Storage.clearDataForOrigin", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ClearDataForOriginRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Storage.clearDataForOrigin", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ClearDataForOriginRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Storage.clearDataForOrigin", r.params, nil)
 }
 
 func init() {
@@ -2,6 +2,8 @@
 package systeminfo
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -42,14 +44,26 @@ type GPUInfo struct {
 	DriverBugWorkarounds []string `json:"driverBugWorkarounds"`
 }
 
+// GetInfoParams are the parameters of SystemInfo.getInfo.
+type GetInfoParams struct {
+}
+
 type GetInfoRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetInfoParams
 }
 
 // Returns information about the system.
 func (d *Client) GetInfo() *GetInfoRequest {
-	return &GetInfoRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetInfoRequest{client: d.Client, params: &GetInfoParams{}}
+}
+
+// DoGetInfo sends SystemInfo.getInfo with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetInfo(ctx context.Context, params *GetInfoParams) (*GetInfoResult, error) {
+	if params == nil {
+		params = new(GetInfoParams)
+	}
+	return (&GetInfoRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetInfoResult struct {
@@ -67,10 +81,32 @@ type GetInfoResult struct {
 }
 
 func (r *GetInfoRequest) Do() (*GetInfoResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetInfoRequest) DoContext(ctx context.Context) (*GetInfoResult, error) {
 	var result GetInfoResult
-	err := r.client.Call("SystemInfo.getInfo", r.opts, &result)
+	err := r.client.CallContext(ctx, "SystemInfo.getInfo", r.params, &result)
 	return &result, err
 }
 
+type GetInfoFuture struct {
+	*rpc.Call
+	result GetInfoResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetInfoFuture) Get() (*GetInfoResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetInfoRequest) DoAsync(ctx context.Context) *GetInfoFuture {
+	f := new(GetInfoFuture)
+	f.Call = r.client.Go(ctx, "SystemInfo.getInfo", r.params, &f.result)
+	return f
+}
+
 func init() {
 }
@@ -2,6 +2,8 @@
 package target
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -30,127 +32,375 @@ type RemoteLocation struct {
 	Port int `json:"port"`
 }
 
+// SetDiscoverTargetsParams are the parameters of Target.setDiscoverTargets.
+type SetDiscoverTargetsParams struct {
+	// Whether to discover available targets.
+	Discover bool `json:"discover"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetDiscoverTargetsParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "discover")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.setDiscoverTargets", Missing: missing, Invalid: invalid}
+}
+
 type SetDiscoverTargetsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetDiscoverTargetsParams
 }
 
 // Controls whether to discover available targets and notify via <code>targetCreated/targetDestroyed</code> events.
 func (d *Client) SetDiscoverTargets() *SetDiscoverTargetsRequest {
-	return &SetDiscoverTargetsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetDiscoverTargetsRequest{client: d.Client, params: &SetDiscoverTargetsParams{set: 1}}
+}
+
+// DoSetDiscoverTargets sends Target.setDiscoverTargets with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetDiscoverTargets(ctx context.Context, params *SetDiscoverTargetsParams) error {
+	if params == nil {
+		params = new(SetDiscoverTargetsParams)
+	}
+	return (&SetDiscoverTargetsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether to discover available targets.
 func (r *SetDiscoverTargetsRequest) Discover(v bool) *SetDiscoverTargetsRequest {
-	r.opts["discover"] = v
+	r.params.Discover = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetDiscoverTargetsRequest) Do() error {
-	return r.client.Call("Target.setDiscoverTargets", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetDiscoverTargetsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Target.setDiscoverTargets", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetDiscoverTargetsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Target.setDiscoverTargets", r.params, nil)
+}
+
+// SetAutoAttachParams are the parameters of Target.setAutoAttach.
+type SetAutoAttachParams struct {
+	// Whether to auto-attach to related targets.
+	AutoAttach bool `json:"autoAttach"`
+
+	// Whether to pause new targets when attaching to them. Use <code>Runtime.runIfWaitingForDebugger</code> to run paused targets.
+	WaitForDebuggerOnStart bool `json:"waitForDebuggerOnStart"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetAutoAttachParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "autoAttach")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 {
+		missing = append(missing, "waitForDebuggerOnStart")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.setAutoAttach", Missing: missing, Invalid: invalid}
 }
 
 type SetAutoAttachRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetAutoAttachParams
 }
 
 // Controls whether to automatically attach to new targets which are considered to be related to this one. When turned on, attaches to all existing related targets as well. When turned off, automatically detaches from all currently attached targets.
 func (d *Client) SetAutoAttach() *SetAutoAttachRequest {
-	return &SetAutoAttachRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetAutoAttachRequest{client: d.Client, params: &SetAutoAttachParams{set: 1}}
+}
+
+// DoSetAutoAttach sends Target.setAutoAttach with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetAutoAttach(ctx context.Context, params *SetAutoAttachParams) error {
+	if params == nil {
+		params = new(SetAutoAttachParams)
+	}
+	return (&SetAutoAttachRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether to auto-attach to related targets.
 func (r *SetAutoAttachRequest) AutoAttach(v bool) *SetAutoAttachRequest {
-	r.opts["autoAttach"] = v
+	r.params.AutoAttach = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Whether to pause new targets when attaching to them. Use <code>Runtime.runIfWaitingForDebugger</code> to run paused targets.
 func (r *SetAutoAttachRequest) WaitForDebuggerOnStart(v bool) *SetAutoAttachRequest {
-	r.opts["waitForDebuggerOnStart"] = v
+	r.params.WaitForDebuggerOnStart = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SetAutoAttachRequest) Do() error {
-	return r.client.Call("Target.setAutoAttach", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetAutoAttachRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Target.setAutoAttach", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetAutoAttachRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Target.setAutoAttach", r.params, nil)
+}
+
+// SetAttachToFramesParams are the parameters of Target.setAttachToFrames.
+type SetAttachToFramesParams struct {
+	// Whether to attach to frames.
+	Value bool `json:"value"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetAttachToFramesParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "value")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.setAttachToFrames", Missing: missing, Invalid: invalid}
 }
 
 type SetAttachToFramesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetAttachToFramesParams
 }
 
 func (d *Client) SetAttachToFrames() *SetAttachToFramesRequest {
-	return &SetAttachToFramesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetAttachToFramesRequest{client: d.Client, params: &SetAttachToFramesParams{set: 1}}
+}
+
+// DoSetAttachToFrames sends Target.setAttachToFrames with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetAttachToFrames(ctx context.Context, params *SetAttachToFramesParams) error {
+	if params == nil {
+		params = new(SetAttachToFramesParams)
+	}
+	return (&SetAttachToFramesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Whether to attach to frames.
 func (r *SetAttachToFramesRequest) Value(v bool) *SetAttachToFramesRequest {
-	r.opts["value"] = v
+	r.params.Value = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetAttachToFramesRequest) Do() error {
-	return r.client.Call("Target.setAttachToFrames", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetAttachToFramesRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Target.setAttachToFrames", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetAttachToFramesRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Target.setAttachToFrames", r.params, nil)
+}
+
+// SetRemoteLocationsParams are the parameters of Target.setRemoteLocations.
+type SetRemoteLocationsParams struct {
+	// List of remote locations.
+	Locations []*RemoteLocation `json:"locations"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SetRemoteLocationsParams) Validate() error {
+	var missing, invalid []string
+	if p.Locations == nil {
+		missing = append(missing, "locations")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.setRemoteLocations", Missing: missing, Invalid: invalid}
 }
 
 type SetRemoteLocationsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SetRemoteLocationsParams
 }
 
 // Enables target discovery for the specified locations, when <code>setDiscoverTargets</code> was set to <code>true</code>.
 func (d *Client) SetRemoteLocations() *SetRemoteLocationsRequest {
-	return &SetRemoteLocationsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SetRemoteLocationsRequest{client: d.Client, params: &SetRemoteLocationsParams{set: 1}}
+}
+
+// DoSetRemoteLocations sends Target.setRemoteLocations with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSetRemoteLocations(ctx context.Context, params *SetRemoteLocationsParams) error {
+	if params == nil {
+		params = new(SetRemoteLocationsParams)
+	}
+	return (&SetRemoteLocationsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // List of remote locations.
 func (r *SetRemoteLocationsRequest) Locations(v []*RemoteLocation) *SetRemoteLocationsRequest {
-	r.opts["locations"] = v
+	r.params.Locations = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SetRemoteLocationsRequest) Do() error {
-	return r.client.Call("Target.setRemoteLocations", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SetRemoteLocationsRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Target.setRemoteLocations", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SetRemoteLocationsRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Target.setRemoteLocations", r.params, nil)
+}
+
+// SendMessageToTargetParams are the parameters of Target.sendMessageToTarget.
+type SendMessageToTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+
+	Message string `json:"message"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *SendMessageToTargetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.TargetId == "" {
+		missing = append(missing, "targetId")
+	}
+	if p.set&1 != 0 && p.set&(1<<2) == 0 || p.set&1 == 0 && p.Message == "" {
+		missing = append(missing, "message")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.sendMessageToTarget", Missing: missing, Invalid: invalid}
 }
 
 type SendMessageToTargetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *SendMessageToTargetParams
 }
 
 // Sends protocol message to the target with given id.
 func (d *Client) SendMessageToTarget() *SendMessageToTargetRequest {
-	return &SendMessageToTargetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &SendMessageToTargetRequest{client: d.Client, params: &SendMessageToTargetParams{set: 1}}
+}
+
+// DoSendMessageToTarget sends Target.sendMessageToTarget with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoSendMessageToTarget(ctx context.Context, params *SendMessageToTargetParams) error {
+	if params == nil {
+		params = new(SendMessageToTargetParams)
+	}
+	return (&SendMessageToTargetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *SendMessageToTargetRequest) TargetId(v TargetID) *SendMessageToTargetRequest {
-	r.opts["targetId"] = v
+	r.params.TargetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *SendMessageToTargetRequest) Message(v string) *SendMessageToTargetRequest {
-	r.opts["message"] = v
+	r.params.Message = v
+	r.params.set |= 1 << 2
 	return r
 }
 
 func (r *SendMessageToTargetRequest) Do() error {
-	return r.client.Call("Target.sendMessageToTarget", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *SendMessageToTargetRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Target.sendMessageToTarget", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *SendMessageToTargetRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Target.sendMessageToTarget", r.params, nil)
+}
+
+// GetTargetInfoParams are the parameters of Target.getTargetInfo.
+type GetTargetInfoParams struct {
+	TargetId TargetID `json:"targetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *GetTargetInfoParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.TargetId == "" {
+		missing = append(missing, "targetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.getTargetInfo", Missing: missing, Invalid: invalid}
 }
 
 type GetTargetInfoRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetTargetInfoParams
 }
 
 // Returns information about a target.
 func (d *Client) GetTargetInfo() *GetTargetInfoRequest {
-	return &GetTargetInfoRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetTargetInfoRequest{client: d.Client, params: &GetTargetInfoParams{set: 1}}
+}
+
+// DoGetTargetInfo sends Target.getTargetInfo with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetTargetInfo(ctx context.Context, params *GetTargetInfoParams) (*GetTargetInfoResult, error) {
+	if params == nil {
+		params = new(GetTargetInfoParams)
+	}
+	return (&GetTargetInfoRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *GetTargetInfoRequest) TargetId(v TargetID) *GetTargetInfoRequest {
-	r.opts["targetId"] = v
+	r.params.TargetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -159,42 +409,135 @@ type GetTargetInfoResult struct {
 }
 
 func (r *GetTargetInfoRequest) Do() (*GetTargetInfoResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetTargetInfoRequest) DoContext(ctx context.Context) (*GetTargetInfoResult, error) {
 	var result GetTargetInfoResult
-	err := r.client.Call("Target.getTargetInfo", r.opts, &result)
+	err := r.client.CallContext(ctx, "Target.getTargetInfo", r.params, &result)
 	return &result, err
 }
 
+type GetTargetInfoFuture struct {
+	*rpc.Call
+	result GetTargetInfoResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetTargetInfoFuture) Get() (*GetTargetInfoResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetTargetInfoRequest) DoAsync(ctx context.Context) *GetTargetInfoFuture {
+	f := new(GetTargetInfoFuture)
+	f.Call = r.client.Go(ctx, "Target.getTargetInfo", r.params, &f.result)
+	return f
+}
+
+// ActivateTargetParams are the parameters of Target.activateTarget.
+type ActivateTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *ActivateTargetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.TargetId == "" {
+		missing = append(missing, "targetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.activateTarget", Missing: missing, Invalid: invalid}
+}
+
 type ActivateTargetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *ActivateTargetParams
 }
 
 // Activates (focuses) the target.
 func (d *Client) ActivateTarget() *ActivateTargetRequest {
-	return &ActivateTargetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &ActivateTargetRequest{client: d.Client, params: &ActivateTargetParams{set: 1}}
+}
+
+// DoActivateTarget sends Target.activateTarget with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoActivateTarget(ctx context.Context, params *ActivateTargetParams) error {
+	if params == nil {
+		params = new(ActivateTargetParams)
+	}
+	return (&ActivateTargetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *ActivateTargetRequest) TargetId(v TargetID) *ActivateTargetRequest {
-	r.opts["targetId"] = v
+	r.params.TargetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *ActivateTargetRequest) Do() error {
-	return r.client.Call("Target.activateTarget", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *ActivateTargetRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Target.activateTarget", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *ActivateTargetRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Target.activateTarget", r.params, nil)
+}
+
+// CloseTargetParams are the parameters of Target.closeTarget.
+type CloseTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CloseTargetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.TargetId == "" {
+		missing = append(missing, "targetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.closeTarget", Missing: missing, Invalid: invalid}
 }
 
 type CloseTargetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CloseTargetParams
 }
 
 // Closes the target. If the target is a page that gets closed too.
 func (d *Client) CloseTarget() *CloseTargetRequest {
-	return &CloseTargetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CloseTargetRequest{client: d.Client, params: &CloseTargetParams{set: 1}}
+}
+
+// DoCloseTarget sends Target.closeTarget with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCloseTarget(ctx context.Context, params *CloseTargetParams) (*CloseTargetResult, error) {
+	if params == nil {
+		params = new(CloseTargetParams)
+	}
+	return (&CloseTargetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *CloseTargetRequest) TargetId(v TargetID) *CloseTargetRequest {
-	r.opts["targetId"] = v
+	r.params.TargetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -203,23 +546,76 @@ type CloseTargetResult struct {
 }
 
 func (r *CloseTargetRequest) Do() (*CloseTargetResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CloseTargetRequest) DoContext(ctx context.Context) (*CloseTargetResult, error) {
 	var result CloseTargetResult
-	err := r.client.Call("Target.closeTarget", r.opts, &result)
+	err := r.client.CallContext(ctx, "Target.closeTarget", r.params, &result)
 	return &result, err
 }
 
+type CloseTargetFuture struct {
+	*rpc.Call
+	result CloseTargetResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CloseTargetFuture) Get() (*CloseTargetResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CloseTargetRequest) DoAsync(ctx context.Context) *CloseTargetFuture {
+	f := new(CloseTargetFuture)
+	f.Call = r.client.Go(ctx, "Target.closeTarget", r.params, &f.result)
+	return f
+}
+
+// AttachToTargetParams are the parameters of Target.attachToTarget.
+type AttachToTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *AttachToTargetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.TargetId == "" {
+		missing = append(missing, "targetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.attachToTarget", Missing: missing, Invalid: invalid}
+}
+
 type AttachToTargetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *AttachToTargetParams
 }
 
 // Attaches to the target with given id.
 func (d *Client) AttachToTarget() *AttachToTargetRequest {
-	return &AttachToTargetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &AttachToTargetRequest{client: d.Client, params: &AttachToTargetParams{set: 1}}
+}
+
+// DoAttachToTarget sends Target.attachToTarget with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoAttachToTarget(ctx context.Context, params *AttachToTargetParams) (*AttachToTargetResult, error) {
+	if params == nil {
+		params = new(AttachToTargetParams)
+	}
+	return (&AttachToTargetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *AttachToTargetRequest) TargetId(v TargetID) *AttachToTargetRequest {
-	r.opts["targetId"] = v
+	r.params.TargetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -229,38 +625,112 @@ type AttachToTargetResult struct {
 }
 
 func (r *AttachToTargetRequest) Do() (*AttachToTargetResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *AttachToTargetRequest) DoContext(ctx context.Context) (*AttachToTargetResult, error) {
 	var result AttachToTargetResult
-	err := r.client.Call("Target.attachToTarget", r.opts, &result)
+	err := r.client.CallContext(ctx, "Target.attachToTarget", r.params, &result)
 	return &result, err
 }
 
+type AttachToTargetFuture struct {
+	*rpc.Call
+	result AttachToTargetResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *AttachToTargetFuture) Get() (*AttachToTargetResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *AttachToTargetRequest) DoAsync(ctx context.Context) *AttachToTargetFuture {
+	f := new(AttachToTargetFuture)
+	f.Call = r.client.Go(ctx, "Target.attachToTarget", r.params, &f.result)
+	return f
+}
+
+// DetachFromTargetParams are the parameters of Target.detachFromTarget.
+type DetachFromTargetParams struct {
+	TargetId TargetID `json:"targetId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DetachFromTargetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.TargetId == "" {
+		missing = append(missing, "targetId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.detachFromTarget", Missing: missing, Invalid: invalid}
+}
+
 type DetachFromTargetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DetachFromTargetParams
 }
 
 // Detaches from the target with given id.
 func (d *Client) DetachFromTarget() *DetachFromTargetRequest {
-	return &DetachFromTargetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DetachFromTargetRequest{client: d.Client, params: &DetachFromTargetParams{set: 1}}
+}
+
+// DoDetachFromTarget sends Target.detachFromTarget with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDetachFromTarget(ctx context.Context, params *DetachFromTargetParams) error {
+	if params == nil {
+		params = new(DetachFromTargetParams)
+	}
+	return (&DetachFromTargetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DetachFromTargetRequest) TargetId(v TargetID) *DetachFromTargetRequest {
-	r.opts["targetId"] = v
+	r.params.TargetId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *DetachFromTargetRequest) Do() error {
-	return r.client.Call("Target.detachFromTarget", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *DetachFromTargetRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Target.detachFromTarget", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *DetachFromTargetRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Target.detachFromTarget", r.params, nil)
+}
+
+// CreateBrowserContextParams are the parameters of Target.createBrowserContext.
+type CreateBrowserContextParams struct {
 }
 
 type CreateBrowserContextRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CreateBrowserContextParams
 }
 
 // Creates a new empty BrowserContext. Similar to an incognito profile but you can have more than one.
 func (d *Client) CreateBrowserContext() *CreateBrowserContextRequest {
-	return &CreateBrowserContextRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CreateBrowserContextRequest{client: d.Client, params: &CreateBrowserContextParams{}}
+}
+
+// DoCreateBrowserContext sends Target.createBrowserContext with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCreateBrowserContext(ctx context.Context, params *CreateBrowserContextParams) (*CreateBrowserContextResult, error) {
+	if params == nil {
+		params = new(CreateBrowserContextParams)
+	}
+	return (&CreateBrowserContextRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type CreateBrowserContextResult struct {
@@ -269,23 +739,76 @@ type CreateBrowserContextResult struct {
 }
 
 func (r *CreateBrowserContextRequest) Do() (*CreateBrowserContextResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CreateBrowserContextRequest) DoContext(ctx context.Context) (*CreateBrowserContextResult, error) {
 	var result CreateBrowserContextResult
-	err := r.client.Call("Target.createBrowserContext", r.opts, &result)
+	err := r.client.CallContext(ctx, "Target.createBrowserContext", r.params, &result)
 	return &result, err
 }
 
+type CreateBrowserContextFuture struct {
+	*rpc.Call
+	result CreateBrowserContextResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CreateBrowserContextFuture) Get() (*CreateBrowserContextResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CreateBrowserContextRequest) DoAsync(ctx context.Context) *CreateBrowserContextFuture {
+	f := new(CreateBrowserContextFuture)
+	f.Call = r.client.Go(ctx, "Target.createBrowserContext", r.params, &f.result)
+	return f
+}
+
+// DisposeBrowserContextParams are the parameters of Target.disposeBrowserContext.
+type DisposeBrowserContextParams struct {
+	BrowserContextId BrowserContextID `json:"browserContextId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *DisposeBrowserContextParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.BrowserContextId == "" {
+		missing = append(missing, "browserContextId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.disposeBrowserContext", Missing: missing, Invalid: invalid}
+}
+
 type DisposeBrowserContextRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *DisposeBrowserContextParams
 }
 
 // Deletes a BrowserContext, will fail of any open page uses it.
 func (d *Client) DisposeBrowserContext() *DisposeBrowserContextRequest {
-	return &DisposeBrowserContextRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &DisposeBrowserContextRequest{client: d.Client, params: &DisposeBrowserContextParams{set: 1}}
+}
+
+// DoDisposeBrowserContext sends Target.disposeBrowserContext with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoDisposeBrowserContext(ctx context.Context, params *DisposeBrowserContextParams) (*DisposeBrowserContextResult, error) {
+	if params == nil {
+		params = new(DisposeBrowserContextParams)
+	}
+	return (&DisposeBrowserContextRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *DisposeBrowserContextRequest) BrowserContextId(v BrowserContextID) *DisposeBrowserContextRequest {
-	r.opts["browserContextId"] = v
+	r.params.BrowserContextId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
@@ -294,42 +817,108 @@ type DisposeBrowserContextResult struct {
 }
 
 func (r *DisposeBrowserContextRequest) Do() (*DisposeBrowserContextResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *DisposeBrowserContextRequest) DoContext(ctx context.Context) (*DisposeBrowserContextResult, error) {
 	var result DisposeBrowserContextResult
-	err := r.client.Call("Target.disposeBrowserContext", r.opts, &result)
+	err := r.client.CallContext(ctx, "Target.disposeBrowserContext", r.params, &result)
 	return &result, err
 }
 
+type DisposeBrowserContextFuture struct {
+	*rpc.Call
+	result DisposeBrowserContextResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *DisposeBrowserContextFuture) Get() (*DisposeBrowserContextResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *DisposeBrowserContextRequest) DoAsync(ctx context.Context) *DisposeBrowserContextFuture {
+	f := new(DisposeBrowserContextFuture)
+	f.Call = r.client.Go(ctx, "Target.disposeBrowserContext", r.params, &f.result)
+	return f
+}
+
+// CreateTargetParams are the parameters of Target.createTarget.
+type CreateTargetParams struct {
+	// The initial URL the page will be navigated to.
+	URL string `json:"url"`
+
+	// Frame width in DIP (headless chrome only). (optional)
+	Width *int `json:"width,omitempty"`
+
+	// Frame height in DIP (headless chrome only). (optional)
+	Height *int `json:"height,omitempty"`
+
+	// The browser context to create the page in (headless chrome only). (optional)
+	BrowserContextId *BrowserContextID `json:"browserContextId,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *CreateTargetParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.URL == "" {
+		missing = append(missing, "url")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Target.createTarget", Missing: missing, Invalid: invalid}
+}
+
 type CreateTargetRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *CreateTargetParams
 }
 
 // Creates a new page.
 func (d *Client) CreateTarget() *CreateTargetRequest {
-	return &CreateTargetRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &CreateTargetRequest{client: d.Client, params: &CreateTargetParams{set: 1}}
+}
+
+// DoCreateTarget sends Target.createTarget with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoCreateTarget(ctx context.Context, params *CreateTargetParams) (*CreateTargetResult, error) {
+	if params == nil {
+		params = new(CreateTargetParams)
+	}
+	return (&CreateTargetRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The initial URL the page will be navigated to.
 func (r *CreateTargetRequest) URL(v string) *CreateTargetRequest {
-	r.opts["url"] = v
+	r.params.URL = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Frame width in DIP (headless chrome only). (optional)
 func (r *CreateTargetRequest) Width(v int) *CreateTargetRequest {
-	r.opts["width"] = v
+	r.params.Width = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // Frame height in DIP (headless chrome only). (optional)
 func (r *CreateTargetRequest) Height(v int) *CreateTargetRequest {
-	r.opts["height"] = v
+	r.params.Height = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // The browser context to create the page in (headless chrome only). (optional)
 func (r *CreateTargetRequest) BrowserContextId(v BrowserContextID) *CreateTargetRequest {
-	r.opts["browserContextId"] = v
+	r.params.BrowserContextId = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
@@ -339,19 +928,53 @@ type CreateTargetResult struct {
 }
 
 func (r *CreateTargetRequest) Do() (*CreateTargetResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *CreateTargetRequest) DoContext(ctx context.Context) (*CreateTargetResult, error) {
 	var result CreateTargetResult
-	err := r.client.Call("Target.createTarget", r.opts, &result)
+	err := r.client.CallContext(ctx, "Target.createTarget", r.params, &result)
 	return &result, err
 }
 
+type CreateTargetFuture struct {
+	*rpc.Call
+	result CreateTargetResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *CreateTargetFuture) Get() (*CreateTargetResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *CreateTargetRequest) DoAsync(ctx context.Context) *CreateTargetFuture {
+	f := new(CreateTargetFuture)
+	f.Call = r.client.Go(ctx, "Target.createTarget", r.params, &f.result)
+	return f
+}
+
+// GetTargetsParams are the parameters of Target.getTargets.
+type GetTargetsParams struct {
+}
+
 type GetTargetsRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetTargetsParams
 }
 
 // Retrieves a list of available targets.
 func (d *Client) GetTargets() *GetTargetsRequest {
-	return &GetTargetsRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetTargetsRequest{client: d.Client, params: &GetTargetsParams{}}
+}
+
+// DoGetTargets sends Target.getTargets with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetTargets(ctx context.Context, params *GetTargetsParams) (*GetTargetsResult, error) {
+	if params == nil {
+		params = new(GetTargetsParams)
+	}
+	return (&GetTargetsRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetTargetsResult struct {
@@ -360,11 +983,33 @@ type GetTargetsResult struct {
 }
 
 func (r *GetTargetsRequest) Do() (*GetTargetsResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetTargetsRequest) DoContext(ctx context.Context) (*GetTargetsResult, error) {
 	var result GetTargetsResult
-	err := r.client.Call("Target.getTargets", r.opts, &result)
+	err := r.client.CallContext(ctx, "Target.getTargets", r.params, &result)
 	return &result, err
 }
 
+type GetTargetsFuture struct {
+	*rpc.Call
+	result GetTargetsResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetTargetsFuture) Get() (*GetTargetsResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetTargetsRequest) DoAsync(ctx context.Context) *GetTargetsFuture {
+	f := new(GetTargetsFuture)
+	f.Call = r.client.Go(ctx, "Target.getTargets", r.params, &f.result)
+	return f
+}
+
 func init() {
 	rpc.EventTypes["Target.targetCreated"] = func() interface{} { return new(TargetCreatedEvent) }
 	rpc.EventTypes["Target.targetDestroyed"] = func() interface{} { return new(TargetDestroyedEvent) }
@@ -378,11 +1023,89 @@ type TargetCreatedEvent struct {
 	TargetInfo *TargetInfo `json:"targetInfo"`
 }
 
+// Issued when a possible inspection target is created.
+func (d *Client) OnTargetCreated(fn func(*TargetCreatedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Target.targetCreated", func(e interface{}) {
+		fn(e.(*TargetCreatedEvent))
+	}, opts...)
+}
+
+// FilterTargetCreated is rpc.WithFilter for OnTargetCreated.
+func FilterTargetCreated(fn func(*TargetCreatedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*TargetCreatedEvent))
+	})
+}
+
+// TargetCreatedWaiter waits for a Target.targetCreated event. It is created by WaitForTargetCreated.
+type TargetCreatedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *TargetCreatedWaiter) Wait() (*TargetCreatedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*TargetCreatedEvent), nil
+}
+
+// WaitForTargetCreated arms a waiter for the first Target.targetCreated event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForTargetCreated(ctx context.Context, predicate func(*TargetCreatedEvent) bool) *TargetCreatedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*TargetCreatedEvent))
+		}
+	}
+	return &TargetCreatedWaiter{d.Client.WaitFor(ctx, "Target.targetCreated", p)}
+}
+
 // Issued when a target is destroyed.
 type TargetDestroyedEvent struct {
 	TargetId TargetID `json:"targetId"`
 }
 
+// Issued when a target is destroyed.
+func (d *Client) OnTargetDestroyed(fn func(*TargetDestroyedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Target.targetDestroyed", func(e interface{}) {
+		fn(e.(*TargetDestroyedEvent))
+	}, opts...)
+}
+
+// FilterTargetDestroyed is rpc.WithFilter for OnTargetDestroyed.
+func FilterTargetDestroyed(fn func(*TargetDestroyedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*TargetDestroyedEvent))
+	})
+}
+
+// TargetDestroyedWaiter waits for a Target.targetDestroyed event. It is created by WaitForTargetDestroyed.
+type TargetDestroyedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *TargetDestroyedWaiter) Wait() (*TargetDestroyedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*TargetDestroyedEvent), nil
+}
+
+// WaitForTargetDestroyed arms a waiter for the first Target.targetDestroyed event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForTargetDestroyed(ctx context.Context, predicate func(*TargetDestroyedEvent) bool) *TargetDestroyedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*TargetDestroyedEvent))
+		}
+	}
+	return &TargetDestroyedWaiter{d.Client.WaitFor(ctx, "Target.targetDestroyed", p)}
+}
+
 // Issued when attached to target because of auto-attach or <code>attachToTarget</code> command.
 type AttachedToTargetEvent struct {
 	TargetInfo *TargetInfo `json:"targetInfo"`
@@ -390,14 +1113,131 @@ type AttachedToTargetEvent struct {
 	WaitingForDebugger bool `json:"waitingForDebugger"`
 }
 
+// Issued when attached to target because of auto-attach or <code>attachToTarget</code> command.
+func (d *Client) OnAttachedToTarget(fn func(*AttachedToTargetEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Target.attachedToTarget", func(e interface{}) {
+		fn(e.(*AttachedToTargetEvent))
+	}, opts...)
+}
+
+// FilterAttachedToTarget is rpc.WithFilter for OnAttachedToTarget.
+func FilterAttachedToTarget(fn func(*AttachedToTargetEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AttachedToTargetEvent))
+	})
+}
+
+// AttachedToTargetWaiter waits for a Target.attachedToTarget event. It is created by WaitForAttachedToTarget.
+type AttachedToTargetWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AttachedToTargetWaiter) Wait() (*AttachedToTargetEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AttachedToTargetEvent), nil
+}
+
+// WaitForAttachedToTarget arms a waiter for the first Target.attachedToTarget event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAttachedToTarget(ctx context.Context, predicate func(*AttachedToTargetEvent) bool) *AttachedToTargetWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AttachedToTargetEvent))
+		}
+	}
+	return &AttachedToTargetWaiter{d.Client.WaitFor(ctx, "Target.attachedToTarget", p)}
+}
+
 // Issued when detached from target for any reason (including <code>detachFromTarget</code> command).
 type DetachedFromTargetEvent struct {
 	TargetId TargetID `json:"targetId"`
 }
 
+// Issued when detached from target for any reason (including <code>detachFromTarget</code> command).
+func (d *Client) OnDetachedFromTarget(fn func(*DetachedFromTargetEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Target.detachedFromTarget", func(e interface{}) {
+		fn(e.(*DetachedFromTargetEvent))
+	}, opts...)
+}
+
+// FilterDetachedFromTarget is rpc.WithFilter for OnDetachedFromTarget.
+func FilterDetachedFromTarget(fn func(*DetachedFromTargetEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DetachedFromTargetEvent))
+	})
+}
+
+// DetachedFromTargetWaiter waits for a Target.detachedFromTarget event. It is created by WaitForDetachedFromTarget.
+type DetachedFromTargetWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DetachedFromTargetWaiter) Wait() (*DetachedFromTargetEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DetachedFromTargetEvent), nil
+}
+
+// WaitForDetachedFromTarget arms a waiter for the first Target.detachedFromTarget event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDetachedFromTarget(ctx context.Context, predicate func(*DetachedFromTargetEvent) bool) *DetachedFromTargetWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DetachedFromTargetEvent))
+		}
+	}
+	return &DetachedFromTargetWaiter{d.Client.WaitFor(ctx, "Target.detachedFromTarget", p)}
+}
+
 // Notifies about new protocol message from attached target.
 type ReceivedMessageFromTargetEvent struct {
 	TargetId TargetID `json:"targetId"`
 
 	Message string `json:"message"`
 }
+
+// Notifies about new protocol message from attached target.
+func (d *Client) OnReceivedMessageFromTarget(fn func(*ReceivedMessageFromTargetEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Target.receivedMessageFromTarget", func(e interface{}) {
+		fn(e.(*ReceivedMessageFromTargetEvent))
+	}, opts...)
+}
+
+// FilterReceivedMessageFromTarget is rpc.WithFilter for OnReceivedMessageFromTarget.
+func FilterReceivedMessageFromTarget(fn func(*ReceivedMessageFromTargetEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*ReceivedMessageFromTargetEvent))
+	})
+}
+
+// ReceivedMessageFromTargetWaiter waits for a Target.receivedMessageFromTarget event. It is created by WaitForReceivedMessageFromTarget.
+type ReceivedMessageFromTargetWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *ReceivedMessageFromTargetWaiter) Wait() (*ReceivedMessageFromTargetEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*ReceivedMessageFromTargetEvent), nil
+}
+
+// WaitForReceivedMessageFromTarget arms a waiter for the first Target.receivedMessageFromTarget event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForReceivedMessageFromTarget(ctx context.Context, predicate func(*ReceivedMessageFromTargetEvent) bool) *ReceivedMessageFromTargetWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*ReceivedMessageFromTargetEvent))
+		}
+	}
+	return &ReceivedMessageFromTargetWaiter{d.Client.WaitFor(ctx, "Target.receivedMessageFromTarget", p)}
+}
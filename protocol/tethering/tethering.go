@@ -2,6 +2,8 @@
 package tethering
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 )
 
@@ -10,44 +12,126 @@ type Client struct {
 	*rpc.Client
 }
 
+// BindParams are the parameters of Tethering.bind.
+type BindParams struct {
+	// Port number to bind.
+	Port int `json:"port"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *BindParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "port")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Tethering.bind", Missing: missing, Invalid: invalid}
+}
+
 type BindRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *BindParams
 }
 
 // Request browser port binding.
 func (d *Client) Bind() *BindRequest {
-	return &BindRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &BindRequest{client: d.Client, params: &BindParams{set: 1}}
+}
+
+// DoBind sends Tethering.bind with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoBind(ctx context.Context, params *BindParams) error {
+	if params == nil {
+		params = new(BindParams)
+	}
+	return (&BindRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Port number to bind.
 func (r *BindRequest) Port(v int) *BindRequest {
-	r.opts["port"] = v
+	r.params.Port = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *BindRequest) Do() error {
-	return r.client.Call("Tethering.bind", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *BindRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Tethering.bind", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *BindRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Tethering.bind", r.params, nil)
+}
+
+// UnbindParams are the parameters of Tethering.unbind.
+type UnbindParams struct {
+	// Port number to unbind.
+	Port int `json:"port"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *UnbindParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 {
+		missing = append(missing, "port")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Tethering.unbind", Missing: missing, Invalid: invalid}
 }
 
 type UnbindRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *UnbindParams
 }
 
 // Request browser port unbinding.
 func (d *Client) Unbind() *UnbindRequest {
-	return &UnbindRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &UnbindRequest{client: d.Client, params: &UnbindParams{set: 1}}
+}
+
+// DoUnbind sends Tethering.unbind with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoUnbind(ctx context.Context, params *UnbindParams) error {
+	if params == nil {
+		params = new(UnbindParams)
+	}
+	return (&UnbindRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Port number to unbind.
 func (r *UnbindRequest) Port(v int) *UnbindRequest {
-	r.opts["port"] = v
+	r.params.Port = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *UnbindRequest) Do() error {
-	return r.client.Call("Tethering.unbind", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *UnbindRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Tethering.unbind", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *UnbindRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Tethering.unbind", r.params, nil)
 }
 
 func init() {
@@ -62,3 +146,42 @@ type AcceptedEvent struct {
 	// Connection id to be used.
 	ConnectionId string `json:"connectionId"`
 }
+
+// Informs that port was successfully bound and got a specified connection id.
+func (d *Client) OnAccepted(fn func(*AcceptedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Tethering.accepted", func(e interface{}) {
+		fn(e.(*AcceptedEvent))
+	}, opts...)
+}
+
+// FilterAccepted is rpc.WithFilter for OnAccepted.
+func FilterAccepted(fn func(*AcceptedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*AcceptedEvent))
+	})
+}
+
+// AcceptedWaiter waits for a Tethering.accepted event. It is created by WaitForAccepted.
+type AcceptedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *AcceptedWaiter) Wait() (*AcceptedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*AcceptedEvent), nil
+}
+
+// WaitForAccepted arms a waiter for the first Tethering.accepted event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForAccepted(ctx context.Context, predicate func(*AcceptedEvent) bool) *AcceptedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*AcceptedEvent))
+		}
+	}
+	return &AcceptedWaiter{d.Client.WaitFor(ctx, "Tethering.accepted", p)}
+}
@@ -2,6 +2,8 @@
 package tracing
 
 import (
+	"context"
+
 	"github.com/neelance/cdp-go/rpc"
 
 	"github.com/neelance/cdp-go/protocol/io"
@@ -14,21 +16,20 @@ type Client struct {
 
 // Configuration for memory dump. Used only when "memory-infra" category is enabled.
 
-type MemoryDumpConfig struct {
-}
+type MemoryDumpConfig map[string]interface{}
 
 type TraceConfig struct {
 	// Controls how the trace buffer stores data. (optional)
-	RecordMode string `json:"recordMode,omitempty"`
+	RecordMode *TraceConfigRecordMode `json:"recordMode,omitempty"`
 
 	// Turns on JavaScript stack sampling. (optional)
-	EnableSampling bool `json:"enableSampling,omitempty"`
+	EnableSampling *bool `json:"enableSampling,omitempty"`
 
 	// Turns on system tracing. (optional)
-	EnableSystrace bool `json:"enableSystrace,omitempty"`
+	EnableSystrace *bool `json:"enableSystrace,omitempty"`
 
 	// Turns on argument filter. (optional)
-	EnableArgumentFilter bool `json:"enableArgumentFilter,omitempty"`
+	EnableArgumentFilter *bool `json:"enableArgumentFilter,omitempty"`
 
 	// Included category filters. (optional)
 	IncludedCategories []string `json:"includedCategories,omitempty"`
@@ -40,75 +41,203 @@ type TraceConfig struct {
 	SyntheticDelays []string `json:"syntheticDelays,omitempty"`
 
 	// Configuration for memory dump triggers. Used only when "memory-infra" category is enabled. (optional)
-	MemoryDumpConfig *MemoryDumpConfig `json:"memoryDumpConfig,omitempty"`
+	MemoryDumpConfig MemoryDumpConfig `json:"memoryDumpConfig,omitempty"`
+}
+
+// Values of the recordMode property of TraceConfig.
+
+type TraceConfigRecordMode string
+
+const (
+	TraceConfigRecordModeRecordUntilFull        TraceConfigRecordMode = "recordUntilFull"
+	TraceConfigRecordModeRecordContinuously     TraceConfigRecordMode = "recordContinuously"
+	TraceConfigRecordModeRecordAsMuchAsPossible TraceConfigRecordMode = "recordAsMuchAsPossible"
+	TraceConfigRecordModeEchoToConsole          TraceConfigRecordMode = "echoToConsole"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v TraceConfigRecordMode) IsValid() bool {
+	switch v {
+	case TraceConfigRecordModeRecordUntilFull, TraceConfigRecordModeRecordContinuously, TraceConfigRecordModeRecordAsMuchAsPossible, TraceConfigRecordModeEchoToConsole:
+		return true
+	}
+	return false
+}
+
+// Values of the transferMode parameter of Tracing.start.
+
+type StartTransferMode string
+
+const (
+	StartTransferModeReportEvents   StartTransferMode = "ReportEvents"
+	StartTransferModeReturnAsStream StartTransferMode = "ReturnAsStream"
+)
+
+// IsValid reports whether v is one of the values defined by the protocol.
+func (v StartTransferMode) IsValid() bool {
+	switch v {
+	case StartTransferModeReportEvents, StartTransferModeReturnAsStream:
+		return true
+	}
+	return false
+}
+
+// StartParams are the parameters of Tracing.start.
+type StartParams struct {
+	// Category/tag filter (optional)
+	Categories *string `json:"categories,omitempty"`
+
+	// Tracing options (optional)
+	Options *string `json:"options,omitempty"`
+
+	// If set, the agent will issue bufferUsage events at this interval, specified in milliseconds (optional)
+	BufferUsageReportingInterval *float64 `json:"bufferUsageReportingInterval,omitempty"`
+
+	// Whether to report trace events as series of dataCollected events or to save trace to a stream (defaults to <code>ReportEvents</code>). (optional)
+	TransferMode *StartTransferMode `json:"transferMode,omitempty"`
+
+	// (optional)
+	TraceConfig *TraceConfig `json:"traceConfig,omitempty"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *StartParams) Validate() error {
+	var missing, invalid []string
+	if p.TransferMode != nil && !p.TransferMode.IsValid() {
+		invalid = append(invalid, "transferMode")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Tracing.start", Missing: missing, Invalid: invalid}
 }
 
 type StartRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *StartParams
 }
 
 // Start trace events collection.
 func (d *Client) Start() *StartRequest {
-	return &StartRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &StartRequest{client: d.Client, params: &StartParams{set: 1}}
+}
+
+// DoStart sends Tracing.start with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoStart(ctx context.Context, params *StartParams) error {
+	if params == nil {
+		params = new(StartParams)
+	}
+	return (&StartRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // Category/tag filter (optional)
 func (r *StartRequest) Categories(v string) *StartRequest {
-	r.opts["categories"] = v
+	r.params.Categories = &v
+	r.params.set |= 1 << 1
 	return r
 }
 
 // Tracing options (optional)
 func (r *StartRequest) Options(v string) *StartRequest {
-	r.opts["options"] = v
+	r.params.Options = &v
+	r.params.set |= 1 << 2
 	return r
 }
 
 // If set, the agent will issue bufferUsage events at this interval, specified in milliseconds (optional)
 func (r *StartRequest) BufferUsageReportingInterval(v float64) *StartRequest {
-	r.opts["bufferUsageReportingInterval"] = v
+	r.params.BufferUsageReportingInterval = &v
+	r.params.set |= 1 << 3
 	return r
 }
 
 // Whether to report trace events as series of dataCollected events or to save trace to a stream (defaults to <code>ReportEvents</code>). (optional)
-func (r *StartRequest) TransferMode(v string) *StartRequest {
-	r.opts["transferMode"] = v
+func (r *StartRequest) TransferMode(v StartTransferMode) *StartRequest {
+	r.params.TransferMode = &v
+	r.params.set |= 1 << 4
 	return r
 }
 
 // (optional)
 func (r *StartRequest) TraceConfig(v *TraceConfig) *StartRequest {
-	r.opts["traceConfig"] = v
+	r.params.TraceConfig = v
+	r.params.set |= 1 << 5
 	return r
 }
 
 func (r *StartRequest) Do() error {
-	return r.client.Call("Tracing.start", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *StartRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Tracing.start", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *StartRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Tracing.start", r.params, nil)
+}
+
+// EndParams are the parameters of Tracing.end.
+type EndParams struct {
 }
 
 type EndRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *EndParams
 }
 
 // Stop trace events collection.
 func (d *Client) End() *EndRequest {
-	return &EndRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &EndRequest{client: d.Client, params: &EndParams{}}
+}
+
+// DoEnd sends Tracing.end with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoEnd(ctx context.Context, params *EndParams) error {
+	if params == nil {
+		params = new(EndParams)
+	}
+	return (&EndRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 func (r *EndRequest) Do() error {
-	return r.client.Call("Tracing.end", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *EndRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Tracing.end", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *EndRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Tracing.end", r.params, nil)
+}
+
+// GetCategoriesParams are the parameters of Tracing.getCategories.
+type GetCategoriesParams struct {
 }
 
 type GetCategoriesRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *GetCategoriesParams
 }
 
 // Gets supported tracing categories.
 func (d *Client) GetCategories() *GetCategoriesRequest {
-	return &GetCategoriesRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &GetCategoriesRequest{client: d.Client, params: &GetCategoriesParams{}}
+}
+
+// DoGetCategories sends Tracing.getCategories with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoGetCategories(ctx context.Context, params *GetCategoriesParams) (*GetCategoriesResult, error) {
+	if params == nil {
+		params = new(GetCategoriesParams)
+	}
+	return (&GetCategoriesRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type GetCategoriesResult struct {
@@ -117,19 +246,53 @@ type GetCategoriesResult struct {
 }
 
 func (r *GetCategoriesRequest) Do() (*GetCategoriesResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *GetCategoriesRequest) DoContext(ctx context.Context) (*GetCategoriesResult, error) {
 	var result GetCategoriesResult
-	err := r.client.Call("Tracing.getCategories", r.opts, &result)
+	err := r.client.CallContext(ctx, "Tracing.getCategories", r.params, &result)
 	return &result, err
 }
 
+type GetCategoriesFuture struct {
+	*rpc.Call
+	result GetCategoriesResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *GetCategoriesFuture) Get() (*GetCategoriesResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *GetCategoriesRequest) DoAsync(ctx context.Context) *GetCategoriesFuture {
+	f := new(GetCategoriesFuture)
+	f.Call = r.client.Go(ctx, "Tracing.getCategories", r.params, &f.result)
+	return f
+}
+
+// RequestMemoryDumpParams are the parameters of Tracing.requestMemoryDump.
+type RequestMemoryDumpParams struct {
+}
+
 type RequestMemoryDumpRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RequestMemoryDumpParams
 }
 
 // Request a global memory dump.
 func (d *Client) RequestMemoryDump() *RequestMemoryDumpRequest {
-	return &RequestMemoryDumpRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RequestMemoryDumpRequest{client: d.Client, params: &RequestMemoryDumpParams{}}
+}
+
+// DoRequestMemoryDump sends Tracing.requestMemoryDump with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRequestMemoryDump(ctx context.Context, params *RequestMemoryDumpParams) (*RequestMemoryDumpResult, error) {
+	if params == nil {
+		params = new(RequestMemoryDumpParams)
+	}
+	return (&RequestMemoryDumpRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 type RequestMemoryDumpResult struct {
@@ -141,29 +304,92 @@ type RequestMemoryDumpResult struct {
 }
 
 func (r *RequestMemoryDumpRequest) Do() (*RequestMemoryDumpResult, error) {
+	return r.DoContext(context.Background())
+}
+
+func (r *RequestMemoryDumpRequest) DoContext(ctx context.Context) (*RequestMemoryDumpResult, error) {
 	var result RequestMemoryDumpResult
-	err := r.client.Call("Tracing.requestMemoryDump", r.opts, &result)
+	err := r.client.CallContext(ctx, "Tracing.requestMemoryDump", r.params, &result)
 	return &result, err
 }
 
+type RequestMemoryDumpFuture struct {
+	*rpc.Call
+	result RequestMemoryDumpResult
+}
+
+// Get waits for the command to complete and returns its result.
+func (f *RequestMemoryDumpFuture) Get() (*RequestMemoryDumpResult, error) {
+	err := f.Wait()
+	return &f.result, err
+}
+
+// DoAsync sends the command without waiting for its result.
+func (r *RequestMemoryDumpRequest) DoAsync(ctx context.Context) *RequestMemoryDumpFuture {
+	f := new(RequestMemoryDumpFuture)
+	f.Call = r.client.Go(ctx, "Tracing.requestMemoryDump", r.params, &f.result)
+	return f
+}
+
+// RecordClockSyncMarkerParams are the parameters of Tracing.recordClockSyncMarker.
+type RecordClockSyncMarkerParams struct {
+	// The ID of this clock sync marker
+	SyncId string `json:"syncId"`
+
+	set uint64 // bit 0 marks params of a builder, bit i+1 that the builder has set parameter i
+}
+
+// Validate returns a *rpc.ParamsError if a required parameter is missing or a parameter has a value that
+// is not defined by the protocol. It is called by the client before sending. If the params were not created
+// by a builder, an empty required string counts as missing, so use the builder to send an empty string.
+// Other required values like numbers can only be reported as missing for params created by a builder.
+func (p *RecordClockSyncMarkerParams) Validate() error {
+	var missing, invalid []string
+	if p.set&1 != 0 && p.set&(1<<1) == 0 || p.set&1 == 0 && p.SyncId == "" {
+		missing = append(missing, "syncId")
+	}
+	if missing == nil && invalid == nil {
+		return nil
+	}
+	return &rpc.ParamsError{Method: "Tracing.recordClockSyncMarker", Missing: missing, Invalid: invalid}
+}
+
 type RecordClockSyncMarkerRequest struct {
 	client *rpc.Client
-	opts   map[string]interface{}
+	params *RecordClockSyncMarkerParams
 }
 
 // Record a clock sync marker in the trace.
 func (d *Client) RecordClockSyncMarker() *RecordClockSyncMarkerRequest {
-	return &RecordClockSyncMarkerRequest{opts: make(map[string]interface{}), client: d.Client}
+	return &RecordClockSyncMarkerRequest{client: d.Client, params: &RecordClockSyncMarkerParams{set: 1}}
+}
+
+// DoRecordClockSyncMarker sends Tracing.recordClockSyncMarker with the given parameters and waits for it to complete. Nil params are empty.
+func (d *Client) DoRecordClockSyncMarker(ctx context.Context, params *RecordClockSyncMarkerParams) error {
+	if params == nil {
+		params = new(RecordClockSyncMarkerParams)
+	}
+	return (&RecordClockSyncMarkerRequest{client: d.Client, params: params}).DoContext(ctx)
 }
 
 // The ID of this clock sync marker
 func (r *RecordClockSyncMarkerRequest) SyncId(v string) *RecordClockSyncMarkerRequest {
-	r.opts["syncId"] = v
+	r.params.SyncId = v
+	r.params.set |= 1 << 1
 	return r
 }
 
 func (r *RecordClockSyncMarkerRequest) Do() error {
-	return r.client.Call("Tracing.recordClockSyncMarker", r.opts, nil)
+	return r.DoContext(context.Background())
+}
+
+func (r *RecordClockSyncMarkerRequest) DoContext(ctx context.Context) error {
+	return r.client.CallContext(ctx, "Tracing.recordClockSyncMarker", r.params, nil)
+}
+
+// DoAsync sends the command without waiting for it to complete.
+func (r *RecordClockSyncMarkerRequest) DoAsync(ctx context.Context) *rpc.Call {
+	return r.client.Go(ctx, "Tracing.recordClockSyncMarker", r.params, nil)
 }
 
 func init() {
@@ -177,19 +403,135 @@ type DataCollectedEvent struct {
 	Value []interface{} `json:"value"`
 }
 
+// Contains an bucket of collected trace events. When tracing is stopped collected events will be send as a sequence of dataCollected events followed by tracingComplete event.
+func (d *Client) OnDataCollected(fn func(*DataCollectedEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Tracing.dataCollected", func(e interface{}) {
+		fn(e.(*DataCollectedEvent))
+	}, opts...)
+}
+
+// FilterDataCollected is rpc.WithFilter for OnDataCollected.
+func FilterDataCollected(fn func(*DataCollectedEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*DataCollectedEvent))
+	})
+}
+
+// DataCollectedWaiter waits for a Tracing.dataCollected event. It is created by WaitForDataCollected.
+type DataCollectedWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *DataCollectedWaiter) Wait() (*DataCollectedEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*DataCollectedEvent), nil
+}
+
+// WaitForDataCollected arms a waiter for the first Tracing.dataCollected event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForDataCollected(ctx context.Context, predicate func(*DataCollectedEvent) bool) *DataCollectedWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*DataCollectedEvent))
+		}
+	}
+	return &DataCollectedWaiter{d.Client.WaitFor(ctx, "Tracing.dataCollected", p)}
+}
+
 // Signals that tracing is stopped and there is no trace buffers pending flush, all data were delivered via dataCollected events.
 type TracingCompleteEvent struct {
 	// A handle of the stream that holds resulting trace data. (optional)
-	Stream io.StreamHandle `json:"stream"`
+	Stream *io.StreamHandle `json:"stream,omitempty"`
+}
+
+// Signals that tracing is stopped and there is no trace buffers pending flush, all data were delivered via dataCollected events.
+func (d *Client) OnTracingComplete(fn func(*TracingCompleteEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Tracing.tracingComplete", func(e interface{}) {
+		fn(e.(*TracingCompleteEvent))
+	}, opts...)
+}
+
+// FilterTracingComplete is rpc.WithFilter for OnTracingComplete.
+func FilterTracingComplete(fn func(*TracingCompleteEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*TracingCompleteEvent))
+	})
+}
+
+// TracingCompleteWaiter waits for a Tracing.tracingComplete event. It is created by WaitForTracingComplete.
+type TracingCompleteWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *TracingCompleteWaiter) Wait() (*TracingCompleteEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*TracingCompleteEvent), nil
+}
+
+// WaitForTracingComplete arms a waiter for the first Tracing.tracingComplete event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForTracingComplete(ctx context.Context, predicate func(*TracingCompleteEvent) bool) *TracingCompleteWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*TracingCompleteEvent))
+		}
+	}
+	return &TracingCompleteWaiter{d.Client.WaitFor(ctx, "Tracing.tracingComplete", p)}
 }
 
 type BufferUsageEvent struct {
 	// A number in range [0..1] that indicates the used size of event buffer as a fraction of its total size. (optional)
-	PercentFull float64 `json:"percentFull"`
+	PercentFull *float64 `json:"percentFull,omitempty"`
 
 	// An approximate number of events in the trace log. (optional)
-	EventCount float64 `json:"eventCount"`
+	EventCount *float64 `json:"eventCount,omitempty"`
 
 	// A number in range [0..1] that indicates the used size of event buffer as a fraction of its total size. (optional)
-	Value float64 `json:"value"`
+	Value *float64 `json:"value,omitempty"`
+}
+
+func (d *Client) OnBufferUsage(fn func(*BufferUsageEvent), opts ...rpc.SubscribeOption) *rpc.Subscription {
+	return d.Client.Subscribe("Tracing.bufferUsage", func(e interface{}) {
+		fn(e.(*BufferUsageEvent))
+	}, opts...)
+}
+
+// FilterBufferUsage is rpc.WithFilter for OnBufferUsage.
+func FilterBufferUsage(fn func(*BufferUsageEvent) bool) rpc.SubscribeOption {
+	return rpc.WithFilter(func(e interface{}) bool {
+		return fn(e.(*BufferUsageEvent))
+	})
+}
+
+// BufferUsageWaiter waits for a Tracing.bufferUsage event. It is created by WaitForBufferUsage.
+type BufferUsageWaiter struct {
+	*rpc.Waiter
+}
+
+// Wait waits for the matching event.
+func (w *BufferUsageWaiter) Wait() (*BufferUsageEvent, error) {
+	e, err := w.Waiter.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return e.(*BufferUsageEvent), nil
+}
+
+// WaitForBufferUsage arms a waiter for the first Tracing.bufferUsage event for which predicate returns true. A nil predicate matches any event.
+func (d *Client) WaitForBufferUsage(ctx context.Context, predicate func(*BufferUsageEvent) bool) *BufferUsageWaiter {
+	var p func(interface{}) bool
+	if predicate != nil {
+		p = func(e interface{}) bool {
+			return predicate(e.(*BufferUsageEvent))
+		}
+	}
+	return &BufferUsageWaiter{d.Client.WaitFor(ctx, "Tracing.bufferUsage", p)}
 }
@@ -0,0 +1,88 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/protocol/page"
+	"github.com/neelance/cdp-go/protocol/runtime"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func TestOptionalAnyValue(t *testing.T) {
+	var undefined, null runtime.RemoteObject
+	if err := json.Unmarshal([]byte(`{"type": "undefined"}`), &undefined); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{"type": "object", "subtype": "null", "value": null}`), &null); err != nil {
+		t.Fatal(err)
+	}
+	if undefined.Value != nil {
+		t.Errorf("absent value decoded as %s", undefined.Value)
+	}
+	if string(null.Value) != "null" {
+		t.Errorf("null value decoded as %q", null.Value)
+	}
+
+	raw, err := json.Marshal(&runtime.CallArgument{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != "{}" {
+		t.Errorf("absent value encoded as %s", raw)
+	}
+}
+
+func TestTypedFilter(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Fake.ping", nil)
+	c, err := DialContext(context.Background(), s.WebSocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	var frames []string
+	c.Page.OnFrameNavigated(func(e *page.FrameNavigatedEvent) {
+		frames = append(frames, e.Frame.Id)
+	}, rpc.Ordered(), page.FilterFrameNavigated(func(e *page.FrameNavigatedEvent) bool {
+		return e.Frame.ParentId == nil
+	}))
+
+	s.Emit("Page.frameNavigated", map[string]interface{}{"frame": map[string]string{"id": "main"}})
+	s.Emit("Page.frameNavigated", map[string]interface{}{"frame": map[string]string{"id": "child", "parentId": "main"}})
+	if err := c.Call("Fake.ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 1 || frames[0] != "main" {
+		t.Errorf("got frames %v, want only the main frame", frames)
+	}
+}
+
+func TestOptionalPrimitiveEncoding(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Runtime.evaluate", map[string]interface{}{"result": map[string]string{"type": "undefined"}})
+	c, err := DialContext(context.Background(), s.WebSocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	if _, err := c.Runtime.Evaluate().Expression("1").Do(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Runtime.Evaluate().Expression("1").ReturnByValue(false).Do(); err != nil {
+		t.Fatal(err)
+	}
+	calls := s.Calls()
+	if got := string(calls[0].Params); got != `{"expression":"1"}` {
+		t.Errorf("unset optional value encoded as %s", got)
+	}
+	if got := string(calls[1].Params); got != `{"expression":"1","returnByValue":false}` {
+		t.Errorf("optional zero value encoded as %s", got)
+	}
+}
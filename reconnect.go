@@ -0,0 +1,151 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// persistentMethods are commands whose effect lasts for the connection. The last call of each is replayed
+// after reconnecting. A method mapped to a non-empty string undoes the effect of that method.
+var persistentMethods = map[string]string{
+	"Network.setExtraHTTPHeaders":          "",
+	"Network.setBlockedURLs":               "",
+	"Network.setUserAgentOverride":         "",
+	"Emulation.setDeviceMetricsOverride":   "",
+	"Emulation.clearDeviceMetricsOverride": "Emulation.setDeviceMetricsOverride",
+	"Page.setDeviceMetricsOverride":        "",
+	"Page.clearDeviceMetricsOverride":      "Page.setDeviceMetricsOverride",
+}
+
+type stateCall struct {
+	method string
+	params json.RawMessage
+}
+
+type script struct {
+	params     json.RawMessage
+	identifier string // returned by the first call, known to the user
+	current    string // returned by the latest call
+}
+
+// connState records the commands that configure a connection, so they can be replayed on a new one.
+type connState struct {
+	mutex   sync.Mutex
+	enabled []stateCall // in the order of enabling
+	config  []stateCall
+	scripts []*script
+}
+
+func (s *connState) intercept(ctx context.Context, method string, params interface{}, invoke rpc.Invoker) (json.RawMessage, error) {
+	if method == "Page.removeScriptToEvaluateOnLoad" {
+		params = s.translateScript(params)
+	}
+	result, err := invoke(ctx, method, params)
+	if err != nil {
+		return result, err
+	}
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return result, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	switch {
+	case strings.HasSuffix(method, ".enable"):
+		s.enabled = append(remove(s.enabled, method), stateCall{method, raw})
+	case strings.HasSuffix(method, ".disable"):
+		s.enabled = remove(s.enabled, strings.TrimSuffix(method, ".disable")+".enable")
+	case method == "Page.addScriptToEvaluateOnLoad":
+		var res struct {
+			Identifier string `json:"identifier"`
+		}
+		json.Unmarshal(result, &res)
+		s.scripts = append(s.scripts, &script{params: raw, identifier: res.Identifier, current: res.Identifier})
+	case method == "Page.removeScriptToEvaluateOnLoad":
+		var p struct {
+			Identifier string `json:"identifier"`
+		}
+		json.Unmarshal(raw, &p)
+		for i, sc := range s.scripts {
+			if sc.current == p.Identifier {
+				s.scripts = append(s.scripts[:i:i], s.scripts[i+1:]...)
+				break
+			}
+		}
+	default:
+		undone, ok := persistentMethods[method]
+		if !ok {
+			break
+		}
+		if undone != "" {
+			s.config = remove(s.config, undone)
+			break
+		}
+		s.config = append(remove(s.config, method), stateCall{method, raw})
+	}
+	return result, nil
+}
+
+// translateScript replaces the identifier that the user knows by the one of the current connection.
+func (s *connState) translateScript(params interface{}) interface{} {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return params
+	}
+	var p map[string]interface{}
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return params
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, sc := range s.scripts {
+		if p["identifier"] == sc.identifier {
+			p["identifier"] = sc.current
+			return p
+		}
+	}
+	return params
+}
+
+func (s *connState) restore(ctx context.Context, call func(method string, params interface{}, result interface{}) error) error {
+	s.mutex.Lock()
+	calls := append(append([]stateCall(nil), s.enabled...), s.config...)
+	scripts := append([]*script(nil), s.scripts...)
+	s.mutex.Unlock()
+
+	var firstErr error
+	for _, c := range calls {
+		if err := call(c.method, c.params, nil); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	for _, sc := range scripts {
+		var res struct {
+			Identifier string `json:"identifier"`
+		}
+		if err := call("Page.addScriptToEvaluateOnLoad", sc.params, &res); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		s.mutex.Lock()
+		sc.current = res.Identifier
+		s.mutex.Unlock()
+	}
+	return firstErr
+}
+
+func remove(calls []stateCall, method string) []stateCall {
+	for i, c := range calls {
+		if c.method == method {
+			return append(calls[:i:i], calls[i+1:]...)
+		}
+	}
+	return calls
+}
@@ -0,0 +1,89 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/protocol/network"
+	"github.com/neelance/cdp-go/protocol/page"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func TestReconnectRestoresState(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Network.enable", nil)
+	s.HandleResult("Network.setExtraHTTPHeaders", nil)
+	s.HandleResult("Page.removeScriptToEvaluateOnLoad", nil)
+	var scripts int32
+	s.Handle("Page.addScriptToEvaluateOnLoad", func(json.RawMessage) (interface{}, error) {
+		return map[string]string{"identifier": fmt.Sprint(atomic.AddInt32(&scripts, 1))}, nil
+	})
+
+	c, err := DialContext(context.Background(), s.WebSocketURL(), WithReconnect())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	gaps := make(chan *rpc.Gap, 1)
+	c.Page.OnLoadEventFired(func(*page.LoadEventFiredEvent) {}, rpc.OnGap(func(g *rpc.Gap) {
+		gaps <- g
+	}))
+
+	if err := c.Network.Enable().Do(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Network.SetExtraHTTPHeaders().Headers(network.Headers{"X-Test": "1"}).Do(); err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Page.AddScriptToEvaluateOnLoad().ScriptSource("1+1").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := len(s.Calls())
+
+	s.Disconnect()
+	select {
+	case g := <-gaps:
+		if g.Err == nil || g.Reconnected.Before(g.Disconnected) {
+			t.Errorf("unexpected gap: %+v", g)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnGap was not called")
+	}
+
+	// the identifier known to the user refers to the script that was added again on the new connection
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		err := c.Page.RemoveScriptToEvaluateOnLoad().Identifier(res.Identifier).Do()
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, rpc.ErrReconnecting) || time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	want := []cdptest.Call{
+		{Method: "Network.enable", Params: json.RawMessage(`{}`)},
+		{Method: "Network.setExtraHTTPHeaders", Params: json.RawMessage(`{"headers":{"X-Test":"1"}}`)},
+		{Method: "Page.addScriptToEvaluateOnLoad", Params: json.RawMessage(`{"scriptSource":"1+1"}`)},
+		{Method: "Page.removeScriptToEvaluateOnLoad", Params: json.RawMessage(`{"identifier":"2"}`)},
+	}
+	got := s.Calls()[before:]
+	if len(got) != len(want) {
+		t.Fatalf("got calls %s, want %s", got, want)
+	}
+	for i := range want {
+		if got[i].Method != want[i].Method || string(got[i].Params) != string(want[i].Params) {
+			t.Errorf("got call %s %s, want %s %s", got[i].Method, got[i].Params, want[i].Method, want[i].Params)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+// Package replay records the messages of a connection to a file and replays them without a browser,
+// for deterministic tests.
+//
+// A recording consists of one JSON object per line, either {"send": <message>} for a message
+// sent to the browser or {"recv": <message>} for a message received from it.
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/neelance/cdp-go/rpc"
+)
+
+type record struct {
+	Send json.RawMessage `json:"send,omitempty"`
+	Recv json.RawMessage `json:"recv,omitempty"`
+}
+
+type message struct {
+	ID     *uint64         `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+type recorder struct {
+	conn io.ReadWriteCloser
+	dec  *json.Decoder
+	buf  bytes.Buffer
+
+	mutex sync.Mutex
+	enc   *json.Encoder
+}
+
+// NewRecorder returns a connection that passes all messages through to conn and writes them to w.
+func NewRecorder(conn io.ReadWriteCloser, w io.Writer) io.ReadWriteCloser {
+	return &recorder{
+		conn: conn,
+		dec:  json.NewDecoder(conn),
+		enc:  json.NewEncoder(w),
+	}
+}
+
+func (r *recorder) Read(p []byte) (int, error) {
+	if r.buf.Len() == 0 {
+		var msg json.RawMessage
+		if err := r.dec.Decode(&msg); err != nil {
+			return 0, err
+		}
+		if err := r.record(record{Recv: msg}); err != nil {
+			return 0, err
+		}
+		r.buf.Write(msg)
+		r.buf.WriteByte('\n')
+	}
+	return r.buf.Read(p)
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	if err := r.record(record{Send: json.RawMessage(bytes.TrimSpace(p))}); err != nil {
+		return 0, err
+	}
+	return r.conn.Write(p)
+}
+
+func (r *recorder) record(rec record) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.enc.Encode(rec)
+}
+
+func (r *recorder) Close() error {
+	return r.conn.Close()
+}
+
+// DivergenceError describes a request that does not match any request of the recording.
+type DivergenceError struct {
+	Method string
+	Params json.RawMessage
+
+	// Expected lists the requests of the recording that have not been replayed yet.
+	Expected []string
+}
+
+func (e *DivergenceError) Error() string {
+	msg := fmt.Sprintf("replay: unexpected request %s %s", e.Method, e.Params)
+	if len(e.Expected) == 0 {
+		return msg + ", recording has no further requests"
+	}
+	return msg + ", expected one of: " + strings.Join(e.Expected, ", ")
+}
+
+type item struct {
+	send    bool
+	msg     message
+	raw     json.RawMessage
+	matched bool
+	liveID  uint64
+}
+
+// Conn is a fake connection that replays a recording. It implements io.ReadWriteCloser, so it can be passed to rpc.NewClient.
+type Conn struct {
+	mutex   sync.Mutex
+	cond    *sync.Cond
+	items   []*item
+	next    int // index of the next item to emit
+	out     bytes.Buffer
+	errs    []error
+	closed  bool
+	partial []byte
+}
+
+// Load reads a recording written by NewRecorder.
+func Load(r io.Reader) (*Conn, error) {
+	c := &Conn{}
+	c.cond = sync.NewCond(&c.mutex)
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 64*1024*1024)
+	for s.Scan() {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		var rec record
+		if err := json.Unmarshal(s.Bytes(), &rec); err != nil {
+			return nil, err
+		}
+		it := &item{send: rec.Send != nil, raw: rec.Recv}
+		if it.send {
+			it.raw = rec.Send
+		}
+		if err := json.Unmarshal(it.raw, &it.msg); err != nil {
+			return nil, err
+		}
+		c.items = append(c.items, it)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	c.emit()
+	return c, nil
+}
+
+// Read returns the recorded responses and events. A response is only returned after its request was made,
+// and no message is returned before all requests that precede it in the recording were made.
+func (c *Conn) Read(p []byte) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for c.out.Len() == 0 && !c.closed {
+		c.cond.Wait()
+	}
+	if c.out.Len() == 0 {
+		return 0, io.EOF
+	}
+	return c.out.Read(p)
+}
+
+// Write accepts requests and matches them to the recording by method and parameters.
+// A request without a match is answered with an error response and reported by Err.
+func (c *Conn) Write(p []byte) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.closed {
+		return 0, io.ErrClosedPipe
+	}
+
+	c.partial = append(c.partial, p...)
+	for {
+		i := bytes.IndexByte(c.partial, '\n')
+		if i == -1 {
+			break
+		}
+		line := c.partial[:i]
+		c.partial = c.partial[i+1:]
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		if err := c.request(line); err != nil {
+			return 0, err
+		}
+	}
+	c.emit()
+	return len(p), nil
+}
+
+func (c *Conn) request(data []byte) error {
+	var req message
+	if err := json.Unmarshal(data, &req); err != nil {
+		return err
+	}
+	if req.ID == nil {
+		return errors.New("replay: request without id")
+	}
+
+	for _, it := range c.items {
+		if it.send && !it.matched && it.msg.Method == req.Method && equalJSON(it.msg.Params, req.Params) {
+			it.matched = true
+			it.liveID = *req.ID
+			return nil
+		}
+	}
+
+	err := &DivergenceError{Method: req.Method, Params: req.Params}
+	for _, it := range c.items {
+		if it.send && !it.matched {
+			err.Expected = append(err.Expected, it.msg.Method+" "+string(it.msg.Params))
+		}
+	}
+	c.errs = append(c.errs, err)
+	resp, _ := json.Marshal(map[string]interface{}{
+		"id": *req.ID,
+		"error": map[string]interface{}{
+			"code":    rpc.CodeServerError,
+			"message": err.Error(),
+		},
+	})
+	c.out.Write(resp)
+	c.out.WriteByte('\n')
+	return nil
+}
+
+// emit writes all recorded messages that are ready to the output buffer.
+func (c *Conn) emit() {
+	for c.next < len(c.items) {
+		it := c.items[c.next]
+		if it.send {
+			if !it.matched {
+				break
+			}
+			c.next++
+			continue
+		}
+
+		raw := it.raw
+		if it.msg.ID != nil {
+			req := c.findRequest(*it.msg.ID)
+			if req == nil {
+				c.next++ // response to a request that is not part of the recording
+				continue
+			}
+			var m map[string]json.RawMessage
+			json.Unmarshal(raw, &m)
+			m["id"], _ = json.Marshal(req.liveID)
+			raw, _ = json.Marshal(m)
+		}
+		c.out.Write(raw)
+		c.out.WriteByte('\n')
+		c.next++
+	}
+	c.cond.Broadcast()
+}
+
+func (c *Conn) findRequest(id uint64) *item {
+	for _, it := range c.items[:c.next] {
+		if it.send && it.msg.ID != nil && *it.msg.ID == id {
+			return it
+		}
+	}
+	return nil
+}
+
+// Err returns an error if a request diverged from the recording or if recorded requests were not made.
+func (c *Conn) Err() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if len(c.errs) != 0 {
+		return c.errs[0]
+	}
+	var missing []string
+	for _, it := range c.items {
+		if it.send && !it.matched {
+			missing = append(missing, it.msg.Method+" "+string(it.msg.Params))
+		}
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("replay: recorded requests not made: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Close closes the connection. Pending reads return io.EOF.
+func (c *Conn) Close() error {
+	c.mutex.Lock()
+	c.closed = true
+	c.cond.Broadcast()
+	c.mutex.Unlock()
+	return nil
+}
+
+func equalJSON(a, b json.RawMessage) bool {
+	var va, vb interface{}
+	if len(a) != 0 {
+		if err := json.Unmarshal(a, &va); err != nil {
+			return false
+		}
+	}
+	if len(b) != 0 {
+		if err := json.Unmarshal(b, &vb); err != nil {
+			return false
+		}
+	}
+	// null, {} and absent params are equivalent
+	if m, ok := va.(map[string]interface{}); ok && len(m) == 0 {
+		va = nil
+	}
+	if m, ok := vb.(map[string]interface{}); ok && len(m) == 0 {
+		vb = nil
+	}
+	return reflect.DeepEqual(va, vb)
+}
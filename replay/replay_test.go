@@ -0,0 +1,109 @@
+package replay_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/replay"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// session makes the calls of a test and returns what the client observed.
+func session(t *testing.T, c *rpc.Client) (result string, events []string) {
+	c.Subscribe("Fake.event", func(e interface{}) {
+		events = append(events, string(e.(*rpc.RawEvent).Params))
+	}, rpc.Ordered())
+	var res struct {
+		Value string `json:"value"`
+	}
+	if err := c.Call("Fake.first", map[string]int{"n": 1}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Call("Fake.second", map[string]int{"n": 2}, &res); err != nil {
+		t.Fatal(err)
+	}
+	return res.Value, events
+}
+
+func record(t *testing.T) *bytes.Buffer {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Fake.first", nil)
+	s.Handle("Fake.second", func(json.RawMessage) (interface{}, error) {
+		s.Emit("Fake.event", map[string]string{"a": "b"})
+		return map[string]string{"value": "recorded"}, nil
+	})
+
+	ws, err := websocket.Dial(s.WebSocketURL(), "", s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	c := rpc.NewClient(replay.NewRecorder(ws, &buf))
+	result, events := session(t, c)
+	c.Close()
+	if result != "recorded" || len(events) != 1 {
+		t.Fatalf("unexpected live session: %q %v", result, events)
+	}
+	return &buf
+}
+
+func TestRoundTrip(t *testing.T) {
+	conn, err := replay.Load(record(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(conn)
+	defer c.Close()
+	result, events := session(t, c)
+	if result != "recorded" {
+		t.Errorf("got result %q", result)
+	}
+	if len(events) != 1 || events[0] != `{"a":"b"}` {
+		t.Errorf("got events %v", events)
+	}
+	if err := conn.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDivergence(t *testing.T) {
+	conn, err := replay.Load(record(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(conn)
+	defer c.Close()
+	if err := c.Call("Fake.first", map[string]int{"n": 3}, nil); !errors.Is(err, rpc.ErrServerError) {
+		t.Fatalf("diverging call returned %v", err)
+	}
+	var derr *replay.DivergenceError
+	if !errors.As(conn.Err(), &derr) {
+		t.Fatalf("Err returned %v", conn.Err())
+	}
+	if derr.Method != "Fake.first" || string(derr.Params) != `{"n":3}` || len(derr.Expected) != 2 {
+		t.Errorf("unexpected divergence: %+v", derr)
+	}
+}
+
+func TestRequestsNotMade(t *testing.T) {
+	conn, err := replay.Load(record(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(conn)
+	defer c.Close()
+	if err := c.Call("Fake.first", map[string]int{"n": 1}, nil); err != nil {
+		t.Fatal(err)
+	}
+	err = conn.Err()
+	if err == nil || !strings.Contains(err.Error(), "not made") || !strings.Contains(err.Error(), `Fake.second {"n":2}`) {
+		t.Errorf("Err returned %v", err)
+	}
+}
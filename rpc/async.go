@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Call is a command that is in progress, created by Client.Go.
+type Call struct {
+	Method string
+
+	result interface{}
+	done   chan struct{}
+	err    error
+}
+
+// Go invokes the given method without waiting for it to complete. The response is decoded into result.
+// Without call interceptors, the command is sent before Go returns, so commands issued with Go are
+// pipelined in order on the connection.
+func (c *Client) Go(ctx context.Context, method string, params interface{}, result interface{}) *Call {
+	ca := &Call{Method: method, result: result, done: make(chan struct{})}
+	if len(c.callInterceptors) != 0 {
+		go func() {
+			ca.finish(c.invoke(ctx, method, params))
+		}()
+		return ca
+	}
+
+	pc, err := c.start(ctx, method, params)
+	if err != nil {
+		ca.finish(nil, err)
+		return ca
+	}
+	go func() {
+		ca.finish(c.wait(ctx, pc))
+	}()
+	return ca
+}
+
+func (ca *Call) finish(raw json.RawMessage, err error) {
+	if err == nil && ca.result != nil {
+		err = json.Unmarshal(raw, ca.result)
+	}
+	ca.err = err
+	close(ca.done)
+}
+
+// Done returns a channel that is closed when the call has completed.
+func (ca *Call) Done() <-chan struct{} {
+	return ca.done
+}
+
+// Wait waits for the call to complete and returns its error.
+func (ca *Call) Wait() error {
+	<-ca.done
+	return ca.err
+}
+
+// Batch collects calls that are in progress, so their completion can be awaited together.
+type Batch struct {
+	Calls []*Call
+}
+
+// Add adds a call to the batch.
+func (b *Batch) Add(ca *Call) {
+	b.Calls = append(b.Calls, ca)
+}
+
+// Wait waits for all calls of the batch to complete. If any of them failed, the error is a *BatchError.
+func (b *Batch) Wait() error {
+	var errs []error
+	failed := 0
+	for i, ca := range b.Calls {
+		if err := ca.Wait(); err != nil {
+			if errs == nil {
+				errs = make([]error, len(b.Calls))
+			}
+			errs[i] = err
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return &BatchError{Errors: errs, Failed: failed}
+}
+
+// BatchError reports the calls of a batch that failed.
+type BatchError struct {
+	// Errors has the same order as Batch.Calls. It is nil for calls that succeeded.
+	Errors []error
+	Failed int
+}
+
+func (e *BatchError) Error() string {
+	for _, err := range e.Errors {
+		if err != nil {
+			return fmt.Sprintf("%d of %d calls failed, first error: %v", e.Failed, len(e.Errors), err)
+		}
+	}
+	return "batch failed"
+}
@@ -0,0 +1,76 @@
+package rpc
+
+// The dispatcher runs the handlers of ordered subscriptions and completes calls in the order in which
+// the events and responses were received. A response is only handed to its caller after all events that
+// were received before it have been handled by ordered subscriptions.
+
+type dispatchItem struct {
+	event interface{}
+	subs  []*Subscription
+
+	call *pendingCall
+	resp *response
+}
+
+func (c *Client) runDispatcher() {
+	c.dispatchMutex.Lock()
+	for {
+		for len(c.dispatchQueue) == 0 {
+			if c.dispatchClosed {
+				c.dispatchMutex.Unlock()
+				close(c.dispatcherDone)
+				return
+			}
+			c.dispatchCond.Wait()
+		}
+		item := c.dispatchQueue[0]
+		c.dispatchQueue[0] = nil
+		c.dispatchQueue = c.dispatchQueue[1:]
+		c.dispatchBusy = true
+		c.dispatchMutex.Unlock()
+
+		if item.call != nil {
+			item.call.done <- item.resp
+		}
+		for _, s := range item.subs {
+			select {
+			case <-s.done:
+				// unsubscribed
+			default:
+				s.deliver(item.event)
+			}
+		}
+
+		c.dispatchMutex.Lock()
+		c.dispatchBusy = false
+	}
+}
+
+// dispatchOrdered queues an event for the ordered subscriptions.
+func (c *Client) dispatchOrdered(e interface{}, subs []*Subscription) {
+	c.dispatchMutex.Lock()
+	c.dispatchQueue = append(c.dispatchQueue, &dispatchItem{event: e, subs: subs})
+	c.dispatchMutex.Unlock()
+	c.dispatchCond.Signal()
+}
+
+// complete hands the response to the caller, after all previously queued events have been handled.
+func (c *Client) complete(pc *pendingCall, resp *response) {
+	c.dispatchMutex.Lock()
+	if len(c.dispatchQueue) == 0 && !c.dispatchBusy {
+		c.dispatchMutex.Unlock()
+		pc.done <- resp
+		return
+	}
+	c.dispatchQueue = append(c.dispatchQueue, &dispatchItem{call: pc, resp: resp})
+	c.dispatchMutex.Unlock()
+	c.dispatchCond.Signal()
+}
+
+// closeDispatcher lets the dispatcher exit after it has handled all queued items.
+func (c *Client) closeDispatcher() {
+	c.dispatchMutex.Lock()
+	c.dispatchClosed = true
+	c.dispatchMutex.Unlock()
+	c.dispatchCond.Signal()
+}
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type domainState struct {
+	lock chan struct{} // held while enabling or disabling, a channel so waiting can be cancelled
+	refs int32         // written while holding lock, accessed atomically so DomainRefs does not wait for it
+}
+
+// EnableDomain enables the domain, e.g. "Network", by sending its enable command with params, unless it is
+// already enabled. Enabling is reference counted: each successful EnableDomain must be balanced by a DisableDomain,
+// and the disable command is only sent when the last user disables the domain. This lets independent components
+// share a client. The params of a domain that is already enabled are ignored.
+func (c *Client) EnableDomain(ctx context.Context, domain string, params interface{}) *Call {
+	return c.changeDomain(ctx, domain, params, true)
+}
+
+// DisableDomain releases a reference taken by EnableDomain and sends the domain's disable command with params if
+// it was the last one. If the domain is not enabled by EnableDomain, the command is sent as is.
+func (c *Client) DisableDomain(ctx context.Context, domain string, params interface{}) *Call {
+	return c.changeDomain(ctx, domain, params, false)
+}
+
+// DomainRefs returns the number of users that have enabled the domain with EnableDomain. It does not wait for
+// enable and disable commands that are in flight.
+func (c *Client) DomainRefs(domain string) int {
+	return int(atomic.LoadInt32(&c.domain(domain).refs))
+}
+
+func (c *Client) domain(name string) *domainState {
+	c.domainsMutex.Lock()
+	defer c.domainsMutex.Unlock()
+	if c.domains == nil {
+		c.domains = make(map[string]*domainState)
+	}
+	d, ok := c.domains[name]
+	if !ok {
+		d = &domainState{lock: make(chan struct{}, 1)}
+		c.domains[name] = d
+	}
+	return d
+}
+
+func (c *Client) changeDomain(ctx context.Context, domain string, params interface{}, enable bool) *Call {
+	method := domain + ".disable"
+	if enable {
+		method = domain + ".enable"
+	}
+	ca := &Call{Method: method, done: make(chan struct{})}
+	d := c.domain(domain)
+	go func() {
+		select {
+		case d.lock <- struct{}{}:
+		case <-ctx.Done():
+			ca.finish(nil, ctx.Err())
+			return
+		}
+		defer func() { <-d.lock }()
+
+		if enable {
+			if atomic.LoadInt32(&d.refs) == 0 {
+				if _, err := c.invoke(ctx, method, params); err != nil {
+					ca.finish(nil, err)
+					return
+				}
+			}
+			atomic.AddInt32(&d.refs, 1)
+			ca.finish(nil, nil)
+			return
+		}
+
+		switch atomic.LoadInt32(&d.refs) {
+		case 0:
+			_, err := c.invoke(ctx, method, params)
+			ca.finish(nil, err)
+		case 1:
+			atomic.StoreInt32(&d.refs, 0)
+			_, err := c.invoke(ctx, method, params)
+			ca.finish(nil, err)
+		default:
+			atomic.AddInt32(&d.refs, -1)
+			ca.finish(nil, nil)
+		}
+	}()
+	return ca
+}
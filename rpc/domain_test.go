@@ -0,0 +1,148 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neelance/cdp-go/cdptest"
+)
+
+func countMethod(s *cdptest.Server, method string) int {
+	n := 0
+	for _, m := range s.Methods() {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDomainConcurrent(t *testing.T) {
+	c, s := newClient(t)
+	s.HandleResult("Fake.enable", nil)
+	s.HandleResult("Fake.disable", nil)
+
+	const users = 20
+	run := func(f func() error) {
+		var wg sync.WaitGroup
+		for i := 0; i < users; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := f(); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	run(func() error { return c.EnableDomain(context.Background(), "Fake", nil).Wait() })
+	if n := c.DomainRefs("Fake"); n != users {
+		t.Errorf("got %d refs, want %d", n, users)
+	}
+	if n := countMethod(s, "Fake.enable"); n != 1 {
+		t.Errorf("enable was sent %d times, want once", n)
+	}
+
+	run(func() error { return c.DisableDomain(context.Background(), "Fake", nil).Wait() })
+	if n := c.DomainRefs("Fake"); n != 0 {
+		t.Errorf("got %d refs, want 0", n)
+	}
+	if n := countMethod(s, "Fake.disable"); n != 1 {
+		t.Errorf("disable was sent %d times, want once", n)
+	}
+}
+
+func TestDomainEnableFails(t *testing.T) {
+	c, s := newClient(t)
+	fail := true
+	s.Handle("Fake.enable", func(json.RawMessage) (interface{}, error) {
+		if fail {
+			return nil, errors.New("cannot enable")
+		}
+		return nil, nil
+	})
+
+	if err := c.EnableDomain(context.Background(), "Fake", nil).Wait(); err == nil {
+		t.Fatal("enable did not fail")
+	}
+	if n := c.DomainRefs("Fake"); n != 0 {
+		t.Errorf("failed enable changed the refs to %d", n)
+	}
+
+	fail = false // the handler of the first call has returned, see above
+	if err := c.EnableDomain(context.Background(), "Fake", nil).Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if n := c.DomainRefs("Fake"); n != 1 {
+		t.Errorf("got %d refs, want 1", n)
+	}
+	if n := countMethod(s, "Fake.enable"); n != 2 {
+		t.Errorf("enable was sent %d times, want it sent again after the failure", n)
+	}
+}
+
+func TestDomainDisableByLastUser(t *testing.T) {
+	c, s := newClient(t)
+	s.HandleResult("Fake.enable", nil)
+	s.HandleResult("Fake.disable", nil)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := c.EnableDomain(ctx, "Fake", nil).Wait(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.DisableDomain(ctx, "Fake", nil).Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if countMethod(s, "Fake.disable") != 0 {
+		t.Error("disable was sent while the domain still has a user")
+	}
+	if err := c.DisableDomain(ctx, "Fake", nil).Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ExpectMethods("Fake.enable", "Fake.disable"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDomainRefsDuringEnable(t *testing.T) {
+	c, s := newClient(t)
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	unblock := func() { releaseOnce.Do(func() { close(release) }) }
+	t.Cleanup(unblock)
+	s.Handle("Fake.enable", func(json.RawMessage) (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+
+	call := c.EnableDomain(context.Background(), "Fake", nil)
+	for countMethod(s, "Fake.enable") == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	refs := make(chan int, 1)
+	go func() { refs <- c.DomainRefs("Fake") }()
+	select {
+	case n := <-refs:
+		if n != 0 {
+			t.Errorf("got %d refs before enable has finished, want 0", n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("DomainRefs waited for the enable command")
+	}
+
+	unblock()
+	if err := call.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if n := c.DomainRefs("Fake"); n != 1 {
+		t.Errorf("got %d refs, want 1", n)
+	}
+}
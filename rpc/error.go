@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Error codes used by the DevTools protocol, as defined by JSON-RPC 2.0.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeServerError    = -32000
+)
+
+// Sentinel errors for use with errors.Is. They match any ProtocolError with the same code.
+var (
+	ErrMethodNotFound = &ProtocolError{Code: CodeMethodNotFound}
+	ErrInvalidParams  = &ProtocolError{Code: CodeInvalidParams}
+	ErrServerError    = &ProtocolError{Code: CodeServerError}
+)
+
+// ProtocolError is an error response that the browser returned for a command.
+type ProtocolError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+
+	// Method is the name of the command that failed, e.g. "Page.navigate".
+	Method string `json:"-"`
+}
+
+func (e *ProtocolError) Error() string {
+	msg := fmt.Sprintf("%s: %s (%d)", e.Method, e.Message, e.Code)
+	if len(e.Data) != 0 {
+		msg += ": " + string(e.Data)
+	}
+	return msg
+}
+
+// Is reports whether target is a ProtocolError with the same code.
+func (e *ProtocolError) Is(target error) bool {
+	t, ok := target.(*ProtocolError)
+	return ok && t.Code == e.Code
+}
+
+// ParamsError is returned by generated commands before sending them if their parameters are invalid.
+// It matches ErrInvalidParams with errors.Is.
+type ParamsError struct {
+	Method string
+
+	// Missing lists the required parameters that are not set.
+	Missing []string
+
+	// Invalid lists the parameters whose value is not one of the values defined by the protocol.
+	Invalid []string
+}
+
+func (e *ParamsError) Error() string {
+	msg := e.Method + ":"
+	if len(e.Missing) != 0 {
+		msg += " missing required parameters: " + strings.Join(e.Missing, ", ")
+		if len(e.Invalid) != 0 {
+			msg += ";"
+		}
+	}
+	if len(e.Invalid) != 0 {
+		msg += " invalid values for parameters: " + strings.Join(e.Invalid, ", ")
+	}
+	return msg
+}
+
+// Is reports whether target is a ProtocolError with the code CodeInvalidParams.
+func (e *ParamsError) Is(target error) bool {
+	t, ok := target.(*ProtocolError)
+	return ok && t.Code == CodeInvalidParams
+}
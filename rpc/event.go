@@ -0,0 +1,321 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// RawEvent is delivered for events whose method is not registered in EventTypes,
+// e.g. events of a newer browser version.
+type RawEvent struct {
+	Method string
+	Params json.RawMessage
+}
+
+// EventError is reported on Client.Errors if an event could not be decoded.
+type EventError struct {
+	Method string
+	Params json.RawMessage
+	Err    error
+}
+
+func (e *EventError) Error() string {
+	return fmt.Sprintf("decoding event %s: %v", e.Method, e.Err)
+}
+
+func (e *EventError) Unwrap() error {
+	return e.Err
+}
+
+// ErrEventOverflow terminates the connection if a subscription with the Disconnect policy overflows.
+var ErrEventOverflow = errors.New("event queue overflow")
+
+// OverflowPolicy determines what happens to an event if a subscription's queue is full.
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest queued event to make room for the new one.
+	DropOldest OverflowPolicy = iota
+	// DropNewest discards the new event.
+	DropNewest
+	// Block waits until the queue has room. This stalls the connection, including pending calls,
+	// until the subscriber catches up.
+	Block
+	// Disconnect closes the connection with ErrEventOverflow.
+	Disconnect
+)
+
+// DefaultBufferSize is the queue size of a subscription unless WithBufferSize is used.
+const DefaultBufferSize = 1024
+
+// SubscribeOption configures a subscription.
+type SubscribeOption func(*Subscription)
+
+// WithBufferSize sets the number of events that can be queued for the subscription.
+func WithBufferSize(n int) SubscribeOption {
+	return func(s *Subscription) {
+		s.bufferSize = n
+	}
+}
+
+// WithOverflowPolicy sets what happens if the subscription's queue is full. The default is DropOldest.
+func WithOverflowPolicy(p OverflowPolicy) SubscribeOption {
+	return func(s *Subscription) {
+		s.policy = p
+	}
+}
+
+// Ordered delivers the events of the subscription in wire order with the responses of calls:
+// a call only returns after all events received before its response have been handled, and a handler
+// sees the events in the order in which they arrived relative to the completion of calls. This makes it
+// possible to mirror state like the DOM tree correctly.
+//
+// All ordered subscriptions of a client share one goroutine and an unbounded queue. Their handlers must not
+// wait for the completion of a call, since the call can only complete after the handler has returned.
+func Ordered() SubscribeOption {
+	return func(s *Subscription) {
+		s.ordered = true
+	}
+}
+
+// WithFilter only delivers events for which fn returns true. Other events are discarded before they are
+// queued, so they neither take room in the queue nor count as dropped. fn is called by the goroutine that
+// reads from the connection, so it must be fast and must not block. If used multiple times, all filters must match.
+func WithFilter(fn func(event interface{}) bool) SubscribeOption {
+	return func(s *Subscription) {
+		s.filters = append(s.filters, fn)
+	}
+}
+
+// Subscription is a handler registered with Client.Subscribe.
+type Subscription struct {
+	dropped uint64 // accessed atomically, first for 64-bit alignment
+
+	client     *Client
+	method     string
+	fn         func(event interface{})
+	filters    []func(event interface{}) bool
+	onGap      func(*Gap)
+	bufferSize int
+	policy     OverflowPolicy
+	ordered    bool
+	queue      chan interface{}
+	done       chan struct{} // closed by Unsubscribe
+	doneOnce   sync.Once
+	finished   chan struct{} // closed when no more events are delivered
+}
+
+// Subscribe registers fn to be called for each event with the given method, e.g. "Page.loadEventFired".
+// An empty method subscribes to all events. Independent subscriptions can be registered for the same method.
+// The event value is shared between all subscribers and must not be modified.
+// Unless Ordered is used, each subscription has its own queue and goroutine, so fn may block or make calls on the client.
+func (c *Client) Subscribe(method string, fn func(event interface{}), opts ...SubscribeOption) *Subscription {
+	s := c.newSubscription(method, opts)
+	s.fn = fn
+	c.register(s)
+	return s
+}
+
+func (c *Client) newSubscription(method string, opts []SubscribeOption) *Subscription {
+	s := &Subscription{
+		client:     c,
+		method:     method,
+		bufferSize: DefaultBufferSize,
+		policy:     DropOldest,
+		done:       make(chan struct{}),
+		finished:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// register starts delivering events to s. Its handler must be set.
+func (c *Client) register(s *Subscription) {
+	// the queue must exist before the reading goroutine can see the subscription
+	if !s.ordered {
+		s.queue = make(chan interface{}, s.bufferSize)
+		go s.run()
+	} else {
+		go func() {
+			select {
+			case <-s.done:
+			case <-c.dispatcherDone:
+			}
+			close(s.finished)
+		}()
+	}
+
+	c.subsMutex.Lock()
+	if c.subs == nil {
+		c.subs = make(map[string][]*Subscription)
+	}
+	c.subs[s.method] = append(c.subs[s.method], s)
+	c.subsMutex.Unlock()
+}
+
+func (s *Subscription) run() {
+	defer close(s.finished)
+	for {
+		select {
+		case e := <-s.queue:
+			s.deliver(e)
+		case <-s.done:
+			return
+		case <-s.client.terminated:
+			// deliver what is left, then stop
+			for {
+				select {
+				case e := <-s.queue:
+					s.deliver(e)
+				case <-s.done:
+					return
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *Subscription) deliver(e interface{}) {
+	if g, ok := e.(*Gap); ok {
+		s.onGap(g)
+		return
+	}
+	s.fn(e)
+}
+
+// Unsubscribe removes the subscription and discards its queued events.
+// It may be called from within the subscription's handler.
+func (s *Subscription) Unsubscribe() {
+	c := s.client
+	c.subsMutex.Lock()
+	subs := c.subs[s.method]
+	for i, s2 := range subs {
+		if s2 == s {
+			c.subs[s.method] = append(subs[:i:i], subs[i+1:]...)
+			break
+		}
+	}
+	c.subsMutex.Unlock()
+	s.doneOnce.Do(func() { close(s.done) })
+}
+
+// Done returns a channel that is closed when the subscription ends, either by Unsubscribe or because the connection
+// terminated. In the latter case, all events received before the termination have been delivered.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.finished
+}
+
+// Err returns the reason why the connection terminated, see Client.Err. It returns nil if the connection is alive.
+func (s *Subscription) Err() error {
+	return s.client.Err()
+}
+
+// Dropped returns the number of events that were discarded because the subscription's queue was full.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// DroppedEvents returns the number of events that were discarded by all subscriptions of the client.
+func (c *Client) DroppedEvents() uint64 {
+	return atomic.LoadUint64(&c.droppedEvents)
+}
+
+// enqueue is only called by the goroutine that reads from the connection.
+func (s *Subscription) enqueue(e interface{}) {
+	select {
+	case s.queue <- e:
+		return
+	default:
+	}
+
+	switch s.policy {
+	case DropOldest:
+		for {
+			select {
+			case <-s.queue:
+				s.drop()
+			default:
+			}
+			select {
+			case s.queue <- e:
+				return
+			default:
+			}
+		}
+	case DropNewest:
+		s.drop()
+	case Block:
+		select {
+		case s.queue <- e:
+		case <-s.done:
+		case <-s.client.closing:
+		}
+	case Disconnect:
+		s.drop()
+		s.client.terminate(ErrEventOverflow)
+	}
+}
+
+func (s *Subscription) accepts(e interface{}) bool {
+	for _, f := range s.filters {
+		if !f(e) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Subscription) drop() {
+	atomic.AddUint64(&s.dropped, 1)
+	atomic.AddUint64(&s.client.droppedEvents, 1)
+}
+
+func (c *Client) dispatchEvent(method string, params json.RawMessage) {
+	if c.Events != nil {
+		c.eventsOnce.Do(func() {
+			c.Subscribe("", func(e interface{}) { c.Events <- e })
+		})
+	}
+
+	c.subsMutex.Lock()
+	subs := c.subs[method]
+	all := c.subs[""]
+	c.subsMutex.Unlock()
+	if len(subs) == 0 && len(all) == 0 {
+		return
+	}
+
+	var e interface{}
+	if newEvent, ok := EventTypes[method]; ok {
+		e = newEvent()
+		if err := json.Unmarshal(params, e); err != nil {
+			c.reportError(&EventError{Method: method, Params: params, Err: err})
+			return
+		}
+	} else {
+		e = &RawEvent{Method: method, Params: params}
+	}
+	var ordered []*Subscription
+	for _, list := range [][]*Subscription{subs, all} {
+		for _, s := range list {
+			if !s.accepts(e) {
+				continue
+			}
+			if s.ordered {
+				ordered = append(ordered, s)
+				continue
+			}
+			s.enqueue(e)
+		}
+	}
+	if len(ordered) != 0 {
+		c.dispatchOrdered(e, ordered)
+	}
+}
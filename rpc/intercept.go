@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Option configures a Client.
+type Option func(*Client)
+
+// Invoker sends a command and returns its raw result.
+type Invoker func(ctx context.Context, method string, params interface{}) (json.RawMessage, error)
+
+// CallInterceptor is called for each command. It must call invoke to pass the command on and may
+// inspect or replace the parameters, the raw result and the error, e.g. for logging or statistics.
+type CallInterceptor func(ctx context.Context, method string, params interface{}, invoke Invoker) (json.RawMessage, error)
+
+// EventInterceptor is called for each event before it is decoded. It must call deliver to pass the event on
+// and may inspect or replace the parameters. Not calling deliver drops the event.
+type EventInterceptor func(method string, params json.RawMessage, deliver func(params json.RawMessage))
+
+// WithCallInterceptor adds an interceptor for commands. The interceptor added first is the outermost one.
+func WithCallInterceptor(i CallInterceptor) Option {
+	return func(c *Client) {
+		c.callInterceptors = append(c.callInterceptors, i)
+	}
+}
+
+// WithEventInterceptor adds an interceptor for events. The interceptor added first is the outermost one.
+func WithEventInterceptor(i EventInterceptor) Option {
+	return func(c *Client) {
+		c.eventInterceptors = append(c.eventInterceptors, i)
+	}
+}
+
+func chainCallInterceptors(interceptors []CallInterceptor, invoke Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoke
+		invoke = func(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+			return interceptor(ctx, method, params, next)
+		}
+	}
+	return invoke
+}
+
+func chainEventInterceptors(interceptors []EventInterceptor, deliver func(method string, params json.RawMessage)) func(method string, params json.RawMessage) {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], deliver
+		deliver = func(method string, params json.RawMessage) {
+			interceptor(method, params, func(params json.RawMessage) {
+				next(method, params)
+			})
+		}
+	}
+	return deliver
+}
@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+// Optional is a value that may be absent. The generator uses it for optional fields of primitive types
+// if it runs with -optional=generic, so an absent value can be told apart from a zero value.
+type Optional[T any] struct {
+	Value T
+	Valid bool // Value is present
+}
+
+// Some returns a present value.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{Value: v, Valid: true}
+}
+
+// Get returns the value and whether it is present.
+func (o Optional[T]) Get() (T, bool) {
+	return o.Value, o.Valid
+}
+
+// Or returns the value if it is present and def otherwise.
+func (o Optional[T]) Or(def T) T {
+	if !o.Valid {
+		return def
+	}
+	return o.Value
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Optional[T]{}
+		return nil
+	}
+	if err := json.Unmarshal(data, &o.Value); err != nil {
+		return err
+	}
+	o.Valid = true
+	return nil
+}
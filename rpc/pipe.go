@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"bytes"
+	"io"
+)
+
+type pipeConn struct {
+	r io.ReadCloser
+	w io.WriteCloser
+}
+
+// NewPipeConn returns a connection for the framing of --remote-debugging-pipe, where each message
+// is terminated by a NUL byte. The browser reads messages from w (its fd 3) and writes them to r (its fd 4).
+func NewPipeConn(r io.ReadCloser, w io.WriteCloser) io.ReadWriteCloser {
+	return &pipeConn{r: r, w: w}
+}
+
+// Read turns the NUL terminators into newlines, which the JSON stream decoder treats as whitespace.
+// NUL can not occur otherwise, since it is escaped inside of JSON strings.
+func (c *pipeConn) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	for i, b := range p[:n] {
+		if b == 0 {
+			p[i] = '\n'
+		}
+	}
+	return n, err
+}
+
+// Write turns the newline after each encoded message into a NUL terminator.
+// Messages are encoded without indentation, so newlines can not occur otherwise.
+func (c *pipeConn) Write(p []byte) (int, error) {
+	return c.w.Write(bytes.Replace(p, []byte{'\n'}, []byte{0}, -1))
+}
+
+func (c *pipeConn) Close() error {
+	err := c.w.Close()
+	if err2 := c.r.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
@@ -0,0 +1,179 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrReconnecting is wrapped in the *NetworkError of calls that are made while the client is reconnecting.
+var ErrReconnecting = errors.New("reconnecting")
+
+// Dialer establishes a new connection for WithReconnect. It should return an error once ctx is done.
+type Dialer func(ctx context.Context) (io.ReadWriteCloser, error)
+
+// RestoreFunc restores the state of a new connection, e.g. by enabling domains again. The call function sends
+// a command on the new connection, bypassing call interceptors. Other calls fail until RestoreFunc has returned.
+type RestoreFunc func(ctx context.Context, call func(method string, params interface{}, result interface{}) error) error
+
+// Gap is delivered to the OnGap handler of a subscription after the client has reconnected.
+// Events of the time between Disconnected and Reconnected are lost.
+type Gap struct {
+	Err          error // why the connection failed
+	Disconnected time.Time
+	Reconnected  time.Time
+}
+
+// OnGap calls fn when events may have been lost because the client reconnected. fn is called in order with
+// the subscription's handler: after all events of the old connection and before any event of the new one.
+func OnGap(fn func(*Gap)) SubscribeOption {
+	return func(s *Subscription) {
+		s.onGap = fn
+	}
+}
+
+const (
+	minReconnectDelay = 100 * time.Millisecond
+	maxReconnectDelay = 10 * time.Second
+)
+
+// WithReconnect makes the client reconnect with dial if the connection fails, instead of terminating.
+// Calls that are pending when the connection fails return a *NetworkError. Dialing is retried with increasing
+// delays until it succeeds or the client is closed. After reconnecting, restore is called if it is not nil.
+// Restoring errors are reported on Errors.
+func WithReconnect(dial Dialer, restore RestoreFunc) Option {
+	return func(c *Client) {
+		c.dial = dial
+		c.restore = restore
+	}
+}
+
+// reconnect replaces the failed connection. It returns false if the client was closed instead.
+func (c *Client) reconnect(readErr error) bool {
+	disconnected := time.Now()
+	if readErr == io.EOF {
+		readErr = io.ErrUnexpectedEOF
+	}
+
+	c.mutex.Lock()
+	if c.closeErr != nil {
+		c.mutex.Unlock()
+		return false
+	}
+	c.reconnecting = true
+	c.generation++ // a restore of the failed connection must not let calls through
+	generation := c.generation
+	for id, pc := range c.pending {
+		pc.done <- &response{err: &NetworkError{Err: readErr}}
+		delete(c.pending, id)
+	}
+	old := c.conn
+	c.mutex.Unlock()
+	old.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-c.closing:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	delay := minReconnectDelay
+	for {
+		conn, err := c.dial(ctx)
+		if err == nil {
+			c.sending.Lock()
+			c.mutex.Lock()
+			if c.closeErr != nil {
+				c.mutex.Unlock()
+				c.sending.Unlock()
+				conn.Close()
+				return false
+			}
+			c.conn = conn
+			c.enc = json.NewEncoder(conn)
+			c.mutex.Unlock()
+			c.sending.Unlock()
+			c.dec = json.NewDecoder(conn)
+
+			c.dispatchGap(&Gap{Err: readErr, Disconnected: disconnected, Reconnected: time.Now()})
+			go c.restoreState(generation)
+			return true
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-c.closing:
+			return false
+		}
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// restoreState runs the RestoreFunc for the connection of the given generation and then lets other calls through.
+func (c *Client) restoreState(generation uint64) {
+	var err error
+	if c.restore != nil {
+		ctx := context.Background()
+		err = c.restore(ctx, func(method string, params interface{}, result interface{}) error {
+			pc, err := c.startCall(ctx, method, params, true)
+			if err != nil {
+				return err
+			}
+			raw, err := c.wait(ctx, pc)
+			if err != nil {
+				return err
+			}
+			if result != nil {
+				return json.Unmarshal(raw, result)
+			}
+			return nil
+		})
+	}
+
+	c.mutex.Lock()
+	if c.generation != generation {
+		c.mutex.Unlock()
+		return // the connection failed again while restoring
+	}
+	c.reconnecting = false
+	c.mutex.Unlock()
+	if err != nil {
+		c.reportError(fmt.Errorf("restoring state after reconnect: %w", err))
+	}
+}
+
+// dispatchGap is only called by the goroutine that reads from the connection, so the gap is queued in order with events.
+func (c *Client) dispatchGap(g *Gap) {
+	c.subsMutex.Lock()
+	var subs, ordered []*Subscription
+	for _, list := range c.subs {
+		for _, s := range list {
+			if s.onGap == nil {
+				continue
+			}
+			if s.ordered {
+				ordered = append(ordered, s)
+				continue
+			}
+			subs = append(subs, s)
+		}
+	}
+	c.subsMutex.Unlock()
+
+	for _, s := range subs {
+		s.enqueue(g)
+	}
+	if len(ordered) != 0 {
+		c.dispatchOrdered(g, ordered)
+	}
+}
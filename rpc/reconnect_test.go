@@ -0,0 +1,90 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func TestConnectionFailsDuringRestore(t *testing.T) {
+	s := cdptest.NewServer()
+	t.Cleanup(s.Close)
+	s.HandleResult("Fake.ping", nil)
+	s.HandleResult("Fake.restore", nil)
+	s.Handle("Fake.disconnect", func(json.RawMessage) (interface{}, error) {
+		s.Disconnect()
+		return nil, nil
+	})
+
+	dial := func(ctx context.Context) (io.ReadWriteCloser, error) {
+		config, err := websocket.NewConfig(s.WebSocketURL(), s.URL)
+		if err != nil {
+			return nil, err
+		}
+		return config.DialContext(ctx)
+	}
+	proceed := make(chan struct{})
+	var proceedOnce sync.Once
+	unblock := func() { proceedOnce.Do(func() { close(proceed) }) }
+	t.Cleanup(unblock)
+	var restores int32
+	restore := func(ctx context.Context, call func(method string, params interface{}, result interface{}) error) error {
+		if atomic.AddInt32(&restores, 1) == 1 {
+			return call("Fake.disconnect", nil, nil) // the connection fails again while restoring
+		}
+		<-proceed
+		return call("Fake.restore", nil, nil)
+	}
+
+	conn, err := dial(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(conn, rpc.WithReconnect(dial, restore))
+	t.Cleanup(func() { c.Close() })
+	errs := make(chan error, 10)
+	c.Errors = errs
+	if err := c.Call("Fake.ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Disconnect()
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&restores) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("client did not reconnect twice")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond) // give the interrupted restore time to finish
+	if err := c.Call("Fake.ping", nil, nil); !errors.Is(err, rpc.ErrReconnecting) {
+		t.Fatalf("call while restoring the second connection returned %v", err)
+	}
+
+	unblock()
+	for {
+		err := c.Call("Fake.ping", nil, nil)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, rpc.ErrReconnecting) || time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case err := <-errs:
+		t.Errorf("error of the interrupted restore was reported: %v", err)
+	default:
+	}
+}
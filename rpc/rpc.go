@@ -1,96 +1,351 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
-	"math"
-	"net/rpc"
+	"sync"
 )
 
 var EventTypes = make(map[string](func() interface{}))
 
+// ErrShutdown is returned by calls on a client whose connection has been closed with Close.
+var ErrShutdown = errors.New("connection is shut down")
+
+// NetworkError is returned by calls on a client whose connection failed, e.g. because the socket was closed
+// by the other side.
+type NetworkError struct {
+	Err error
+}
+
+func (e *NetworkError) Error() string {
+	return "connection failed: " + e.Err.Error()
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
 type Client struct {
-	*rpc.Client
+	droppedEvents uint64 // accessed atomically, first for 64-bit alignment
+
 	Events chan<- interface{}
+
+	// Errors receives errors that do not belong to a call, e.g. events that could not be decoded.
+	// Such errors do not terminate the connection.
+	Errors chan<- error
+
+	conn io.ReadWriteCloser
+	dec  *json.Decoder
+
+	sending sync.Mutex
+	enc     *json.Encoder
+
+	mutex      sync.Mutex
+	seq        uint64
+	pending    map[uint64]*pendingCall
+	closeErr   error         // reason passed to terminate
+	closing    chan struct{} // closed by terminate
+	err        error         // set when the connection has terminated
+	terminated chan struct{} // closed when the connection has terminated
+
+	dial         Dialer // set by WithReconnect
+	restore      RestoreFunc
+	reconnecting bool   // calls fail until the state of the new connection is restored
+	generation   uint64 // number of connection failures
+
+	domainsMutex sync.Mutex
+	domains      map[string]*domainState
+
+	subsMutex  sync.Mutex
+	subs       map[string][]*Subscription
+	eventsOnce sync.Once
+
+	dispatchMutex  sync.Mutex
+	dispatchCond   *sync.Cond
+	dispatchQueue  []*dispatchItem
+	dispatchBusy   bool
+	dispatchClosed bool
+	dispatcherDone chan struct{}
+
+	callInterceptors  []CallInterceptor
+	eventInterceptors []EventInterceptor
+	invoke            Invoker
+	handleEvent       func(method string, params json.RawMessage)
 }
 
 type Listener func(params json.RawMessage)
 
-type clientCodec struct {
-	client     *Client
-	dec        *json.Decoder
-	enc        *json.Encoder
-	c          io.Closer
-	lastResult json.RawMessage
-}
-
-func NewClient(conn io.ReadWriteCloser) *Client {
-	cl := &Client{}
-	cl.Client = rpc.NewClientWithCodec(&clientCodec{
-		client: cl,
-		dec:    json.NewDecoder(conn),
-		enc:    json.NewEncoder(conn),
-		c:      conn,
-	})
+type pendingCall struct {
+	id     uint64
+	method string
+	done   chan *response
+}
+
+type response struct {
+	result json.RawMessage
+	err    error
+}
+
+func NewClient(conn io.ReadWriteCloser, opts ...Option) *Client {
+	cl := &Client{
+		conn:       conn,
+		dec:        json.NewDecoder(conn),
+		enc:        json.NewEncoder(conn),
+		pending:    make(map[uint64]*pendingCall),
+		closing:    make(chan struct{}),
+		terminated: make(chan struct{}),
+
+		dispatcherDone: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(cl)
+	}
+	cl.invoke = chainCallInterceptors(cl.callInterceptors, cl.roundTrip)
+	cl.handleEvent = chainEventInterceptors(cl.eventInterceptors, cl.dispatchEvent)
+	cl.dispatchCond = sync.NewCond(&cl.dispatchMutex)
+	go cl.runDispatcher()
+	go cl.input()
 	return cl
 }
 
-func (c *clientCodec) WriteRequest(r *rpc.Request, param interface{}) error {
-	return c.enc.Encode(struct {
-		ID     uint64      `json:"id"`
-		Method string      `json:"method"`
-		Params interface{} `json:"params"`
+// Call invokes the given method and waits for it to complete.
+func (c *Client) Call(method string, params interface{}, result interface{}) error {
+	return c.CallContext(context.Background(), method, params, result)
+}
+
+// CallContext invokes the given method and waits for it to complete or for ctx to be done.
+// If ctx is done first, the call is abandoned, ctx.Err() is returned and a late response is discarded.
+func (c *Client) CallContext(ctx context.Context, method string, params interface{}, result interface{}) error {
+	raw, err := c.invoke(ctx, method, params)
+	if err != nil {
+		return err
+	}
+	if result != nil {
+		return json.Unmarshal(raw, result)
+	}
+	return nil
+}
+
+func (c *Client) roundTrip(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	pc, err := c.start(ctx, method, params)
+	if err != nil {
+		return nil, err
+	}
+	return c.wait(ctx, pc)
+}
+
+// start sends the command without waiting for its response.
+func (c *Client) start(ctx context.Context, method string, params interface{}) (*pendingCall, error) {
+	return c.startCall(ctx, method, params, false)
+}
+
+// startCall is start, but restoring calls are also sent while reconnecting.
+func (c *Client) startCall(ctx context.Context, method string, params interface{}, restoring bool) (*pendingCall, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if v, ok := params.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	pc := &pendingCall{method: method, done: make(chan *response, 1)}
+	c.mutex.Lock()
+	if c.err != nil {
+		err := c.err
+		c.mutex.Unlock()
+		return nil, err
+	}
+	if c.closeErr != nil {
+		err := c.closeErr
+		c.mutex.Unlock()
+		return nil, err
+	}
+	if c.reconnecting && !restoring {
+		c.mutex.Unlock()
+		return nil, &NetworkError{Err: ErrReconnecting}
+	}
+	c.seq++
+	pc.id = c.seq
+	c.pending[pc.id] = pc
+	c.mutex.Unlock()
+
+	if err := c.send(pc.id, method, rawParams); err != nil {
+		c.forget(pc.id)
+		err = &NetworkError{Err: err}
+		if c.dial == nil {
+			c.terminate(err)
+		}
+		return nil, err
+	}
+	return pc, nil
+}
+
+func (c *Client) wait(ctx context.Context, pc *pendingCall) (json.RawMessage, error) {
+	select {
+	case resp := <-pc.done:
+		return resp.result, resp.err
+	case <-ctx.Done():
+		c.forget(pc.id)
+		return nil, ctx.Err()
+	}
+}
+
+// send writes the command. An error means that the connection failed.
+func (c *Client) send(id uint64, method string, params json.RawMessage) error {
+	c.sending.Lock()
+	defer c.sending.Unlock()
+	err := c.enc.Encode(struct {
+		ID     uint64          `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
 	}{
-		ID:     r.Seq,
-		Method: r.ServiceMethod,
-		Params: param,
+		ID:     id,
+		Method: method,
+		Params: params,
 	})
+	if err != nil && c.dial != nil {
+		c.conn.Close() // let input reconnect
+	}
+	return err
+}
+
+func (c *Client) forget(id uint64) {
+	c.mutex.Lock()
+	delete(c.pending, id)
+	c.mutex.Unlock()
 }
 
-func (c *clientCodec) ReadResponseHeader(r *rpc.Response) error {
+func (c *Client) input() {
+	var err error
+	for {
+		err = nil
+		for err == nil {
+			err = c.readMessage()
+		}
+		if c.dial == nil || !c.reconnect(err) {
+			break
+		}
+	}
+
+	c.mutex.Lock()
+	if c.closeErr != nil {
+		err = c.closeErr
+	} else {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		err = &NetworkError{Err: err}
+	}
+	c.err = err
+	for id, pc := range c.pending {
+		pc.done <- &response{err: err}
+		delete(c.pending, id)
+	}
+	c.mutex.Unlock()
+	c.closeDispatcher()
+	close(c.terminated)
+}
+
+func (c *Client) readMessage() error {
 	var resp struct {
 		// for responses
-		ID     uint64           `json:"id"`
-		Result json.RawMessage  `json:"result"`
-		Error  *json.RawMessage `json:"error"`
+		ID     uint64          `json:"id"`
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"` // decoded below, so a malformed error still completes the call
 
 		// for events
 		Method string
 		Params json.RawMessage
 	}
 	if err := c.dec.Decode(&resp); err != nil {
+		if _, ok := err.(*json.UnmarshalTypeError); ok {
+			// the message was consumed, so the stream is still intact
+			c.reportError(err)
+			return nil
+		}
 		return err
 	}
 
 	if resp.Method != "" {
-		if c.client.Events != nil {
-			e := EventTypes[resp.Method]()
-			if err := json.Unmarshal(resp.Params, e); err != nil {
-				return err
-			}
-			c.client.Events <- e
-		}
-		r.Seq = math.MaxUint64 // ignore
+		c.handleEvent(resp.Method, resp.Params)
 		return nil
 	}
 
-	r.Seq = resp.ID
-	c.lastResult = resp.Result
-	if resp.Error != nil {
-		r.Error = string(*resp.Error)
+	c.mutex.Lock()
+	pc, ok := c.pending[resp.ID]
+	delete(c.pending, resp.ID)
+	c.mutex.Unlock()
+	if !ok {
+		return nil // abandoned call, discard late response
 	}
+
+	if len(resp.Error) != 0 && string(resp.Error) != "null" {
+		perr := &ProtocolError{}
+		if err := json.Unmarshal(resp.Error, perr); err != nil {
+			c.complete(pc, &response{err: fmt.Errorf("%s: decoding error response %s: %w", pc.method, resp.Error, err)})
+			return nil
+		}
+		perr.Method = pc.method
+		c.complete(pc, &response{err: perr})
+		return nil
+	}
+	c.complete(pc, &response{result: resp.Result})
 	return nil
 }
 
-func (c *clientCodec) ReadResponseBody(v interface{}) error {
-	var err error
-	if v != nil {
-		err = json.Unmarshal(c.lastResult, v)
+// reportError sends err on Errors without blocking. The error is dropped if nobody is ready to receive it.
+func (c *Client) reportError(err error) {
+	if c.Errors != nil {
+		select {
+		case c.Errors <- err:
+		default:
+		}
 	}
-	c.lastResult = nil
-	return err
 }
 
-func (c *clientCodec) Close() error {
-	return c.c.Close()
+// Close closes the underlying connection. Pending calls fail with ErrShutdown.
+func (c *Client) Close() error {
+	return c.terminate(ErrShutdown)
+}
+
+// CloseWithError closes the underlying connection. Pending and later calls fail with err.
+// It has no effect if the connection is already closing.
+func (c *Client) CloseWithError(err error) error {
+	return c.terminate(err)
+}
+
+// Done returns a channel that is closed when the connection has terminated and all pending calls have failed.
+func (c *Client) Done() <-chan struct{} {
+	return c.terminated
+}
+
+// Err returns nil while the connection is alive and the reason why it terminated after Done is closed:
+// ErrShutdown after Close, a *NetworkError if the connection failed, or the error passed to CloseWithError.
+func (c *Client) Err() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.err
+}
+
+// terminate closes the underlying connection. Pending calls fail with err.
+func (c *Client) terminate(err error) error {
+	c.mutex.Lock()
+	if c.closeErr != nil {
+		c.mutex.Unlock()
+		return ErrShutdown
+	}
+	c.closeErr = err
+	close(c.closing)
+	conn := c.conn
+	c.mutex.Unlock()
+	return conn.Close()
 }
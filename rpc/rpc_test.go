@@ -0,0 +1,451 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/protocol/runtime"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func newClient(t *testing.T, opts ...rpc.Option) (*rpc.Client, *cdptest.Server) {
+	s := cdptest.NewServer()
+	t.Cleanup(s.Close)
+	ws, err := websocket.Dial(s.WebSocketURL(), "", s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := rpc.NewClient(ws, opts...)
+	t.Cleanup(func() { c.Close() })
+	return c, s
+}
+
+func TestOrderedEventBeforeResponse(t *testing.T) {
+	c, s := newClient(t)
+	s.Handle("Fake.navigate", func(json.RawMessage) (interface{}, error) {
+		s.Emit("Fake.loaded", nil)
+		return nil, nil
+	})
+	loaded := false
+	c.Subscribe("Fake.loaded", func(interface{}) {
+		time.Sleep(50 * time.Millisecond)
+		loaded = true
+	}, rpc.Ordered())
+
+	if err := c.Call("Fake.navigate", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded {
+		t.Error("call returned before the event that preceded its response was handled")
+	}
+}
+
+// overflow is a subscription with a queue of one event and a handler that blocks on the first event.
+type overflow struct {
+	c       *rpc.Client
+	s       *cdptest.Server
+	sub     *rpc.Subscription
+	release chan struct{}
+
+	mutex    sync.Mutex
+	received []int
+}
+
+// newOverflow subscribes with the given policy and returns once the handler blocks on the first event.
+func newOverflow(t *testing.T, policy rpc.OverflowPolicy) *overflow {
+	c, s := newClient(t)
+	o := &overflow{c: c, s: s, release: make(chan struct{})}
+	started := make(chan struct{})
+	o.sub = c.Subscribe("Fake.event", func(e interface{}) {
+		var p struct{ N int }
+		json.Unmarshal(e.(*rpc.RawEvent).Params, &p)
+		o.mutex.Lock()
+		o.received = append(o.received, p.N)
+		o.mutex.Unlock()
+		if p.N == 1 {
+			close(started)
+			<-o.release
+		}
+	}, rpc.WithBufferSize(1), rpc.WithOverflowPolicy(policy))
+	t.Cleanup(o.unblock)
+
+	o.emit(t, 1)
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("first event was not delivered")
+	}
+	return o
+}
+
+func (o *overflow) emit(t *testing.T, ns ...int) {
+	for _, n := range ns {
+		if err := o.s.Emit("Fake.event", map[string]int{"n": n}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// sync waits until the client has read all events that were emitted before.
+func (o *overflow) sync(t *testing.T) {
+	o.s.HandleResult("Fake.ping", nil)
+	if err := o.c.Call("Fake.ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (o *overflow) unblock() {
+	select {
+	case <-o.release:
+	default:
+		close(o.release)
+	}
+}
+
+// finish releases the handler and returns all events that it received.
+func (o *overflow) finish(t *testing.T) []int {
+	o.unblock()
+	o.c.Close()
+	<-o.sub.Done()
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return o.received
+}
+
+func checkEvents(t *testing.T, got []int, want ...int) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got events %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("got events %v, want %v", got, want)
+		}
+	}
+}
+
+func checkDropped(t *testing.T, o *overflow, n uint64) {
+	t.Helper()
+	if o.sub.Dropped() != n || o.c.DroppedEvents() != n {
+		t.Errorf("got %d dropped events on the subscription and %d on the client, want %d", o.sub.Dropped(), o.c.DroppedEvents(), n)
+	}
+}
+
+func TestDropOldest(t *testing.T) {
+	o := newOverflow(t, rpc.DropOldest)
+	o.emit(t, 2, 3, 4)
+	o.sync(t)
+	checkDropped(t, o, 2)
+	checkEvents(t, o.finish(t), 1, 4)
+}
+
+func TestDropNewest(t *testing.T) {
+	o := newOverflow(t, rpc.DropNewest)
+	o.emit(t, 2, 3, 4)
+	o.sync(t)
+	checkDropped(t, o, 2)
+	checkEvents(t, o.finish(t), 1, 2)
+}
+
+func TestBlock(t *testing.T) {
+	o := newOverflow(t, rpc.Block)
+	o.emit(t, 2, 3)
+	o.s.HandleResult("Fake.ping", nil)
+	ping := o.c.Go(context.Background(), "Fake.ping", nil, nil)
+	select {
+	case <-ping.Done():
+		t.Fatal("call completed while the connection should be stalled")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	o.unblock()
+	if err := ping.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	checkDropped(t, o, 0)
+	checkEvents(t, o.finish(t), 1, 2, 3)
+}
+
+func TestDisconnect(t *testing.T) {
+	o := newOverflow(t, rpc.Disconnect)
+	o.emit(t, 2, 3)
+	select {
+	case <-o.c.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("client did not terminate on overflow")
+	}
+	if !errors.Is(o.c.Err(), rpc.ErrEventOverflow) {
+		t.Errorf("got %v, want %v", o.c.Err(), rpc.ErrEventOverflow)
+	}
+	checkDropped(t, o, 1)
+	checkEvents(t, o.finish(t), 1, 2)
+}
+
+func TestLateResponse(t *testing.T) {
+	c, s := newClient(t)
+	release := make(chan struct{})
+	var releaseOnce sync.Once
+	unblock := func() { releaseOnce.Do(func() { close(release) }) }
+	t.Cleanup(unblock)
+	s.Handle("Fake.slow", func(json.RawMessage) (interface{}, error) {
+		<-release
+		return map[string]string{"value": "slow"}, nil
+	})
+	s.HandleResult("Fake.fast", map[string]string{"value": "fast"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var res struct{ Value string }
+	if err := c.CallContext(ctx, "Fake.slow", nil, &res); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	unblock()
+
+	if err := c.Call("Fake.fast", nil, &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Value != "fast" {
+		t.Errorf("got result %q, want the one of the second call", res.Value)
+	}
+	if c.Err() != nil {
+		t.Errorf("late response terminated the client: %v", c.Err())
+	}
+}
+
+func TestSubscribeWhileStreaming(t *testing.T) {
+	c, s := newClient(t)
+	s.HandleResult("Fake.ping", nil)
+	if err := c.Call("Fake.ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				s.Emit("Fake.event", nil)
+			}
+		}
+	}()
+
+	for start, i := time.Now(), 0; time.Since(start) < 200*time.Millisecond; i++ {
+		policy := rpc.Block
+		if i%2 == 1 {
+			policy = rpc.DropOldest
+		}
+		sub := c.Subscribe("Fake.event", func(interface{}) {}, rpc.WithBufferSize(1), rpc.WithOverflowPolicy(policy))
+		if i%100 != 0 {
+			sub.Unsubscribe()
+		}
+	}
+	close(stop)
+	<-stopped
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.CallContext(ctx, "Fake.ping", nil, nil); err != nil {
+		t.Errorf("client is stuck: %v", err)
+	}
+}
+
+func TestMalformedErrorResponse(t *testing.T) {
+	clientConn, serverConn := net.Pipe()
+	c := rpc.NewClient(clientConn)
+	defer c.Close()
+	go func() {
+		var req struct{ ID int }
+		if err := json.NewDecoder(serverConn).Decode(&req); err != nil {
+			return
+		}
+		fmt.Fprintf(serverConn, `{"id":%d,"error":"boom"}`+"\n", req.ID)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := c.CallContext(ctx, "Fake.fail", nil, nil)
+	if err == nil || errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("got %v, want an error about the malformed response", err)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	c, s := newClient(t)
+	var received []int // only accessed by the handler until Done is closed
+	sub := c.Subscribe("Fake.event", func(e interface{}) {
+		var p struct{ N int }
+		json.Unmarshal(e.(*rpc.RawEvent).Params, &p)
+		received = append(received, p.N)
+	}, rpc.WithBufferSize(1), rpc.WithOverflowPolicy(rpc.Block), rpc.WithFilter(func(e interface{}) bool {
+		return string(e.(*rpc.RawEvent).Params) != `{"n":3}`
+	}), rpc.WithFilter(func(e interface{}) bool {
+		return string(e.(*rpc.RawEvent).Params) != `{"n":4}`
+	}))
+	for n := 1; n <= 5; n++ {
+		s.Emit("Fake.event", map[string]int{"n": n})
+	}
+	s.HandleResult("Fake.ping", nil)
+	if err := c.Call("Fake.ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	<-sub.Done()
+	checkEvents(t, received, 1, 2, 5)
+	if sub.Dropped() != 0 {
+		t.Errorf("filtered events counted as dropped: %d", sub.Dropped())
+	}
+}
+
+func TestCallInterceptorOrder(t *testing.T) {
+	var log []string // calls are sequential, so no lock is needed
+	interceptor := func(name string) rpc.Option {
+		return rpc.WithCallInterceptor(func(ctx context.Context, method string, params interface{}, invoke rpc.Invoker) (json.RawMessage, error) {
+			log = append(log, name+" before")
+			result, err := invoke(ctx, method, params)
+			log = append(log, name+" after "+string(result))
+			return json.RawMessage(`"` + name + `"`), err
+		})
+	}
+	c, s := newClient(t, interceptor("first"), interceptor("second"))
+	s.HandleResult("Fake.method", "browser")
+
+	var result string
+	if err := c.Call("Fake.method", nil, &result); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"first before", "second before", `second after "browser"`, `first after "second"`}
+	if strings.Join(log, ", ") != strings.Join(want, ", ") {
+		t.Errorf("got %q, want %q", log, want)
+	}
+	if result != "first" {
+		t.Errorf("got result %q, want the one of the outermost interceptor", result)
+	}
+}
+
+func TestEventInterceptorDrop(t *testing.T) {
+	var mutex sync.Mutex
+	var log []string
+	interceptor := func(name, drop string) rpc.Option {
+		return rpc.WithEventInterceptor(func(method string, params json.RawMessage, deliver func(json.RawMessage)) {
+			mutex.Lock()
+			log = append(log, name+" "+string(params))
+			mutex.Unlock()
+			if string(params) != drop {
+				deliver(params)
+			}
+		})
+	}
+	c, s := newClient(t, interceptor("first", `{"n":2}`), interceptor("second", `{"n":3}`))
+	var received []int // only accessed by the handler until Done is closed
+	sub := c.Subscribe("Fake.event", func(e interface{}) {
+		var p struct{ N int }
+		json.Unmarshal(e.(*rpc.RawEvent).Params, &p)
+		received = append(received, p.N)
+	}, rpc.WithOverflowPolicy(rpc.Block))
+	for n := 1; n <= 4; n++ {
+		s.Emit("Fake.event", map[string]int{"n": n})
+	}
+	s.HandleResult("Fake.ping", nil)
+	if err := c.Call("Fake.ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	<-sub.Done()
+
+	checkEvents(t, received, 1, 4)
+	if sub.Dropped() != 0 {
+		t.Errorf("events dropped by an interceptor counted as dropped: %d", sub.Dropped())
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	want := []string{`first {"n":1}`, `second {"n":1}`, `first {"n":2}`, `first {"n":3}`, `second {"n":3}`, `first {"n":4}`, `second {"n":4}`}
+	if strings.Join(log, ", ") != strings.Join(want, ", ") {
+		t.Errorf("got %q, want %q", log, want)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	c, s := newClient(t)
+	s.HandleResult("Fake.ok", nil)
+	s.Handle("Fake.fail", func(json.RawMessage) (interface{}, error) {
+		return nil, errors.New("failed")
+	})
+	ctx := context.Background()
+
+	var b rpc.Batch
+	b.Add(c.Go(ctx, "Fake.ok", nil, nil))
+	b.Add(c.Go(ctx, "Fake.ok", nil, nil))
+	if err := b.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	b.Add(c.Go(ctx, "Fake.fail", nil, nil))
+	err := b.Wait()
+	var berr *rpc.BatchError
+	if !errors.As(err, &berr) {
+		t.Fatalf("got %v, want a *rpc.BatchError", err)
+	}
+	if berr.Failed != 1 || len(berr.Errors) != 3 || berr.Errors[0] != nil || berr.Errors[1] != nil || berr.Errors[2] == nil {
+		t.Errorf("got %d failed calls and errors %v, want only the third call failed", berr.Failed, berr.Errors)
+	}
+	if !strings.HasPrefix(err.Error(), "1 of 3 calls failed") {
+		t.Errorf("got message %q", err.Error())
+	}
+}
+
+func TestDoAsync(t *testing.T) {
+	c, s := newClient(t)
+	s.Handle("Runtime.evaluate", func(params json.RawMessage) (interface{}, error) {
+		var p struct{ Expression string }
+		json.Unmarshal(params, &p)
+		if p.Expression == "fail" {
+			return nil, errors.New("failed")
+		}
+		return map[string]interface{}{"result": map[string]string{"type": "string", "value": p.Expression}}, nil
+	})
+	r := &runtime.Client{Client: c}
+	ctx := context.Background()
+
+	first := r.Evaluate().Expression("first").DoAsync(ctx)
+	second := r.Evaluate().Expression("second").DoAsync(ctx)
+	failed := r.Evaluate().Expression("fail").DoAsync(ctx)
+
+	for _, v := range []struct {
+		f    *runtime.EvaluateFuture
+		want string
+	}{{second, `"second"`}, {first, `"first"`}} {
+		res, err := v.f.Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(res.Result.Value) != v.want {
+			t.Errorf("got value %s, want %s", res.Result.Value, v.want)
+		}
+	}
+	if _, err := failed.Get(); err == nil {
+		t.Error("future of a failed command succeeded")
+	}
+
+	var expressions []string
+	for _, call := range s.Calls() {
+		var p struct{ Expression string }
+		json.Unmarshal(call.Params, &p)
+		expressions = append(expressions, p.Expression)
+	}
+	if strings.Join(expressions, ",") != "first,second,fail" {
+		t.Errorf("commands were sent in the order %v, want the order of DoAsync", expressions)
+	}
+}
@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+)
+
+// Waiter waits for the first event that matches a predicate. It is created by Client.WaitFor.
+type Waiter struct {
+	ctx    context.Context
+	client *Client
+	sub    *Subscription
+	match  chan interface{}
+	once   sync.Once
+}
+
+// WaitFor arms a waiter for the first event with the given method for which predicate returns true.
+// An empty method matches all events and a nil predicate matches any event. Since the waiter is armed
+// immediately, it should be created before sending the command that triggers the event, e.g.:
+//
+//	w := client.WaitFor(ctx, "Page.loadEventFired", nil)
+//	client.Call("Page.navigate", params, nil)
+//	event, err := w.Wait()
+//
+// The context limits how long Wait waits. The waiter is cancelled when the context is done, even if Wait
+// is never called.
+func (c *Client) WaitFor(ctx context.Context, method string, predicate func(event interface{}) bool) *Waiter {
+	w := &Waiter{
+		ctx:    ctx,
+		client: c,
+		match:  make(chan interface{}, 1),
+	}
+	s := c.newSubscription(method, []SubscribeOption{WithOverflowPolicy(Block)})
+	s.fn = func(e interface{}) {
+		if predicate != nil && !predicate(e) {
+			return
+		}
+		w.once.Do(func() {
+			w.match <- e
+			s.Unsubscribe()
+		})
+	}
+	w.sub = s
+	c.register(s)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			w.Cancel()
+		case <-s.Done():
+		}
+	}()
+	return w
+}
+
+// Wait waits for the matching event. It returns the context's error if the context is done first,
+// the client's Err if the connection terminates first, or context.Canceled if the waiter was cancelled.
+// An event that was received before the connection terminated is still returned.
+func (w *Waiter) Wait() (interface{}, error) {
+	select {
+	case e := <-w.match:
+		w.match <- e // keep it for subsequent calls
+		return e, nil
+	case <-w.ctx.Done():
+		w.Cancel()
+		return nil, w.ctx.Err()
+	case <-w.sub.Done():
+		// all events have been passed to the predicate
+		select {
+		case e := <-w.match:
+			w.match <- e
+			return e, nil
+		default:
+		}
+		if err := w.ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := w.client.Err(); err != nil {
+			return nil, err
+		}
+		return nil, context.Canceled
+	}
+}
+
+// Cancel disarms the waiter.
+func (w *Waiter) Cancel() {
+	w.sub.Unsubscribe()
+}
@@ -0,0 +1,116 @@
+package rpc_test
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func TestWaitForEventBeforeDisconnect(t *testing.T) {
+	c, s := newClient(t)
+	w := c.WaitFor(context.Background(), "Page.loadEventFired", func(interface{}) bool {
+		time.Sleep(100 * time.Millisecond)
+		return true
+	})
+	if err := s.Emit("Page.loadEventFired", map[string]float64{"timestamp": 1}); err != nil {
+		t.Fatal(err)
+	}
+	s.Disconnect()
+
+	e, err := w.Wait()
+	if err != nil {
+		t.Fatalf("event received before the disconnect was lost: %v", err)
+	}
+	if raw, ok := e.(*rpc.RawEvent); !ok || raw.Method != "Page.loadEventFired" {
+		t.Errorf("got event %#v", e)
+	}
+}
+
+func TestWaitForDisconnect(t *testing.T) {
+	c, s := newClient(t)
+	w := c.WaitFor(context.Background(), "Page.loadEventFired", nil)
+	s.Disconnect()
+	if _, err := w.Wait(); err == nil || err != c.Err() {
+		t.Errorf("got %v, want the client's error %v", err, c.Err())
+	}
+}
+
+func TestWaitForCancel(t *testing.T) {
+	c, _ := newClient(t)
+	w := c.WaitFor(context.Background(), "Page.loadEventFired", nil)
+	w.Cancel()
+	if _, err := w.Wait(); err != context.Canceled {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestWaitForWhileStreaming(t *testing.T) {
+	c, s := newClient(t)
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				s.Emit("Page.loadEventFired", nil)
+				runtime.Gosched() // let the client keep up
+			}
+		}
+	}()
+	defer func() {
+		close(stop)
+		<-stopped
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for i := 0; i < 50; i++ {
+		if _, err := c.WaitFor(ctx, "Page.loadEventFired", nil).Wait(); err != nil {
+			t.Fatalf("waiter %d: %v", i, err)
+		}
+	}
+}
+
+func TestWaitForContextWithoutWait(t *testing.T) {
+	c, s := newClient(t)
+	s.HandleResult("Fake.ping", nil)
+	var mutex sync.Mutex
+	seen := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	c.WaitFor(ctx, "Fake.event", func(interface{}) bool {
+		mutex.Lock()
+		seen++
+		mutex.Unlock()
+		return false
+	})
+	cancel()
+
+	// the subscription ends asynchronously, so wait until an event is no longer seen
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mutex.Lock()
+		before := seen
+		mutex.Unlock()
+		s.Emit("Fake.event", nil)
+		if err := c.Call("Fake.ping", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond) // the subscription's own goroutine delivers the event
+		mutex.Lock()
+		after := seen
+		mutex.Unlock()
+		if after == before {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("waiter was not cancelled when its context was done")
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package cdp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/neelance/cdp-go/protocol/target"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+// sessionConn tunnels the messages of a target through Target.sendMessageToTarget
+// and Target.receivedMessageFromTarget of the parent connection.
+type sessionConn struct {
+	parent   *Client
+	targetID target.TargetID
+	session  *rpc.Client
+
+	r         *io.PipeReader
+	w         *io.PipeWriter
+	subs      []*rpc.Subscription
+	closeOnce sync.Once
+
+	writeCtx     context.Context // cancelled by Close, so calls on the parent that are still pending end
+	cancelWrites context.CancelFunc
+
+	mutex    sync.Mutex
+	attached bool // DetachFromTarget is needed on Close
+}
+
+// detachTimeout limits how long closing a session waits for the parent to detach from the target.
+const detachTimeout = 5 * time.Second
+
+// AttachSession attaches to the given target, e.g. a page, iframe, worker or service worker,
+// and returns a Client for it. Closing the returned Client detaches from the target.
+// The returned Client terminates with a *DetachedError if the target goes away, with the parent's Err
+// if the parent connection terminates and with a *rpc.NetworkError if it reconnects.
+// The options are passed to rpc.NewClient.
+func (c *Client) AttachSession(ctx context.Context, targetID target.TargetID, opts ...rpc.Option) (*Client, error) {
+	r, w := io.Pipe()
+	writeCtx, cancelWrites := context.WithCancel(context.Background())
+	conn := &sessionConn{
+		parent:       c,
+		targetID:     targetID,
+		r:            r,
+		w:            w,
+		writeCtx:     writeCtx,
+		cancelWrites: cancelWrites,
+	}
+
+	session := NewClient(rpc.NewClient(conn, opts...))
+	conn.session = session.Client
+
+	// subscribe before attaching, so no message gets lost
+	conn.subs = []*rpc.Subscription{
+		c.Target.OnReceivedMessageFromTarget(func(e *target.ReceivedMessageFromTargetEvent) {
+			if e.TargetId == targetID {
+				w.Write([]byte(e.Message + "\n"))
+			}
+		}, rpc.WithOverflowPolicy(rpc.Block), rpc.OnGap(func(g *rpc.Gap) {
+			// the parent reconnected, the session is gone
+			conn.detached()
+			session.CloseWithError(&rpc.NetworkError{Err: g.Err})
+		})),
+		c.Target.OnDetachedFromTarget(func(e *target.DetachedFromTargetEvent) {
+			if e.TargetId == targetID {
+				conn.detached()
+				session.CloseWithError(&DetachedError{Reason: "detached from target"})
+			}
+		}),
+		c.Target.OnTargetDestroyed(func(e *target.TargetDestroyedEvent) {
+			if e.TargetId == targetID {
+				conn.detached()
+				session.CloseWithError(&DetachedError{Reason: "target destroyed"})
+			}
+		}),
+	}
+	go func() {
+		select {
+		case <-c.Done():
+			conn.detached()
+			session.CloseWithError(c.Err())
+		case <-session.Done():
+		}
+	}()
+
+	res, err := c.Target.AttachToTarget().TargetId(targetID).DoContext(ctx)
+	if err == nil && !res.Success {
+		err = fmt.Errorf("cdp: attaching to target %s failed", targetID)
+	}
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	conn.mutex.Lock()
+	conn.attached = true
+	conn.mutex.Unlock()
+
+	return session, nil
+}
+
+func (c *sessionConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// Write sends the message without waiting for the parent's response, so the commands of the session are
+// pipelined like those of the parent. If sending fails, the session is closed with the error.
+func (c *sessionConn) Write(p []byte) (int, error) {
+	msg := string(bytes.TrimSuffix(p, []byte{'\n'}))
+	call := c.parent.Target.SendMessageToTarget().TargetId(c.targetID).Message(msg).DoAsync(c.writeCtx)
+	go func() {
+		if err := call.Wait(); err != nil && c.writeCtx.Err() == nil {
+			c.session.CloseWithError(err)
+		}
+	}()
+	return len(p), nil
+}
+
+func (c *sessionConn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		c.cancelWrites()
+		c.unsubscribe()
+		c.mutex.Lock()
+		attached := c.attached
+		c.mutex.Unlock()
+		if attached {
+			ctx, cancel := context.WithTimeout(context.Background(), detachTimeout)
+			defer cancel()
+			err = c.parent.Target.DetachFromTarget().TargetId(c.targetID).DoContext(ctx)
+		}
+		c.w.Close()
+	})
+	return err
+}
+
+// detached records that the target is gone, so Close does not need to detach.
+func (c *sessionConn) detached() {
+	c.mutex.Lock()
+	c.attached = false
+	c.mutex.Unlock()
+}
+
+func (c *sessionConn) unsubscribe() {
+	for _, s := range c.subs {
+		s.Unsubscribe()
+	}
+}
@@ -0,0 +1,125 @@
+package cdp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func TestSessionCloseCancelsWrite(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Target.attachToTarget", map[string]bool{"success": true})
+	block := make(chan struct{})
+	defer close(block)
+	s.Handle("Target.sendMessageToTarget", func(json.RawMessage) (interface{}, error) {
+		<-block
+		return nil, nil
+	})
+
+	c, err := DialContext(context.Background(), s.WebSocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	session, err := c.AttachSession(context.Background(), "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Call("Page.enable", nil, nil)
+	}()
+	for !hasMethod(s, "Target.sendMessageToTarget") {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := s.Emit("Target.targetDestroyed", map[string]string{"targetId": "t1"}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("call on destroyed target succeeded")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("write to the parent was not cancelled when the session closed")
+	}
+}
+
+func TestSessionWritesArePipelined(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Target.attachToTarget", map[string]bool{"success": true})
+	block := make(chan struct{})
+	defer close(block)
+	s.Handle("Target.sendMessageToTarget", func(json.RawMessage) (interface{}, error) {
+		<-block
+		return nil, nil
+	})
+
+	c, err := DialContext(context.Background(), s.WebSocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	session, err := c.AttachSession(context.Background(), "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sent := make(chan struct{})
+	go func() {
+		session.Go(context.Background(), "Page.enable", nil, nil)
+		session.Go(context.Background(), "Runtime.enable", nil, nil)
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sending on the session waited for the response of the parent")
+	}
+}
+
+func TestSessionWriteFailure(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Target.attachToTarget", map[string]bool{"success": true})
+	s.Handle("Target.sendMessageToTarget", func(json.RawMessage) (interface{}, error) {
+		return nil, errors.New("no session")
+	})
+
+	c, err := DialContext(context.Background(), s.WebSocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	session, err := c.AttachSession(context.Background(), "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := session.CallContext(ctx, "Page.enable", nil, nil); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want the error of the parent", err)
+	}
+	var perr *rpc.ProtocolError
+	if !errors.As(session.Err(), &perr) {
+		t.Errorf("session was not closed with the error of the parent: %v", session.Err())
+	}
+}
+
+func hasMethod(s *cdptest.Server, method string) bool {
+	for _, m := range s.Methods() {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package cdp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/neelance/cdp-go/cdptest"
+	"github.com/neelance/cdp-go/protocol/debugger"
+	"github.com/neelance/cdp-go/protocol/page"
+	"github.com/neelance/cdp-go/rpc"
+)
+
+func TestValidateParams(t *testing.T) {
+	s := cdptest.NewServer()
+	defer s.Close()
+	s.HandleResult("Page.navigate", map[string]string{"frameId": "f1"})
+	c, err := DialContext(context.Background(), s.WebSocketURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	_, err = c.Page.Navigate().Do()
+	var perr *rpc.ParamsError
+	if !errors.As(err, &perr) || perr.Method != "Page.navigate" || !reflect.DeepEqual(perr.Missing, []string{"url"}) {
+		t.Errorf("missing url: got %v", err)
+	}
+
+	_, err = c.Page.Navigate().URL("about:blank").TransitionType("bogus").Do()
+	if !errors.As(err, &perr) || !reflect.DeepEqual(perr.Invalid, []string{"transitionType"}) || !errors.Is(err, rpc.ErrInvalidParams) {
+		t.Errorf("invalid enum value: got %v", err)
+	}
+
+	if _, err := c.Page.Navigate().URL("about:blank").TransitionType(page.TransitionTypeLink).Do(); err != nil {
+		t.Errorf("valid params: %v", err)
+	}
+	// the struct path has no record of which fields were set
+	_, err = c.Page.DoNavigate(context.Background(), &page.NavigateParams{})
+	if !errors.As(err, &perr) || !reflect.DeepEqual(perr.Missing, []string{"url"}) {
+		t.Errorf("missing url on struct path: got %v", err)
+	}
+	err = c.Debugger.DoSetPauseOnExceptions(context.Background(), &debugger.SetPauseOnExceptionsParams{})
+	if !errors.As(err, &perr) || !reflect.DeepEqual(perr.Missing, []string{"state"}) {
+		t.Errorf("missing enum on struct path: got %v", err)
+	}
+	err = c.Debugger.DoSetPauseOnExceptions(context.Background(), &debugger.SetPauseOnExceptionsParams{State: "bogus"})
+	if !errors.As(err, &perr) || !reflect.DeepEqual(perr.Invalid, []string{"state"}) {
+		t.Errorf("invalid enum on struct path: got %v", err)
+	}
+
+	if len(s.Calls()) != 1 {
+		t.Errorf("invalid commands were sent: %v", s.Methods())
+	}
+}